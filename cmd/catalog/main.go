@@ -0,0 +1,149 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	_ "time/tzdata" // embeds the IANA timezones, the alpine images don't have them
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/gateway"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/cache"
+	appConfig "github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/database"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/datasource"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+const usage = `Usage:
+  catalog import -file <catalog.csv|json|xml> [-dry-run]
+  catalog export [-format csv|json|xml] [-out <file>]`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appCfg := appConfig.LoadConfig()
+
+	loggerInstance := logger.NewLogger(appCfg.Environment)
+
+	db, err := database.NewPostgresConnection(appCfg, loggerInstance)
+	if err != nil {
+		loggerInstance.Error("Failed to connect to database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	catalogGateway := gateway.NewCatalogGateway(datasource.NewCatalogDataSource(db.DB))
+	// The menu is cached on the server, the TTL bounds how long it serves the catalog imported here
+	catalogUC := usecase.NewCatalogUseCase(catalogGateway, cache.NewMenuCache(0))
+
+	switch os.Args[1] {
+	case "import":
+		err = importCatalog(ctx, catalogUC, os.Args[2:])
+	case "export":
+		err = exportCatalog(ctx, catalogUC, os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		loggerInstance.Error("Catalog command failed", "command", os.Args[1], "error", err.Error())
+		os.Exit(1)
+	}
+}
+
+// importCatalog reads the file with the same decoding of the import endpoint and prints the report,
+// failing when a row is invalid
+func importCatalog(ctx context.Context, uc port.CatalogUseCase, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	file := flags.String("file", "", "catalog file, the format is given by the extension: .csv, .json or .xml")
+	dryRun := flags.Bool("dry-run", false, "validate and report the changes without writing them")
+	_ = flags.Parse(args)
+
+	if *file == "" {
+		return fmt.Errorf("the -file flag is required")
+	}
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rows, err := handler.DecodeCatalog(contentTypeOf(*file), f)
+	if err != nil {
+		return err
+	}
+
+	catalogImport, err := uc.Import(ctx, dto.ImportCatalogInput{Rows: rows, DryRun: *dryRun})
+	if err != nil {
+		return err
+	}
+	output, err := presenter.NewCatalogJsonPresenter().Present(dto.PresenterInput{Result: catalogImport})
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(output))
+
+	if catalogImport.HasErrors() {
+		return fmt.Errorf("catalog has %d invalid rows, nothing was written", len(catalogImport.Errors))
+	}
+	return nil
+}
+
+// exportCatalog writes the catalog to the output file or to the stdout
+func exportCatalog(ctx context.Context, uc port.CatalogUseCase, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	format := flags.String("format", "csv", "catalog format: csv, json or xml")
+	out := flags.String("out", "", "output file, the stdout when empty")
+	_ = flags.Parse(args)
+
+	var p port.Presenter
+	switch *format {
+	case "csv":
+		p = presenter.NewCatalogCsvPresenter()
+	case "json":
+		p = presenter.NewCatalogJsonPresenter()
+	case "xml":
+		p = presenter.NewCatalogXmlPresenter()
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+
+	catalog, err := uc.Export(ctx)
+	if err != nil {
+		return err
+	}
+	output, err := p.Present(dto.PresenterInput{Result: catalog})
+	if err != nil {
+		return err
+	}
+	if *out == "" {
+		_, err = os.Stdout.Write(output)
+		return err
+	}
+	return os.WriteFile(*out, output, 0o644)
+}
+
+func contentTypeOf(file string) string {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".csv":
+		return "text/csv"
+	case ".xml":
+		return "text/xml"
+	}
+	return "application/json"
+}
@@ -1,17 +1,28 @@
 package main
 
 import (
+	"context"
 	"os"
+	"time"
+	_ "time/tzdata" // embeds the IANA timezones, the alpine images don't have them
 
 	_ "github.com/FIAP-SOAT-G20/tc4-order-service/docs"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/gateway"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/cache"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/database"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/datasource"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/event"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/ratelimit"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/replay"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/route"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/server"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
@@ -30,10 +41,20 @@ import (
 // @tag.description			List, create, update and delete customers
 // @tag.name					products
 // @tag.description			List, create, update and delete products
+// @tag.name					menu
+// @tag.description			Menu of the totem
+// @tag.name					catalog
+// @tag.description			Bulk import and export of the categories and products
 // @tag.name					orders
 // @tag.description			List, create, update and delete orders
+// @tag.name					kitchen
+// @tag.description			Kitchen tickets by station
+// @tag.name					pickup-board
+// @tag.description			Pickup codes shown on the lobby monitor
 // @tag.name					payments
 // @tag.description			Process payments
+// @tag.name					webhooks
+// @tag.description			Status updates sent by the partners and order events sent to the subscribers
 // @tag.name					staffs
 // @tag.description			List, create, update and delete staff
 // @tag.name					health-check
@@ -46,6 +67,11 @@ import (
 // @in							header
 // @name						Authorization
 // @description				Type "Bearer" followed by a space and the access token.
+//
+// @securityDefinitions.apikey	ApiKeyAuth
+// @in							header
+// @name						X-API-Key
+// @description				Key of the API client, for the administrative routes.
 func main() {
 	cfg := config.LoadConfig()
 
@@ -62,25 +88,89 @@ func main() {
 		os.Exit(1)
 	}
 
-	handlers := setupHandlers(db, cfg)
+	orderStatusMachine, err := config.LoadOrderStatusMachine(cfg.OrderStatusMachineFile)
+	if err != nil {
+		loggerInstance.Error("failed to load order status machine", "error", err.Error())
+		os.Exit(1)
+	}
+
+	notificationTemplates, err := config.LoadNotificationTemplates(cfg.NotificationTemplatesFile)
+	if err != nil {
+		loggerInstance.Error("failed to load notification templates", "error", err.Error())
+		os.Exit(1)
+	}
+
+	restaurantLocation, err := config.LoadRestaurantLocation(cfg.RestaurantTimezone)
+	if err != nil {
+		loggerInstance.Error("failed to load restaurant timezone", "error", err.Error())
+		os.Exit(1)
+	}
+
+	eventPublisher := event.NewPublisher(context.Background(), cfg.AWS_SQS_EventsURL, loggerInstance)
+
+	httpClient := httpclient.NewRestyClient(cfg, loggerInstance)
+
+	handlers := setupHandlers(db, cfg, loggerInstance, orderStatusMachine, notificationTemplates, restaurantLocation, eventPublisher, httpClient)
+
+	rateLimits, err := config.LoadRateLimits(cfg.RateLimits)
+	if err != nil {
+		loggerInstance.Error("failed to load rate limits", "error", err.Error())
+		os.Exit(1)
+	}
+
+	rateLimiter := middleware.NewRateLimiter(setupRateLimitStore(db, cfg, loggerInstance), service.NewJWTService(cfg), cfg.APIKeys, rateLimits, loggerInstance)
 
-	srv := server.NewServer(cfg, loggerInstance, handlers)
+	srv, err := server.NewServer(cfg, loggerInstance, handlers, rateLimiter)
+	if err != nil {
+		loggerInstance.Error("failed to create server", "error", err.Error())
+		os.Exit(1)
+	}
 	if err := srv.Start(); err != nil {
 		loggerInstance.Error("server failed to start", "error", err.Error())
 		os.Exit(1)
 	}
 }
 
-func setupHandlers(db *database.Database, cfg *config.Config) *route.Handlers {
+// setupRateLimitStore creates the store of the rate limit buckets, the postgres store shares the limits between the instances
+func setupRateLimitStore(db *database.Database, cfg *config.Config, loggerInstance *logger.Logger) port.RateLimitStore {
+	if cfg.RateLimitStore == "postgres" {
+		return ratelimit.NewPostgresStore(db.DB, loggerInstance)
+	}
+	return ratelimit.NewMemoryStore()
+}
+
+// setupWebhookReplayStore creates the store of the received webhook requests, the postgres store shares them between the instances
+func setupWebhookReplayStore(db *database.Database, cfg *config.Config, loggerInstance *logger.Logger) port.WebhookReplayStore {
+	if cfg.WebhookReplayStore == "postgres" {
+		return replay.NewPostgresStore(db.DB, loggerInstance)
+	}
+	return replay.NewMemoryStore()
+}
+
+func setupHandlers(db *database.Database, cfg *config.Config, loggerInstance *logger.Logger, orderStatusMachine *valueobject.OrderStatusMachine, notificationTemplates valueobject.NotificationTemplates, restaurantLocation *time.Location, eventPublisher port.EventPublisher, httpClient *httpclient.HTTPClient) *route.Handlers {
 	// Datasources
 	productDS := datasource.NewProductDataSource(db.DB)
 	orderDS := datasource.NewOrderDataSource(db.DB)
 	orderProductDS := datasource.NewOrderProductDataSource(db.DB)
 	orderHistoryDS := datasource.NewOrderHistoryDataSource(db.DB)
 	categoryDS := datasource.NewCategoryDataSource(db.DB)
+	promotionDS := datasource.NewPromotionDataSource(db.DB)
+	catalogDS := datasource.NewCatalogDataSource(db.DB)
+	kitchenTicketDS := datasource.NewKitchenTicketDataSource(db.DB)
+	webhookSubscriptionDS := datasource.NewWebhookSubscriptionDataSource(db.DB)
+	webhookDeliveryDS := datasource.NewWebhookDeliveryDataSource(db.DB)
+	notificationPreferenceDS := datasource.NewNotificationPreferenceDataSource(db.DB)
 
 	// Services
 	jwtService := service.NewJWTService(cfg)
+	paymentService := service.NewPaymentService(cfg, httpClient)
+	webhookSender := service.NewWebhookSender(httpclient.NewWebhookClient(cfg, loggerInstance), cfg.WebhookAllowPrivateNetworks)
+	notificationSenders := service.NewNotificationSenders(cfg.NotificationSinkFile, loggerInstance)
+	webhookSignatureConfig := middleware.WebhookSignatureConfig{
+		Tolerance:    cfg.WebhookTimestampTolerance,
+		MaxBodyBytes: cfg.WebhookMaxBodyBytes,
+		ReplayStore:  setupWebhookReplayStore(db, cfg, loggerInstance),
+	}
 
 	// Gateways
 	productGateway := gateway.NewProductGateway(productDS)
@@ -88,13 +178,43 @@ func setupHandlers(db *database.Database, cfg *config.Config) *route.Handlers {
 	orderGateway := gateway.NewOrderGateway(orderDS)
 	orderProductGateway := gateway.NewOrderProductGateway(orderProductDS)
 	categoryGateway := gateway.NewCategoryGateway(categoryDS)
+	promotionGateway := gateway.NewPromotionGateway(promotionDS)
+	catalogGateway := gateway.NewCatalogGateway(catalogDS)
+	kitchenTicketGateway := gateway.NewKitchenTicketGateway(kitchenTicketDS)
+	webhookSubscriptionGateway := gateway.NewWebhookSubscriptionGateway(webhookSubscriptionDS)
+	webhookDeliveryGateway := gateway.NewWebhookDeliveryGateway(webhookDeliveryDS)
+	notificationPreferenceGateway := gateway.NewNotificationPreferenceGateway(notificationPreferenceDS)
+
+	// Caches
+	menuCache := cache.NewMenuCache(cfg.MenuCacheTTL)
 
 	// Use cases
-	productUC := usecase.NewProductUseCase(productGateway)
+	productUC := usecase.NewProductUseCase(productGateway, menuCache)
 	orderHistoryUC := usecase.NewOrderHistoryUseCase(orderHistoryGateway)
-	orderUC := usecase.NewOrderUseCase(orderGateway, orderHistoryUC)
-	orderProductUC := usecase.NewOrderProductUseCase(orderProductGateway)
-	categoryUC := usecase.NewCategoryUseCase(categoryGateway)
+	stockUC := usecase.NewStockUseCase(productGateway, eventPublisher, menuCache)
+	productPriceUC := usecase.NewProductPriceUseCase(productGateway, menuCache)
+	kitchenRoutingUC := usecase.NewKitchenRoutingUseCase(kitchenTicketGateway, productGateway, categoryGateway)
+	webhookSubscriptionUC := usecase.NewWebhookSubscriptionUseCase(webhookSubscriptionGateway)
+	webhookDeliveryUC := usecase.NewWebhookDeliveryUseCase(webhookDeliveryGateway, webhookSubscriptionGateway, webhookSender)
+	notificationUC := usecase.NewNotificationUseCase(notificationPreferenceGateway, notificationTemplates, notificationSenders)
+	// The order events go to the events queue, to the webhook subscribers and to the notified customers
+	orderEventPublisher := event.NewMultiPublisher(
+		eventPublisher,
+		event.NewWebhookPublisher(webhookDeliveryUC, loggerInstance),
+		event.NewNotificationPublisher(notificationUC, loggerInstance),
+	)
+	orderUC := usecase.NewOrderUseCase(orderGateway, orderHistoryGateway, stockUC, kitchenRoutingUC, orderEventPublisher, orderStatusMachine, restaurantLocation)
+	kitchenTicketUC := usecase.NewKitchenTicketUseCase(kitchenTicketGateway, orderUC)
+	pickupBoardUC := usecase.NewPickupBoardUseCase(orderGateway, restaurantLocation)
+	promotionUC := usecase.NewPromotionUseCase(promotionGateway, orderGateway)
+	orderProductUC := usecase.NewOrderProductUseCase(orderProductGateway, orderGateway, productGateway, categoryGateway, promotionUC)
+	categoryUC := usecase.NewCategoryUseCase(categoryGateway, menuCache)
+	menuUC := usecase.NewMenuUseCase(categoryGateway, productGateway, menuCache)
+	catalogUC := usecase.NewCatalogUseCase(catalogGateway, menuCache)
+	reorderUC := usecase.NewReorderUseCase(orderUC, orderProductUC)
+	paymentUC := usecase.NewPaymentUseCase(orderGateway, orderUC, paymentService)
+	orderStatusUpdatedUC := usecase.NewOrderStatusUpdatedUseCase(orderUC)
+	notificationPreferenceUC := usecase.NewNotificationPreferenceUseCase(notificationPreferenceGateway)
 
 	// Controllers
 	productController := controller.NewProductController(productUC)
@@ -102,24 +222,63 @@ func setupHandlers(db *database.Database, cfg *config.Config) *route.Handlers {
 	orderProductController := controller.NewOrderProductController(orderProductUC)
 	orderHistoryController := controller.NewOrderHistoryController(orderHistoryUC)
 	categoryController := controller.NewCategoryController(categoryUC)
+	promotionController := controller.NewPromotionController(promotionUC)
+	stockController := controller.NewStockController(stockUC)
+	productPriceController := controller.NewProductPriceController(productPriceUC)
+	menuController := controller.NewMenuController(menuUC)
+	catalogController := controller.NewCatalogController(catalogUC)
+	kitchenTicketController := controller.NewKitchenTicketController(kitchenTicketUC)
+	pickupBoardController := controller.NewPickupBoardController(pickupBoardUC)
+	reorderController := controller.NewReorderController(reorderUC)
+	paymentController := controller.NewPaymentController(paymentUC)
+	orderStatusUpdatedController := controller.NewOrderStatusUpdatedController(orderStatusUpdatedUC)
+	webhookSubscriptionController := controller.NewWebhookSubscriptionController(webhookSubscriptionUC)
+	webhookDeliveryController := controller.NewWebhookDeliveryController(webhookDeliveryUC)
+	notificationPreferenceController := controller.NewNotificationPreferenceController(notificationPreferenceUC)
 
 	// Handlers
 	productHandler := handler.NewProductHandler(productController)
-	orderHandler := handler.NewOrderHandler(orderController, jwtService)
+	orderHandler := handler.NewOrderHandler(orderController, jwtService, cfg.APIKeys, restaurantLocation)
 	orderProductHandler := handler.NewOrderProductHandler(orderProductController)
 	healthCheckHandler := handler.NewHealthCheckHandler()
 	orderHistoryHandler := handler.NewOrderHistoryHandler(orderHistoryController, jwtService)
 	categoryHandler := handler.NewCategoryHandler(categoryController)
+	promotionHandler := handler.NewPromotionHandler(promotionController)
+	stockHandler := handler.NewStockHandler(stockController)
+	productPriceHandler := handler.NewProductPriceHandler(productPriceController)
+	menuHandler := handler.NewMenuHandler(menuController)
+	catalogHandler := handler.NewCatalogHandler(catalogController)
+	kitchenTicketHandler := handler.NewKitchenTicketHandler(kitchenTicketController)
+	pickupBoardHandler := handler.NewPickupBoardHandler(pickupBoardController)
+	reorderHandler := handler.NewReorderHandler(reorderController, jwtService)
+	paymentHandler := handler.NewPaymentHandler(paymentController, cfg.PaymentCallbackSecret, webhookSignatureConfig)
+	webhookHandler := handler.NewWebhookHandler(orderStatusUpdatedController, cfg.WebhookPartnerSecrets, webhookSignatureConfig)
+	webhookSubscriptionHandler := handler.NewWebhookSubscriptionHandler(webhookSubscriptionController, cfg.APIKeys)
+	webhookDeliveryHandler := handler.NewWebhookDeliveryHandler(webhookDeliveryController, cfg.APIKeys)
+	notificationPreferenceHandler := handler.NewNotificationPreferenceHandler(notificationPreferenceController, jwtService)
 	redocHandler := handler.NewRedocHandler()
 
 	handlers := &route.Handlers{
-		Product:      productHandler,
-		Order:        orderHandler,
-		OrderProduct: orderProductHandler,
-		OrderHistory: orderHistoryHandler,
-		HealthCheck:  healthCheckHandler,
-		Category:     categoryHandler,
-		Redoc:        redocHandler,
+		Product:                productHandler,
+		Order:                  orderHandler,
+		OrderProduct:           orderProductHandler,
+		OrderHistory:           orderHistoryHandler,
+		Reorder:                reorderHandler,
+		Payment:                paymentHandler,
+		Webhook:                webhookHandler,
+		WebhookSubscription:    webhookSubscriptionHandler,
+		WebhookDelivery:        webhookDeliveryHandler,
+		NotificationPreference: notificationPreferenceHandler,
+		HealthCheck:            healthCheckHandler,
+		Category:               categoryHandler,
+		Promotion:              promotionHandler,
+		Stock:                  stockHandler,
+		ProductPrice:           productPriceHandler,
+		Menu:                   menuHandler,
+		Catalog:                catalogHandler,
+		KitchenTicket:          kitchenTicketHandler,
+		PickupBoard:            pickupBoardHandler,
+		Redoc:                  redocHandler,
 	}
 
 	return handlers
@@ -5,18 +5,24 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	_ "time/tzdata" // embeds the IANA timezones, the alpine images don't have them
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/gateway"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/cache"
 	appConfig "github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/database"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/datasource"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/event"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/aws/sqs"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
 	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
 )
 
@@ -37,10 +43,52 @@ func main() {
 
 	orderDS := datasource.NewOrderDataSource(db.DB)
 	orderHistoryDS := datasource.NewOrderHistoryDataSource(db.DB)
+	productDS := datasource.NewProductDataSource(db.DB)
+	categoryDS := datasource.NewCategoryDataSource(db.DB)
+	kitchenTicketDS := datasource.NewKitchenTicketDataSource(db.DB)
+	webhookSubscriptionDS := datasource.NewWebhookSubscriptionDataSource(db.DB)
+	webhookDeliveryDS := datasource.NewWebhookDeliveryDataSource(db.DB)
+	notificationPreferenceDS := datasource.NewNotificationPreferenceDataSource(db.DB)
 	orderGateway := gateway.NewOrderGateway(orderDS)
 	orderHistoryGateway := gateway.NewOrderHistoryGateway(orderHistoryDS)
-	orderHistoryUC := usecase.NewOrderHistoryUseCase(orderHistoryGateway)
-	orderUC := usecase.NewOrderUseCase(orderGateway, orderHistoryUC)
+	productGateway := gateway.NewProductGateway(productDS)
+	categoryGateway := gateway.NewCategoryGateway(categoryDS)
+	kitchenTicketGateway := gateway.NewKitchenTicketGateway(kitchenTicketDS)
+	webhookSubscriptionGateway := gateway.NewWebhookSubscriptionGateway(webhookSubscriptionDS)
+	webhookDeliveryGateway := gateway.NewWebhookDeliveryGateway(webhookDeliveryDS)
+	notificationPreferenceGateway := gateway.NewNotificationPreferenceGateway(notificationPreferenceDS)
+
+	orderStatusMachine, err := appConfig.LoadOrderStatusMachine(appCfg.OrderStatusMachineFile)
+	if err != nil {
+		loggerInstance.Error("Failed to load order status machine", "error", err.Error())
+		os.Exit(1)
+	}
+	notificationTemplates, err := appConfig.LoadNotificationTemplates(appCfg.NotificationTemplatesFile)
+	if err != nil {
+		loggerInstance.Error("Failed to load notification templates", "error", err.Error())
+		os.Exit(1)
+	}
+	restaurantLocation, err := appConfig.LoadRestaurantLocation(appCfg.RestaurantTimezone)
+	if err != nil {
+		loggerInstance.Error("Failed to load restaurant timezone", "error", err.Error())
+		os.Exit(1)
+	}
+	eventPublisher := event.NewPublisher(ctx, appCfg.AWS_SQS_EventsURL, loggerInstance)
+	// The menu is cached on the server, the TTL bounds how long it serves the stock changed here
+	stockUC := usecase.NewStockUseCase(productGateway, eventPublisher, cache.NewMenuCache(0))
+	kitchenRoutingUC := usecase.NewKitchenRoutingUseCase(kitchenTicketGateway, productGateway, categoryGateway)
+	webhookSender := service.NewWebhookSender(httpclient.NewWebhookClient(appCfg, loggerInstance), appCfg.WebhookAllowPrivateNetworks)
+	webhookDeliveryUC := usecase.NewWebhookDeliveryUseCase(webhookDeliveryGateway, webhookSubscriptionGateway, webhookSender)
+	notificationSenders := service.NewNotificationSenders(appCfg.NotificationSinkFile, loggerInstance)
+	notificationUC := usecase.NewNotificationUseCase(notificationPreferenceGateway, notificationTemplates, notificationSenders)
+	// The order events go to the events queue, to the webhook subscribers and to the notified customers
+	orderEventPublisher := event.NewMultiPublisher(
+		eventPublisher,
+		event.NewWebhookPublisher(webhookDeliveryUC, loggerInstance),
+		event.NewNotificationPublisher(notificationUC, loggerInstance),
+	)
+	orderUC := usecase.NewOrderUseCase(orderGateway, orderHistoryGateway, stockUC, kitchenRoutingUC, orderEventPublisher, orderStatusMachine, restaurantLocation)
+	orderStatusUpdatedUC := usecase.NewOrderStatusUpdatedUseCase(orderUC)
 
 	if appCfg.AWS_SQS_OrderStatusUpdatedURL == "" {
 		loggerInstance.Error("AWS SQS Order Status Updated URL is not configured")
@@ -68,7 +116,7 @@ func main() {
 		err = sqsHandler.ReceiveMessages(ctx, func(message types.Message) (bool, error) {
 			loggerInstance.Info("Processing message", "message", message)
 
-			reprocess, err := processedMessage(ctx, message, loggerInstance, orderUC)
+			reprocess, err := processedMessage(ctx, message, loggerInstance, orderStatusUpdatedUC)
 			if err != nil {
 				loggerInstance.Error("Failed to process message", "error", err.Error(), "messageID", *message.MessageId)
 				return reprocess, err
@@ -82,9 +130,7 @@ func main() {
 	}
 }
 
-func processedMessage(ctx context.Context, message types.Message, logger *logger.Logger, uc port.OrderUseCase) (reprocess bool, err error) {
-	// Here you can implement the logic to process the message
-	// For example, you can unmarshal the message body and update the order status in your database
+func processedMessage(ctx context.Context, message types.Message, logger *logger.Logger, uc port.OrderStatusUpdatedUseCase) (reprocess bool, err error) {
 	logger.Info("Processing message", "messageID", *message.MessageId, "body", *message.Body)
 
 	// Unmarshal the message body to your entity
@@ -94,37 +140,16 @@ func processedMessage(ctx context.Context, message types.Message, logger *logger
 		return false, err
 	}
 
-	if updatedOrderStatus.OrderID == 0 {
-		return false, domain.NewValidationError(errors.New(domain.ErrOrderIsMandatory))
-	}
-
-	if updatedOrderStatus.Status == "" {
-		return false, domain.NewValidationError(errors.New(domain.ErrStatusIsMandatory))
-	}
-
-	// Get Order by ID
-	_, err = uc.Get(ctx, dto.GetOrderInput{ID: updatedOrderStatus.OrderID})
+	// The webhook goes through the same use case, only the source differs
+	_, err = uc.Process(ctx, dto.ProcessOrderStatusUpdatedInput{
+		Event:  updatedOrderStatus,
+		Source: valueobject.SourceSQS,
+	})
 	if err != nil {
-		if err.Error() == domain.ErrInternalError {
-			return true, err
-		}
-		return false, err
-	}
-
-	// Update the order status in the database
-	uoi := dto.UpdateOrderInput{
-		ID:     updatedOrderStatus.OrderID,
-		Status: updatedOrderStatus.Status,
-	}
-	if updatedOrderStatus.StaffID != nil {
-		uoi.StaffID = *updatedOrderStatus.StaffID
-	}
-	_, err = uc.Update(ctx, uoi)
-	if err != nil {
-		if err.Error() == domain.ErrInternalError {
-			return true, err
-		}
-		return false, err
+		// Internal errors and concurrent updates may succeed on the next delivery
+		var internalErr *domain.InternalError
+		var conflictErr *domain.ConflictError
+		return errors.As(err, &internalErr) || errors.As(err, &conflictErr), err
 	}
 
 	logger.Info("Message processed successfully", "orderID", updatedOrderStatus.OrderID, "status", updatedOrderStatus.Status, "staffID", updatedOrderStatus.StaffID)
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // embeds the IANA timezones, the alpine images don't have them
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/gateway"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	appConfig "github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/database"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/datasource"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appCfg := appConfig.LoadConfig()
+
+	loggerInstance := logger.NewLogger(appCfg.Environment)
+
+	if appCfg.WebhookDispatchInterval <= 0 {
+		loggerInstance.Error("Webhook dispatch interval must be greater than zero")
+		os.Exit(1)
+	}
+
+	db, err := database.NewPostgresConnection(appCfg, loggerInstance)
+	if err != nil {
+		loggerInstance.Error("Failed to connect to database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	webhookSubscriptionDS := datasource.NewWebhookSubscriptionDataSource(db.DB)
+	webhookDeliveryDS := datasource.NewWebhookDeliveryDataSource(db.DB)
+	webhookSubscriptionGateway := gateway.NewWebhookSubscriptionGateway(webhookSubscriptionDS)
+	webhookDeliveryGateway := gateway.NewWebhookDeliveryGateway(webhookDeliveryDS)
+	webhookSender := service.NewWebhookSender(httpclient.NewWebhookClient(appCfg, loggerInstance), appCfg.WebhookAllowPrivateNetworks)
+	webhookDeliveryUC := usecase.NewWebhookDeliveryUseCase(webhookDeliveryGateway, webhookSubscriptionGateway, webhookSender)
+
+	input := dto.DispatchWebhooksInput{
+		Limit:       appCfg.WebhookDispatchBatchSize,
+		Lease:       appCfg.WebhookDispatchLease,
+		MaxAttempts: uint32(appCfg.WebhookMaxAttempts),
+		Backoff:     appCfg.WebhookRetryBackoff,
+		MaxFailures: uint32(appCfg.WebhookMaxFailures),
+	}
+
+	loggerInstance.Info("Starting webhook dispatcher",
+		"interval", appCfg.WebhookDispatchInterval.String(),
+		"maxAttempts", appCfg.WebhookMaxAttempts,
+		"retryBackoff", appCfg.WebhookRetryBackoff.String(),
+	)
+
+	ticker := time.NewTicker(appCfg.WebhookDispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		dispatchWebhooks(ctx, webhookDeliveryUC, input, loggerInstance)
+
+		select {
+		case <-ctx.Done():
+			loggerInstance.Info("Stopping webhook dispatcher")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func dispatchWebhooks(ctx context.Context, uc port.WebhookDeliveryUseCase, input dto.DispatchWebhooksInput, logger *logger.Logger) {
+	deliveries, err := uc.Dispatch(ctx, input)
+	for _, delivery := range deliveries {
+		logger.Info("Webhook dispatched",
+			"deliveryID", delivery.ID,
+			"subscriptionID", delivery.SubscriptionID,
+			"status", delivery.Status.String(),
+			"attempts", delivery.Attempts,
+		)
+	}
+	if err != nil {
+		logger.Error("Failed to dispatch webhooks", "error", err.Error())
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+	_ "time/tzdata" // embeds the IANA timezones, the alpine images don't have them
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/gateway"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/cache"
+	appConfig "github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/database"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/datasource"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/event"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appCfg := appConfig.LoadConfig()
+
+	loggerInstance := logger.NewLogger(appCfg.Environment)
+
+	if appCfg.SchedulerInterval <= 0 {
+		loggerInstance.Error("Scheduler interval must be greater than zero")
+		os.Exit(1)
+	}
+
+	db, err := database.NewPostgresConnection(appCfg, loggerInstance)
+	if err != nil {
+		loggerInstance.Error("Failed to connect to database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	orderStatusMachine, err := appConfig.LoadOrderStatusMachine(appCfg.OrderStatusMachineFile)
+	if err != nil {
+		loggerInstance.Error("Failed to load order status machine", "error", err.Error())
+		os.Exit(1)
+	}
+	notificationTemplates, err := appConfig.LoadNotificationTemplates(appCfg.NotificationTemplatesFile)
+	if err != nil {
+		loggerInstance.Error("Failed to load notification templates", "error", err.Error())
+		os.Exit(1)
+	}
+	restaurantLocation, err := appConfig.LoadRestaurantLocation(appCfg.RestaurantTimezone)
+	if err != nil {
+		loggerInstance.Error("Failed to load restaurant timezone", "error", err.Error())
+		os.Exit(1)
+	}
+
+	orderDS := datasource.NewOrderDataSource(db.DB)
+	orderHistoryDS := datasource.NewOrderHistoryDataSource(db.DB)
+	productDS := datasource.NewProductDataSource(db.DB)
+	categoryDS := datasource.NewCategoryDataSource(db.DB)
+	kitchenTicketDS := datasource.NewKitchenTicketDataSource(db.DB)
+	webhookSubscriptionDS := datasource.NewWebhookSubscriptionDataSource(db.DB)
+	webhookDeliveryDS := datasource.NewWebhookDeliveryDataSource(db.DB)
+	notificationPreferenceDS := datasource.NewNotificationPreferenceDataSource(db.DB)
+	orderGateway := gateway.NewOrderGateway(orderDS)
+	orderHistoryGateway := gateway.NewOrderHistoryGateway(orderHistoryDS)
+	productGateway := gateway.NewProductGateway(productDS)
+	categoryGateway := gateway.NewCategoryGateway(categoryDS)
+	kitchenTicketGateway := gateway.NewKitchenTicketGateway(kitchenTicketDS)
+	webhookSubscriptionGateway := gateway.NewWebhookSubscriptionGateway(webhookSubscriptionDS)
+	webhookDeliveryGateway := gateway.NewWebhookDeliveryGateway(webhookDeliveryDS)
+	notificationPreferenceGateway := gateway.NewNotificationPreferenceGateway(notificationPreferenceDS)
+	eventPublisher := event.NewPublisher(ctx, appCfg.AWS_SQS_EventsURL, loggerInstance)
+	// The menu is cached on the server, the TTL bounds how long it serves the stock changed here
+	stockUC := usecase.NewStockUseCase(productGateway, eventPublisher, cache.NewMenuCache(0))
+	kitchenRoutingUC := usecase.NewKitchenRoutingUseCase(kitchenTicketGateway, productGateway, categoryGateway)
+	webhookSender := service.NewWebhookSender(httpclient.NewWebhookClient(appCfg, loggerInstance), appCfg.WebhookAllowPrivateNetworks)
+	webhookDeliveryUC := usecase.NewWebhookDeliveryUseCase(webhookDeliveryGateway, webhookSubscriptionGateway, webhookSender)
+	notificationSenders := service.NewNotificationSenders(appCfg.NotificationSinkFile, loggerInstance)
+	notificationUC := usecase.NewNotificationUseCase(notificationPreferenceGateway, notificationTemplates, notificationSenders)
+	// The order events go to the events queue, to the webhook subscribers and to the notified customers
+	orderEventPublisher := event.NewMultiPublisher(
+		eventPublisher,
+		event.NewWebhookPublisher(webhookDeliveryUC, loggerInstance),
+		event.NewNotificationPublisher(notificationUC, loggerInstance),
+	)
+	orderUC := usecase.NewOrderUseCase(orderGateway, orderHistoryGateway, stockUC, kitchenRoutingUC, orderEventPublisher, orderStatusMachine, restaurantLocation)
+
+	input := dto.ExpireIdleOrdersInput{
+		TTLs: map[valueobject.OrderStatus]time.Duration{
+			valueobject.OPEN:    appCfg.SchedulerOpenOrderTTL,
+			valueobject.PENDING: appCfg.SchedulerPendingOrderTTL,
+		},
+		Limit: appCfg.SchedulerBatchSize,
+	}
+
+	loggerInstance.Info("Starting scheduler",
+		"interval", appCfg.SchedulerInterval.String(),
+		"openOrderTTL", appCfg.SchedulerOpenOrderTTL.String(),
+		"pendingOrderTTL", appCfg.SchedulerPendingOrderTTL.String(),
+	)
+
+	ticker := time.NewTicker(appCfg.SchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		expireIdleOrders(ctx, orderUC, input, loggerInstance)
+
+		select {
+		case <-ctx.Done():
+			loggerInstance.Info("Stopping scheduler")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func expireIdleOrders(ctx context.Context, uc port.OrderUseCase, input dto.ExpireIdleOrdersInput, logger *logger.Logger) {
+	orders, err := uc.ExpireIdle(ctx, input)
+	for _, order := range orders {
+		logger.Info("Order expired", "orderID", order.ID)
+	}
+	if err != nil {
+		logger.Error("Failed to expire idle orders", "error", err.Error())
+	}
+}
@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogController struct {
+	useCase port.CatalogUseCase
+}
+
+func NewCatalogController(useCase port.CatalogUseCase) port.CatalogController {
+	return &catalogController{useCase}
+}
+
+func (c *catalogController) Import(ctx context.Context, p port.Presenter, i dto.ImportCatalogInput) ([]byte, error) {
+	catalogImport, err := c.useCase.Import(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: catalogImport})
+}
+
+func (c *catalogController) Export(ctx context.Context, p port.Presenter) ([]byte, error) {
+	catalog, err := c.useCase.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: catalog})
+}
@@ -0,0 +1,163 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestCatalogController_Import(t *testing.T) {
+	input := dto.ImportCatalogInput{
+		Rows: []dto.CatalogRowInput{
+			{Row: 1, Category: "Burgers", ParentCategory: "Foods", Name: "X-Burger", Description: "Hamburger with cheese", Price: 25.9},
+			{Row: 2, Category: "Beverages", Name: "Water", Price: 0},
+		},
+		DryRun: true,
+	}
+	mockImport := &entity.CatalogImport{
+		DryRun: true,
+		Rows:   2,
+		Categories: []*entity.CatalogCategory{
+			{Row: 1, Category: &entity.Category{Name: "Burgers", Active: true}, New: true},
+		},
+		Products: []*entity.CatalogProduct{
+			{Row: 1, Product: &entity.Product{Name: "X-Burger"}, New: true},
+		},
+		Errors: []entity.CatalogImportError{
+			{Row: 2, Message: "price must be greater than zero"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "Import catalog report - json",
+			presenter: presenter.NewCatalogJsonPresenter(),
+			golden:    "catalog/import_report",
+		},
+		{
+			name:      "Import catalog report - xml",
+			presenter: presenter.NewCatalogXmlPresenter(),
+			golden:    "catalog/import_report_xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockCatalogUseCase := mockport.NewMockCatalogUseCase(ctrl)
+			controller := controller.NewCatalogController(mockCatalogUseCase)
+
+			mockCatalogUseCase.EXPECT().
+				Import(ctx, input).
+				Return(mockImport, nil)
+
+			output, err := controller.Import(ctx, tt.presenter, input)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestCatalogController_Export(t *testing.T) {
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	quantity := int64(0)
+	available := true
+	mockCatalog := &entity.Catalog{
+		Rows: []entity.CatalogRow{
+			{Row: 1, Category: "Foods"},
+			{
+				Row:            2,
+				Category:       "Burgers",
+				ParentCategory: "Foods",
+				Name:           "X-Burger",
+				Description:    "Hamburger with cheese",
+				Price:          25.9,
+				StockMode:      valueobject.StockUnlimited,
+				StockQuantity:  &quantity,
+				Available:      &available,
+			},
+		},
+		GeneratedAt: mockDate,
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "Export catalog success - json",
+			presenter: presenter.NewCatalogJsonPresenter(),
+			golden:    "catalog/export_success",
+		},
+		{
+			name:      "Export catalog success - xml",
+			presenter: presenter.NewCatalogXmlPresenter(),
+			golden:    "catalog/export_success_xml",
+		},
+		{
+			name:      "Export catalog success - csv",
+			presenter: presenter.NewCatalogCsvPresenter(),
+			golden:    "catalog/export_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockCatalogUseCase := mockport.NewMockCatalogUseCase(ctrl)
+			controller := controller.NewCatalogController(mockCatalogUseCase)
+
+			mockCatalogUseCase.EXPECT().
+				Export(ctx).
+				Return(mockCatalog, nil)
+
+			output, err := controller.Export(ctx, tt.presenter)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestCatalogController_Export_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockCatalogUseCase := mockport.NewMockCatalogUseCase(ctrl)
+	controller := controller.NewCatalogController(mockCatalogUseCase)
+
+	mockCatalogUseCase.EXPECT().
+		Export(ctx).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Export(ctx, presenter.NewCatalogJsonPresenter())
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -32,24 +32,30 @@ func (s *CategoryControllerSuiteTest) SetupTest() {
 	mockDateAt2, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28-03:00")
 	mockDateAt3, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:58-03:00")
 	s.mockCategory = &entity.Category{
-		ID:        6,
-		Name:      "Foods",
-		CreatedAt: mockDateAt2,
-		UpdatedAt: mockDateAt3,
+		ID:           6,
+		Name:         "Foods",
+		DisplayOrder: 1,
+		Active:       true,
+		CreatedAt:    mockDateAt2,
+		UpdatedAt:    mockDateAt3,
 	}
 	mockDateAt, _ := time.Parse(time.RFC3339, "2025-02-28T16:28:18Z")
 	s.mockCategories = []*entity.Category{
 		{
-			ID:        1,
-			Name:      "Foods",
-			CreatedAt: mockDateAt,
-			UpdatedAt: mockDateAt,
+			ID:           1,
+			Name:         "Foods",
+			DisplayOrder: 1,
+			Active:       true,
+			CreatedAt:    mockDateAt,
+			UpdatedAt:    mockDateAt,
 		},
 		{
-			ID:        2,
-			Name:      "Beverages",
-			CreatedAt: mockDateAt,
-			UpdatedAt: mockDateAt,
+			ID:           2,
+			Name:         "Beverages",
+			DisplayOrder: 2,
+			Active:       true,
+			CreatedAt:    mockDateAt,
+			UpdatedAt:    mockDateAt,
 		},
 	}
 	s.controller = controller.NewCategoryController(s.mockUseCase)
@@ -3,8 +3,10 @@ package controller_test
 import (
 	"testing"
 
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
 	"github.com/stretchr/testify/assert"
 )
@@ -130,10 +132,12 @@ func (s *CategoryControllerSuiteTest) TestCategoryController_GetCategory() {
 
 func (s *CategoryControllerSuiteTest) TestCategoryController_UpdateCategory() {
 	categoryUpdated := &entity.Category{
-		ID:        6,
-		Name:      "Foods UPDATED",
-		CreatedAt: s.mockCategory.CreatedAt,
-		UpdatedAt: s.mockCategory.UpdatedAt,
+		ID:           6,
+		Name:         "Foods UPDATED",
+		DisplayOrder: 1,
+		Active:       true,
+		CreatedAt:    s.mockCategory.CreatedAt,
+		UpdatedAt:    s.mockCategory.UpdatedAt,
 	}
 	tests := []struct {
 		name        string
@@ -284,3 +288,40 @@ func (s *CategoryControllerSuiteTest) TestCategoryController_CreateCategory() {
 		})
 	}
 }
+
+func (s *CategoryControllerSuiteTest) TestCategoryController_ListCategoriesFormats() {
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List categories success - xml",
+			presenter: presenter.NewCategoryXmlPresenter(),
+			golden:    "category/list_success_xml",
+		},
+		{
+			name:      "List categories success - csv",
+			presenter: presenter.NewCategoryCsvPresenter(),
+			golden:    "category/list_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			input := dto.ListCategoriesInput{Page: 1, Limit: 10}
+			s.mockUseCase.EXPECT().
+				List(s.ctx, input).
+				Return(s.mockCategories, int64(2), nil)
+
+			// Act
+			output, err := s.controller.List(s.ctx, tt.presenter, input)
+
+			// Assert
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenTicketController struct {
+	useCase port.KitchenTicketUseCase
+}
+
+func NewKitchenTicketController(useCase port.KitchenTicketUseCase) port.KitchenTicketController {
+	return &kitchenTicketController{useCase}
+}
+
+func (c *kitchenTicketController) List(ctx context.Context, p port.Presenter, i dto.ListKitchenTicketsInput) ([]byte, error) {
+	tickets, total, err := c.useCase.List(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: tickets,
+	})
+}
+
+func (c *kitchenTicketController) Update(ctx context.Context, p port.Presenter, i dto.UpdateKitchenTicketInput) ([]byte, error) {
+	ticket, err := c.useCase.Update(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: ticket})
+}
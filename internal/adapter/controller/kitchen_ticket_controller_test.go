@@ -0,0 +1,139 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestKitchenTicketController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockKitchenTicketUseCase := mockport.NewMockKitchenTicketUseCase(ctrl)
+	controller := controller.NewKitchenTicketController(mockKitchenTicketUseCase)
+
+	ctx := context.Background()
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	startedAt := mockDate.Add(5 * time.Minute)
+	staffID := uint64(7)
+	input := dto.ListKitchenTicketsInput{Station: valueobject.StationGrill, Page: 1, Limit: 10}
+	mockTickets := []*entity.KitchenTicket{
+		{
+			ID: 1, OrderID: 1, Station: valueobject.StationGrill, Status: valueobject.TicketInProgress,
+			StaffID: &staffID, StartedAt: &startedAt,
+			Items: []entity.KitchenTicketItem{
+				{ID: 1, KitchenTicketID: 1, OrderProductID: 1, ProductID: 1, Name: "X-Burger", Quantity: 2, Modifiers: "Extra cheese, No onion", Notes: "Well done"},
+			},
+			CreatedAt: mockDate, UpdatedAt: startedAt,
+		},
+		{
+			ID: 3, OrderID: 2, Station: valueobject.StationGrill, Status: valueobject.TicketQueued,
+			Items: []entity.KitchenTicketItem{
+				{ID: 4, KitchenTicketID: 3, OrderProductID: 5, ProductID: 1, Name: "X-Burger", Quantity: 1},
+				{ID: 5, KitchenTicketID: 3, OrderProductID: 6, ProductID: 3, Name: "X-Bacon", Quantity: 1},
+			},
+			CreatedAt: mockDate, UpdatedAt: mockDate,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List kitchen tickets success",
+			presenter: presenter.NewKitchenTicketJsonPresenter(),
+			golden:    "kitchen_ticket/list_success",
+		},
+		{
+			name:      "List kitchen tickets success - xml",
+			presenter: presenter.NewKitchenTicketXmlPresenter(),
+			golden:    "kitchen_ticket/list_success_xml",
+		},
+		{
+			name:      "List kitchen tickets success - csv",
+			presenter: presenter.NewKitchenTicketCsvPresenter(),
+			golden:    "kitchen_ticket/list_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockKitchenTicketUseCase.EXPECT().
+				List(ctx, input).
+				Return(mockTickets, int64(2), nil)
+
+			output, err := controller.List(ctx, tt.presenter, input)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestKitchenTicketController_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockKitchenTicketUseCase := mockport.NewMockKitchenTicketUseCase(ctrl)
+	controller := controller.NewKitchenTicketController(mockKitchenTicketUseCase)
+
+	ctx := context.Background()
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	startedAt := mockDate.Add(5 * time.Minute)
+	doneAt := mockDate.Add(12 * time.Minute)
+	staffID := uint64(7)
+	input := dto.UpdateKitchenTicketInput{ID: 2, Status: valueobject.TicketDone, StaffID: 7}
+	mockTicket := &entity.KitchenTicket{
+		ID: 2, OrderID: 1, Station: valueobject.StationDrinks, Status: valueobject.TicketDone,
+		StaffID: &staffID, StartedAt: &startedAt, DoneAt: &doneAt,
+		Items: []entity.KitchenTicketItem{
+			{ID: 2, KitchenTicketID: 2, OrderProductID: 2, ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+		},
+		CreatedAt: mockDate, UpdatedAt: doneAt,
+	}
+
+	mockKitchenTicketUseCase.EXPECT().
+		Update(ctx, input).
+		Return(mockTicket, nil)
+
+	output, err := controller.Update(ctx, presenter.NewKitchenTicketJsonPresenter(), input)
+
+	want, _ := util.ReadGoldenFile("kitchen_ticket/update_success")
+	assert.NoError(t, err)
+	assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+}
+
+func TestKitchenTicketController_Update_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockKitchenTicketUseCase := mockport.NewMockKitchenTicketUseCase(ctrl)
+	controller := controller.NewKitchenTicketController(mockKitchenTicketUseCase)
+
+	ctx := context.Background()
+	input := dto.UpdateKitchenTicketInput{ID: 2, Status: valueobject.TicketDone, StaffID: 7}
+
+	mockKitchenTicketUseCase.EXPECT().
+		Update(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Update(ctx, presenter.NewKitchenTicketJsonPresenter(), input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type menuController struct {
+	useCase port.MenuUseCase
+}
+
+func NewMenuController(useCase port.MenuUseCase) port.MenuController {
+	return &menuController{useCase}
+}
+
+func (c *menuController) Get(ctx context.Context, p port.Presenter, i dto.GetMenuInput) ([]byte, error) {
+	menu, err := c.useCase.Get(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: menu})
+}
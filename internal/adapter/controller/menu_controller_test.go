@@ -0,0 +1,105 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestMenuController_Get(t *testing.T) {
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	foodsID := uint64(1)
+	mockMenu := &entity.Menu{
+		Categories: []entity.MenuCategory{
+			{
+				Category: entity.Category{ID: 1, Name: "Foods", DisplayOrder: 1, Active: true},
+				Subcategories: []entity.MenuCategory{
+					{
+						Category: entity.Category{ID: 3, Name: "Burgers", ParentID: &foodsID, DisplayOrder: 1, Active: true},
+						Products: []*entity.Product{
+							{
+								ID:          1,
+								Name:        "X-Burger",
+								Description: "Hamburger with cheese",
+								Price:       25.9,
+								CategoryID:  3,
+								StockMode:   valueobject.StockUnlimited,
+								Available:   true,
+								CreatedAt:   mockDate,
+								UpdatedAt:   mockDate,
+							},
+						},
+					},
+				},
+			},
+		},
+		GeneratedAt: mockDate,
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "Get menu success - json",
+			presenter: presenter.NewMenuJsonPresenter(),
+			golden:    "menu/get_success",
+		},
+		{
+			name:      "Get menu success - xml",
+			presenter: presenter.NewMenuXmlPresenter(),
+			golden:    "menu/get_success_xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockMenuUseCase := mockport.NewMockMenuUseCase(ctrl)
+			controller := controller.NewMenuController(mockMenuUseCase)
+
+			mockMenuUseCase.EXPECT().
+				Get(ctx, dto.GetMenuInput{}).
+				Return(mockMenu, nil)
+
+			output, err := controller.Get(ctx, tt.presenter, dto.GetMenuInput{})
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestMenuController_Get_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockMenuUseCase := mockport.NewMockMenuUseCase(ctrl)
+	controller := controller.NewMenuController(mockMenuUseCase)
+
+	mockMenuUseCase.EXPECT().
+		Get(ctx, dto.GetMenuInput{}).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Get(ctx, presenter.NewMenuJsonPresenter(), dto.GetMenuInput{})
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceController struct {
+	useCase port.NotificationPreferenceUseCase
+}
+
+func NewNotificationPreferenceController(useCase port.NotificationPreferenceUseCase) port.NotificationPreferenceController {
+	return &notificationPreferenceController{useCase}
+}
+
+func (c *notificationPreferenceController) Get(ctx context.Context, p port.Presenter, i dto.GetNotificationPreferenceInput) ([]byte, error) {
+	preference, err := c.useCase.Get(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: preference})
+}
+
+func (c *notificationPreferenceController) Update(ctx context.Context, p port.Presenter, i dto.UpdateNotificationPreferenceInput) ([]byte, error) {
+	preference, err := c.useCase.Update(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: preference})
+}
+
+func (c *notificationPreferenceController) Delete(ctx context.Context, p port.Presenter, i dto.DeleteNotificationPreferenceInput) ([]byte, error) {
+	preference, err := c.useCase.Delete(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: preference})
+}
@@ -0,0 +1,92 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNotificationPreferenceController_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationPreferenceUseCase := mockport.NewMockNotificationPreferenceUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewNotificationPreferenceController(mockNotificationPreferenceUseCase)
+
+	ctx := context.Background()
+	input := dto.GetNotificationPreferenceInput{CustomerID: 1}
+
+	mockPreference := &entity.NotificationPreference{ID: 1, CustomerID: 1, Locale: valueobject.LocalePtBR}
+
+	mockNotificationPreferenceUseCase.EXPECT().
+		Get(ctx, input).
+		Return(mockPreference, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockPreference}).
+		Return([]byte{}, nil)
+
+	output, err := controller.Get(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestNotificationPreferenceController_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationPreferenceUseCase := mockport.NewMockNotificationPreferenceUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewNotificationPreferenceController(mockNotificationPreferenceUseCase)
+
+	ctx := context.Background()
+	input := dto.UpdateNotificationPreferenceInput{
+		CustomerID: 1,
+		Locale:     valueobject.LocaleEn,
+		Channels:   []string{valueobject.NotificationEmail.String()},
+		Email:      "john.doe@email.com",
+	}
+
+	mockPreference := input.ToEntity()
+
+	mockNotificationPreferenceUseCase.EXPECT().
+		Update(ctx, input).
+		Return(mockPreference, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockPreference}).
+		Return([]byte{}, nil)
+
+	output, err := controller.Update(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestNotificationPreferenceController_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockNotificationPreferenceUseCase := mockport.NewMockNotificationPreferenceUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewNotificationPreferenceController(mockNotificationPreferenceUseCase)
+
+	ctx := context.Background()
+	input := dto.DeleteNotificationPreferenceInput{CustomerID: 1}
+
+	mockNotificationPreferenceUseCase.EXPECT().
+		Delete(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Delete(ctx, mockPresenter, input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
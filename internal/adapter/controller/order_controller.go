@@ -29,31 +29,83 @@ func (c *OrderController) List(ctx context.Context, p port.Presenter, i dto.List
 	})
 }
 
-func (c *OrderController) Create(ctx context.Context, p port.Presenter, i dto.CreateOrderInput) ([]byte, error) {
-	order, err := c.useCase.Create(ctx, i)
+func (c *OrderController) ListByCustomer(ctx context.Context, p port.Presenter, i dto.ListCustomerOrdersInput) ([]byte, error) {
+	history, total, err := c.useCase.ListByCustomer(ctx, i)
 	if err != nil {
 		return nil, err
 	}
 
-	return p.Present(dto.PresenterInput{Result: order})
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: history,
+	})
+}
+
+func (c *OrderController) Create(ctx context.Context, p port.Presenter, i dto.CreateOrderInput) ([]byte, uint32, error) {
+	order, err := c.useCase.Create(ctx, i)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	output, err := p.Present(dto.PresenterInput{Result: order})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output, order.Version, nil
 }
 
-func (c *OrderController) Get(ctx context.Context, p port.Presenter, i dto.GetOrderInput) ([]byte, error) {
+func (c *OrderController) Get(ctx context.Context, p port.Presenter, i dto.GetOrderInput) ([]byte, uint32, error) {
 	order, err := c.useCase.Get(ctx, i)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return p.Present(dto.PresenterInput{Result: order})
+	output, err := p.Present(dto.PresenterInput{Result: order})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output, order.Version, nil
 }
 
-func (c *OrderController) Update(ctx context.Context, p port.Presenter, i dto.UpdateOrderInput) ([]byte, error) {
+func (c *OrderController) GetByGuestToken(ctx context.Context, p port.Presenter, i dto.GetGuestOrderInput) ([]byte, uint32, error) {
+	order, err := c.useCase.GetByGuestToken(ctx, i)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	output, err := p.Present(dto.PresenterInput{Result: order})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output, order.Version, nil
+}
+
+func (c *OrderController) AttachCustomer(ctx context.Context, p port.Presenter, i dto.AttachOrderCustomerInput) ([]byte, uint32, error) {
+	order, err := c.useCase.AttachCustomer(ctx, i)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	output, err := p.Present(dto.PresenterInput{Result: order})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output, order.Version, nil
+}
+
+func (c *OrderController) Update(ctx context.Context, p port.Presenter, i dto.UpdateOrderInput) ([]byte, uint32, error) {
 	order, err := c.useCase.Update(ctx, i)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return p.Present(dto.PresenterInput{Result: order})
+	output, err := p.Present(dto.PresenterInput{Result: order})
+	if err != nil {
+		return nil, 0, err
+	}
+	return output, order.Version, nil
 }
 
 func (c *OrderController) Delete(ctx context.Context, p port.Presenter, i dto.DeleteOrderInput) ([]byte, error) {
@@ -64,3 +116,7 @@ func (c *OrderController) Delete(ctx context.Context, p port.Presenter, i dto.De
 
 	return p.Present(dto.PresenterInput{Result: order})
 }
+
+func (c *OrderController) GetStatusMachine(ctx context.Context, p port.Presenter) ([]byte, error) {
+	return p.Present(dto.PresenterInput{Result: c.useCase.GetStatusMachine(ctx)})
+}
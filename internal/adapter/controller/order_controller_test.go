@@ -2,16 +2,22 @@ package controller_test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
 )
 
 // TODO: Add more test cenarios
@@ -62,6 +68,56 @@ func TestOrderController_ListOrders(t *testing.T) {
 	assert.NotNil(t, output)
 }
 
+func TestOrderController_ListByCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mokOrdercUseCase := mockport.NewMockOrderUseCase(ctrl)
+	controller := controller.NewOrderController(mokOrdercUseCase)
+
+	ctx := context.Background()
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	from, _ := time.Parse(time.RFC3339, "2025-03-01T00:00:00Z")
+	input := dto.ListCustomerOrdersInput{
+		CustomerID: 1,
+		From:       from,
+		Page:       1,
+		Limit:      10,
+	}
+
+	mockHistory := &entity.CustomerOrderHistory{
+		CustomerID: 1,
+		From:       from,
+		Orders: []*entity.Order{
+			{
+				ID:             2,
+				CustomerID:     1,
+				Status:         valueobject.COMPLETED,
+				PickupCode:     "A42",
+				Channel:        valueobject.ChannelApp,
+				FulfilmentMode: valueobject.FulfilmentTakeaway,
+				OrderProducts: []entity.OrderProduct{
+					{ProductID: 1, Quantity: 2, Price: 21.99},
+					{ProductID: 2, Quantity: 1, Price: 6.00},
+				},
+				DiscountTotal: 5.00,
+				CreatedAt:     mockDate,
+			},
+		},
+		TotalSpent: 44.98,
+	}
+
+	mokOrdercUseCase.EXPECT().
+		ListByCustomer(ctx, input).
+		Return(mockHistory, int64(1), nil)
+
+	output, err := controller.ListByCustomer(ctx, presenter.NewCustomerOrderHistoryJsonPresenter(), input)
+
+	want, _ := util.ReadGoldenFile("order/list_by_customer_success")
+	assert.NoError(t, err)
+	assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+}
+
 func TestOrderController_CreateOrder(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -76,6 +132,7 @@ func TestOrderController_CreateOrder(t *testing.T) {
 	}
 
 	mockOrder := &entity.Order{
+		Version:    2,
 		ID:         1,
 		CustomerID: 1,
 		Status:     "OPEN",
@@ -89,9 +146,10 @@ func TestOrderController_CreateOrder(t *testing.T) {
 		Present(dto.PresenterInput{Result: mockOrder}).
 		Return([]byte{}, nil)
 
-	output, err := controller.Create(ctx, mockPresenter, input)
+	output, version, err := controller.Create(ctx, mockPresenter, input)
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
+	assert.Equal(t, uint32(2), version)
 }
 
 func TestOrderController_GetOrder(t *testing.T) {
@@ -108,6 +166,7 @@ func TestOrderController_GetOrder(t *testing.T) {
 	}
 
 	mockOrder := &entity.Order{
+		Version:    2,
 		ID:         1,
 		CustomerID: 1,
 		Status:     "PENDING",
@@ -121,9 +180,82 @@ func TestOrderController_GetOrder(t *testing.T) {
 		Present(dto.PresenterInput{Result: mockOrder}).
 		Return([]byte{}, nil)
 
-	output, err := controller.Get(ctx, mockPresenter, input)
+	output, version, err := controller.Get(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+	assert.Equal(t, uint32(2), version)
+}
+
+func TestOrderController_GetGuestOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mokOrdercUseCase := mockport.NewMockOrderUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewOrderController(mokOrdercUseCase)
+
+	ctx := context.Background()
+	input := dto.GetGuestOrderInput{
+		GuestToken: "TOKEN",
+	}
+
+	mockOrder := &entity.Order{
+		Version:    2,
+		ID:         1,
+		GuestName:  "John",
+		GuestToken: "TOKEN",
+		Status:     "OPEN",
+	}
+
+	mokOrdercUseCase.EXPECT().
+		GetByGuestToken(ctx, input).
+		Return(mockOrder, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockOrder}).
+		Return([]byte{}, nil)
+
+	output, version, err := controller.GetByGuestToken(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+	assert.Equal(t, uint32(2), version)
+}
+
+func TestOrderController_AttachOrderCustomer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mokOrdercUseCase := mockport.NewMockOrderUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewOrderController(mokOrdercUseCase)
+
+	ctx := context.Background()
+	input := dto.AttachOrderCustomerInput{
+		ID:         uint64(1),
+		CustomerID: uint64(7),
+		GuestToken: "TOKEN",
+	}
+
+	mockOrder := &entity.Order{
+		Version:    2,
+		ID:         1,
+		CustomerID: 7,
+		GuestToken: "TOKEN",
+		Status:     "PENDING",
+	}
+
+	mokOrdercUseCase.EXPECT().
+		AttachCustomer(ctx, input).
+		Return(mockOrder, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockOrder}).
+		Return([]byte{}, nil)
+
+	output, version, err := controller.AttachCustomer(ctx, mockPresenter, input)
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
+	assert.Equal(t, uint32(2), version)
 }
 
 func TestOrderController_UpdateOrder(t *testing.T) {
@@ -142,6 +274,7 @@ func TestOrderController_UpdateOrder(t *testing.T) {
 	}
 
 	mockOrder := &entity.Order{
+		Version:    2,
 		ID:         1,
 		CustomerID: 1,
 		Status:     "PENDING",
@@ -155,9 +288,10 @@ func TestOrderController_UpdateOrder(t *testing.T) {
 		Present(dto.PresenterInput{Result: mockOrder}).
 		Return([]byte{}, nil)
 
-	output, err := controller.Update(ctx, mockPresenter, input)
+	output, version, err := controller.Update(ctx, mockPresenter, input)
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
+	assert.Equal(t, uint32(2), version)
 }
 
 func TestOrderController_DeleteOrder(t *testing.T) {
@@ -191,3 +325,165 @@ func TestOrderController_DeleteOrder(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
 }
+
+func TestOrderController_GetStatusMachine(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderUseCase := mockport.NewMockOrderUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewOrderController(mockOrderUseCase)
+
+	ctx := context.Background()
+	machine := valueobject.DefaultOrderStatusMachine()
+
+	mockOrderUseCase.EXPECT().
+		GetStatusMachine(ctx).
+		Return(machine)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: machine}).
+		Return([]byte{}, nil)
+
+	output, err := controller.GetStatusMachine(ctx, mockPresenter)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestOrderController_GetStatusMachineXml(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderUseCase := mockport.NewMockOrderUseCase(ctrl)
+	controller := controller.NewOrderController(mockOrderUseCase)
+
+	ctx := context.Background()
+	machine := &valueobject.OrderStatusMachine{
+		Initial: valueobject.OPEN,
+		States: []valueobject.OrderStatusState{
+			{Name: valueobject.OPEN, Description: "Order is being assembled by the customer"},
+			{Name: valueobject.CANCELLED, Description: "Order was cancelled", Final: true},
+		},
+		Transitions: []valueobject.OrderStatusTransition{
+			{
+				From:  valueobject.OPEN,
+				To:    valueobject.CANCELLED,
+				Roles: []valueobject.ActorType{valueobject.ActorCustomer, valueobject.ActorStaff},
+				Modes: []valueobject.FulfilmentMode{valueobject.FulfilmentTakeaway},
+			},
+		},
+	}
+
+	mockOrderUseCase.EXPECT().
+		GetStatusMachine(ctx).
+		Return(machine)
+
+	output, err := controller.GetStatusMachine(ctx, presenter.NewOrderStatusMachineXmlPresenter())
+
+	want, _ := util.ReadGoldenFile("order/get_status_machine_success_xml")
+	assert.NoError(t, err)
+	assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+}
+
+func TestOrderController_GetOrderReceipt(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderUseCase := mockport.NewMockOrderUseCase(ctrl)
+	controller := controller.NewOrderController(mockOrderUseCase)
+
+	ctx := context.Background()
+	input := dto.GetOrderInput{ID: 5}
+	createdAt, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	paidAt, _ := time.Parse(time.RFC3339, "2025-03-06T17:05:10Z")
+	// The receipts are printed on the time of the restaurant, 3 hours behind UTC
+	location := time.FixedZone("America/Sao_Paulo", -3*60*60)
+	mockOrder := &entity.Order{
+		ID:         5,
+		CustomerID: 1,
+		Status:     valueobject.RECEIVED,
+		PickupCode: "A42",
+		OrderProducts: []entity.OrderProduct{
+			{
+				ID:       1,
+				Quantity: 2,
+				Price:    25.9,
+				Notes:    "No tomato",
+				Product:  entity.Product{ID: 1, Name: "X-Burger"},
+				Modifiers: []entity.OrderProductModifier{
+					{ProductModifierID: 1, GroupName: "Extras", Name: "Extra cheese", PriceDelta: 2},
+				},
+			},
+			{
+				ID:       2,
+				Quantity: 1,
+				Price:    32.9,
+				Product:  entity.Product{ID: 5, Name: "Combo X-Burger"},
+				Components: []entity.OrderProductComponent{
+					{SlotName: "Drink", ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+				},
+			},
+		},
+		Subtotal:      88.7,
+		DiscountTotal: 5,
+		Total:         83.7,
+		Discounts: []entity.OrderDiscount{
+			{PromotionID: 1, Name: "Welcome coupon", Code: "WELCOME10", Amount: 5},
+		},
+		Histories: []entity.OrderHistory{
+			{ID: 1, OrderID: 5, Status: valueobject.OPEN, CreatedAt: createdAt},
+			{ID: 2, OrderID: 5, Status: valueobject.RECEIVED, ReasonText: "Payment approved", CreatedAt: paidAt},
+		},
+		CreatedAt: createdAt,
+		UpdatedAt: paidAt,
+	}
+
+	tests := []struct {
+		name        string
+		presenter   port.Presenter
+		checkResult func(*testing.T, []byte)
+	}{
+		{
+			name:      "Get order receipt success - text",
+			presenter: presenter.NewOrderReceiptTextPresenter(location),
+			checkResult: func(t *testing.T, output []byte) {
+				want, _ := util.ReadGoldenFile("order/receipt_success_text")
+				assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+				for _, line := range strings.Split(string(output), "\n") {
+					assert.LessOrEqual(t, utf8.RuneCountInString(line), presenter.ReceiptWidth)
+				}
+			},
+		},
+		{
+			name:      "Get order receipt success - html",
+			presenter: presenter.NewOrderReceiptHtmlPresenter(location),
+			checkResult: func(t *testing.T, output []byte) {
+				want, _ := util.ReadGoldenFile("order/receipt_success_html")
+				assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+			},
+		},
+		{
+			name:      "Get order receipt success - pdf",
+			presenter: presenter.NewOrderReceiptPdfPresenter(location),
+			checkResult: func(t *testing.T, output []byte) {
+				assert.True(t, strings.HasPrefix(string(output), "%PDF-1.4"))
+				assert.True(t, strings.HasSuffix(string(output), "%%EOF\n"))
+				assert.Contains(t, string(output), "(2x X-Burger                        55.80) Tj")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderUseCase.EXPECT().
+				Get(ctx, input).
+				Return(mockOrder, nil)
+
+			output, _, err := controller.Get(ctx, tt.presenter, input)
+
+			assert.NoError(t, err)
+			tt.checkResult(t, output)
+		})
+	}
+}
@@ -29,15 +29,6 @@ func (c *OrderHistoryController) List(ctx context.Context, p port.Presenter, i d
 	})
 }
 
-func (c *OrderHistoryController) Create(ctx context.Context, p port.Presenter, i dto.CreateOrderHistoryInput) ([]byte, error) {
-	orderHistory, err := c.useCase.Create(ctx, i)
-	if err != nil {
-		return nil, err
-	}
-
-	return p.Present(dto.PresenterInput{Result: orderHistory})
-}
-
 func (c *OrderHistoryController) Get(ctx context.Context, p port.Presenter, i dto.GetOrderHistoryInput) ([]byte, error) {
 	orderHistory, err := c.useCase.Get(ctx, i)
 	if err != nil {
@@ -47,11 +38,11 @@ func (c *OrderHistoryController) Get(ctx context.Context, p port.Presenter, i dt
 	return p.Present(dto.PresenterInput{Result: orderHistory})
 }
 
-func (c *OrderHistoryController) Delete(ctx context.Context, p port.Presenter, i dto.DeleteOrderHistoryInput) ([]byte, error) {
-	orderHistory, err := c.useCase.Delete(ctx, i)
-
+func (c *OrderHistoryController) Verify(ctx context.Context, p port.Presenter, i dto.VerifyOrderHistoriesInput) ([]byte, error) {
+	verification, err := c.useCase.Verify(ctx, i)
 	if err != nil {
 		return nil, err
 	}
-	return p.Present(dto.PresenterInput{Result: orderHistory})
+
+	return p.Present(dto.PresenterInput{Result: verification})
 }
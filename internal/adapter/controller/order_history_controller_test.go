@@ -11,9 +11,12 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
 )
 
 // TODO: Add more test cenarios
@@ -73,7 +76,7 @@ func TestOrderHistoryController_ListOrderHistories(t *testing.T) {
 	assert.NotNil(t, output)
 }
 
-func TestOrderHistoryController_CreateOrderHistory(t *testing.T) {
+func TestOrderHistoryController_GetOrderHistory(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -82,31 +85,30 @@ func TestOrderHistoryController_CreateOrderHistory(t *testing.T) {
 	controller := controller.NewOrderHistoryController(mockOrderHistoryUseCase)
 
 	ctx := context.Background()
-	input := dto.CreateOrderHistoryInput{
-		OrderID: 1,
-		Status:  "READY",
+	input := dto.GetOrderHistoryInput{
+		ID: uint64(1),
 	}
 
 	mockOrderHistory := &entity.OrderHistory{
 		ID:      1,
 		OrderID: 1,
-		Status:  valueobject.READY,
+		Status:  valueobject.OPEN,
 	}
 
 	mockOrderHistoryUseCase.EXPECT().
-		Create(ctx, input).
+		Get(ctx, input).
 		Return(mockOrderHistory, nil)
 
 	mockPresenter.EXPECT().
 		Present(dto.PresenterInput{Result: mockOrderHistory}).
 		Return([]byte{}, nil)
 
-	output, err := controller.Create(ctx, mockPresenter, input)
+	output, err := controller.Get(ctx, mockPresenter, input)
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
 }
 
-func TestOrderHistoryController_GetOrderHistory(t *testing.T) {
+func TestOrderHistoryController_VerifyOrderHistories(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -115,57 +117,92 @@ func TestOrderHistoryController_GetOrderHistory(t *testing.T) {
 	controller := controller.NewOrderHistoryController(mockOrderHistoryUseCase)
 
 	ctx := context.Background()
-	input := dto.GetOrderHistoryInput{
-		ID: uint64(1),
+	input := dto.VerifyOrderHistoriesInput{
+		OrderID: uint64(1),
 	}
 
-	mockOrderHistory := &entity.OrderHistory{
-		ID:      1,
+	mockVerification := &entity.OrderHistoryVerification{
 		OrderID: 1,
-		Status:  valueobject.OPEN,
+		Valid:   true,
+		Total:   2,
+		Sealed:  2,
 	}
 
 	mockOrderHistoryUseCase.EXPECT().
-		Get(ctx, input).
-		Return(mockOrderHistory, nil)
+		Verify(ctx, input).
+		Return(mockVerification, nil)
 
 	mockPresenter.EXPECT().
-		Present(dto.PresenterInput{Result: mockOrderHistory}).
+		Present(dto.PresenterInput{Result: mockVerification}).
 		Return([]byte{}, nil)
 
-	output, err := controller.Get(ctx, mockPresenter, input)
+	output, err := controller.Verify(ctx, mockPresenter, input)
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
 }
 
-func TestOrderHistoryController_DeleteOrderHistory(t *testing.T) {
+func TestOrderHistoryController_ListOrderHistoriesFormats(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockOrderHistoryUseCase := mockport.NewMockOrderHistoryUseCase(ctrl)
-	mockPresenter := mockport.NewMockPresenter(ctrl)
-	controller := controller.NewOrderHistoryController(mockOrderHistoryUseCase)
+	mockOrderHistoriesUseCase := mockport.NewMockOrderHistoryUseCase(ctrl)
+	controller := controller.NewOrderHistoryController(mockOrderHistoriesUseCase)
 
 	ctx := context.Background()
-	input := dto.DeleteOrderHistoryInput{
-		ID: uint64(1),
+	input := dto.ListOrderHistoriesInput{OrderID: 1, Page: 1, Limit: 10}
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	staffID := uint64(7)
+	mockOrderHistories := []*entity.OrderHistory{
+		{
+			ID:        1,
+			OrderID:   1,
+			Status:    valueobject.OPEN,
+			ActorType: valueobject.ActorCustomer,
+			Source:    valueobject.SourceAPI,
+			CreatedAt: mockDate,
+		},
+		{
+			ID:         2,
+			OrderID:    1,
+			StaffID:    &staffID,
+			Status:     valueobject.CANCELLED,
+			ActorType:  valueobject.ActorStaff,
+			ActorID:    "7",
+			ReasonCode: "OUT_OF_STOCK",
+			ReasonText: "No buns, sorry",
+			Source:     valueobject.SourceAPI,
+			CreatedAt:  mockDate.Add(5 * time.Minute),
+		},
 	}
 
-	mockOrderHistory := &entity.OrderHistory{
-		ID:      1,
-		OrderID: 1,
-		Status:  valueobject.OPEN,
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List order histories success - xml",
+			presenter: presenter.NewOrderHistoryXmlPresenter(),
+			golden:    "order_history/list_success_xml",
+		},
+		{
+			name:      "List order histories success - csv",
+			presenter: presenter.NewOrderHistoryCsvPresenter(),
+			golden:    "order_history/list_success_csv",
+		},
 	}
 
-	mockOrderHistoryUseCase.EXPECT().
-		Delete(ctx, input).
-		Return(mockOrderHistory, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderHistoriesUseCase.EXPECT().
+				List(ctx, input).
+				Return(mockOrderHistories, int64(2), nil)
 
-	mockPresenter.EXPECT().
-		Present(dto.PresenterInput{Result: mockOrderHistory}).
-		Return([]byte{}, nil)
+			output, err := controller.List(ctx, tt.presenter, input)
 
-	output, err := controller.Delete(ctx, mockPresenter, input)
-	assert.NoError(t, err)
-	assert.NotNil(t, output)
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
 }
@@ -64,3 +64,12 @@ func (c *OrderProductController) Delete(ctx context.Context, p port.Presenter, i
 
 	return p.Present(dto.PresenterInput{Result: order})
 }
+
+func (c *OrderProductController) SalesReport(ctx context.Context, p port.Presenter, i dto.GetSalesReportInput) ([]byte, error) {
+	report, err := c.useCase.SalesReport(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: report})
+}
@@ -3,14 +3,19 @@ package controller_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
 )
 
 // TODO: Add more test cenarios
@@ -104,11 +109,11 @@ func TestOrderProductController_GetOrderProduct(t *testing.T) {
 
 	ctx := context.Background()
 	input := dto.GetOrderProductInput{
-		OrderID:   1,
-		ProductID: 1,
+		ID: 1,
 	}
 
 	mockOrderProduct := &entity.OrderProduct{
+		ID:        1,
 		OrderID:   1,
 		ProductID: 1,
 		Quantity:  1,
@@ -137,12 +142,14 @@ func TestOrderProductController_UpdateOrderProduct(t *testing.T) {
 
 	ctx := context.Background()
 	input := dto.UpdateOrderProductInput{
-		OrderID:   1,
-		ProductID: 1,
-		Quantity:  2,
+		ID:       1,
+		Quantity: 2,
+		Notes:    "No tomato",
 	}
 
 	mockOrderProduct := &entity.OrderProduct{
+		ID:        1,
+		Notes:     "No tomato",
 		OrderID:   1,
 		ProductID: 1,
 		Quantity:  2,
@@ -171,11 +178,11 @@ func TestOrderProductController_DeleteOrderProduct(t *testing.T) {
 
 	ctx := context.Background()
 	input := dto.DeleteOrderProductInput{
-		OrderID:   1,
-		ProductID: 1,
+		ID: 1,
 	}
 
 	mockOrderProduct := &entity.OrderProduct{
+		ID:        1,
 		OrderID:   1,
 		ProductID: 1,
 		Quantity:  1,
@@ -193,3 +200,107 @@ func TestOrderProductController_DeleteOrderProduct(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, output)
 }
+
+func TestOrderProductController_SalesReport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderProductUseCase := mockport.NewMockOrderProductUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewOrderProductController(mockOrderProductUseCase)
+
+	ctx := context.Background()
+	input := dto.GetSalesReportInput{
+		From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	mockReport := &entity.SalesReport{
+		From:  input.From,
+		To:    input.To,
+		Lines: []entity.SalesReportLine{{ProductID: 1, Name: "X-Burger", Quantity: 2, BundleQuantity: 1}},
+	}
+
+	mockOrderProductUseCase.EXPECT().
+		SalesReport(ctx, input).
+		Return(mockReport, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockReport}).
+		Return([]byte{}, nil)
+
+	output, err := controller.SalesReport(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestOrderProductController_ListOrderProductsFormats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockOrderProductUseCase := mockport.NewMockOrderProductUseCase(ctrl)
+	controller := controller.NewOrderProductController(mockOrderProductUseCase)
+
+	ctx := context.Background()
+	input := dto.ListOrderProductsInput{OrderID: 1, Page: 1, Limit: 10}
+	mockDate, _ := time.Parse(time.RFC3339, "2025-02-28T16:28:18Z")
+	productPriceID := uint64(4)
+	mockOrder := entity.Order{ID: 1, CustomerID: 1, Status: valueobject.OPEN, CreatedAt: mockDate, UpdatedAt: mockDate}
+	mockOrderProducts := []*entity.OrderProduct{
+		{
+			ID:             1,
+			OrderID:        1,
+			ProductID:      1,
+			Quantity:       2,
+			Notes:          "No onion, well done",
+			Price:          12.11,
+			ProductPriceID: &productPriceID,
+			Order:          mockOrder,
+			Product:        entity.Product{ID: 1, Name: "X-Burger", Price: 12.11, CategoryID: 1, CreatedAt: mockDate, UpdatedAt: mockDate},
+			CreatedAt:      mockDate,
+			UpdatedAt:      mockDate,
+		},
+		{
+			ID:        2,
+			OrderID:   1,
+			ProductID: 2,
+			Quantity:  1,
+			Price:     6.9,
+			Order:     mockOrder,
+			Product:   entity.Product{ID: 2, Name: "Coca-Cola 350ml", Price: 6.9, CategoryID: 2, CreatedAt: mockDate, UpdatedAt: mockDate},
+			CreatedAt: mockDate,
+			UpdatedAt: mockDate,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List order products success - xml",
+			presenter: presenter.NewOrderProductXmlPresenter(),
+			golden:    "order_product/list_success_xml",
+		},
+		{
+			name:      "List order products success - csv",
+			presenter: presenter.NewOrderProductCsvPresenter(),
+			golden:    "order_product/list_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockOrderProductUseCase.EXPECT().
+				List(ctx, input).
+				Return(mockOrderProducts, int64(2), nil)
+
+			output, err := controller.List(ctx, tt.presenter, input)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
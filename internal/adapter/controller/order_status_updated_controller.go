@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderStatusUpdatedController struct {
+	useCase port.OrderStatusUpdatedUseCase
+}
+
+func NewOrderStatusUpdatedController(useCase port.OrderStatusUpdatedUseCase) port.OrderStatusUpdatedController {
+	return &orderStatusUpdatedController{useCase}
+}
+
+func (c *orderStatusUpdatedController) Process(ctx context.Context, p port.Presenter, i dto.ProcessOrderStatusUpdatedInput) ([]byte, error) {
+	order, err := c.useCase.Process(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: order})
+}
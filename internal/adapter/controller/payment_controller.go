@@ -0,0 +1,34 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type paymentController struct {
+	useCase port.PaymentUseCase
+}
+
+func NewPaymentController(useCase port.PaymentUseCase) port.PaymentController {
+	return &paymentController{useCase}
+}
+
+func (c *paymentController) Checkout(ctx context.Context, p port.Presenter, i dto.CheckoutInput) ([]byte, error) {
+	order, err := c.useCase.Checkout(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: order})
+}
+
+func (c *paymentController) Callback(ctx context.Context, p port.Presenter, i dto.PaymentCallbackInput) ([]byte, error) {
+	order, err := c.useCase.Callback(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: order})
+}
@@ -0,0 +1,75 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestPaymentController_Checkout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockPaymentUseCase := mockport.NewMockPaymentUseCase(ctrl)
+	controller := controller.NewPaymentController(mockPaymentUseCase)
+
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	mockPaymentUseCase.EXPECT().
+		Checkout(ctx, dto.CheckoutInput{ID: 1}).
+		Return(&entity.Order{
+			ID:               1,
+			CustomerID:       1,
+			Status:           valueobject.PENDING,
+			Channel:          valueobject.ChannelTotem,
+			FulfilmentMode:   valueobject.FulfilmentTakeaway,
+			PaymentReference: "pay_8f14e45f",
+			OrderProducts: []entity.OrderProduct{
+				{
+					OrderID:   1,
+					ProductID: 1,
+					Quantity:  2,
+					Price:     29.99,
+					Product:   entity.Product{ID: 1, Name: "X-Burger", Price: 29.99, CategoryID: 1, CreatedAt: mockDate, UpdatedAt: mockDate},
+				},
+			},
+			Version:   2,
+			CreatedAt: mockDate,
+			UpdatedAt: mockDate,
+		}, nil)
+
+	output, err := controller.Checkout(ctx, presenter.NewOrderJsonPresenter(), dto.CheckoutInput{ID: 1})
+
+	want, _ := util.ReadGoldenFile("payment/checkout_success")
+	assert.NoError(t, err)
+	assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+}
+
+func TestPaymentController_Callback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockPaymentUseCase := mockport.NewMockPaymentUseCase(ctrl)
+	controller := controller.NewPaymentController(mockPaymentUseCase)
+	input := dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentApproved}
+
+	mockPaymentUseCase.EXPECT().
+		Callback(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Callback(ctx, presenter.NewOrderJsonPresenter(), input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type pickupBoardController struct {
+	useCase port.PickupBoardUseCase
+}
+
+func NewPickupBoardController(useCase port.PickupBoardUseCase) port.PickupBoardController {
+	return &pickupBoardController{useCase}
+}
+
+func (c *pickupBoardController) Get(ctx context.Context, p port.Presenter) ([]byte, error) {
+	board, err := c.useCase.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: board})
+}
@@ -0,0 +1,81 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestPickupBoardController_Get(t *testing.T) {
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	mockBoard := &entity.PickupBoard{
+		Preparing:   []string{"A43", "A44"},
+		Ready:       []string{"A41", "A42"},
+		GeneratedAt: mockDate,
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "Get pickup board success - json",
+			presenter: presenter.NewPickupBoardJsonPresenter(),
+			golden:    "pickup_board/get_success",
+		},
+		{
+			name:      "Get pickup board success - html",
+			presenter: presenter.NewPickupBoardHtmlPresenter(),
+			golden:    "pickup_board/get_success_html",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockPickupBoardUseCase := mockport.NewMockPickupBoardUseCase(ctrl)
+			controller := controller.NewPickupBoardController(mockPickupBoardUseCase)
+
+			mockPickupBoardUseCase.EXPECT().
+				Get(ctx).
+				Return(mockBoard, nil)
+
+			output, err := controller.Get(ctx, tt.presenter)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestPickupBoardController_Get_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockPickupBoardUseCase := mockport.NewMockPickupBoardUseCase(ctrl)
+	controller := controller.NewPickupBoardController(mockPickupBoardUseCase)
+
+	mockPickupBoardUseCase.EXPECT().
+		Get(ctx).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Get(ctx, presenter.NewPickupBoardJsonPresenter())
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
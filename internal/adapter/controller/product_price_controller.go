@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type productPriceController struct {
+	useCase port.ProductPriceUseCase
+}
+
+func NewProductPriceController(useCase port.ProductPriceUseCase) port.ProductPriceController {
+	return &productPriceController{useCase}
+}
+
+func (c *productPriceController) List(ctx context.Context, p port.Presenter, i dto.ListProductPricesInput) ([]byte, error) {
+	prices, total, err := c.useCase.List(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: prices,
+	})
+}
+
+func (c *productPriceController) Schedule(ctx context.Context, p port.Presenter, i dto.ScheduleProductPriceInput) ([]byte, error) {
+	price, err := c.useCase.Schedule(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: price})
+}
@@ -0,0 +1,114 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestProductPriceController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductPriceUseCase := mockport.NewMockProductPriceUseCase(ctrl)
+	controller := controller.NewProductPriceController(mockProductPriceUseCase)
+
+	ctx := context.Background()
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	changedAt, _ := time.Parse(time.RFC3339, "2025-03-10T03:00:00Z")
+	input := dto.ListProductPricesInput{ProductID: 1, Page: 1, Limit: 10}
+	mockPrices := []*entity.ProductPrice{
+		{ID: 2, ProductID: 1, Price: 27.9, EffectiveFrom: changedAt, CreatedAt: mockDate},
+		{ID: 1, ProductID: 1, Price: 25.9, EffectiveFrom: mockDate, EffectiveTo: &changedAt, CreatedAt: mockDate},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List product prices success",
+			presenter: presenter.NewProductPriceJsonPresenter(),
+			golden:    "product_price/list_success",
+		},
+		{
+			name:      "List product prices success - xml",
+			presenter: presenter.NewProductPriceXmlPresenter(),
+			golden:    "product_price/list_success_xml",
+		},
+		{
+			name:      "List product prices success - csv",
+			presenter: presenter.NewProductPriceCsvPresenter(),
+			golden:    "product_price/list_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockProductPriceUseCase.EXPECT().
+				List(ctx, input).
+				Return(mockPrices, int64(2), nil)
+
+			output, err := controller.List(ctx, tt.presenter, input)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestProductPriceController_Schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductPriceUseCase := mockport.NewMockProductPriceUseCase(ctrl)
+	controller := controller.NewProductPriceController(mockProductPriceUseCase)
+
+	ctx := context.Background()
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	changedAt, _ := time.Parse(time.RFC3339, "2025-03-10T03:00:00Z")
+	input := dto.ScheduleProductPriceInput{ProductID: 1, Price: 27.9, EffectiveFrom: changedAt}
+	mockPrice := &entity.ProductPrice{ID: 2, ProductID: 1, Price: 27.9, EffectiveFrom: changedAt, CreatedAt: mockDate}
+
+	mockProductPriceUseCase.EXPECT().
+		Schedule(ctx, input).
+		Return(mockPrice, nil)
+
+	output, err := controller.Schedule(ctx, presenter.NewProductPriceJsonPresenter(), input)
+
+	want, _ := util.ReadGoldenFile("product_price/schedule_success")
+	assert.NoError(t, err)
+	assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+}
+
+func TestProductPriceController_Schedule_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductPriceUseCase := mockport.NewMockProductPriceUseCase(ctrl)
+	controller := controller.NewProductPriceController(mockProductPriceUseCase)
+
+	ctx := context.Background()
+	input := dto.ScheduleProductPriceInput{ProductID: 1, Price: 27.9}
+
+	mockProductPriceUseCase.EXPECT().
+		Schedule(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Schedule(ctx, presenter.NewProductPriceJsonPresenter(), input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
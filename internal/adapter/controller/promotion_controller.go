@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionController struct {
+	useCase port.PromotionUseCase
+}
+
+func NewPromotionController(useCase port.PromotionUseCase) port.PromotionController {
+	return &promotionController{useCase}
+}
+
+func (c *promotionController) List(ctx context.Context, p port.Presenter, i dto.ListPromotionsInput) ([]byte, error) {
+	promotions, total, err := c.useCase.List(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: promotions,
+	})
+}
+
+func (c *promotionController) Create(ctx context.Context, p port.Presenter, i dto.CreatePromotionInput) ([]byte, error) {
+	promotion, err := c.useCase.Create(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: promotion})
+}
+
+func (c *promotionController) Get(ctx context.Context, p port.Presenter, i dto.GetPromotionInput) ([]byte, error) {
+	promotion, err := c.useCase.Get(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: promotion})
+}
+
+func (c *promotionController) Update(ctx context.Context, p port.Presenter, i dto.UpdatePromotionInput) ([]byte, error) {
+	promotion, err := c.useCase.Update(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: promotion})
+}
+
+func (c *promotionController) Delete(ctx context.Context, p port.Presenter, i dto.DeletePromotionInput) ([]byte, error) {
+	promotion, err := c.useCase.Delete(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: promotion})
+}
+
+func (c *promotionController) ApplyToOrder(ctx context.Context, p port.Presenter, i dto.ApplyOrderPromotionsInput) ([]byte, error) {
+	order, err := c.useCase.ApplyToOrder(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: order})
+}
+
+func (c *promotionController) RemoveFromOrder(ctx context.Context, p port.Presenter, i dto.RemoveOrderCouponInput) ([]byte, error) {
+	order, err := c.useCase.RemoveFromOrder(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: order})
+}
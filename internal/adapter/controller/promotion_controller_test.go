@@ -0,0 +1,184 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestPromotionController_ListPromotions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionUseCase := mockport.NewMockPromotionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewPromotionController(mockPromotionUseCase)
+
+	ctx := context.Background()
+	input := dto.ListPromotionsInput{
+		Page:  1,
+		Limit: 10,
+	}
+
+	mockPromotions := []*entity.Promotion{
+		{ID: 1, Name: "Welcome coupon"},
+		{ID: 2, Name: "Happy hour"},
+	}
+
+	mockPromotionUseCase.EXPECT().
+		List(ctx, input).
+		Return(mockPromotions, int64(2), nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{
+			Result: mockPromotions,
+			Total:  int64(2),
+			Page:   1,
+			Limit:  10,
+		}).
+		Return([]byte{}, nil)
+
+	output, err := controller.List(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestPromotionController_ApplyToOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionUseCase := mockport.NewMockPromotionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewPromotionController(mockPromotionUseCase)
+
+	ctx := context.Background()
+	input := dto.ApplyOrderPromotionsInput{
+		OrderID:    1,
+		CouponCode: "WELCOME10",
+	}
+
+	mockOrder := &entity.Order{
+		ID:        1,
+		Coupons:   []entity.OrderCoupon{{OrderID: 1, PromotionID: 1, Code: "WELCOME10"}},
+		Discounts: []entity.OrderDiscount{{OrderID: 1, PromotionID: 1, Amount: 3.28}},
+	}
+
+	mockPromotionUseCase.EXPECT().
+		ApplyToOrder(ctx, input).
+		Return(mockOrder, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockOrder}).
+		Return([]byte{}, nil)
+
+	output, err := controller.ApplyToOrder(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestPromotionController_ApplyToOrder_UseCaseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionUseCase := mockport.NewMockPromotionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewPromotionController(mockPromotionUseCase)
+
+	ctx := context.Background()
+	input := dto.ApplyOrderPromotionsInput{
+		OrderID:    1,
+		CouponCode: "UNKNOWN",
+	}
+
+	mockPromotionUseCase.EXPECT().
+		ApplyToOrder(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.ApplyToOrder(ctx, mockPresenter, input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
+
+func TestPromotionController_ListPromotionsFormats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPromotionUseCase := mockport.NewMockPromotionUseCase(ctrl)
+	controller := controller.NewPromotionController(mockPromotionUseCase)
+
+	ctx := context.Background()
+	input := dto.ListPromotionsInput{Page: 1, Limit: 10}
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	code := "WELCOME10"
+	categoryID := uint64(2)
+	mockPromotions := []*entity.Promotion{
+		{
+			ID:                 1,
+			Name:               "Welcome coupon",
+			Code:               &code,
+			DiscountType:       valueobject.DiscountPercentage,
+			Value:              10,
+			MaxUsesPerCustomer: 1,
+			Active:             true,
+			CreatedAt:          mockDate,
+			UpdatedAt:          mockDate,
+		},
+		{
+			ID:             2,
+			Name:           "Happy hour drinks",
+			DiscountType:   valueobject.DiscountPercentage,
+			Value:          20,
+			CategoryID:     &categoryID,
+			HappyHourStart: "17:00",
+			HappyHourEnd:   "19:00",
+			Timezone:       "America/Sao_Paulo",
+			Stackable:      true,
+			Active:         true,
+			CreatedAt:      mockDate,
+			UpdatedAt:      mockDate,
+		},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "List promotions success - xml",
+			presenter: presenter.NewPromotionXmlPresenter(),
+			golden:    "promotion/list_success_xml",
+		},
+		{
+			name:      "List promotions success - csv",
+			presenter: presenter.NewPromotionCsvPresenter(),
+			golden:    "promotion/list_success_csv",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockPromotionUseCase.EXPECT().
+				List(ctx, input).
+				Return(mockPromotions, int64(2), nil)
+
+			output, err := controller.List(ctx, tt.presenter, input)
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
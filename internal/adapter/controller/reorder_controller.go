@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type reorderController struct {
+	useCase port.ReorderUseCase
+}
+
+func NewReorderController(useCase port.ReorderUseCase) port.ReorderController {
+	return &reorderController{useCase}
+}
+
+func (c *reorderController) Reorder(ctx context.Context, p port.Presenter, i dto.ReorderInput) ([]byte, error) {
+	reorder, err := c.useCase.Reorder(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: reorder})
+}
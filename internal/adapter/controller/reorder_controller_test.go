@@ -0,0 +1,104 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func TestReorderController_Reorder(t *testing.T) {
+	mockDate, _ := time.Parse(time.RFC3339, "2025-03-06T17:03:28Z")
+	mockReorder := &entity.Reorder{
+		SourceOrderID: 1,
+		Order: &entity.Order{
+			ID:             2,
+			CustomerID:     1,
+			Status:         valueobject.OPEN,
+			Channel:        valueobject.ChannelApp,
+			FulfilmentMode: valueobject.FulfilmentTakeaway,
+			OrderProducts: []entity.OrderProduct{
+				{
+					ID:        3,
+					OrderID:   2,
+					ProductID: 1,
+					Quantity:  2,
+					Price:     21.99,
+					Product:   entity.Product{ID: 1, Name: "X-Burger", Price: 21.99, CategoryID: 1, CreatedAt: mockDate, UpdatedAt: mockDate},
+				},
+			},
+			Version:   1,
+			CreatedAt: mockDate,
+			UpdatedAt: mockDate,
+		},
+		Warnings: []entity.ReorderWarning{
+			{ProductID: 2, ProductName: "X-Bacon", Reason: "product is unavailable"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		presenter port.Presenter
+		golden    string
+	}{
+		{
+			name:      "Reorder success - json",
+			presenter: presenter.NewReorderJsonPresenter(),
+			golden:    "reorder/reorder_success",
+		},
+		{
+			name:      "Reorder success - xml",
+			presenter: presenter.NewReorderXmlPresenter(),
+			golden:    "reorder/reorder_success_xml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockReorderUseCase := mockport.NewMockReorderUseCase(ctrl)
+			controller := controller.NewReorderController(mockReorderUseCase)
+
+			mockReorderUseCase.EXPECT().
+				Reorder(ctx, dto.ReorderInput{ID: 1, CustomerID: 1}).
+				Return(mockReorder, nil)
+
+			output, err := controller.Reorder(ctx, tt.presenter, dto.ReorderInput{ID: 1, CustomerID: 1})
+
+			want, _ := util.ReadGoldenFile(tt.golden)
+			assert.NoError(t, err)
+			assert.Equal(t, want, util.RemoveAllSpaces(string(output)))
+		})
+	}
+}
+
+func TestReorderController_Reorder_Error(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockReorderUseCase := mockport.NewMockReorderUseCase(ctrl)
+	controller := controller.NewReorderController(mockReorderUseCase)
+
+	mockReorderUseCase.EXPECT().
+		Reorder(ctx, dto.ReorderInput{ID: 1, CustomerID: 1}).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Reorder(ctx, presenter.NewReorderJsonPresenter(), dto.ReorderInput{ID: 1, CustomerID: 1})
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
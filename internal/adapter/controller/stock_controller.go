@@ -0,0 +1,25 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type stockController struct {
+	useCase port.StockUseCase
+}
+
+func NewStockController(useCase port.StockUseCase) port.StockController {
+	return &stockController{useCase}
+}
+
+func (c *stockController) Update(ctx context.Context, p port.Presenter, i dto.UpdateProductStockInput) ([]byte, error) {
+	product, err := c.useCase.Update(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: product})
+}
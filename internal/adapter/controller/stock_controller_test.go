@@ -0,0 +1,52 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+)
+
+func TestStockController_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStockUseCase := mockport.NewMockStockUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewStockController(mockStockUseCase)
+
+	ctx := context.Background()
+	quantity := int64(20)
+	input := dto.UpdateProductStockInput{
+		ProductID:     1,
+		StockMode:     valueobject.StockCounted,
+		StockQuantity: &quantity,
+	}
+
+	mockProduct := &entity.Product{
+		ID:            1,
+		Name:          "X-Burger",
+		StockMode:     valueobject.StockCounted,
+		StockQuantity: 20,
+		Available:     true,
+	}
+
+	mockStockUseCase.EXPECT().
+		Update(ctx, input).
+		Return(mockProduct, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockProduct}).
+		Return([]byte{}, nil)
+
+	output, err := controller.Update(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
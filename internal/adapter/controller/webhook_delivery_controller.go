@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookDeliveryController struct {
+	useCase port.WebhookDeliveryUseCase
+}
+
+func NewWebhookDeliveryController(useCase port.WebhookDeliveryUseCase) port.WebhookDeliveryController {
+	return &webhookDeliveryController{useCase}
+}
+
+func (c *webhookDeliveryController) List(ctx context.Context, p port.Presenter, i dto.ListWebhookDeliveriesInput) ([]byte, error) {
+	deliveries, total, err := c.useCase.List(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: deliveries,
+	})
+}
+
+func (c *webhookDeliveryController) Redeliver(ctx context.Context, p port.Presenter, i dto.RedeliverWebhookInput) ([]byte, error) {
+	delivery, err := c.useCase.Redeliver(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: delivery})
+}
@@ -0,0 +1,105 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+)
+
+func TestWebhookDeliveryController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookDeliveryUseCase := mockport.NewMockWebhookDeliveryUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookDeliveryController(mockWebhookDeliveryUseCase)
+
+	ctx := context.Background()
+	input := dto.ListWebhookDeliveriesInput{
+		SubscriptionID: 1,
+		Status:         valueobject.DeliveryFailed,
+		Page:           1,
+		Limit:          10,
+	}
+
+	mockDeliveries := []*entity.WebhookDelivery{
+		{ID: 1, SubscriptionID: 1, Status: valueobject.DeliveryFailed},
+	}
+
+	mockWebhookDeliveryUseCase.EXPECT().
+		List(ctx, input).
+		Return(mockDeliveries, int64(1), nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{
+			Result: mockDeliveries,
+			Total:  int64(1),
+			Page:   1,
+			Limit:  10,
+		}).
+		Return([]byte{}, nil)
+
+	output, err := controller.List(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestWebhookDeliveryController_Redeliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookDeliveryUseCase := mockport.NewMockWebhookDeliveryUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookDeliveryController(mockWebhookDeliveryUseCase)
+
+	ctx := context.Background()
+	input := dto.RedeliverWebhookInput{
+		SubscriptionID: 1,
+		DeliveryID:     1,
+	}
+
+	mockDelivery := &entity.WebhookDelivery{ID: 2, SubscriptionID: 1, Status: valueobject.DeliveryPending}
+
+	mockWebhookDeliveryUseCase.EXPECT().
+		Redeliver(ctx, input).
+		Return(mockDelivery, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockDelivery}).
+		Return([]byte{}, nil)
+
+	output, err := controller.Redeliver(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestWebhookDeliveryController_Redeliver_UseCaseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookDeliveryUseCase := mockport.NewMockWebhookDeliveryUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookDeliveryController(mockWebhookDeliveryUseCase)
+
+	ctx := context.Background()
+	input := dto.RedeliverWebhookInput{
+		SubscriptionID: 1,
+		DeliveryID:     1,
+	}
+
+	mockWebhookDeliveryUseCase.EXPECT().
+		Redeliver(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Redeliver(ctx, mockPresenter, input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookSubscriptionController struct {
+	useCase port.WebhookSubscriptionUseCase
+}
+
+func NewWebhookSubscriptionController(useCase port.WebhookSubscriptionUseCase) port.WebhookSubscriptionController {
+	return &webhookSubscriptionController{useCase}
+}
+
+func (c *webhookSubscriptionController) List(ctx context.Context, p port.Presenter, i dto.ListWebhookSubscriptionsInput) ([]byte, error) {
+	subscriptions, total, err := c.useCase.List(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{
+		Total:  total,
+		Page:   i.Page,
+		Limit:  i.Limit,
+		Result: subscriptions,
+	})
+}
+
+func (c *webhookSubscriptionController) Create(ctx context.Context, p port.Presenter, i dto.CreateWebhookSubscriptionInput) ([]byte, error) {
+	subscription, err := c.useCase.Create(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: subscription})
+}
+
+func (c *webhookSubscriptionController) Get(ctx context.Context, p port.Presenter, i dto.GetWebhookSubscriptionInput) ([]byte, error) {
+	subscription, err := c.useCase.Get(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: subscription})
+}
+
+func (c *webhookSubscriptionController) Update(ctx context.Context, p port.Presenter, i dto.UpdateWebhookSubscriptionInput) ([]byte, error) {
+	subscription, err := c.useCase.Update(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: subscription})
+}
+
+func (c *webhookSubscriptionController) Delete(ctx context.Context, p port.Presenter, i dto.DeleteWebhookSubscriptionInput) ([]byte, error) {
+	subscription, err := c.useCase.Delete(ctx, i)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.Present(dto.PresenterInput{Result: subscription})
+}
@@ -0,0 +1,102 @@
+package controller_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/controller"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+)
+
+func TestWebhookSubscriptionController_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookSubscriptionUseCase := mockport.NewMockWebhookSubscriptionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookSubscriptionController(mockWebhookSubscriptionUseCase)
+
+	ctx := context.Background()
+	input := dto.ListWebhookSubscriptionsInput{
+		Page:  1,
+		Limit: 10,
+	}
+
+	mockSubscriptions := []*entity.WebhookSubscription{
+		{ID: 1, URL: "https://partner-a.example.com/webhooks"},
+		{ID: 2, URL: "https://partner-b.example.com/webhooks"},
+	}
+
+	mockWebhookSubscriptionUseCase.EXPECT().
+		List(ctx, input).
+		Return(mockSubscriptions, int64(2), nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{
+			Result: mockSubscriptions,
+			Total:  int64(2),
+			Page:   1,
+			Limit:  10,
+		}).
+		Return([]byte{}, nil)
+
+	output, err := controller.List(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestWebhookSubscriptionController_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookSubscriptionUseCase := mockport.NewMockWebhookSubscriptionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookSubscriptionController(mockWebhookSubscriptionUseCase)
+
+	ctx := context.Background()
+	input := dto.CreateWebhookSubscriptionInput{
+		URL:        "https://partner-a.example.com/webhooks",
+		EventTypes: []string{"order.created"},
+		Secret:     "partner-secret-0001",
+		Active:     true,
+	}
+
+	mockSubscription := &entity.WebhookSubscription{ID: 1, URL: input.URL, Active: true}
+
+	mockWebhookSubscriptionUseCase.EXPECT().
+		Create(ctx, input).
+		Return(mockSubscription, nil)
+
+	mockPresenter.EXPECT().
+		Present(dto.PresenterInput{Result: mockSubscription}).
+		Return([]byte{}, nil)
+
+	output, err := controller.Create(ctx, mockPresenter, input)
+	assert.NoError(t, err)
+	assert.NotNil(t, output)
+}
+
+func TestWebhookSubscriptionController_Delete_UseCaseError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockWebhookSubscriptionUseCase := mockport.NewMockWebhookSubscriptionUseCase(ctrl)
+	mockPresenter := mockport.NewMockPresenter(ctrl)
+	controller := controller.NewWebhookSubscriptionController(mockWebhookSubscriptionUseCase)
+
+	ctx := context.Background()
+	input := dto.DeleteWebhookSubscriptionInput{ID: 1}
+
+	mockWebhookSubscriptionUseCase.EXPECT().
+		Delete(ctx, input).
+		Return(nil, assert.AnError)
+
+	output, err := controller.Delete(ctx, mockPresenter, input)
+	assert.Error(t, err)
+	assert.Nil(t, output)
+}
@@ -0,0 +1,29 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogGateway struct {
+	dataSource port.CatalogDataSource
+}
+
+func NewCatalogGateway(dataSource port.CatalogDataSource) port.CatalogGateway {
+	return &catalogGateway{dataSource}
+}
+
+func (g *catalogGateway) FindAllCategories(ctx context.Context) ([]*entity.Category, error) {
+	return g.dataSource.FindAllCategories(ctx)
+}
+
+func (g *catalogGateway) FindAllProducts(ctx context.Context) ([]*entity.Product, error) {
+	return g.dataSource.FindAllProducts(ctx)
+}
+
+// Apply writes the categories and products of the import in a single transaction
+func (g *catalogGateway) Apply(ctx context.Context, catalogImport *entity.CatalogImport) error {
+	return g.dataSource.Apply(ctx, catalogImport)
+}
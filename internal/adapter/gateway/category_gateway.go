@@ -29,6 +29,10 @@ func (g *categoryGateway) FindAll(ctx context.Context, name string, page, limit
 	return g.dataSource.FindAll(ctx, filters, page, limit)
 }
 
+func (g *categoryGateway) FindAllActive(ctx context.Context) ([]*entity.Category, error) {
+	return g.dataSource.FindAllActive(ctx)
+}
+
 func (g *categoryGateway) Create(ctx context.Context, category *entity.Category) error {
 	return g.dataSource.Create(ctx, category)
 }
@@ -37,6 +41,10 @@ func (g *categoryGateway) Update(ctx context.Context, category *entity.Category)
 	return g.dataSource.Update(ctx, category)
 }
 
+func (g *categoryGateway) ReplaceAvailabilityWindows(ctx context.Context, categoryID uint64, windows []entity.CategoryAvailabilityWindow) error {
+	return g.dataSource.ReplaceAvailabilityWindows(ctx, categoryID, windows)
+}
+
 func (g *categoryGateway) Delete(ctx context.Context, id uint64) error {
 	return g.dataSource.Delete(ctx, id)
 }
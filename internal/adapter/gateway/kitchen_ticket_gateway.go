@@ -0,0 +1,51 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenTicketGateway struct {
+	dataSource port.KitchenTicketDataSource
+}
+
+func NewKitchenTicketGateway(dataSource port.KitchenTicketDataSource) port.KitchenTicketGateway {
+	return &kitchenTicketGateway{dataSource}
+}
+
+func (g *kitchenTicketGateway) FindByID(ctx context.Context, id uint64) (*entity.KitchenTicket, error) {
+	return g.dataSource.FindByID(ctx, id)
+}
+
+// FindAll returns the tickets of the orders still being prepared, the tickets of cancelled
+// and ready orders are left out of the kitchen screens
+func (g *kitchenTicketGateway) FindAll(ctx context.Context, station valueobject.KitchenStation, status valueobject.KitchenTicketStatus, page, limit int) ([]*entity.KitchenTicket, int64, error) {
+	filters := map[string]interface{}{
+		"order_statuses": []valueobject.OrderStatus{valueobject.RECEIVED, valueobject.PREPARING},
+	}
+
+	if station != "" {
+		filters["station"] = station
+	}
+
+	if status != "" {
+		filters["status"] = status
+	}
+
+	return g.dataSource.FindAll(ctx, filters, page, limit)
+}
+
+func (g *kitchenTicketGateway) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.KitchenTicket, error) {
+	return g.dataSource.FindAllByOrderID(ctx, orderID)
+}
+
+func (g *kitchenTicketGateway) CreateAll(ctx context.Context, tickets []*entity.KitchenTicket) error {
+	return g.dataSource.CreateAll(ctx, tickets)
+}
+
+func (g *kitchenTicketGateway) Update(ctx context.Context, ticket *entity.KitchenTicket) error {
+	return g.dataSource.Update(ctx, ticket)
+}
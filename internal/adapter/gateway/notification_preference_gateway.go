@@ -0,0 +1,32 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceGateway struct {
+	dataSource port.NotificationPreferenceDataSource
+}
+
+func NewNotificationPreferenceGateway(dataSource port.NotificationPreferenceDataSource) port.NotificationPreferenceGateway {
+	return &notificationPreferenceGateway{dataSource}
+}
+
+func (g *notificationPreferenceGateway) FindByCustomerID(ctx context.Context, customerID uint64) (*entity.NotificationPreference, error) {
+	return g.dataSource.FindByCustomerID(ctx, customerID)
+}
+
+func (g *notificationPreferenceGateway) Create(ctx context.Context, preference *entity.NotificationPreference) error {
+	return g.dataSource.Create(ctx, preference)
+}
+
+func (g *notificationPreferenceGateway) Update(ctx context.Context, preference *entity.NotificationPreference) error {
+	return g.dataSource.Update(ctx, preference)
+}
+
+func (g *notificationPreferenceGateway) Delete(ctx context.Context, id uint64) error {
+	return g.dataSource.Delete(ctx, id)
+}
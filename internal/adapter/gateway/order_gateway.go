@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
@@ -21,11 +22,39 @@ func (g *orderGateway) FindByID(ctx context.Context, id uint64) (*entity.Order,
 	return g.dataSource.FindByID(ctx, id)
 }
 
+// FindByGuestToken returns the guest order of the token, or nil when there's none
+func (g *orderGateway) FindByGuestToken(ctx context.Context, guestToken string) (*entity.Order, error) {
+	filters := map[string]interface{}{
+		"guest_token": guestToken,
+	}
+
+	orders, _, err := g.dataSource.FindAll(ctx, filters, "", 1, 1)
+	if err != nil || len(orders) == 0 {
+		return nil, err
+	}
+	return orders[0], nil
+}
+
+// FindByPaymentReference returns the order the payment was created for, or nil when there's none
+func (g *orderGateway) FindByPaymentReference(ctx context.Context, paymentReference string) (*entity.Order, error) {
+	filters := map[string]interface{}{
+		"payment_reference": paymentReference,
+	}
+
+	orders, _, err := g.dataSource.FindAll(ctx, filters, "", 1, 1)
+	if err != nil || len(orders) == 0 {
+		return nil, err
+	}
+	return orders[0], nil
+}
+
 func (g *orderGateway) FindAll(
 	ctx context.Context,
 	customerId uint64,
 	status []valueobject.OrderStatus,
 	statusExclude []valueobject.OrderStatus,
+	channels []valueobject.OrderChannel,
+	fulfilmentModes []valueobject.FulfilmentMode,
 	page,
 	limit int,
 	sort string,
@@ -42,6 +71,12 @@ func (g *orderGateway) FindAll(
 	if statusExclude != nil {
 		filters["statuses_exclude"] = statusExclude
 	}
+	if channels != nil {
+		filters["channels"] = channels
+	}
+	if fulfilmentModes != nil {
+		filters["fulfilment_modes"] = fulfilmentModes
+	}
 
 	// Create Sort "status:d,created_at" -> "status desc, created_at asc"
 	sortFormatted := strings.ReplaceAll(sort, ":d", " desc")
@@ -49,6 +84,58 @@ func (g *orderGateway) FindAll(
 	return g.dataSource.FindAll(ctx, filters, sortFormatted, page, limit)
 }
 
+// FindByCustomer returns the orders the customer placed in the period, the newest first.
+// Zero from or to leaves the period open on that side
+func (g *orderGateway) FindByCustomer(ctx context.Context, customerID uint64, from, to time.Time, page, limit int) ([]*entity.Order, int64, error) {
+	return g.dataSource.FindAll(ctx, customerPeriodFilters(customerID, from, to), "created_at desc, id desc", page, limit)
+}
+
+// SumCustomerSpending sums the totals of the orders the customer paid in the period,
+// the orders not paid yet and the cancelled ones are left out
+func (g *orderGateway) SumCustomerSpending(ctx context.Context, customerID uint64, from, to time.Time) (float64, error) {
+	filters := customerPeriodFilters(customerID, from, to)
+	filters["statuses_exclude"] = []valueobject.OrderStatus{valueobject.OPEN, valueobject.PENDING, valueobject.CANCELLED}
+	return g.dataSource.SumTotals(ctx, filters)
+}
+
+func customerPeriodFilters(customerID uint64, from, to time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"customer_id":  customerID,
+		"created_from": from,
+		"created_to":   to,
+	}
+}
+
+// FindIdle returns the oldest orders on the status not updated since updatedBefore
+func (g *orderGateway) FindIdle(ctx context.Context, status valueobject.OrderStatus, updatedBefore time.Time, limit int) ([]*entity.Order, error) {
+	filters := map[string]interface{}{
+		"statuses":       []valueobject.OrderStatus{status},
+		"updated_before": updatedBefore,
+	}
+
+	orders, _, err := g.dataSource.FindAll(ctx, filters, "updated_at", 1, limit)
+	return orders, err
+}
+
+// FindOnPickupBoard returns the orders of the day being prepared or ready to be picked up, on the order they reached
+// the status. Delivery orders are handed to the courier and the codes of the other days repeat, so they are left out
+func (g *orderGateway) FindOnPickupBoard(ctx context.Context, day time.Time, limit int) ([]*entity.Order, error) {
+	filters := map[string]interface{}{
+		"statuses":         []valueobject.OrderStatus{valueobject.PREPARING, valueobject.READY},
+		"fulfilment_modes": []valueobject.FulfilmentMode{valueobject.FulfilmentDineIn, valueobject.FulfilmentTakeaway},
+		"with_pickup_code": true,
+		"pickup_date":      day,
+	}
+
+	orders, _, err := g.dataSource.FindAll(ctx, filters, "updated_at", 1, limit)
+	return orders, err
+}
+
+// NextPickupNumber returns the next number of the pickup codes of the day, they start at 1 every day
+func (g *orderGateway) NextPickupNumber(ctx context.Context, day time.Time) (uint32, error) {
+	return g.dataSource.NextPickupNumber(ctx, day)
+}
+
 func (g *orderGateway) Create(ctx context.Context, order *entity.Order) error {
 	return g.dataSource.Create(ctx, order)
 }
@@ -57,6 +144,15 @@ func (g *orderGateway) Update(ctx context.Context, order *entity.Order) error {
 	return g.dataSource.Update(ctx, order)
 }
 
+func (g *orderGateway) UpdateTotals(ctx context.Context, order *entity.Order) error {
+	return g.dataSource.UpdateTotals(ctx, order)
+}
+
 func (g *orderGateway) Delete(ctx context.Context, id uint64) error {
 	return g.dataSource.Delete(ctx, id)
 }
+
+// Transaction runs fn in a transaction, the gateways called with its context take part in it
+func (g *orderGateway) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return g.dataSource.Transaction(ctx, fn)
+}
@@ -34,14 +34,28 @@ func (g *orderHistoryGateway) FindAll(ctx context.Context, orderID uint64, statu
 	return g.dataSource.FindAll(ctx, filters, page, limit)
 }
 
+func (g *orderHistoryGateway) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error) {
+	return g.dataSource.FindAllByOrderID(ctx, orderID)
+}
+
+// Create seals the history to the last sealed history of the order before saving it
 func (g *orderHistoryGateway) Create(ctx context.Context, orderHistory *entity.OrderHistory) error {
-	orderHistory.CreatedAt = time.Now()
+	// Truncated to microseconds, the precision stored by the database, so the hash can be recomputed
+	orderHistory.CreatedAt = time.Now().UTC().Truncate(time.Microsecond)
 	if orderHistory.StaffID != nil && *orderHistory.StaffID <= 0 {
 		orderHistory.StaffID = nil
 	}
-	return g.dataSource.Create(ctx, orderHistory)
-}
 
-func (g *orderHistoryGateway) Delete(ctx context.Context, id uint64) error {
-	return g.dataSource.Delete(ctx, id)
+	last, err := g.dataSource.FindLastSealedByOrderID(ctx, orderHistory.OrderID)
+	if err != nil {
+		return err
+	}
+
+	previousHash := ""
+	if last != nil {
+		previousHash = *last.Hash
+	}
+	orderHistory.Seal(previousHash)
+
+	return g.dataSource.Create(ctx, orderHistory)
 }
@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
@@ -15,8 +16,8 @@ func NewOrderProductGateway(dataSource port.OrderProductDataSource) port.OrderPr
 	return &orderProductGateway{dataSource}
 }
 
-func (g *orderProductGateway) FindByID(ctx context.Context, orderId, productId uint64) (*entity.OrderProduct, error) {
-	return g.dataSource.FindByID(ctx, orderId, productId)
+func (g *orderProductGateway) FindByID(ctx context.Context, id uint64) (*entity.OrderProduct, error) {
+	return g.dataSource.FindByID(ctx, id)
 }
 
 func (g *orderProductGateway) FindAll(ctx context.Context, orderId uint64, productId uint64, page, limit int) ([]*entity.OrderProduct, int64, error) {
@@ -41,6 +42,10 @@ func (g *orderProductGateway) Update(ctx context.Context, orderProduct *entity.O
 	return g.dataSource.Update(ctx, orderProduct)
 }
 
-func (g *orderProductGateway) Delete(ctx context.Context, orderId, productId uint64) error {
-	return g.dataSource.Delete(ctx, orderId, productId)
+func (g *orderProductGateway) Delete(ctx context.Context, id uint64) error {
+	return g.dataSource.Delete(ctx, id)
+}
+
+func (g *orderProductGateway) FindAllSold(ctx context.Context, from, to time.Time) ([]*entity.OrderProduct, error) {
+	return g.dataSource.FindAllSold(ctx, from, to)
 }
@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
@@ -19,7 +20,8 @@ func (g *productGateway) FindByID(ctx context.Context, id uint64) (*entity.Produ
 	return g.dataSource.FindByID(ctx, id)
 }
 
-func (g *productGateway) FindAll(ctx context.Context, name string, categoryID uint64, page, limit int) ([]*entity.Product, int64, error) {
+// FindAll returns the products matching the filters, a non zero availableAt keeps only the products sold at the moment
+func (g *productGateway) FindAll(ctx context.Context, name string, categoryID uint64, availableAt time.Time, page, limit int) ([]*entity.Product, int64, error) {
 	filters := make(map[string]interface{})
 
 	if name != "" {
@@ -28,10 +30,17 @@ func (g *productGateway) FindAll(ctx context.Context, name string, categoryID ui
 	if categoryID != 0 {
 		filters["category_id"] = categoryID
 	}
+	if !availableAt.IsZero() {
+		filters["available_at"] = availableAt
+	}
 
 	return g.dataSource.FindAll(ctx, filters, page, limit)
 }
 
+func (g *productGateway) FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error) {
+	return g.dataSource.FindAllByCategoryIDs(ctx, categoryIDs)
+}
+
 func (g *productGateway) Create(ctx context.Context, product *entity.Product) error {
 	return g.dataSource.Create(ctx, product)
 }
@@ -40,6 +49,31 @@ func (g *productGateway) Update(ctx context.Context, product *entity.Product) er
 	return g.dataSource.Update(ctx, product)
 }
 
+func (g *productGateway) ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error {
+	return g.dataSource.ReplaceModifierGroups(ctx, productID, groups)
+}
+
+func (g *productGateway) ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error {
+	return g.dataSource.ReplaceBundleSlots(ctx, productID, slots)
+}
+
+func (g *productGateway) ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error {
+	return g.dataSource.ReplaceAvailabilityWindows(ctx, productID, windows)
+}
+
+// UpdateStock adds the changes to the stock of the counted products, returning the products changed
+func (g *productGateway) UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error) {
+	return g.dataSource.UpdateStock(ctx, changes)
+}
+
 func (g *productGateway) Delete(ctx context.Context, id uint64) error {
 	return g.dataSource.Delete(ctx, id)
 }
+
+func (g *productGateway) FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error) {
+	return g.dataSource.FindPrices(ctx, productID, page, limit)
+}
+
+func (g *productGateway) SchedulePrice(ctx context.Context, price *entity.ProductPrice) error {
+	return g.dataSource.SchedulePrice(ctx, price)
+}
@@ -0,0 +1,54 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionGateway struct {
+	dataSource port.PromotionDataSource
+}
+
+func NewPromotionGateway(dataSource port.PromotionDataSource) port.PromotionGateway {
+	return &promotionGateway{dataSource}
+}
+
+func (g *promotionGateway) FindByID(ctx context.Context, id uint64) (*entity.Promotion, error) {
+	return g.dataSource.FindByID(ctx, id)
+}
+
+func (g *promotionGateway) FindByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	return g.dataSource.FindByCode(ctx, entity.NormalizeCouponCode(code))
+}
+
+func (g *promotionGateway) FindAll(ctx context.Context, name string, page, limit int) ([]*entity.Promotion, int64, error) {
+	filters := make(map[string]interface{})
+
+	if name != "" {
+		filters["name"] = name
+	}
+
+	return g.dataSource.FindAll(ctx, filters, page, limit)
+}
+
+func (g *promotionGateway) FindAllAutomatic(ctx context.Context) ([]*entity.Promotion, error) {
+	return g.dataSource.FindAllAutomatic(ctx)
+}
+
+func (g *promotionGateway) CountCustomerUsage(ctx context.Context, promotionID, customerID, excludeOrderID uint64) (int64, error) {
+	return g.dataSource.CountCustomerUsage(ctx, promotionID, customerID, excludeOrderID)
+}
+
+func (g *promotionGateway) Create(ctx context.Context, promotion *entity.Promotion) error {
+	return g.dataSource.Create(ctx, promotion)
+}
+
+func (g *promotionGateway) Update(ctx context.Context, promotion *entity.Promotion) error {
+	return g.dataSource.Update(ctx, promotion)
+}
+
+func (g *promotionGateway) Delete(ctx context.Context, id uint64) error {
+	return g.dataSource.Delete(ctx, id)
+}
@@ -0,0 +1,48 @@
+package gateway
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookDeliveryGateway struct {
+	dataSource port.WebhookDeliveryDataSource
+}
+
+func NewWebhookDeliveryGateway(dataSource port.WebhookDeliveryDataSource) port.WebhookDeliveryGateway {
+	return &webhookDeliveryGateway{dataSource}
+}
+
+func (g *webhookDeliveryGateway) FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error) {
+	return g.dataSource.FindByID(ctx, id)
+}
+
+func (g *webhookDeliveryGateway) FindAll(ctx context.Context, subscriptionID uint64, status valueobject.WebhookDeliveryStatus, page, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	filters := make(map[string]interface{})
+
+	if subscriptionID != 0 {
+		filters["subscription_id"] = subscriptionID
+	}
+
+	if status != "" {
+		filters["status"] = status
+	}
+
+	return g.dataSource.FindAll(ctx, filters, page, limit)
+}
+
+func (g *webhookDeliveryGateway) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	return g.dataSource.ClaimDue(ctx, now, lease, limit)
+}
+
+func (g *webhookDeliveryGateway) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return g.dataSource.Create(ctx, delivery)
+}
+
+func (g *webhookDeliveryGateway) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	return g.dataSource.Update(ctx, delivery)
+}
@@ -0,0 +1,40 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookSubscriptionGateway struct {
+	dataSource port.WebhookSubscriptionDataSource
+}
+
+func NewWebhookSubscriptionGateway(dataSource port.WebhookSubscriptionDataSource) port.WebhookSubscriptionGateway {
+	return &webhookSubscriptionGateway{dataSource}
+}
+
+func (g *webhookSubscriptionGateway) FindByID(ctx context.Context, id uint64) (*entity.WebhookSubscription, error) {
+	return g.dataSource.FindByID(ctx, id)
+}
+
+func (g *webhookSubscriptionGateway) FindAll(ctx context.Context, page, limit int) ([]*entity.WebhookSubscription, int64, error) {
+	return g.dataSource.FindAll(ctx, make(map[string]interface{}), page, limit)
+}
+
+func (g *webhookSubscriptionGateway) FindAllActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	return g.dataSource.FindAllActive(ctx)
+}
+
+func (g *webhookSubscriptionGateway) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	return g.dataSource.Create(ctx, subscription)
+}
+
+func (g *webhookSubscriptionGateway) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	return g.dataSource.Update(ctx, subscription)
+}
+
+func (g *webhookSubscriptionGateway) Delete(ctx context.Context, id uint64) error {
+	return g.dataSource.Delete(ctx, id)
+}
@@ -0,0 +1,8 @@
+package presenter
+
+type AvailabilityWindowJsonResponse struct {
+	Weekday   int    `json:"weekday" example:"1"`
+	StartTime string `json:"start_time" example:"06:00"`
+	EndTime   string `json:"end_time" example:"10:30"`
+	Timezone  string `json:"timezone" example:"America/Sao_Paulo"`
+}
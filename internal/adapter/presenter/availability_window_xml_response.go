@@ -0,0 +1,13 @@
+package presenter
+
+// AvailabilityXmlResponse wraps the windows so the element is left out when there are none
+type AvailabilityXmlResponse struct {
+	Windows []AvailabilityWindowXmlResponse `xml:"window"`
+}
+
+type AvailabilityWindowXmlResponse struct {
+	Weekday   int    `xml:"weekday" example:"1"`
+	StartTime string `xml:"start_time" example:"06:00"`
+	EndTime   string `xml:"end_time" example:"10:30"`
+	Timezone  string `xml:"timezone" example:"America/Sao_Paulo"`
+}
@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// CatalogCsvHeader are the columns of the catalog CSV file, in the order they are exported
+var CatalogCsvHeader = []string{"category", "parent_category", "name", "description", "price", "stock_mode", "stock_quantity", "available"}
+
+type catalogCsvPresenter struct{}
+
+// NewCatalogCsvPresenter creates a new CatalogCsvPresenter
+func NewCatalogCsvPresenter() port.Presenter {
+	return &catalogCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *catalogCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Catalog:
+		records := make([][]string, len(v.Rows))
+		for i, row := range v.Rows {
+			records[i] = toCatalogCsvRecord(row)
+		}
+		return writeCsv(CatalogCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// toCatalogCsvRecord converts a catalog row to the CSV columns, the rows without name only carry a category
+func toCatalogCsvRecord(row entity.CatalogRow) []string {
+	if row.Name == "" {
+		return []string{row.Category, row.ParentCategory, "", "", "", "", "", ""}
+	}
+	var quantity, available string
+	if row.StockQuantity != nil {
+		quantity = strconv.FormatInt(*row.StockQuantity, 10)
+	}
+	if row.Available != nil {
+		available = strconv.FormatBool(*row.Available)
+	}
+	return []string{
+		row.Category,
+		row.ParentCategory,
+		row.Name,
+		row.Description,
+		formatCsvPrice(row.Price),
+		row.StockMode.String(),
+		quantity,
+		available,
+	}
+}
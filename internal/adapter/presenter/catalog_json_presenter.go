@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogJsonPresenter struct{}
+
+// NewCatalogJsonPresenter creates a new CatalogJsonPresenter
+func NewCatalogJsonPresenter() port.Presenter {
+	return &catalogJsonPresenter{}
+}
+
+// Present writes the response to the client
+func (p *catalogJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Catalog:
+		products := make([]CatalogProductJsonResponse, len(v.Rows))
+		for i, row := range v.Rows {
+			products[i] = CatalogProductJsonResponse{
+				Category:       row.Category,
+				ParentCategory: row.ParentCategory,
+				Name:           row.Name,
+				Description:    row.Description,
+				Price:          row.Price,
+				StockMode:      row.StockMode.String(),
+				StockQuantity:  row.StockQuantity,
+				Available:      row.Available,
+			}
+		}
+		output := CatalogJsonResponse{
+			Products:    products,
+			GeneratedAt: v.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		return json.Marshal(output)
+	case *entity.CatalogImport:
+		summary := v.Summary()
+		errs := make([]CatalogImportErrorJsonResponse, len(v.Errors))
+		for i, err := range v.Errors {
+			errs[i] = CatalogImportErrorJsonResponse{Row: err.Row, Message: err.Message}
+		}
+		output := CatalogImportJsonResponse{
+			DryRun:            v.DryRun,
+			Applied:           v.Applied,
+			Rows:              v.Rows,
+			CategoriesCreated: summary.CategoriesCreated,
+			CategoriesUpdated: summary.CategoriesUpdated,
+			ProductsCreated:   summary.ProductsCreated,
+			ProductsUpdated:   summary.ProductsUpdated,
+			Unchanged:         summary.Unchanged,
+			Errors:            errs,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
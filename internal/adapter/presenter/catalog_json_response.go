@@ -0,0 +1,35 @@
+package presenter
+
+type CatalogJsonResponse struct {
+	Products    []CatalogProductJsonResponse `json:"products"`
+	GeneratedAt string                       `json:"generated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+// CatalogProductJsonResponse is a row of the catalog, the rows without name only carry a category
+type CatalogProductJsonResponse struct {
+	Category       string  `json:"category" example:"Burgers"`
+	ParentCategory string  `json:"parent_category,omitempty" example:"Foods"`
+	Name           string  `json:"name,omitempty" example:"X-Burger"`
+	Description    string  `json:"description,omitempty" example:"Hamburger with cheese"`
+	Price          float64 `json:"price,omitempty" example:"25.90"`
+	StockMode      string  `json:"stock_mode,omitempty" example:"UNLIMITED"`
+	StockQuantity  *int64  `json:"stock_quantity,omitempty" example:"0"`
+	Available      *bool   `json:"available,omitempty" example:"true"`
+}
+
+type CatalogImportJsonResponse struct {
+	DryRun            bool                             `json:"dry_run" example:"false"`
+	Applied           bool                             `json:"applied" example:"true"`
+	Rows              int                              `json:"rows" example:"12"`
+	CategoriesCreated int                              `json:"categories_created" example:"1"`
+	CategoriesUpdated int                              `json:"categories_updated" example:"0"`
+	ProductsCreated   int                              `json:"products_created" example:"3"`
+	ProductsUpdated   int                              `json:"products_updated" example:"2"`
+	Unchanged         int                              `json:"unchanged" example:"7"`
+	Errors            []CatalogImportErrorJsonResponse `json:"errors"`
+}
+
+type CatalogImportErrorJsonResponse struct {
+	Row     int    `json:"row" example:"3"`
+	Message string `json:"message" example:"price must be greater than zero"`
+}
@@ -0,0 +1,63 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogXmlPresenter struct{}
+
+// NewCatalogXmlPresenter creates a new CatalogXmlPresenter
+func NewCatalogXmlPresenter() port.Presenter {
+	return &catalogXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *catalogXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Catalog:
+		products := make([]CatalogProductXmlResponse, len(v.Rows))
+		for i, row := range v.Rows {
+			products[i] = CatalogProductXmlResponse{
+				Category:       row.Category,
+				ParentCategory: row.ParentCategory,
+				Name:           row.Name,
+				Description:    row.Description,
+				Price:          row.Price,
+				StockMode:      row.StockMode.String(),
+				StockQuantity:  row.StockQuantity,
+				Available:      row.Available,
+			}
+		}
+		output := CatalogXmlResponse{
+			Products:    products,
+			GeneratedAt: v.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		return xml.Marshal(output)
+	case *entity.CatalogImport:
+		summary := v.Summary()
+		errs := make([]CatalogImportErrorXmlResponse, len(v.Errors))
+		for i, err := range v.Errors {
+			errs[i] = CatalogImportErrorXmlResponse{Row: err.Row, Message: err.Message}
+		}
+		output := CatalogImportXmlResponse{
+			DryRun:            v.DryRun,
+			Applied:           v.Applied,
+			Rows:              v.Rows,
+			CategoriesCreated: summary.CategoriesCreated,
+			CategoriesUpdated: summary.CategoriesUpdated,
+			ProductsCreated:   summary.ProductsCreated,
+			ProductsUpdated:   summary.ProductsUpdated,
+			Unchanged:         summary.Unchanged,
+			Errors:            errs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
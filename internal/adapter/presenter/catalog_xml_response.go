@@ -0,0 +1,39 @@
+package presenter
+
+import "encoding/xml"
+
+type CatalogXmlResponse struct {
+	XMLName     xml.Name                    `xml:"catalog"`
+	Products    []CatalogProductXmlResponse `xml:"products>product"`
+	GeneratedAt string                      `xml:"generated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+// CatalogProductXmlResponse follows the elements of ProductXmlResponse, the rows without name only carry a category
+type CatalogProductXmlResponse struct {
+	Category       string  `xml:"category" example:"Burgers"`
+	ParentCategory string  `xml:"parent_category,omitempty" example:"Foods"`
+	Name           string  `xml:"name,omitempty" example:"X-Burger"`
+	Description    string  `xml:"description,omitempty" example:"Hamburger with cheese"`
+	Price          float64 `xml:"price,omitempty" example:"25.90"`
+	StockMode      string  `xml:"stock_mode,omitempty" example:"UNLIMITED"`
+	StockQuantity  *int64  `xml:"stock_quantity,omitempty" example:"0"`
+	Available      *bool   `xml:"available,omitempty" example:"true"`
+}
+
+type CatalogImportXmlResponse struct {
+	XMLName           xml.Name                        `xml:"catalog_import"`
+	DryRun            bool                            `xml:"dry_run" example:"false"`
+	Applied           bool                            `xml:"applied" example:"true"`
+	Rows              int                             `xml:"rows" example:"12"`
+	CategoriesCreated int                             `xml:"categories_created" example:"1"`
+	CategoriesUpdated int                             `xml:"categories_updated" example:"0"`
+	ProductsCreated   int                             `xml:"products_created" example:"3"`
+	ProductsUpdated   int                             `xml:"products_updated" example:"2"`
+	Unchanged         int                             `xml:"unchanged" example:"7"`
+	Errors            []CatalogImportErrorXmlResponse `xml:"errors>error"`
+}
+
+type CatalogImportErrorXmlResponse struct {
+	Row     int    `xml:"row" example:"3"`
+	Message string `xml:"message" example:"price must be greater than zero"`
+}
@@ -0,0 +1,43 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var categoryCsvHeader = []string{"id", "name", "parent_id", "display_order", "active", "image_url", "created_at", "updated_at"}
+
+type categoryCsvPresenter struct{}
+
+// NewCategoryCsvPresenter creates a new CategoryCsvPresenter, only the lists of categories are presented
+func NewCategoryCsvPresenter() port.Presenter {
+	return &categoryCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *categoryCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.Category:
+		records := make([][]string, len(v))
+		for i, category := range v {
+			records[i] = []string{
+				strconv.FormatUint(category.ID, 10),
+				category.Name,
+				formatCsvID(category.ParentID),
+				strconv.Itoa(category.DisplayOrder),
+				strconv.FormatBool(category.Active),
+				category.ImageURL,
+				category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+				category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		return writeCsv(categoryCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -20,13 +20,31 @@ func NewCategoryJsonPresenter() port.Presenter {
 // ToCategoryJsonResponse convert entity.Category to CategoryJsonResponse
 func ToCategoryJsonResponse(category *entity.Category) CategoryJsonResponse {
 	return CategoryJsonResponse{
-		ID:        category.ID,
-		Name:      category.Name,
-		CreatedAt: category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		ID:             category.ID,
+		Name:           category.Name,
+		ParentID:       category.ParentID,
+		DisplayOrder:   category.DisplayOrder,
+		Active:         category.Active,
+		ImageURL:       category.ImageURL,
+		KitchenStation: kitchenStationString(category.KitchenStation),
+		Availability:   ToCategoryAvailabilityJsonResponse(category.AvailabilityWindows),
+		CreatedAt:      category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
+// ToCategoryAvailabilityJsonResponse convert a slice of entity.CategoryAvailabilityWindow to a slice of AvailabilityWindowJsonResponse
+func ToCategoryAvailabilityJsonResponse(windows []entity.CategoryAvailabilityWindow) []AvailabilityWindowJsonResponse {
+	if len(windows) == 0 {
+		return nil
+	}
+	output := make([]AvailabilityWindowJsonResponse, len(windows))
+	for i, window := range windows {
+		output[i] = toAvailabilityWindowJsonResponse(window.AvailabilityWindow)
+	}
+	return output
+}
+
 // Present write the response to the client
 func (p *categoryJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
 	switch v := pp.Result.(type) {
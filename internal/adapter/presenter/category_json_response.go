@@ -3,10 +3,16 @@ package presenter
 import "encoding/json"
 
 type CategoryJsonResponse struct {
-	ID        uint64 `json:"id" example:"1"`
-	Name      string `json:"name" example:"John Doe"`
-	CreatedAt string `json:"created_at" example:"2024-02-09T10:00:00Z"`
-	UpdatedAt string `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+	ID             uint64                           `json:"id" example:"1"`
+	Name           string                           `json:"name" example:"John Doe"`
+	ParentID       *uint64                          `json:"parent_id" example:"1"`
+	DisplayOrder   int                              `json:"display_order" example:"1"`
+	Active         bool                             `json:"active" example:"true"`
+	ImageURL       string                           `json:"image_url,omitempty" example:"https://cdn.fastfood.com/categories/foods.png"`
+	KitchenStation *string                          `json:"kitchen_station,omitempty" example:"GRILL"`
+	Availability   []AvailabilityWindowJsonResponse `json:"availability,omitempty"`
+	CreatedAt      string                           `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string                           `json:"updated_at" example:"2024-02-09T10:00:00Z"`
 }
 
 func (r CategoryJsonResponse) String() string {
@@ -0,0 +1,69 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type categoryXmlPresenter struct{}
+
+// NewCategoryXmlPresenter creates a new CategoryXmlPresenter
+func NewCategoryXmlPresenter() port.Presenter {
+	return &categoryXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *categoryXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Category:
+		output := toCategoryXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.Category:
+		categoryOutputs := make([]CategoryXmlResponse, len(v))
+		for i, category := range v {
+			categoryOutputs[i] = toCategoryXmlResponse(category)
+		}
+
+		output := &CategoryXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Categories: categoryOutputs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// toCategoryXmlResponse converts a Category entity to a CategoryXmlResponse
+func toCategoryXmlResponse(category *entity.Category) CategoryXmlResponse {
+	var availability *AvailabilityXmlResponse
+	if len(category.AvailabilityWindows) > 0 {
+		windows := make([]AvailabilityWindowXmlResponse, len(category.AvailabilityWindows))
+		for i, window := range category.AvailabilityWindows {
+			windows[i] = toAvailabilityWindowXmlResponse(window.AvailabilityWindow)
+		}
+		availability = &AvailabilityXmlResponse{Windows: windows}
+	}
+
+	return CategoryXmlResponse{
+		ID:             category.ID,
+		Name:           category.Name,
+		ParentID:       category.ParentID,
+		DisplayOrder:   category.DisplayOrder,
+		Active:         category.Active,
+		ImageURL:       category.ImageURL,
+		KitchenStation: kitchenStationString(category.KitchenStation),
+		Availability:   availability,
+		CreatedAt:      category.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      category.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,23 @@
+package presenter
+
+import "encoding/xml"
+
+type CategoryXmlResponse struct {
+	XMLName        xml.Name                 `xml:"category"`
+	ID             uint64                   `xml:"id" example:"1"`
+	Name           string                   `xml:"name" example:"Foods"`
+	ParentID       *uint64                  `xml:"parent_id,omitempty" example:"1"`
+	DisplayOrder   int                      `xml:"display_order" example:"1"`
+	Active         bool                     `xml:"active" example:"true"`
+	ImageURL       string                   `xml:"image_url,omitempty" example:"https://cdn.fastfood.com/categories/foods.png"`
+	KitchenStation *string                  `xml:"kitchen_station,omitempty" example:"GRILL"`
+	Availability   *AvailabilityXmlResponse `xml:"availability,omitempty"`
+	CreatedAt      string                   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string                   `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type CategoryXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"categories"`
+	XmlPagination
+	Categories []CategoryXmlResponse `xml:"category"`
+}
@@ -0,0 +1,35 @@
+package presenter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+)
+
+// writeCsv writes the header followed by one line per record
+func writeCsv(header []string, records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(header); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if err := w.WriteAll(records); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// formatCsvID writes an optional ID, empty when it's not set
+func formatCsvID(id *uint64) string {
+	if id == nil {
+		return ""
+	}
+	return strconv.FormatUint(*id, 10)
+}
+
+// formatCsvPrice writes a money value with two decimals
+func formatCsvPrice(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
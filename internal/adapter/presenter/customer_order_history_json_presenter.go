@@ -0,0 +1,87 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type customerOrderHistoryJsonPresenter struct{}
+
+// NewCustomerOrderHistoryJsonPresenter creates a new CustomerOrderHistoryJsonPresenter
+func NewCustomerOrderHistoryJsonPresenter() port.Presenter {
+	return &customerOrderHistoryJsonPresenter{}
+}
+
+// Present writes the response to the client
+func (p *customerOrderHistoryJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.CustomerOrderHistory:
+		orders := make([]CustomerOrderSummaryJsonResponse, len(v.Orders))
+		for i, order := range v.Orders {
+			orders[i] = CustomerOrderSummaryJsonResponse(toCustomerOrderSummary(order))
+		}
+
+		output := CustomerOrderHistoryJsonResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			CustomerID: v.CustomerID,
+			From:       formatHistoryPeriod(v.From),
+			To:         formatHistoryPeriod(v.To),
+			TotalSpent: fmt.Sprintf("%.2f", v.TotalSpent),
+			Orders:     orders,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// customerOrderSummary is the summary of an order shared by the JSON and XML responses
+type customerOrderSummary struct {
+	ID             uint64
+	Status         string
+	PickupCode     string
+	Channel        string
+	FulfilmentMode string
+	ItemsCount     uint32
+	TotalBill      string
+	CreatedAt      string
+}
+
+// toCustomerOrderSummary counts the items and calculates the bill of the order, like the order responses
+func toCustomerOrderSummary(order *entity.Order) customerOrderSummary {
+	var itemsCount uint32
+	for _, orderProduct := range order.OrderProducts {
+		itemsCount += orderProduct.Quantity
+	}
+
+	return customerOrderSummary{
+		ID:             order.ID,
+		Status:         string(order.Status),
+		PickupCode:     order.PickupCode,
+		Channel:        string(order.Channel),
+		FulfilmentMode: string(order.FulfilmentMode),
+		ItemsCount:     itemsCount,
+		TotalBill:      fmt.Sprintf("%.2f", math.Max(calculateSubtotal(order.OrderProducts)-order.DiscountTotal, 0)),
+		CreatedAt:      order.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// formatHistoryPeriod formats a side of the period, empty when the period is open on that side
+func formatHistoryPeriod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05Z07:00")
+}
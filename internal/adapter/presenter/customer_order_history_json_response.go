@@ -0,0 +1,22 @@
+package presenter
+
+type CustomerOrderHistoryJsonResponse struct {
+	JsonPagination
+	CustomerID uint64 `json:"customer_id" example:"1"`
+	From       string `json:"from,omitempty" example:"2024-02-01T00:00:00Z"`
+	To         string `json:"to,omitempty" example:"2024-03-01T00:00:00Z"`
+	// TotalSpent sums the orders paid in the whole period
+	TotalSpent string                             `json:"total_spent" example:"250.00"`
+	Orders     []CustomerOrderSummaryJsonResponse `json:"orders"`
+}
+
+type CustomerOrderSummaryJsonResponse struct {
+	ID             uint64 `json:"id" example:"1"`
+	Status         string `json:"status" example:"COMPLETED"`
+	PickupCode     string `json:"pickup_code,omitempty" example:"A42"`
+	Channel        string `json:"channel,omitempty" example:"APP"`
+	FulfilmentMode string `json:"fulfilment_mode,omitempty" example:"TAKEAWAY"`
+	ItemsCount     uint32 `json:"items_count" example:"3"`
+	TotalBill      string `json:"total_bill" example:"100.00"`
+	CreatedAt      string `json:"created_at" example:"2024-02-09T10:00:00Z"`
+}
@@ -0,0 +1,46 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type customerOrderHistoryXmlPresenter struct{}
+
+// NewCustomerOrderHistoryXmlPresenter creates a new CustomerOrderHistoryXmlPresenter
+func NewCustomerOrderHistoryXmlPresenter() port.Presenter {
+	return &customerOrderHistoryXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *customerOrderHistoryXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.CustomerOrderHistory:
+		orders := make([]CustomerOrderSummaryXmlResponse, len(v.Orders))
+		for i, order := range v.Orders {
+			orders[i] = CustomerOrderSummaryXmlResponse(toCustomerOrderSummary(order))
+		}
+
+		output := CustomerOrderHistoryXmlResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			CustomerID: v.CustomerID,
+			From:       formatHistoryPeriod(v.From),
+			To:         formatHistoryPeriod(v.To),
+			TotalSpent: fmt.Sprintf("%.2f", v.TotalSpent),
+			Orders:     orders,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,24 @@
+package presenter
+
+import "encoding/xml"
+
+type CustomerOrderHistoryXmlResponse struct {
+	XMLName xml.Name `xml:"customer_orders"`
+	XmlPagination
+	CustomerID uint64                            `xml:"customer_id" example:"1"`
+	From       string                            `xml:"from,omitempty" example:"2024-02-01T00:00:00Z"`
+	To         string                            `xml:"to,omitempty" example:"2024-03-01T00:00:00Z"`
+	TotalSpent string                            `xml:"total_spent" example:"250.00"`
+	Orders     []CustomerOrderSummaryXmlResponse `xml:"orders>order"`
+}
+
+type CustomerOrderSummaryXmlResponse struct {
+	ID             uint64 `xml:"id" example:"1"`
+	Status         string `xml:"status" example:"COMPLETED"`
+	PickupCode     string `xml:"pickup_code,omitempty" example:"A42"`
+	Channel        string `xml:"channel,omitempty" example:"APP"`
+	FulfilmentMode string `xml:"fulfilment_mode,omitempty" example:"TAKEAWAY"`
+	ItemsCount     uint32 `xml:"items_count" example:"3"`
+	TotalBill      string `xml:"total_bill" example:"100.00"`
+	CreatedAt      string `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+}
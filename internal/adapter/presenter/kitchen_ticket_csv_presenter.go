@@ -0,0 +1,48 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var kitchenTicketCsvHeader = []string{"ticket_id", "order_id", "station", "status", "product_id", "name", "quantity", "modifiers", "notes", "created_at"}
+
+type kitchenTicketCsvPresenter struct{}
+
+// NewKitchenTicketCsvPresenter creates a new KitchenTicketCsvPresenter, writing one line per item of the tickets
+func NewKitchenTicketCsvPresenter() port.Presenter {
+	return &kitchenTicketCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *kitchenTicketCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.KitchenTicket:
+		var records [][]string
+		for _, ticket := range v {
+			output := toKitchenTicketJsonResponse(ticket)
+			for _, item := range output.Items {
+				records = append(records, []string{
+					strconv.FormatUint(output.ID, 10),
+					strconv.FormatUint(output.OrderID, 10),
+					output.Station,
+					output.Status,
+					strconv.FormatUint(item.ProductID, 10),
+					item.Name,
+					strconv.FormatUint(uint64(item.Quantity), 10),
+					item.Modifiers,
+					item.Notes,
+					output.CreatedAt,
+				})
+			}
+		}
+		return writeCsv(kitchenTicketCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,91 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenTicketJsonPresenter struct{}
+
+// NewKitchenTicketJsonPresenter creates a presenter of the kitchen tickets
+func NewKitchenTicketJsonPresenter() port.Presenter {
+	return &kitchenTicketJsonPresenter{}
+}
+
+// toKitchenTicketJsonResponse convert entity.KitchenTicket to KitchenTicketJsonResponse
+func toKitchenTicketJsonResponse(ticket *entity.KitchenTicket) KitchenTicketJsonResponse {
+	items := make([]KitchenTicketItemJsonResponse, len(ticket.Items))
+	for i, item := range ticket.Items {
+		items[i] = KitchenTicketItemJsonResponse{
+			OrderProductID: item.OrderProductID,
+			ProductID:      item.ProductID,
+			Name:           item.Name,
+			Quantity:       item.Quantity,
+			Modifiers:      item.Modifiers,
+			Notes:          item.Notes,
+		}
+	}
+
+	return KitchenTicketJsonResponse{
+		ID:        ticket.ID,
+		OrderID:   ticket.OrderID,
+		Station:   ticket.Station.String(),
+		Status:    ticket.Status.String(),
+		StaffID:   ticket.StaffID,
+		Items:     items,
+		StartedAt: formatOptionalTime(ticket.StartedAt),
+		DoneAt:    formatOptionalTime(ticket.DoneAt),
+		CreatedAt: ticket.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt: ticket.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// formatOptionalTime writes an optional time, empty when it's not set
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02T15:04:05Z07:00")
+}
+
+// kitchenStationString returns the station of a product or category, nil when it is inherited
+func kitchenStationString(station *valueobject.KitchenStation) *string {
+	if station == nil {
+		return nil
+	}
+	s := station.String()
+	return &s
+}
+
+// Present write the response to the client
+func (p *kitchenTicketJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.KitchenTicket:
+		output := toKitchenTicketJsonResponse(v)
+		return json.Marshal(output)
+	case []*entity.KitchenTicket:
+		ticketOutputs := make([]KitchenTicketJsonResponse, len(v))
+		for i, ticket := range v {
+			ticketOutputs[i] = toKitchenTicketJsonResponse(ticket)
+		}
+
+		output := &KitchenTicketJsonPaginatedResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Tickets: ticketOutputs,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
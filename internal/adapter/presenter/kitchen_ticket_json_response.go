@@ -0,0 +1,28 @@
+package presenter
+
+type KitchenTicketJsonResponse struct {
+	ID        uint64                          `json:"id" example:"1"`
+	OrderID   uint64                          `json:"order_id" example:"1"`
+	Station   string                          `json:"station" example:"GRILL"`
+	Status    string                          `json:"status" example:"QUEUED"`
+	StaffID   *uint64                         `json:"staff_id,omitempty" example:"1"`
+	Items     []KitchenTicketItemJsonResponse `json:"items"`
+	StartedAt string                          `json:"started_at,omitempty" example:"2024-02-09T10:05:00Z"`
+	DoneAt    string                          `json:"done_at,omitempty" example:"2024-02-09T10:15:00Z"`
+	CreatedAt string                          `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt string                          `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type KitchenTicketItemJsonResponse struct {
+	OrderProductID uint64 `json:"order_product_id" example:"1"`
+	ProductID      uint64 `json:"product_id" example:"1"`
+	Name           string `json:"name" example:"X-Burger"`
+	Quantity       uint32 `json:"quantity" example:"2"`
+	Modifiers      string `json:"modifiers,omitempty" example:"Extra cheese, No onion"`
+	Notes          string `json:"notes,omitempty" example:"Well done"`
+}
+
+type KitchenTicketJsonPaginatedResponse struct {
+	JsonPagination
+	Tickets []KitchenTicketJsonResponse `json:"tickets"`
+}
@@ -0,0 +1,66 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenTicketXmlPresenter struct{}
+
+// NewKitchenTicketXmlPresenter creates a presenter of the kitchen tickets
+func NewKitchenTicketXmlPresenter() port.Presenter {
+	return &kitchenTicketXmlPresenter{}
+}
+
+// toKitchenTicketXmlResponse converts a KitchenTicket entity to a KitchenTicketXmlResponse
+func toKitchenTicketXmlResponse(ticket *entity.KitchenTicket) KitchenTicketXmlResponse {
+	output := toKitchenTicketJsonResponse(ticket)
+	items := make([]KitchenTicketItemXmlResponse, len(output.Items))
+	for i, item := range output.Items {
+		items[i] = KitchenTicketItemXmlResponse(item)
+	}
+
+	return KitchenTicketXmlResponse{
+		ID:        output.ID,
+		OrderID:   output.OrderID,
+		Station:   output.Station,
+		Status:    output.Status,
+		StaffID:   output.StaffID,
+		Items:     items,
+		StartedAt: output.StartedAt,
+		DoneAt:    output.DoneAt,
+		CreatedAt: output.CreatedAt,
+		UpdatedAt: output.UpdatedAt,
+	}
+}
+
+// Present writes the response to the client
+func (p *kitchenTicketXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.KitchenTicket:
+		output := toKitchenTicketXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.KitchenTicket:
+		ticketOutputs := make([]KitchenTicketXmlResponse, len(v))
+		for i, ticket := range v {
+			ticketOutputs[i] = toKitchenTicketXmlResponse(ticket)
+		}
+
+		output := &KitchenTicketXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Tickets: ticketOutputs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
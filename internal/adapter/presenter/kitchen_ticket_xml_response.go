@@ -0,0 +1,32 @@
+package presenter
+
+import "encoding/xml"
+
+type KitchenTicketXmlResponse struct {
+	XMLName   xml.Name                       `xml:"ticket"`
+	ID        uint64                         `xml:"id" example:"1"`
+	OrderID   uint64                         `xml:"order_id" example:"1"`
+	Station   string                         `xml:"station" example:"GRILL"`
+	Status    string                         `xml:"status" example:"QUEUED"`
+	StaffID   *uint64                        `xml:"staff_id,omitempty" example:"1"`
+	Items     []KitchenTicketItemXmlResponse `xml:"items>item"`
+	StartedAt string                         `xml:"started_at,omitempty" example:"2024-02-09T10:05:00Z"`
+	DoneAt    string                         `xml:"done_at,omitempty" example:"2024-02-09T10:15:00Z"`
+	CreatedAt string                         `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt string                         `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type KitchenTicketItemXmlResponse struct {
+	OrderProductID uint64 `xml:"order_product_id" example:"1"`
+	ProductID      uint64 `xml:"product_id" example:"1"`
+	Name           string `xml:"name" example:"X-Burger"`
+	Quantity       uint32 `xml:"quantity" example:"2"`
+	Modifiers      string `xml:"modifiers,omitempty" example:"Extra cheese, No onion"`
+	Notes          string `xml:"notes,omitempty" example:"Well done"`
+}
+
+type KitchenTicketXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"tickets"`
+	XmlPagination
+	Tickets []KitchenTicketXmlResponse `xml:"ticket"`
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type menuJsonPresenter struct{}
+
+// NewMenuJsonPresenter creates a new MenuJsonPresenter
+func NewMenuJsonPresenter() port.Presenter {
+	return &menuJsonPresenter{}
+}
+
+// Present writes the response to the client
+func (p *menuJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Menu:
+		output := MenuJsonResponse{
+			Categories:  toMenuCategoriesJsonResponse(v.Categories),
+			GeneratedAt: v.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+func toMenuCategoriesJsonResponse(categories []entity.MenuCategory) []MenuCategoryJsonResponse {
+	output := make([]MenuCategoryJsonResponse, len(categories))
+	for i, category := range categories {
+		products := make([]ProductJsonResponse, len(category.Products))
+		for j, product := range category.Products {
+			products[j] = ToProductJsonResponse(product)
+		}
+
+		output[i] = MenuCategoryJsonResponse{
+			ID:            category.ID,
+			Name:          category.Name,
+			DisplayOrder:  category.DisplayOrder,
+			ImageURL:      category.ImageURL,
+			Products:      products,
+			Subcategories: toMenuCategoriesJsonResponse(category.Subcategories),
+		}
+	}
+	return output
+}
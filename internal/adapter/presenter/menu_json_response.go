@@ -0,0 +1,15 @@
+package presenter
+
+type MenuJsonResponse struct {
+	Categories  []MenuCategoryJsonResponse `json:"categories"`
+	GeneratedAt string                     `json:"generated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type MenuCategoryJsonResponse struct {
+	ID            uint64                     `json:"id" example:"1"`
+	Name          string                     `json:"name" example:"Foods"`
+	DisplayOrder  int                        `json:"display_order" example:"1"`
+	ImageURL      string                     `json:"image_url,omitempty" example:"https://cdn.fastfood.com/categories/foods.png"`
+	Products      []ProductJsonResponse      `json:"products"`
+	Subcategories []MenuCategoryJsonResponse `json:"subcategories,omitempty"`
+}
@@ -0,0 +1,52 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type menuXmlPresenter struct{}
+
+// NewMenuXmlPresenter creates a new MenuXmlPresenter
+func NewMenuXmlPresenter() port.Presenter {
+	return &menuXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *menuXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Menu:
+		output := MenuXmlResponse{
+			Categories:  toMenuCategoriesXmlResponse(v.Categories),
+			GeneratedAt: v.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+func toMenuCategoriesXmlResponse(categories []entity.MenuCategory) []MenuCategoryXmlResponse {
+	output := make([]MenuCategoryXmlResponse, len(categories))
+	for i, category := range categories {
+		products := make([]ProductXmlResponse, len(category.Products))
+		for j, product := range category.Products {
+			products[j] = toProductXmlResponse(product)
+		}
+
+		output[i] = MenuCategoryXmlResponse{
+			ID:            category.ID,
+			Name:          category.Name,
+			DisplayOrder:  category.DisplayOrder,
+			ImageURL:      category.ImageURL,
+			Products:      products,
+			Subcategories: toMenuCategoriesXmlResponse(category.Subcategories),
+		}
+	}
+	return output
+}
@@ -0,0 +1,18 @@
+package presenter
+
+import "encoding/xml"
+
+type MenuXmlResponse struct {
+	XMLName     xml.Name                  `xml:"menu"`
+	Categories  []MenuCategoryXmlResponse `xml:"categories>category"`
+	GeneratedAt string                    `xml:"generated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type MenuCategoryXmlResponse struct {
+	ID            uint64                    `xml:"id" example:"1"`
+	Name          string                    `xml:"name" example:"Foods"`
+	DisplayOrder  int                       `xml:"display_order" example:"1"`
+	ImageURL      string                    `xml:"image_url,omitempty" example:"https://cdn.fastfood.com/categories/foods.png"`
+	Products      []ProductXmlResponse      `xml:"products>product"`
+	Subcategories []MenuCategoryXmlResponse `xml:"subcategories>category"`
+}
@@ -0,0 +1,44 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceJsonPresenter struct{}
+
+// NewNotificationPreferenceJsonPresenter creates a presenter for the notification preferences of the customers
+func NewNotificationPreferenceJsonPresenter() port.Presenter {
+	return &notificationPreferenceJsonPresenter{}
+}
+
+// ToNotificationPreferenceJsonResponse converts entity.NotificationPreference to NotificationPreferenceJsonResponse
+func ToNotificationPreferenceJsonResponse(preference *entity.NotificationPreference) NotificationPreferenceJsonResponse {
+	return NotificationPreferenceJsonResponse{
+		ID:         preference.ID,
+		CustomerID: preference.CustomerID,
+		Locale:     preference.Locale.String(),
+		Channels:   nonNilStrings(preference.Channels),
+		Email:      preference.Email,
+		Phone:      preference.Phone,
+		PushToken:  preference.PushToken,
+		CreatedAt:  preference.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:  preference.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// Present writes the response to the client
+func (p *notificationPreferenceJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.NotificationPreference:
+		output := ToNotificationPreferenceJsonResponse(v)
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
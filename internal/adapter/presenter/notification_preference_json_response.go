@@ -0,0 +1,23 @@
+package presenter
+
+import "encoding/json"
+
+type NotificationPreferenceJsonResponse struct {
+	ID         uint64   `json:"id" example:"1"`
+	CustomerID uint64   `json:"customer_id" example:"1"`
+	Locale     string   `json:"locale" example:"pt-BR"`
+	Channels   []string `json:"channels" example:"SMS"`
+	Email      string   `json:"email,omitempty" example:"john.doe@email.com"`
+	Phone      string   `json:"phone,omitempty" example:"+5511999999999"`
+	PushToken  string   `json:"push_token,omitempty" example:"fcm-token"`
+	CreatedAt  string   `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt  string   `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+func (r NotificationPreferenceJsonResponse) String() string {
+	o, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+	return string(o)
+}
@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceXmlPresenter struct{}
+
+// NewNotificationPreferenceXmlPresenter creates a presenter for the notification preferences of the customers
+func NewNotificationPreferenceXmlPresenter() port.Presenter {
+	return &notificationPreferenceXmlPresenter{}
+}
+
+// toNotificationPreferenceXmlResponse converts a NotificationPreference entity to a NotificationPreferenceXmlResponse
+func toNotificationPreferenceXmlResponse(preference *entity.NotificationPreference) NotificationPreferenceXmlResponse {
+	output := ToNotificationPreferenceJsonResponse(preference)
+	return NotificationPreferenceXmlResponse{
+		ID:         output.ID,
+		CustomerID: output.CustomerID,
+		Locale:     output.Locale,
+		Channels:   output.Channels,
+		Email:      output.Email,
+		Phone:      output.Phone,
+		PushToken:  output.PushToken,
+		CreatedAt:  output.CreatedAt,
+		UpdatedAt:  output.UpdatedAt,
+	}
+}
+
+// Present writes the response to the client
+func (p *notificationPreferenceXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.NotificationPreference:
+		output := toNotificationPreferenceXmlResponse(v)
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
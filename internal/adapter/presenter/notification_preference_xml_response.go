@@ -0,0 +1,16 @@
+package presenter
+
+import "encoding/xml"
+
+type NotificationPreferenceXmlResponse struct {
+	XMLName    xml.Name `xml:"notification_preference"`
+	ID         uint64   `xml:"id" example:"1"`
+	CustomerID uint64   `xml:"customer_id" example:"1"`
+	Locale     string   `xml:"locale" example:"pt-BR"`
+	Channels   []string `xml:"channels>channel" example:"SMS"`
+	Email      string   `xml:"email,omitempty" example:"john.doe@email.com"`
+	Phone      string   `xml:"phone,omitempty" example:"+5511999999999"`
+	PushToken  string   `xml:"push_token,omitempty" example:"fcm-token"`
+	CreatedAt  string   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt  string   `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderCreatedJsonPresenter struct{}
+
+// NewOrderCreatedJsonPresenter creates a new OrderCreatedJsonPresenter
+func NewOrderCreatedJsonPresenter() port.Presenter {
+	return &orderCreatedJsonPresenter{}
+}
+
+// Present writes the created order, the guest token is only shown here so it can't be read by other clients
+func (p *orderCreatedJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		output := OrderCreatedJsonResponse{
+			OrderJsonResponse: ToOrderJsonResponse(v),
+			GuestToken:        v.GuestToken,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,7 @@
+package presenter
+
+// OrderCreatedJsonResponse is the order returned only to its creator, with the secret of the guest orders
+type OrderCreatedJsonResponse struct {
+	OrderJsonResponse
+	GuestToken string `json:"guest_token,omitempty" example:"JBSWY3DPEHPK3PXPJBSWY3DPEH"`
+}
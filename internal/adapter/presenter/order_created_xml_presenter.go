@@ -0,0 +1,32 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderCreatedXmlPresenter struct{}
+
+// NewOrderCreatedXmlPresenter creates a new OrderCreatedXmlPresenter
+func NewOrderCreatedXmlPresenter() port.Presenter {
+	return &orderCreatedXmlPresenter{}
+}
+
+// Present writes the created order, the guest token is only shown here so it can't be read by other clients
+func (p *orderCreatedXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		output := OrderCreatedXmlResponse{
+			OrderXmlResponse: toOrderXmlResponse(v),
+			GuestToken:       v.GuestToken,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
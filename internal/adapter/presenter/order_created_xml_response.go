@@ -0,0 +1,7 @@
+package presenter
+
+// OrderCreatedXmlResponse is the order returned only to its creator, with the secret of the guest orders
+type OrderCreatedXmlResponse struct {
+	OrderXmlResponse
+	GuestToken string `xml:"guest_token,omitempty" example:"JBSWY3DPEHPK3PXPJBSWY3DPEH"`
+}
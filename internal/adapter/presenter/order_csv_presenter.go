@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"errors"
+	"math"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var orderCsvHeader = []string{"id", "customer_id", "status", "channel", "fulfilment_mode", "table_number", "items", "subtotal", "discount_total", "total_bill", "version", "created_at", "updated_at"}
+
+type orderCsvPresenter struct{}
+
+// NewOrderCsvPresenter creates a new OrderCsvPresenter, only the lists of orders are presented, one line per order
+func NewOrderCsvPresenter() port.Presenter {
+	return &orderCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.Order:
+		records := make([][]string, len(v))
+		for i, order := range v {
+			subtotal := calculateSubtotal(order.OrderProducts)
+			var items uint32
+			for _, orderProduct := range order.OrderProducts {
+				items += orderProduct.Quantity
+			}
+			records[i] = []string{
+				strconv.FormatUint(order.ID, 10),
+				strconv.FormatUint(order.CustomerID, 10),
+				string(order.Status),
+				string(order.Channel),
+				string(order.FulfilmentMode),
+				formatCsvTableNumber(order.TableNumber),
+				strconv.FormatUint(uint64(items), 10),
+				formatCsvPrice(subtotal),
+				formatCsvPrice(order.DiscountTotal),
+				formatCsvPrice(math.Max(subtotal-order.DiscountTotal, 0)),
+				strconv.FormatUint(uint64(order.Version), 10),
+				order.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+				order.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		return writeCsv(orderCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// formatCsvTableNumber leaves the column empty for the orders not eaten at the restaurant
+func formatCsvTableNumber(tableNumber *uint32) string {
+	if tableNumber == nil {
+		return ""
+	}
+	return strconv.FormatUint(uint64(*tableNumber), 10)
+}
@@ -0,0 +1,50 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var orderHistoryCsvHeader = []string{"id", "order_id", "staff_id", "status", "actor_type", "actor_id", "reason_code", "reason_text", "source", "hash", "created_at"}
+
+type orderHistoryCsvPresenter struct{}
+
+// NewOrderHistoryCsvPresenter creates a new OrderHistoryCsvPresenter, only the lists of order histories are presented
+func NewOrderHistoryCsvPresenter() port.Presenter {
+	return &orderHistoryCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderHistoryCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.OrderHistory:
+		records := make([][]string, len(v))
+		for i, orderHistory := range v {
+			var hash string
+			if orderHistory.Hash != nil {
+				hash = *orderHistory.Hash
+			}
+			records[i] = []string{
+				strconv.FormatUint(orderHistory.ID, 10),
+				strconv.FormatUint(orderHistory.OrderID, 10),
+				formatCsvID(orderHistory.StaffID),
+				orderHistory.Status.String(),
+				orderHistory.ActorType.String(),
+				orderHistory.ActorID,
+				orderHistory.ReasonCode,
+				orderHistory.ReasonText,
+				orderHistory.Source.String(),
+				hash,
+				orderHistory.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		return writeCsv(orderHistoryCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -20,11 +20,18 @@ func NewOrderHistoryJsonPresenter() port.Presenter {
 // toOrderHistoryJsonResponse convert entity.OrderHistory to OrderHistoryJsonResponse
 func toOrderHistoryJsonResponse(orderHistory *entity.OrderHistory) OrderHistoryJsonResponse {
 	return OrderHistoryJsonResponse{
-		ID:        orderHistory.ID,
-		OrderID:   orderHistory.OrderID,
-		StaffID:   orderHistory.StaffID,
-		Status:    orderHistory.Status.String(),
-		CreatedAt: orderHistory.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		ID:           orderHistory.ID,
+		OrderID:      orderHistory.OrderID,
+		StaffID:      orderHistory.StaffID,
+		Status:       orderHistory.Status.String(),
+		ActorType:    orderHistory.ActorType.String(),
+		ActorID:      orderHistory.ActorID,
+		ReasonCode:   orderHistory.ReasonCode,
+		ReasonText:   orderHistory.ReasonText,
+		Source:       orderHistory.Source.String(),
+		PreviousHash: orderHistory.PreviousHash,
+		Hash:         orderHistory.Hash,
+		CreatedAt:    orderHistory.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
@@ -34,6 +41,17 @@ func (p *orderHistoryJsonPresenter) Present(pp dto.PresenterInput) ([]byte, erro
 	case *entity.OrderHistory:
 		output := toOrderHistoryJsonResponse(v)
 		return json.Marshal(output)
+	case *entity.OrderHistoryVerification:
+		output := OrderHistoryVerificationJsonResponse{
+			OrderID:  v.OrderID,
+			Valid:    v.Valid,
+			Total:    v.Total,
+			Sealed:   v.Sealed,
+			Unsealed: v.Unsealed,
+			BrokenAt: v.BrokenAt,
+			Reason:   v.Reason,
+		}
+		return json.Marshal(output)
 	case []*entity.OrderHistory:
 		orderHistoryOutputs := make([]OrderHistoryJsonResponse, len(v))
 		for i, orderHistory := range v {
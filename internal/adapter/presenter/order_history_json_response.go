@@ -1,14 +1,32 @@
 package presenter
 
 type OrderHistoryJsonResponse struct {
-	ID        uint64  `json:"id" example:"1"`
-	OrderID   uint64  `json:"order_id" example:"1"`
-	StaffID   *uint64 `json:"staff_id" example:"1"`
-	Status    string  `json:"status" example:"OPEN, CANCELLED, PENDING, RECEIVED, PREPARING, READY, COMPLETED"`
-	CreatedAt string  `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	ID         uint64  `json:"id" example:"1"`
+	OrderID    uint64  `json:"order_id" example:"1"`
+	StaffID    *uint64 `json:"staff_id" example:"1"`
+	Status     string  `json:"status" example:"OPEN, CANCELLED, PENDING, RECEIVED, PREPARING, READY, COMPLETED"`
+	ActorType  string  `json:"actor_type,omitempty" example:"SYSTEM"`
+	ActorID    string  `json:"actor_id,omitempty" example:"1"`
+	ReasonCode string  `json:"reason_code,omitempty" example:"EXPIRED"`
+	ReasonText string  `json:"reason_text,omitempty" example:"Order was not paid in time"`
+	Source     string  `json:"source,omitempty" example:"API, SQS, SCHEDULER"`
+	// PreviousHash and Hash are empty for histories created before the hash chain
+	PreviousHash *string `json:"previous_hash,omitempty" example:""`
+	Hash         *string `json:"hash,omitempty" example:"5f2b..."`
+	CreatedAt    string  `json:"created_at" example:"2024-02-09T10:00:00Z"`
 }
 
 type OrderHistoryJsonPaginatedResponse struct {
 	JsonPagination
 	OrderHistories []OrderHistoryJsonResponse `json:"order_histories"`
 }
+
+type OrderHistoryVerificationJsonResponse struct {
+	OrderID  uint64  `json:"order_id" example:"1"`
+	Valid    bool    `json:"valid" example:"true"`
+	Total    int     `json:"total" example:"3"`
+	Sealed   int     `json:"sealed" example:"2"`
+	Unsealed int     `json:"unsealed" example:"1"`
+	BrokenAt *uint64 `json:"broken_at,omitempty" example:"3"`
+	Reason   string  `json:"reason,omitempty" example:"hash does not match the history content"`
+}
@@ -0,0 +1,73 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderHistoryXmlPresenter struct{}
+
+// NewOrderHistoryXmlPresenter creates a new OrderHistoryXmlPresenter
+func NewOrderHistoryXmlPresenter() port.Presenter {
+	return &orderHistoryXmlPresenter{}
+}
+
+// toOrderHistoryXmlResponse converts an OrderHistory entity to an OrderHistoryXmlResponse
+func toOrderHistoryXmlResponse(orderHistory *entity.OrderHistory) OrderHistoryXmlResponse {
+	return OrderHistoryXmlResponse{
+		ID:           orderHistory.ID,
+		OrderID:      orderHistory.OrderID,
+		StaffID:      orderHistory.StaffID,
+		Status:       orderHistory.Status.String(),
+		ActorType:    orderHistory.ActorType.String(),
+		ActorID:      orderHistory.ActorID,
+		ReasonCode:   orderHistory.ReasonCode,
+		ReasonText:   orderHistory.ReasonText,
+		Source:       orderHistory.Source.String(),
+		PreviousHash: orderHistory.PreviousHash,
+		Hash:         orderHistory.Hash,
+		CreatedAt:    orderHistory.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// Present writes the response to the client
+func (p *orderHistoryXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.OrderHistory:
+		output := toOrderHistoryXmlResponse(v)
+		return xml.Marshal(output)
+	case *entity.OrderHistoryVerification:
+		output := OrderHistoryVerificationXmlResponse{
+			OrderID:  v.OrderID,
+			Valid:    v.Valid,
+			Total:    v.Total,
+			Sealed:   v.Sealed,
+			Unsealed: v.Unsealed,
+			BrokenAt: v.BrokenAt,
+			Reason:   v.Reason,
+		}
+		return xml.Marshal(output)
+	case []*entity.OrderHistory:
+		orderHistoryOutputs := make([]OrderHistoryXmlResponse, len(v))
+		for i, orderHistory := range v {
+			orderHistoryOutputs[i] = toOrderHistoryXmlResponse(orderHistory)
+		}
+
+		output := &OrderHistoryXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			OrderHistories: orderHistoryOutputs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
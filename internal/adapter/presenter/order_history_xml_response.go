@@ -0,0 +1,36 @@
+package presenter
+
+import "encoding/xml"
+
+type OrderHistoryXmlResponse struct {
+	XMLName      xml.Name `xml:"order_history"`
+	ID           uint64   `xml:"id" example:"1"`
+	OrderID      uint64   `xml:"order_id" example:"1"`
+	StaffID      *uint64  `xml:"staff_id,omitempty" example:"1"`
+	Status       string   `xml:"status" example:"OPEN, CANCELLED, PENDING, RECEIVED, PREPARING, READY, COMPLETED"`
+	ActorType    string   `xml:"actor_type,omitempty" example:"SYSTEM"`
+	ActorID      string   `xml:"actor_id,omitempty" example:"1"`
+	ReasonCode   string   `xml:"reason_code,omitempty" example:"EXPIRED"`
+	ReasonText   string   `xml:"reason_text,omitempty" example:"Order was not paid in time"`
+	Source       string   `xml:"source,omitempty" example:"API, SQS, SCHEDULER"`
+	PreviousHash *string  `xml:"previous_hash,omitempty" example:""`
+	Hash         *string  `xml:"hash,omitempty" example:"5f2b..."`
+	CreatedAt    string   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type OrderHistoryXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"order_histories"`
+	XmlPagination
+	OrderHistories []OrderHistoryXmlResponse `xml:"order_history"`
+}
+
+type OrderHistoryVerificationXmlResponse struct {
+	XMLName  xml.Name `xml:"order_history_verification"`
+	OrderID  uint64   `xml:"order_id" example:"1"`
+	Valid    bool     `xml:"valid" example:"true"`
+	Total    int      `xml:"total" example:"3"`
+	Sealed   int      `xml:"sealed" example:"2"`
+	Unsealed int      `xml:"unsealed" example:"1"`
+	BrokenAt *uint64  `xml:"broken_at,omitempty" example:"3"`
+	Reason   string   `xml:"reason,omitempty" example:"hash does not match the history content"`
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -46,35 +47,133 @@ func (p *orderJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
 
 // ToOrderJsonResponse convert entity.Order to OrderJsonResponse
 func ToOrderJsonResponse(order *entity.Order) OrderJsonResponse {
+	subtotal := calculateSubtotal(order.OrderProducts)
 	return OrderJsonResponse{
-		ID:         order.ID,
-		CustomerID: order.CustomerID,
-		TotalBill:  calculateTotalBill(order.OrderProducts),
-		Status:     string(order.Status),
-		Products:   ToProductsJsonResponse(order.OrderProducts),
-		CreatedAt:  order.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:  order.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		ID:              order.ID,
+		CustomerID:      order.CustomerID,
+		GuestName:       order.GuestName,
+		Subtotal:        fmt.Sprintf("%.2f", subtotal),
+		DiscountTotal:   fmt.Sprintf("%.2f", order.DiscountTotal),
+		TotalBill:       fmt.Sprintf("%.2f", math.Max(subtotal-order.DiscountTotal, 0)),
+		Status:          string(order.Status),
+		PickupCode:      order.PickupCode,
+		Channel:         string(order.Channel),
+		FulfilmentMode:  string(order.FulfilmentMode),
+		TableNumber:     order.TableNumber,
+		DeliveryAddress: toOrderDeliveryAddressJsonResponse(order.DeliveryAddress),
+		Products:        ToProductsJsonResponse(order.OrderProducts),
+		Coupons:         ToOrderCouponsJsonResponse(order.Coupons),
+		Discounts:       ToOrderDiscountsJsonResponse(order.Discounts),
+		Version:         order.Version,
+		CreatedAt:       order.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:       order.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
 
+// toOrderDeliveryAddressJsonResponse converts the delivery address snapshot, nil for the orders not delivered
+func toOrderDeliveryAddressJsonResponse(address *entity.OrderDeliveryAddress) *OrderDeliveryAddressJsonResponse {
+	if address == nil {
+		return nil
+	}
+	return &OrderDeliveryAddressJsonResponse{
+		Street:       address.Street,
+		Number:       address.Number,
+		Complement:   address.Complement,
+		Neighborhood: address.Neighborhood,
+		City:         address.City,
+		State:        address.State,
+		ZipCode:      address.ZipCode,
+	}
+}
+
+// ToOrderCouponsJsonResponse returns the codes of the coupons applied to the order
+func ToOrderCouponsJsonResponse(coupons []entity.OrderCoupon) []string {
+	if len(coupons) == 0 {
+		return nil
+	}
+	output := make([]string, len(coupons))
+	for i, coupon := range coupons {
+		output[i] = coupon.Code
+	}
+	return output
+}
+
+// ToOrderDiscountsJsonResponse convert a slice of entity.OrderDiscount to a slice of OrderDiscountJsonResponse
+func ToOrderDiscountsJsonResponse(discounts []entity.OrderDiscount) []OrderDiscountJsonResponse {
+	if len(discounts) == 0 {
+		return nil
+	}
+	output := make([]OrderDiscountJsonResponse, len(discounts))
+	for i, discount := range discounts {
+		output[i] = OrderDiscountJsonResponse{
+			PromotionID: discount.PromotionID,
+			Name:        discount.Name,
+			Code:        discount.Code,
+			Amount:      discount.Amount,
+		}
+	}
+	return output
+}
+
 // ToProductsJsonResponse convert a slice of entity.OrderProduct to a slice of ProductsJsonResponse
 func ToProductsJsonResponse(orderProducts []entity.OrderProduct) []ProductsJsonResponse {
 	products := make([]ProductsJsonResponse, len(orderProducts))
 	for i, orderProduct := range orderProducts {
 		products[i] = ProductsJsonResponse{
 			ProductJsonResponse: ToProductJsonResponse(&orderProduct.Product),
+			ItemID:              orderProduct.ID,
 			Quantity:            orderProduct.Quantity,
+			Notes:               orderProduct.Notes,
+			Modifiers:           ToOrderProductModifiersJsonResponse(orderProduct.Modifiers),
+			Components:          ToOrderProductComponentsJsonResponse(orderProduct.Components, orderProduct.Quantity),
+			UnitPrice:           orderProduct.UnitPrice(),
+			ProductPriceID:      orderProduct.ProductPriceID,
 		}
 	}
 	return products
 }
 
-// calculateTotalBill calculate the total bill of an order
-func calculateTotalBill(orderProducts []entity.OrderProduct) string {
+// ToOrderProductModifiersJsonResponse convert a slice of entity.OrderProductModifier to a slice of OrderProductModifierJsonResponse
+func ToOrderProductModifiersJsonResponse(modifiers []entity.OrderProductModifier) []OrderProductModifierJsonResponse {
+	if len(modifiers) == 0 {
+		return nil
+	}
+	output := make([]OrderProductModifierJsonResponse, len(modifiers))
+	for i, modifier := range modifiers {
+		output[i] = OrderProductModifierJsonResponse{
+			ID:         modifier.ProductModifierID,
+			Group:      modifier.GroupName,
+			Name:       modifier.Name,
+			PriceDelta: modifier.PriceDelta,
+		}
+	}
+	return output
+}
+
+// ToOrderProductComponentsJsonResponse expands the bundle components of a line item, the quantity of each
+// component is multiplied by the quantity of the line item
+func ToOrderProductComponentsJsonResponse(components []entity.OrderProductComponent, quantity uint32) []OrderProductComponentJsonResponse {
+	if len(components) == 0 {
+		return nil
+	}
+	output := make([]OrderProductComponentJsonResponse, len(components))
+	for i, component := range components {
+		output[i] = OrderProductComponentJsonResponse{
+			Slot:       component.SlotName,
+			ProductID:  component.ProductID,
+			Name:       component.Name,
+			Quantity:   component.Quantity * quantity,
+			PriceDelta: component.PriceDelta,
+		}
+	}
+	return output
+}
+
+// calculateSubtotal calculate the total of the line items of an order, before the discounts
+func calculateSubtotal(orderProducts []entity.OrderProduct) float64 {
 	var total float64
 	for _, orderProduct := range orderProducts {
-		total += orderProduct.Product.Price * float64(orderProduct.Quantity)
+		total += orderProduct.Total()
 	}
-	// 2 decimal places
-	return fmt.Sprintf("%.2f", total)
+	return total
 }
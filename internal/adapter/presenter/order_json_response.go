@@ -1,13 +1,41 @@
 package presenter
 
 type OrderJsonResponse struct {
-	ID         uint64                 `json:"id"`
-	CustomerID uint64                 `json:"customer_id" example:"1"`
-	TotalBill  string                 `json:"total_bill,omitempty" example:"100.00"`
-	Status     string                 `json:"status" example:"PENDING"`
-	Products   []ProductsJsonResponse `json:"products,omitempty"`
-	CreatedAt  string                 `json:"created_at" example:"2024-02-09T10:00:00Z"`
-	UpdatedAt  string                 `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+	ID              uint64                            `json:"id"`
+	CustomerID      uint64                            `json:"customer_id" example:"1"`
+	GuestName       string                            `json:"guest_name,omitempty" example:"John"`
+	Subtotal        string                            `json:"subtotal,omitempty" example:"110.00"`
+	DiscountTotal   string                            `json:"discount_total,omitempty" example:"10.00"`
+	TotalBill       string                            `json:"total_bill,omitempty" example:"100.00"`
+	Status          string                            `json:"status" example:"PENDING"`
+	PickupCode      string                            `json:"pickup_code,omitempty" example:"A42"`
+	Channel         string                            `json:"channel,omitempty" example:"TOTEM"`
+	FulfilmentMode  string                            `json:"fulfilment_mode,omitempty" example:"DINE_IN"`
+	TableNumber     *uint32                           `json:"table_number,omitempty" example:"12"`
+	DeliveryAddress *OrderDeliveryAddressJsonResponse `json:"delivery_address,omitempty"`
+	Products        []ProductsJsonResponse            `json:"products,omitempty"`
+	Coupons         []string                          `json:"coupons,omitempty" example:"WELCOME10"`
+	Discounts       []OrderDiscountJsonResponse       `json:"discounts,omitempty"`
+	Version         uint32                            `json:"version" example:"1"`
+	CreatedAt       string                            `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt       string                            `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type OrderDeliveryAddressJsonResponse struct {
+	Street       string `json:"street" example:"Av. Paulista"`
+	Number       string `json:"number" example:"1000"`
+	Complement   string `json:"complement,omitempty" example:"Apto 42"`
+	Neighborhood string `json:"neighborhood,omitempty" example:"Bela Vista"`
+	City         string `json:"city" example:"São Paulo"`
+	State        string `json:"state" example:"SP"`
+	ZipCode      string `json:"zip_code" example:"01310-100"`
+}
+
+type OrderDiscountJsonResponse struct {
+	PromotionID uint64  `json:"promotion_id" example:"1"`
+	Name        string  `json:"name" example:"Welcome coupon"`
+	Code        string  `json:"code,omitempty" example:"WELCOME10"`
+	Amount      float64 `json:"amount" example:"10.00"`
 }
 
 type OrderJsonPaginatedResponse struct {
@@ -17,5 +45,27 @@ type OrderJsonPaginatedResponse struct {
 
 type ProductsJsonResponse struct {
 	ProductJsonResponse
-	Quantity uint32 `json:"quantity"`
+	ItemID     uint64                              `json:"item_id" example:"1"`
+	Quantity   uint32                              `json:"quantity"`
+	Notes      string                              `json:"notes,omitempty" example:"No tomato"`
+	Modifiers  []OrderProductModifierJsonResponse  `json:"modifiers,omitempty"`
+	Components []OrderProductComponentJsonResponse `json:"components,omitempty"`
+	UnitPrice  float64                             `json:"unit_price" example:"21.99"`
+	// ProductPriceID is the price record copied to the line item
+	ProductPriceID *uint64 `json:"product_price_id,omitempty" example:"1"`
+}
+
+type OrderProductComponentJsonResponse struct {
+	Slot       string  `json:"slot" example:"Drink"`
+	ProductID  uint64  `json:"product_id" example:"2"`
+	Name       string  `json:"name" example:"Coca-Cola 350ml"`
+	Quantity   uint32  `json:"quantity" example:"1"`
+	PriceDelta float64 `json:"price_delta" example:"0"`
+}
+
+type OrderProductModifierJsonResponse struct {
+	ID         uint64  `json:"id" example:"1"`
+	Group      string  `json:"group" example:"Extras"`
+	Name       string  `json:"name" example:"Extra cheese"`
+	PriceDelta float64 `json:"price_delta" example:"2.00"`
 }
@@ -0,0 +1,62 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var (
+	orderProductCsvHeader = []string{"id", "order_id", "product_id", "name", "quantity", "unit_price", "product_price_id", "total", "notes", "created_at", "updated_at"}
+	salesReportCsvHeader  = []string{"product_id", "name", "quantity", "bundle_quantity", "total_quantity", "revenue"}
+)
+
+type orderProductCsvPresenter struct{}
+
+// NewOrderProductCsvPresenter creates a new OrderProductCsvPresenter, the lists of order products and the sales report are presented
+func NewOrderProductCsvPresenter() port.Presenter {
+	return &orderProductCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderProductCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.OrderProduct:
+		records := make([][]string, len(v))
+		for i, orderProduct := range v {
+			records[i] = []string{
+				strconv.FormatUint(orderProduct.ID, 10),
+				strconv.FormatUint(orderProduct.OrderID, 10),
+				strconv.FormatUint(orderProduct.ProductID, 10),
+				orderProduct.Product.Name,
+				strconv.FormatUint(uint64(orderProduct.Quantity), 10),
+				formatCsvPrice(orderProduct.UnitPrice()),
+				formatCsvID(orderProduct.ProductPriceID),
+				formatCsvPrice(orderProduct.Total()),
+				orderProduct.Notes,
+				orderProduct.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+				orderProduct.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		return writeCsv(orderProductCsvHeader, records)
+	case *entity.SalesReport:
+		records := make([][]string, len(v.Lines))
+		for i, line := range v.Lines {
+			records[i] = []string{
+				strconv.FormatUint(line.ProductID, 10),
+				line.Name,
+				strconv.FormatUint(uint64(line.Quantity), 10),
+				strconv.FormatUint(uint64(line.BundleQuantity), 10),
+				strconv.FormatUint(uint64(line.TotalQuantity()), 10),
+				formatCsvPrice(line.Revenue),
+			}
+		}
+		return writeCsv(salesReportCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -3,6 +3,7 @@ package presenter
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -38,6 +39,9 @@ func (p *orderProductJsonPresenter) Present(pp dto.PresenterInput) ([]byte, erro
 			OrderProducts: orderProductOutputs,
 		}
 		return json.Marshal(output)
+	case *entity.SalesReport:
+		output := ToSalesReportJsonResponse(v)
+		return json.Marshal(output)
 	default:
 		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
 	}
@@ -46,14 +50,53 @@ func (p *orderProductJsonPresenter) Present(pp dto.PresenterInput) ([]byte, erro
 // ToOrderProductJsonResponse convert entity.OrderProduct to OrderProductJsonResponse
 func ToOrderProductJsonResponse(orderProduct *entity.OrderProduct) OrderProductJsonResponse {
 	order := ToOrderJsonResponse(&orderProduct.Order)
+	// The line items of the order are not loaded, so its totals are not presented
+	order.Subtotal = ""
+	order.DiscountTotal = ""
 	order.TotalBill = ""
 	return OrderProductJsonResponse{
-		OrderID:   orderProduct.OrderID,
-		ProductID: orderProduct.ProductID,
-		Quantity:  orderProduct.Quantity,
-		Order:     order,
-		Product:   ToProductJsonResponse(&orderProduct.Product),
-		CreatedAt: orderProduct.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt: orderProduct.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		ID:             orderProduct.ID,
+		OrderID:        orderProduct.OrderID,
+		ProductID:      orderProduct.ProductID,
+		Quantity:       orderProduct.Quantity,
+		Notes:          orderProduct.Notes,
+		Modifiers:      ToOrderProductModifiersJsonResponse(orderProduct.Modifiers),
+		Components:     ToOrderProductComponentsJsonResponse(orderProduct.Components, orderProduct.Quantity),
+		UnitPrice:      orderProduct.UnitPrice(),
+		ProductPriceID: orderProduct.ProductPriceID,
+		Total:          fmt.Sprintf("%.2f", orderProduct.Total()),
+		Order:          order,
+		Product:        ToProductJsonResponse(&orderProduct.Product),
+		CreatedAt:      orderProduct.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      orderProduct.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToSalesReportJsonResponse convert entity.SalesReport to SalesReportJsonResponse
+func ToSalesReportJsonResponse(report *entity.SalesReport) SalesReportJsonResponse {
+	lines := make([]SalesReportLineJsonResponse, len(report.Lines))
+	for i, line := range report.Lines {
+		var prices []SalesReportPriceJsonResponse
+		for _, price := range line.Prices {
+			prices = append(prices, SalesReportPriceJsonResponse{
+				ProductPriceID: price.ProductPriceID,
+				Price:          price.Price,
+				Quantity:       price.Quantity,
+			})
+		}
+		lines[i] = SalesReportLineJsonResponse{
+			ProductID:      line.ProductID,
+			Name:           line.Name,
+			Quantity:       line.Quantity,
+			BundleQuantity: line.BundleQuantity,
+			TotalQuantity:  line.TotalQuantity(),
+			Revenue:        fmt.Sprintf("%.2f", line.Revenue),
+			Prices:         prices,
+		}
+	}
+	return SalesReportJsonResponse{
+		From:     report.From.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		To:       report.To.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		Products: lines,
 	}
 }
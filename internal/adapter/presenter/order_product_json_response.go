@@ -1,13 +1,21 @@
 package presenter
 
 type OrderProductJsonResponse struct {
-	OrderID   uint64              `json:"order_id"`
-	ProductID uint64              `json:"product_id"`
-	Quantity  uint32              `json:"quantity"`
-	Order     OrderJsonResponse   `json:"order,omitempty"`
-	Product   ProductJsonResponse `json:"product,omitempty"`
-	CreatedAt string              `json:"created_at" example:"2024-02-09T10:00:00Z"`
-	UpdatedAt string              `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+	ID         uint64                              `json:"id" example:"1"`
+	OrderID    uint64                              `json:"order_id"`
+	ProductID  uint64                              `json:"product_id"`
+	Quantity   uint32                              `json:"quantity"`
+	Notes      string                              `json:"notes,omitempty" example:"No tomato"`
+	Modifiers  []OrderProductModifierJsonResponse  `json:"modifiers,omitempty"`
+	Components []OrderProductComponentJsonResponse `json:"components,omitempty"`
+	UnitPrice  float64                             `json:"unit_price" example:"21.99"`
+	// ProductPriceID is the price record copied to the line item
+	ProductPriceID *uint64             `json:"product_price_id,omitempty" example:"1"`
+	Total          string              `json:"total" example:"43.98"`
+	Order          OrderJsonResponse   `json:"order,omitempty"`
+	Product        ProductJsonResponse `json:"product,omitempty"`
+	CreatedAt      string              `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string              `json:"updated_at" example:"2024-02-09T10:00:00Z"`
 }
 
 func NewOrderProductJsonResponse(orderID uint64, productID uint64, quantity uint32) *OrderProductJsonResponse {
@@ -24,3 +32,25 @@ type OrderProductJsonPaginatedResponse struct {
 	JsonPagination
 	OrderProducts []OrderProductJsonResponse `json:"order_products"`
 }
+
+type SalesReportJsonResponse struct {
+	From     string                        `json:"from" example:"2024-02-01T00:00:00Z"`
+	To       string                        `json:"to" example:"2024-03-01T00:00:00Z"`
+	Products []SalesReportLineJsonResponse `json:"products"`
+}
+
+type SalesReportLineJsonResponse struct {
+	ProductID      uint64                         `json:"product_id" example:"1"`
+	Name           string                         `json:"name" example:"X-Burger"`
+	Quantity       uint32                         `json:"quantity" example:"10"`
+	BundleQuantity uint32                         `json:"bundle_quantity" example:"4"`
+	TotalQuantity  uint32                         `json:"total_quantity" example:"14"`
+	Revenue        string                         `json:"revenue" example:"259.00"`
+	Prices         []SalesReportPriceJsonResponse `json:"prices,omitempty"`
+}
+
+type SalesReportPriceJsonResponse struct {
+	ProductPriceID *uint64 `json:"product_price_id,omitempty" example:"1"`
+	Price          float64 `json:"price" example:"25.90"`
+	Quantity       uint32  `json:"quantity" example:"10"`
+}
@@ -0,0 +1,102 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderProductXmlPresenter struct{}
+
+// NewOrderProductXmlPresenter creates a new OrderProductXmlPresenter
+func NewOrderProductXmlPresenter() port.Presenter {
+	return &orderProductXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderProductXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.OrderProduct:
+		output := toOrderProductXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.OrderProduct:
+		orderProductOutputs := make([]OrderProductXmlResponse, len(v))
+		for i, orderProduct := range v {
+			orderProductOutputs[i] = toOrderProductXmlResponse(orderProduct)
+		}
+
+		output := &OrderProductXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			OrderProducts: orderProductOutputs,
+		}
+		return xml.Marshal(output)
+	case *entity.SalesReport:
+		output := toSalesReportXmlResponse(v)
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// toOrderProductXmlResponse converts an OrderProduct entity to an OrderProductXmlResponse
+func toOrderProductXmlResponse(orderProduct *entity.OrderProduct) OrderProductXmlResponse {
+	order := toOrderXmlResponse(&orderProduct.Order)
+	// The line items of the order are not loaded, so its totals are not presented
+	order.Subtotal = ""
+	order.DiscountTotal = ""
+	order.TotalBill = ""
+	return OrderProductXmlResponse{
+		ID:             orderProduct.ID,
+		OrderID:        orderProduct.OrderID,
+		ProductID:      orderProduct.ProductID,
+		Quantity:       orderProduct.Quantity,
+		Notes:          orderProduct.Notes,
+		Modifiers:      toOrderProductModifiersXmlResponse(orderProduct.Modifiers),
+		Components:     toOrderProductComponentsXmlResponse(orderProduct.Components, orderProduct.Quantity),
+		UnitPrice:      orderProduct.UnitPrice(),
+		ProductPriceID: orderProduct.ProductPriceID,
+		Total:          fmt.Sprintf("%.2f", orderProduct.Total()),
+		Order:          order,
+		Product:        toProductXmlResponse(&orderProduct.Product),
+		CreatedAt:      orderProduct.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      orderProduct.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toSalesReportXmlResponse converts a SalesReport entity to a SalesReportXmlResponse
+func toSalesReportXmlResponse(report *entity.SalesReport) SalesReportXmlResponse {
+	lines := make([]SalesReportLineXmlResponse, len(report.Lines))
+	for i, line := range report.Lines {
+		var prices []SalesReportPriceXmlResponse
+		for _, price := range line.Prices {
+			prices = append(prices, SalesReportPriceXmlResponse{
+				ProductPriceID: price.ProductPriceID,
+				Price:          price.Price,
+				Quantity:       price.Quantity,
+			})
+		}
+		lines[i] = SalesReportLineXmlResponse{
+			ProductID:      line.ProductID,
+			Name:           line.Name,
+			Quantity:       line.Quantity,
+			BundleQuantity: line.BundleQuantity,
+			TotalQuantity:  line.TotalQuantity(),
+			Revenue:        fmt.Sprintf("%.2f", line.Revenue),
+			Prices:         prices,
+		}
+	}
+	return SalesReportXmlResponse{
+		From:     report.From.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		To:       report.To.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		Products: lines,
+	}
+}
@@ -0,0 +1,50 @@
+package presenter
+
+import "encoding/xml"
+
+type OrderProductXmlResponse struct {
+	XMLName        xml.Name                           `xml:"order_product"`
+	ID             uint64                             `xml:"id" example:"1"`
+	OrderID        uint64                             `xml:"order_id"`
+	ProductID      uint64                             `xml:"product_id"`
+	Quantity       uint32                             `xml:"quantity"`
+	Notes          string                             `xml:"notes,omitempty" example:"No tomato"`
+	Modifiers      []OrderProductModifierXmlResponse  `xml:"modifiers>modifier"`
+	Components     []OrderProductComponentXmlResponse `xml:"components>component"`
+	UnitPrice      float64                            `xml:"unit_price" example:"21.99"`
+	ProductPriceID *uint64                            `xml:"product_price_id,omitempty" example:"1"`
+	Total          string                             `xml:"total" example:"43.98"`
+	Order          OrderXmlResponse                   `xml:"order"`
+	Product        ProductXmlResponse                 `xml:"product"`
+	CreatedAt      string                             `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string                             `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type OrderProductXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"order_products"`
+	XmlPagination
+	OrderProducts []OrderProductXmlResponse `xml:"order_product"`
+}
+
+type SalesReportXmlResponse struct {
+	XMLName  xml.Name                     `xml:"sales_report"`
+	From     string                       `xml:"from" example:"2024-02-01T00:00:00Z"`
+	To       string                       `xml:"to" example:"2024-03-01T00:00:00Z"`
+	Products []SalesReportLineXmlResponse `xml:"products>product"`
+}
+
+type SalesReportLineXmlResponse struct {
+	ProductID      uint64                        `xml:"product_id" example:"1"`
+	Name           string                        `xml:"name" example:"X-Burger"`
+	Quantity       uint32                        `xml:"quantity" example:"10"`
+	BundleQuantity uint32                        `xml:"bundle_quantity" example:"4"`
+	TotalQuantity  uint32                        `xml:"total_quantity" example:"14"`
+	Revenue        string                        `xml:"revenue" example:"259.00"`
+	Prices         []SalesReportPriceXmlResponse `xml:"prices>price"`
+}
+
+type SalesReportPriceXmlResponse struct {
+	ProductPriceID *uint64 `xml:"product_price_id,omitempty" example:"1"`
+	Price          float64 `xml:"price" example:"25.90"`
+	Quantity       uint32  `xml:"quantity" example:"10"`
+}
@@ -0,0 +1,120 @@
+package presenter
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+// orderReceipt is the content of the printable receipt of an order, shared by the text, HTML and PDF presenters
+type orderReceipt struct {
+	OrderID       uint64
+	CustomerID    uint64
+	GuestName     string
+	Status        string
+	PickupCode    string
+	CreatedAt     string
+	Items         []orderReceiptItem
+	Discounts     []orderReceiptAmount
+	Subtotal      string
+	DiscountTotal string
+	Total         string
+	Timeline      []orderReceiptEvent
+}
+
+type orderReceiptItem struct {
+	Name     string
+	Quantity uint32
+	// UnitPrice is the price copied to the line item, the price deltas of the details are added to it
+	UnitPrice string
+	Total     string
+	// Details are the chosen modifiers and bundle components, Amount is empty when there's no price delta
+	Details []orderReceiptAmount
+	Notes   string
+}
+
+type orderReceiptAmount struct {
+	Label  string
+	Amount string
+}
+
+type orderReceiptEvent struct {
+	At     string
+	Status string
+	Reason string
+}
+
+const receiptTimeLayout = "2006-01-02 15:04"
+
+// toOrderReceipt converts an Order entity to the receipt content, the times are printed in the location
+// of the restaurant and the totals are the ones the order was charged with
+func toOrderReceipt(order *entity.Order, location *time.Location) orderReceipt {
+	receipt := orderReceipt{
+		OrderID:       order.ID,
+		CustomerID:    order.CustomerID,
+		GuestName:     order.GuestName,
+		Status:        string(order.Status),
+		PickupCode:    order.PickupCode,
+		CreatedAt:     order.CreatedAt.In(location).Format(receiptTimeLayout),
+		Subtotal:      fmt.Sprintf("%.2f", order.Subtotal),
+		DiscountTotal: fmt.Sprintf("%.2f", order.DiscountTotal),
+		Total:         fmt.Sprintf("%.2f", order.Total),
+	}
+
+	for _, orderProduct := range order.OrderProducts {
+		item := orderReceiptItem{
+			Name:      orderProduct.Product.Name,
+			Quantity:  orderProduct.Quantity,
+			UnitPrice: fmt.Sprintf("%.2f", orderProduct.Price),
+			Total:     fmt.Sprintf("%.2f", orderProduct.Total()),
+			Notes:     orderProduct.Notes,
+		}
+		for _, modifier := range orderProduct.Modifiers {
+			item.Details = append(item.Details, orderReceiptAmount{
+				Label:  "+ " + modifier.Name,
+				Amount: formatReceiptDelta(modifier.PriceDelta),
+			})
+		}
+		for _, component := range orderProduct.Components {
+			item.Details = append(item.Details, orderReceiptAmount{
+				Label:  fmt.Sprintf("%s: %dx %s", component.SlotName, component.Quantity*orderProduct.Quantity, component.Name),
+				Amount: formatReceiptDelta(component.PriceDelta),
+			})
+		}
+		receipt.Items = append(receipt.Items, item)
+	}
+
+	for _, discount := range order.Discounts {
+		label := discount.Name
+		if discount.Code != "" {
+			label += " (" + discount.Code + ")"
+		}
+		receipt.Discounts = append(receipt.Discounts, orderReceiptAmount{
+			Label:  label,
+			Amount: fmt.Sprintf("-%.2f", discount.Amount),
+		})
+	}
+
+	for _, history := range order.Histories {
+		event := orderReceiptEvent{
+			At:     history.CreatedAt.In(location).Format(receiptTimeLayout),
+			Status: history.Status.String(),
+			Reason: history.ReasonText,
+		}
+		if event.Reason == "" {
+			event.Reason = history.ReasonCode
+		}
+		receipt.Timeline = append(receipt.Timeline, event)
+	}
+
+	return receipt
+}
+
+// formatReceiptDelta prints the price delta of a modifier or component per unit, empty when it's free
+func formatReceiptDelta(delta float64) string {
+	if delta == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%+.2f", delta)
+}
@@ -0,0 +1,85 @@
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var orderReceiptHtmlTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Order #{{.OrderID}}</title>
+<style>
+body { font-family: monospace; width: 80mm; margin: 0 auto; }
+h1 { font-size: 1.2em; text-align: center; }
+table { width: 100%; border-collapse: collapse; }
+td.amount { text-align: right; white-space: nowrap; }
+.detail td:first-child, .note td { padding-left: 1.5em; }
+.total td { font-weight: bold; border-top: 1px dashed; }
+@media print { @page { size: 80mm auto; margin: 0; } }
+</style>
+</head>
+<body>
+<h1>Order #{{.OrderID}}</h1>
+<p>Date: {{.CreatedAt}}<br>{{if .CustomerID}}Customer: {{.CustomerID}}<br>{{else if .GuestName}}Guest: {{.GuestName}}<br>{{end}}Status: {{.Status}}{{if .PickupCode}}<br>Pickup code: <strong>{{.PickupCode}}</strong>{{end}}</p>
+<table class="items">
+{{- range .Items}}
+<tr class="item"><td>{{.Quantity}}x {{.Name}}</td><td class="amount">{{.Total}}</td></tr>
+<tr class="detail"><td>{{.UnitPrice}} each</td><td></td></tr>
+{{- range .Details}}
+<tr class="detail"><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{- end}}
+{{- if .Notes}}
+<tr class="note"><td colspan="2">* {{.Notes}}</td></tr>
+{{- end}}
+{{- end}}
+</table>
+<table class="totals">
+<tr><td>Subtotal</td><td class="amount">{{.Subtotal}}</td></tr>
+{{- range .Discounts}}
+<tr class="discount"><td>{{.Label}}</td><td class="amount">{{.Amount}}</td></tr>
+{{- end}}
+<tr class="total"><td>Total</td><td class="amount">{{.Total}}</td></tr>
+</table>
+{{- if .Timeline}}
+<h2>Status timeline</h2>
+<table class="timeline">
+{{- range .Timeline}}
+<tr><td>{{.At}}</td><td>{{.Status}}{{if .Reason}} - {{.Reason}}{{end}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+type orderReceiptHtmlPresenter struct {
+	location *time.Location
+}
+
+// NewOrderReceiptHtmlPresenter creates a presenter of the order receipt as an HTML page, styled to be printed
+func NewOrderReceiptHtmlPresenter(location *time.Location) port.Presenter {
+	return &orderReceiptHtmlPresenter{location}
+}
+
+// Present writes the response to the client
+func (p *orderReceiptHtmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		var buf bytes.Buffer
+		if err := orderReceiptHtmlTemplate.Execute(&buf, toOrderReceipt(v, p.location)); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,102 @@
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// The receipt is printed with Courier on a page as wide as the 80mm roll, 8pt Courier fits the ReceiptWidth columns
+const (
+	receiptPdfPageWidth  = 227
+	receiptPdfMargin     = 17
+	receiptPdfFontSize   = 8
+	receiptPdfLineHeight = 10
+)
+
+type orderReceiptPdfPresenter struct {
+	location *time.Location
+}
+
+// NewOrderReceiptPdfPresenter creates a presenter of the order receipt as a PDF with the lines of the text receipt
+func NewOrderReceiptPdfPresenter(location *time.Location) port.Presenter {
+	return &orderReceiptPdfPresenter{location}
+}
+
+// Present writes the response to the client
+func (p *orderReceiptPdfPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		return writeReceiptPdf(orderReceiptTextLines(toOrderReceipt(v, p.location))), nil
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// writeReceiptPdf writes a single page PDF with one text line per line, the page is as tall as the receipt.
+// Courier is one of the standard PDF fonts, so no font is embedded and the file stays small
+func writeReceiptPdf(lines []string) []byte {
+	height := len(lines)*receiptPdfLineHeight + 2*receiptPdfMargin
+
+	var content bytes.Buffer
+	fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", receiptPdfFontSize, receiptPdfLineHeight, receiptPdfMargin, height-receiptPdfMargin-receiptPdfFontSize)
+	for i, line := range lines {
+		if i > 0 {
+			content.WriteString("T*\n")
+		}
+		fmt.Fprintf(&content, "(%s) Tj\n", escapePdfText(line))
+	}
+	content.WriteString("ET")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>", receiptPdfPageWidth, height),
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()),
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, object := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, object)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return buf.Bytes()
+}
+
+// escapePdfText encodes a line as a PDF string on WinAnsiEncoding, the characters out of Latin-1 are replaced by "?"
+func escapePdfText(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20:
+			b.WriteByte(' ')
+		case r < 0x80:
+			b.WriteRune(r)
+		case r >= 0xA0 && r <= 0xFF:
+			fmt.Fprintf(&b, "\\%03o", r)
+		default:
+			b.WriteByte('?')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,126 @@
+package presenter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// ReceiptWidth is the number of columns of the 80mm thermal printers
+const ReceiptWidth = 40
+
+type orderReceiptTextPresenter struct {
+	location *time.Location
+}
+
+// NewOrderReceiptTextPresenter creates a presenter of the order receipt as plain text for thermal printers
+func NewOrderReceiptTextPresenter(location *time.Location) port.Presenter {
+	return &orderReceiptTextPresenter{location}
+}
+
+// Present writes the response to the client
+func (p *orderReceiptTextPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		lines := orderReceiptTextLines(toOrderReceipt(v, p.location))
+		return []byte(strings.Join(lines, "\n") + "\n"), nil
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// orderReceiptTextLines lays the receipt out in lines of up to ReceiptWidth columns, the PDF prints the same lines
+func orderReceiptTextLines(receipt orderReceipt) []string {
+	separator := strings.Repeat("-", ReceiptWidth)
+	border := strings.Repeat("=", ReceiptWidth)
+
+	lines := []string{border, receiptCenter(fmt.Sprintf("ORDER #%d", receipt.OrderID)), border}
+	lines = append(lines, "Date: "+receipt.CreatedAt)
+	if receipt.CustomerID != 0 {
+		lines = append(lines, fmt.Sprintf("Customer: %d", receipt.CustomerID))
+	} else if receipt.GuestName != "" {
+		lines = append(lines, receiptWrap("Guest: "+receipt.GuestName, "  ")...)
+	}
+	lines = append(lines, "Status: "+receipt.Status)
+	if receipt.PickupCode != "" {
+		lines = append(lines, "Pickup code: "+receipt.PickupCode)
+	}
+	lines = append(lines, separator)
+
+	for _, item := range receipt.Items {
+		lines = append(lines, receiptRow(fmt.Sprintf("%dx %s", item.Quantity, item.Name), item.Total)...)
+		lines = append(lines, receiptWrap("   "+item.UnitPrice+" each", "   ")...)
+		for _, detail := range item.Details {
+			lines = append(lines, receiptRow("   "+detail.Label, detail.Amount)...)
+		}
+		if item.Notes != "" {
+			lines = append(lines, receiptWrap("   * "+item.Notes, "     ")...)
+		}
+	}
+
+	lines = append(lines, separator)
+	lines = append(lines, receiptRow("Subtotal", receipt.Subtotal)...)
+	for _, discount := range receipt.Discounts {
+		lines = append(lines, receiptRow(discount.Label, discount.Amount)...)
+	}
+	lines = append(lines, receiptRow("TOTAL", receipt.Total)...)
+
+	if len(receipt.Timeline) > 0 {
+		lines = append(lines, separator, "STATUS TIMELINE")
+		for _, event := range receipt.Timeline {
+			lines = append(lines, receiptRow(event.At, event.Status)...)
+			if event.Reason != "" {
+				lines = append(lines, receiptWrap("   "+event.Reason, "   ")...)
+			}
+		}
+	}
+
+	return append(lines, border)
+}
+
+// receiptRow aligns the amount to the right of the label, the label is wrapped when they don't fit on a line
+func receiptRow(label, amount string) []string {
+	if amount == "" {
+		return receiptWrap(label, "   ")
+	}
+
+	amountWidth := utf8.RuneCountInString(amount)
+	lines := receiptWrap(label, "   ")
+	last := lines[len(lines)-1]
+	if gap := ReceiptWidth - utf8.RuneCountInString(last) - amountWidth; gap > 0 {
+		lines[len(lines)-1] = last + strings.Repeat(" ", gap) + amount
+		return lines
+	}
+	return append(lines, strings.Repeat(" ", ReceiptWidth-amountWidth)+amount)
+}
+
+// receiptWrap breaks a text in lines of up to ReceiptWidth columns, the next lines start with the indent
+func receiptWrap(text, indent string) []string {
+	var lines []string
+	line := []rune(text)
+	for len(line) > ReceiptWidth {
+		cut := ReceiptWidth
+		if i := strings.LastIndex(string(line[:ReceiptWidth]), " "); i > len(indent) {
+			cut = utf8.RuneCountInString(string(line[:ReceiptWidth])[:i])
+		}
+		lines = append(lines, strings.TrimRight(string(line[:cut]), " "))
+		line = []rune(indent + strings.TrimLeft(string(line[cut:]), " "))
+	}
+	return append(lines, string(line))
+}
+
+// receiptCenter centers a text on the receipt width
+func receiptCenter(text string) string {
+	gap := (ReceiptWidth - utf8.RuneCountInString(text)) / 2
+	if gap <= 0 {
+		return text
+	}
+	return strings.Repeat(" ", gap) + text
+}
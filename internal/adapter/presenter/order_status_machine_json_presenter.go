@@ -0,0 +1,67 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderStatusMachineJsonPresenter struct{}
+
+// NewOrderStatusMachineJsonPresenter creates a presenter for the order status machine
+func NewOrderStatusMachineJsonPresenter() port.Presenter {
+	return &orderStatusMachineJsonPresenter{}
+}
+
+// Present write the response to the client
+func (p *orderStatusMachineJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *valueobject.OrderStatusMachine:
+		return json.Marshal(ToOrderStatusMachineJsonResponse(v))
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// ToOrderStatusMachineJsonResponse convert valueobject.OrderStatusMachine to OrderStatusMachineJsonResponse
+func ToOrderStatusMachineJsonResponse(m *valueobject.OrderStatusMachine) OrderStatusMachineJsonResponse {
+	states := make([]OrderStatusStateJsonResponse, len(m.States))
+	for i, state := range m.States {
+		states[i] = OrderStatusStateJsonResponse{
+			Name:        string(state.Name),
+			Description: state.Description,
+			Final:       state.Final,
+		}
+	}
+
+	transitions := make([]OrderStatusTransitionJsonResponse, len(m.Transitions))
+	for i, t := range m.Transitions {
+		transitions[i] = OrderStatusTransitionJsonResponse{
+			From:           string(t.From),
+			To:             string(t.To),
+			Roles:          toStrings(t.Roles),
+			Modes:          toStrings(t.Modes),
+			RequiredFields: toStrings(t.RequiredFields),
+			Guards:         toStrings(t.Guards),
+		}
+	}
+
+	return OrderStatusMachineJsonResponse{
+		Initial:     string(m.Initial),
+		States:      states,
+		Transitions: transitions,
+	}
+}
+
+// toStrings convert a slice of string based types to a non nil slice of strings
+func toStrings[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
@@ -0,0 +1,22 @@
+package presenter
+
+type OrderStatusStateJsonResponse struct {
+	Name        string `json:"name" example:"OPEN"`
+	Description string `json:"description" example:"Order is being assembled by the customer"`
+	Final       bool   `json:"final" example:"false"`
+}
+
+type OrderStatusTransitionJsonResponse struct {
+	From           string   `json:"from" example:"RECEIVED"`
+	To             string   `json:"to" example:"PREPARING"`
+	Roles          []string `json:"roles" example:"STAFF"`
+	Modes          []string `json:"modes" example:"DELIVERY"`
+	RequiredFields []string `json:"required_fields" example:"staff_id"`
+	Guards         []string `json:"guards" example:"has_products"`
+}
+
+type OrderStatusMachineJsonResponse struct {
+	Initial     string                              `json:"initial" example:"OPEN"`
+	States      []OrderStatusStateJsonResponse      `json:"states"`
+	Transitions []OrderStatusTransitionJsonResponse `json:"transitions"`
+}
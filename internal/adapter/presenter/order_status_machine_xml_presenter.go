@@ -0,0 +1,53 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderStatusMachineXmlPresenter struct{}
+
+// NewOrderStatusMachineXmlPresenter creates a presenter for the order status machine
+func NewOrderStatusMachineXmlPresenter() port.Presenter {
+	return &orderStatusMachineXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderStatusMachineXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *valueobject.OrderStatusMachine:
+		states := make([]OrderStatusStateXmlResponse, len(v.States))
+		for i, state := range v.States {
+			states[i] = OrderStatusStateXmlResponse{
+				Name:        string(state.Name),
+				Description: state.Description,
+				Final:       state.Final,
+			}
+		}
+
+		transitions := make([]OrderStatusTransitionXmlResponse, len(v.Transitions))
+		for i, t := range v.Transitions {
+			transitions[i] = OrderStatusTransitionXmlResponse{
+				From:           string(t.From),
+				To:             string(t.To),
+				Roles:          toStrings(t.Roles),
+				Modes:          toStrings(t.Modes),
+				RequiredFields: toStrings(t.RequiredFields),
+				Guards:         toStrings(t.Guards),
+			}
+		}
+
+		return xml.Marshal(OrderStatusMachineXmlResponse{
+			Initial:     string(v.Initial),
+			States:      states,
+			Transitions: transitions,
+		})
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
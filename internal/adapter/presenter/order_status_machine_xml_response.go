@@ -0,0 +1,25 @@
+package presenter
+
+import "encoding/xml"
+
+type OrderStatusStateXmlResponse struct {
+	Name        string `xml:"name" example:"OPEN"`
+	Description string `xml:"description" example:"Order is being assembled by the customer"`
+	Final       bool   `xml:"final" example:"false"`
+}
+
+type OrderStatusTransitionXmlResponse struct {
+	From           string   `xml:"from" example:"RECEIVED"`
+	To             string   `xml:"to" example:"PREPARING"`
+	Roles          []string `xml:"roles>role" example:"STAFF"`
+	Modes          []string `xml:"modes>mode" example:"DELIVERY"`
+	RequiredFields []string `xml:"required_fields>field" example:"staff_id"`
+	Guards         []string `xml:"guards>guard" example:"has_products"`
+}
+
+type OrderStatusMachineXmlResponse struct {
+	XMLName     xml.Name                           `xml:"order_status_machine"`
+	Initial     string                             `xml:"initial" example:"OPEN"`
+	States      []OrderStatusStateXmlResponse      `xml:"states>state"`
+	Transitions []OrderStatusTransitionXmlResponse `xml:"transitions>transition"`
+}
@@ -0,0 +1,144 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type orderXmlPresenter struct{}
+
+// NewOrderXmlPresenter creates a new OrderXmlPresenter
+func NewOrderXmlPresenter() port.Presenter {
+	return &orderXmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *orderXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Order:
+		output := toOrderXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.Order:
+		orderOutputs := make([]OrderXmlResponse, len(v))
+		for i, order := range v {
+			orderOutputs[i] = toOrderXmlResponse(order)
+		}
+
+		output := &OrderXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Orders: orderOutputs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// toOrderXmlResponse converts an Order entity to an OrderXmlResponse
+func toOrderXmlResponse(order *entity.Order) OrderXmlResponse {
+	subtotal := calculateSubtotal(order.OrderProducts)
+	var discounts []OrderDiscountXmlResponse
+	for _, discount := range order.Discounts {
+		discounts = append(discounts, OrderDiscountXmlResponse{
+			PromotionID: discount.PromotionID,
+			Name:        discount.Name,
+			Code:        discount.Code,
+			Amount:      discount.Amount,
+		})
+	}
+
+	return OrderXmlResponse{
+		ID:              order.ID,
+		CustomerID:      order.CustomerID,
+		GuestName:       order.GuestName,
+		Subtotal:        fmt.Sprintf("%.2f", subtotal),
+		DiscountTotal:   fmt.Sprintf("%.2f", order.DiscountTotal),
+		TotalBill:       fmt.Sprintf("%.2f", math.Max(subtotal-order.DiscountTotal, 0)),
+		Status:          string(order.Status),
+		PickupCode:      order.PickupCode,
+		Channel:         string(order.Channel),
+		FulfilmentMode:  string(order.FulfilmentMode),
+		TableNumber:     order.TableNumber,
+		DeliveryAddress: toOrderDeliveryAddressXmlResponse(order.DeliveryAddress),
+		Products:        toProductsXmlResponse(order.OrderProducts),
+		Coupons:         ToOrderCouponsJsonResponse(order.Coupons),
+		Discounts:       discounts,
+		Version:         order.Version,
+		CreatedAt:       order.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:       order.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toOrderDeliveryAddressXmlResponse converts the delivery address snapshot, nil for the orders not delivered
+func toOrderDeliveryAddressXmlResponse(address *entity.OrderDeliveryAddress) *OrderDeliveryAddressXmlResponse {
+	if address == nil {
+		return nil
+	}
+	return &OrderDeliveryAddressXmlResponse{
+		Street:       address.Street,
+		Number:       address.Number,
+		Complement:   address.Complement,
+		Neighborhood: address.Neighborhood,
+		City:         address.City,
+		State:        address.State,
+		ZipCode:      address.ZipCode,
+	}
+}
+
+// toProductsXmlResponse converts the line items of an order to ProductsXmlResponse
+func toProductsXmlResponse(orderProducts []entity.OrderProduct) []ProductsXmlResponse {
+	products := make([]ProductsXmlResponse, len(orderProducts))
+	for i, orderProduct := range orderProducts {
+		products[i] = ProductsXmlResponse{
+			ProductXmlResponse: toProductXmlResponse(&orderProduct.Product),
+			ItemID:             orderProduct.ID,
+			Quantity:           orderProduct.Quantity,
+			Notes:              orderProduct.Notes,
+			Modifiers:          toOrderProductModifiersXmlResponse(orderProduct.Modifiers),
+			Components:         toOrderProductComponentsXmlResponse(orderProduct.Components, orderProduct.Quantity),
+			UnitPrice:          orderProduct.UnitPrice(),
+			ProductPriceID:     orderProduct.ProductPriceID,
+		}
+	}
+	return products
+}
+
+// toOrderProductModifiersXmlResponse converts the modifiers chosen for a line item to OrderProductModifierXmlResponse
+func toOrderProductModifiersXmlResponse(modifiers []entity.OrderProductModifier) []OrderProductModifierXmlResponse {
+	var output []OrderProductModifierXmlResponse
+	for _, modifier := range modifiers {
+		output = append(output, OrderProductModifierXmlResponse{
+			ID:         modifier.ProductModifierID,
+			Group:      modifier.GroupName,
+			Name:       modifier.Name,
+			PriceDelta: modifier.PriceDelta,
+		})
+	}
+	return output
+}
+
+// toOrderProductComponentsXmlResponse expands the bundle components of a line item, as ToOrderProductComponentsJsonResponse
+func toOrderProductComponentsXmlResponse(components []entity.OrderProductComponent, quantity uint32) []OrderProductComponentXmlResponse {
+	var output []OrderProductComponentXmlResponse
+	for _, component := range components {
+		output = append(output, OrderProductComponentXmlResponse{
+			Slot:       component.SlotName,
+			ProductID:  component.ProductID,
+			Name:       component.Name,
+			Quantity:   component.Quantity * quantity,
+			PriceDelta: component.PriceDelta,
+		})
+	}
+	return output
+}
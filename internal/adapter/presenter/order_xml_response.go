@@ -0,0 +1,74 @@
+package presenter
+
+import "encoding/xml"
+
+type OrderXmlResponse struct {
+	XMLName         xml.Name                         `xml:"order"`
+	ID              uint64                           `xml:"id"`
+	CustomerID      uint64                           `xml:"customer_id" example:"1"`
+	GuestName       string                           `xml:"guest_name,omitempty" example:"John"`
+	Subtotal        string                           `xml:"subtotal,omitempty" example:"110.00"`
+	DiscountTotal   string                           `xml:"discount_total,omitempty" example:"10.00"`
+	TotalBill       string                           `xml:"total_bill,omitempty" example:"100.00"`
+	Status          string                           `xml:"status" example:"PENDING"`
+	PickupCode      string                           `xml:"pickup_code,omitempty" example:"A42"`
+	Channel         string                           `xml:"channel,omitempty" example:"TOTEM"`
+	FulfilmentMode  string                           `xml:"fulfilment_mode,omitempty" example:"DINE_IN"`
+	TableNumber     *uint32                          `xml:"table_number,omitempty" example:"12"`
+	DeliveryAddress *OrderDeliveryAddressXmlResponse `xml:"delivery_address,omitempty"`
+	Products        []ProductsXmlResponse            `xml:"products>product"`
+	Coupons         []string                         `xml:"coupons>coupon" example:"WELCOME10"`
+	Discounts       []OrderDiscountXmlResponse       `xml:"discounts>discount"`
+	Version         uint32                           `xml:"version" example:"1"`
+	CreatedAt       string                           `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt       string                           `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type OrderDeliveryAddressXmlResponse struct {
+	Street       string `xml:"street" example:"Av. Paulista"`
+	Number       string `xml:"number" example:"1000"`
+	Complement   string `xml:"complement,omitempty" example:"Apto 42"`
+	Neighborhood string `xml:"neighborhood,omitempty" example:"Bela Vista"`
+	City         string `xml:"city" example:"São Paulo"`
+	State        string `xml:"state" example:"SP"`
+	ZipCode      string `xml:"zip_code" example:"01310-100"`
+}
+
+type OrderDiscountXmlResponse struct {
+	PromotionID uint64  `xml:"promotion_id" example:"1"`
+	Name        string  `xml:"name" example:"Welcome coupon"`
+	Code        string  `xml:"code,omitempty" example:"WELCOME10"`
+	Amount      float64 `xml:"amount" example:"10.00"`
+}
+
+type OrderXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"orders"`
+	XmlPagination
+	Orders []OrderXmlResponse `xml:"order"`
+}
+
+type ProductsXmlResponse struct {
+	ProductXmlResponse
+	ItemID         uint64                             `xml:"item_id" example:"1"`
+	Quantity       uint32                             `xml:"quantity"`
+	Notes          string                             `xml:"notes,omitempty" example:"No tomato"`
+	Modifiers      []OrderProductModifierXmlResponse  `xml:"modifiers>modifier"`
+	Components     []OrderProductComponentXmlResponse `xml:"components>component"`
+	UnitPrice      float64                            `xml:"unit_price" example:"21.99"`
+	ProductPriceID *uint64                            `xml:"product_price_id,omitempty" example:"1"`
+}
+
+type OrderProductComponentXmlResponse struct {
+	Slot       string  `xml:"slot" example:"Drink"`
+	ProductID  uint64  `xml:"product_id" example:"2"`
+	Name       string  `xml:"name" example:"Coca-Cola 350ml"`
+	Quantity   uint32  `xml:"quantity" example:"1"`
+	PriceDelta float64 `xml:"price_delta" example:"0"`
+}
+
+type OrderProductModifierXmlResponse struct {
+	ID         uint64  `xml:"id" example:"1"`
+	Group      string  `xml:"group" example:"Extras"`
+	Name       string  `xml:"name" example:"Extra cheese"`
+	PriceDelta float64 `xml:"price_delta" example:"2.00"`
+}
@@ -0,0 +1,77 @@
+package presenter
+
+import (
+	"bytes"
+	"errors"
+	"html/template"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// pickupBoardRefreshSeconds is how often the lobby monitor reloads the page
+const pickupBoardRefreshSeconds = 10
+
+var pickupBoardHtmlTemplate = template.Must(template.New("pickup-board").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta http-equiv="refresh" content="{{.Refresh}}">
+<title>Pickup board</title>
+<style>
+body { font-family: sans-serif; margin: 0; display: flex; height: 100vh; background: #111; color: #fff; }
+section { flex: 1; padding: 1em; }
+section.ready { background: #1b5e20; }
+h1 { font-size: 2.5em; text-align: center; text-transform: uppercase; }
+ul { list-style: none; padding: 0; display: flex; flex-wrap: wrap; gap: 0.5em; justify-content: center; }
+li { font-size: 3em; font-weight: bold; min-width: 3em; text-align: center; }
+</style>
+</head>
+<body>
+<section class="preparing">
+<h1>Preparing</h1>
+<ul>
+{{- range .Preparing}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</section>
+<section class="ready">
+<h1>Ready</h1>
+<ul>
+{{- range .Ready}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+</section>
+</body>
+</html>
+`))
+
+type pickupBoardHtmlPresenter struct{}
+
+// NewPickupBoardHtmlPresenter creates a presenter of the pickup board as an HTML page for the lobby monitor,
+// the page reloads itself every pickupBoardRefreshSeconds
+func NewPickupBoardHtmlPresenter() port.Presenter {
+	return &pickupBoardHtmlPresenter{}
+}
+
+// Present writes the response to the client
+func (p *pickupBoardHtmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.PickupBoard:
+		var buf bytes.Buffer
+		err := pickupBoardHtmlTemplate.Execute(&buf, struct {
+			*entity.PickupBoard
+			Refresh int
+		}{v, pickupBoardRefreshSeconds})
+		if err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,33 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type pickupBoardJsonPresenter struct{}
+
+// NewPickupBoardJsonPresenter creates a new PickupBoardJsonPresenter
+func NewPickupBoardJsonPresenter() port.Presenter {
+	return &pickupBoardJsonPresenter{}
+}
+
+// Present writes the response to the client
+func (p *pickupBoardJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.PickupBoard:
+		output := PickupBoardJsonResponse{
+			Preparing:   v.Preparing,
+			Ready:       v.Ready,
+			GeneratedAt: v.GeneratedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
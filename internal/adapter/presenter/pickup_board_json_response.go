@@ -0,0 +1,7 @@
+package presenter
+
+type PickupBoardJsonResponse struct {
+	Preparing   []string `json:"preparing" example:"A43,A44"`
+	Ready       []string `json:"ready" example:"A41,A42"`
+	GeneratedAt string   `json:"generated_at" example:"2024-02-09T10:00:00Z"`
+}
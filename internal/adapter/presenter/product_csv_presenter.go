@@ -0,0 +1,45 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var productCsvHeader = []string{"id", "name", "description", "price", "category_id", "stock_mode", "stock_quantity", "available", "created_at", "updated_at"}
+
+type productCsvPresenter struct{}
+
+// NewProductCsvPresenter creates a new ProductCsvPresenter, only the lists of products are presented
+func NewProductCsvPresenter() port.Presenter {
+	return &productCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *productCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.Product:
+		records := make([][]string, len(v))
+		for i, product := range v {
+			records[i] = []string{
+				strconv.FormatUint(product.ID, 10),
+				product.Name,
+				product.Description,
+				formatCsvPrice(product.Price),
+				strconv.FormatUint(product.CategoryID, 10),
+				product.StockMode.String(),
+				strconv.FormatInt(product.StockQuantity, 10),
+				strconv.FormatBool(product.Available),
+				product.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+				product.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+			}
+		}
+		return writeCsv(productCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -3,6 +3,7 @@ package presenter
 import (
 	"encoding/json"
 	"errors"
+	"math"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -46,12 +47,96 @@ func (p *productJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
 // ToProductJsonResponse convert entity.Product to ProductJsonResponse
 func ToProductJsonResponse(product *entity.Product) ProductJsonResponse {
 	return ProductJsonResponse{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		CategoryID:  product.CategoryID,
-		CreatedAt:   product.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   product.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		ID:             product.ID,
+		Name:           product.Name,
+		Description:    product.Description,
+		Price:          product.Price,
+		CategoryID:     product.CategoryID,
+		KitchenStation: kitchenStationString(product.KitchenStation),
+		StockMode:      product.StockMode.String(),
+		StockQuantity:  product.StockQuantity,
+		Available:      product.Available,
+		ModifierGroups: ToProductModifierGroupsJsonResponse(product.ModifierGroups),
+		Bundle:         ToProductBundleJsonResponse(product),
+		Availability:   ToProductAvailabilityJsonResponse(product.AvailabilityWindows),
+		CreatedAt:      product.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      product.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// ToProductModifierGroupsJsonResponse convert a slice of entity.ProductModifierGroup to a slice of ProductModifierGroupJsonResponse
+func ToProductModifierGroupsJsonResponse(groups []entity.ProductModifierGroup) []ProductModifierGroupJsonResponse {
+	if len(groups) == 0 {
+		return nil
+	}
+	output := make([]ProductModifierGroupJsonResponse, len(groups))
+	for i, group := range groups {
+		modifiers := make([]ProductModifierJsonResponse, len(group.Modifiers))
+		for j, modifier := range group.Modifiers {
+			modifiers[j] = ProductModifierJsonResponse{
+				ID:         modifier.ID,
+				Name:       modifier.Name,
+				PriceDelta: modifier.PriceDelta,
+			}
+		}
+		output[i] = ProductModifierGroupJsonResponse{
+			ID:         group.ID,
+			Name:       group.Name,
+			MinChoices: group.MinChoices,
+			MaxChoices: group.MaxChoices,
+			Modifiers:  modifiers,
+		}
+	}
+	return output
+}
+
+// ToProductBundleJsonResponse convert the bundle slots of entity.Product to ProductBundleJsonResponse
+func ToProductBundleJsonResponse(product *entity.Product) *ProductBundleJsonResponse {
+	if !product.IsBundle() {
+		return nil
+	}
+	slots := make([]ProductBundleSlotJsonResponse, len(product.BundleSlots))
+	for i, slot := range product.BundleSlots {
+		options := make([]ProductBundleOptionJsonResponse, len(slot.Options))
+		for j, option := range slot.Options {
+			options[j] = ProductBundleOptionJsonResponse{
+				ProductID:  option.ComponentProductID,
+				Name:       option.ComponentProduct.Name,
+				Price:      option.ComponentProduct.Price,
+				PriceDelta: option.PriceDelta,
+			}
+		}
+		slots[i] = ProductBundleSlotJsonResponse{
+			ID:       slot.ID,
+			Name:     slot.Name,
+			Quantity: slot.Quantity,
+			Options:  options,
+		}
+	}
+	return &ProductBundleJsonResponse{
+		ComponentsPrice: math.Round(product.ComponentsPrice()*100) / 100,
+		Savings:         math.Round(product.Savings()*100) / 100,
+		Slots:           slots,
+	}
+}
+
+// ToProductAvailabilityJsonResponse convert a slice of entity.ProductAvailabilityWindow to a slice of AvailabilityWindowJsonResponse
+func ToProductAvailabilityJsonResponse(windows []entity.ProductAvailabilityWindow) []AvailabilityWindowJsonResponse {
+	if len(windows) == 0 {
+		return nil
+	}
+	output := make([]AvailabilityWindowJsonResponse, len(windows))
+	for i, window := range windows {
+		output[i] = toAvailabilityWindowJsonResponse(window.AvailabilityWindow)
+	}
+	return output
+}
+
+func toAvailabilityWindowJsonResponse(window entity.AvailabilityWindow) AvailabilityWindowJsonResponse {
+	return AvailabilityWindowJsonResponse{
+		Weekday:   int(window.Weekday),
+		StartTime: window.StartTime,
+		EndTime:   window.EndTime,
+		Timezone:  window.Timezone,
 	}
 }
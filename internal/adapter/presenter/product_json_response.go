@@ -1,16 +1,57 @@
 package presenter
 
 type ProductJsonResponse struct {
-	ID          uint64  `json:"id" example:"1"`
-	Name        string  `json:"name" example:"Product A"`
-	Description string  `json:"description" example:"Description of product A"`
-	Price       float64 `json:"price" example:"99.99"`
-	CategoryID  uint64  `json:"category_id" example:"1"`
-	CreatedAt   string  `json:"created_at" example:"2024-02-09T10:00:00Z"`
-	UpdatedAt   string  `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+	ID             uint64                             `json:"id" example:"1"`
+	Name           string                             `json:"name" example:"Product A"`
+	Description    string                             `json:"description" example:"Description of product A"`
+	Price          float64                            `json:"price" example:"99.99"`
+	CategoryID     uint64                             `json:"category_id" example:"1"`
+	KitchenStation *string                            `json:"kitchen_station,omitempty" example:"GRILL"`
+	StockMode      string                             `json:"stock_mode" example:"COUNTED"`
+	StockQuantity  int64                              `json:"stock_quantity" example:"20"`
+	Available      bool                               `json:"available" example:"true"`
+	ModifierGroups []ProductModifierGroupJsonResponse `json:"modifier_groups,omitempty"`
+	Bundle         *ProductBundleJsonResponse         `json:"bundle,omitempty"`
+	Availability   []AvailabilityWindowJsonResponse   `json:"availability,omitempty"`
+	CreatedAt      string                             `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string                             `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type ProductModifierGroupJsonResponse struct {
+	ID         uint64                        `json:"id" example:"1"`
+	Name       string                        `json:"name" example:"Extras"`
+	MinChoices uint32                        `json:"min_choices" example:"0"`
+	MaxChoices uint32                        `json:"max_choices" example:"2"`
+	Modifiers  []ProductModifierJsonResponse `json:"modifiers"`
+}
+
+type ProductModifierJsonResponse struct {
+	ID         uint64  `json:"id" example:"1"`
+	Name       string  `json:"name" example:"Extra cheese"`
+	PriceDelta float64 `json:"price_delta" example:"2.00"`
 }
 
 type ProductJsonPaginatedResponse struct {
 	JsonPagination
 	Products []ProductJsonResponse `json:"products"`
 }
+
+type ProductBundleJsonResponse struct {
+	ComponentsPrice float64                         `json:"components_price" example:"48.70"`
+	Savings         float64                         `json:"savings" example:"5.80"`
+	Slots           []ProductBundleSlotJsonResponse `json:"slots"`
+}
+
+type ProductBundleSlotJsonResponse struct {
+	ID       uint64                            `json:"id" example:"1"`
+	Name     string                            `json:"name" example:"Drink"`
+	Quantity uint32                            `json:"quantity" example:"1"`
+	Options  []ProductBundleOptionJsonResponse `json:"options"`
+}
+
+type ProductBundleOptionJsonResponse struct {
+	ProductID  uint64  `json:"product_id" example:"2"`
+	Name       string  `json:"name" example:"Coca-Cola 350ml"`
+	Price      float64 `json:"price" example:"6.90"`
+	PriceDelta float64 `json:"price_delta" example:"0"`
+}
@@ -0,0 +1,42 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var productPriceCsvHeader = []string{"id", "product_id", "price", "effective_from", "effective_to", "created_at"}
+
+type productPriceCsvPresenter struct{}
+
+// NewProductPriceCsvPresenter creates a new ProductPriceCsvPresenter, only the price history is presented
+func NewProductPriceCsvPresenter() port.Presenter {
+	return &productPriceCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *productPriceCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.ProductPrice:
+		records := make([][]string, len(v))
+		for i, price := range v {
+			output := toProductPriceJsonResponse(price)
+			records[i] = []string{
+				strconv.FormatUint(output.ID, 10),
+				strconv.FormatUint(output.ProductID, 10),
+				formatCsvPrice(output.Price),
+				output.EffectiveFrom,
+				output.EffectiveTo,
+				output.CreatedAt,
+			}
+		}
+		return writeCsv(productPriceCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,59 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type productPriceJsonPresenter struct{}
+
+// NewProductPriceJsonPresenter creates a presenter of the product prices
+func NewProductPriceJsonPresenter() port.Presenter {
+	return &productPriceJsonPresenter{}
+}
+
+// toProductPriceJsonResponse convert entity.ProductPrice to ProductPriceJsonResponse
+func toProductPriceJsonResponse(price *entity.ProductPrice) ProductPriceJsonResponse {
+	output := ProductPriceJsonResponse{
+		ID:            price.ID,
+		ProductID:     price.ProductID,
+		Price:         price.Price,
+		EffectiveFrom: price.EffectiveFrom.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		CreatedAt:     price.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if price.EffectiveTo != nil {
+		output.EffectiveTo = price.EffectiveTo.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return output
+}
+
+// Present write the response to the client
+func (p *productPriceJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.ProductPrice:
+		output := toProductPriceJsonResponse(v)
+		return json.Marshal(output)
+	case []*entity.ProductPrice:
+		priceOutputs := make([]ProductPriceJsonResponse, len(v))
+		for i, price := range v {
+			priceOutputs[i] = toProductPriceJsonResponse(price)
+		}
+
+		output := &ProductPriceJsonPaginatedResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Prices: priceOutputs,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,15 @@
+package presenter
+
+type ProductPriceJsonResponse struct {
+	ID            uint64  `json:"id" example:"1"`
+	ProductID     uint64  `json:"product_id" example:"1"`
+	Price         float64 `json:"price" example:"25.90"`
+	EffectiveFrom string  `json:"effective_from" example:"2024-02-05T00:00:00Z"`
+	EffectiveTo   string  `json:"effective_to,omitempty" example:"2024-02-12T00:00:00Z"`
+	CreatedAt     string  `json:"created_at" example:"2024-02-01T10:00:00Z"`
+}
+
+type ProductPriceJsonPaginatedResponse struct {
+	JsonPagination
+	Prices []ProductPriceJsonResponse `json:"prices"`
+}
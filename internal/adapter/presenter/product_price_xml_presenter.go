@@ -0,0 +1,57 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type productPriceXmlPresenter struct{}
+
+// NewProductPriceXmlPresenter creates a presenter of the product prices
+func NewProductPriceXmlPresenter() port.Presenter {
+	return &productPriceXmlPresenter{}
+}
+
+// toProductPriceXmlResponse converts a ProductPrice entity to a ProductPriceXmlResponse
+func toProductPriceXmlResponse(price *entity.ProductPrice) ProductPriceXmlResponse {
+	output := toProductPriceJsonResponse(price)
+	return ProductPriceXmlResponse{
+		ID:            output.ID,
+		ProductID:     output.ProductID,
+		Price:         output.Price,
+		EffectiveFrom: output.EffectiveFrom,
+		EffectiveTo:   output.EffectiveTo,
+		CreatedAt:     output.CreatedAt,
+	}
+}
+
+// Present writes the response to the client
+func (p *productPriceXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.ProductPrice:
+		output := toProductPriceXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.ProductPrice:
+		priceOutputs := make([]ProductPriceXmlResponse, len(v))
+		for i, price := range v {
+			priceOutputs[i] = toProductPriceXmlResponse(price)
+		}
+
+		output := &ProductPriceXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Prices: priceOutputs,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
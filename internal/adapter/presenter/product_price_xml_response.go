@@ -0,0 +1,19 @@
+package presenter
+
+import "encoding/xml"
+
+type ProductPriceXmlResponse struct {
+	XMLName       xml.Name `xml:"price"`
+	ID            uint64   `xml:"id" example:"1"`
+	ProductID     uint64   `xml:"product_id" example:"1"`
+	Price         float64  `xml:"price" example:"25.90"`
+	EffectiveFrom string   `xml:"effective_from" example:"2024-02-05T00:00:00Z"`
+	EffectiveTo   string   `xml:"effective_to,omitempty" example:"2024-02-12T00:00:00Z"`
+	CreatedAt     string   `xml:"created_at" example:"2024-02-01T10:00:00Z"`
+}
+
+type ProductPriceXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"prices"`
+	XmlPagination
+	Prices []ProductPriceXmlResponse `xml:"price"`
+}
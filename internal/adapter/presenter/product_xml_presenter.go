@@ -46,12 +46,39 @@ func (p *productXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
 // toProductXmlResponse converts a Product entity to a ProductXmlResponse
 func toProductXmlResponse(product *entity.Product) ProductXmlResponse {
 	return ProductXmlResponse{
-		ID:          product.ID,
-		Name:        product.Name,
-		Description: product.Description,
-		Price:       product.Price,
-		CategoryID:  product.CategoryID,
-		CreatedAt:   product.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
-		UpdatedAt:   product.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		ID:             product.ID,
+		Name:           product.Name,
+		Description:    product.Description,
+		Price:          product.Price,
+		CategoryID:     product.CategoryID,
+		KitchenStation: kitchenStationString(product.KitchenStation),
+		StockMode:      product.StockMode.String(),
+		StockQuantity:  product.StockQuantity,
+		Available:      product.Available,
+		Availability:   toProductAvailabilityXmlResponse(product.AvailabilityWindows),
+		CreatedAt:      product.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:      product.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// toProductAvailabilityXmlResponse converts the availability windows of a Product entity to AvailabilityWindowXmlResponse
+func toProductAvailabilityXmlResponse(windows []entity.ProductAvailabilityWindow) *AvailabilityXmlResponse {
+	if len(windows) == 0 {
+		return nil
+	}
+	output := make([]AvailabilityWindowXmlResponse, len(windows))
+	for i, window := range windows {
+		output[i] = toAvailabilityWindowXmlResponse(window.AvailabilityWindow)
+	}
+	return &AvailabilityXmlResponse{Windows: output}
+}
+
+// toAvailabilityWindowXmlResponse converts an availability window of a category or product to AvailabilityWindowXmlResponse
+func toAvailabilityWindowXmlResponse(window entity.AvailabilityWindow) AvailabilityWindowXmlResponse {
+	return AvailabilityWindowXmlResponse{
+		Weekday:   int(window.Weekday),
+		StartTime: window.StartTime,
+		EndTime:   window.EndTime,
+		Timezone:  window.Timezone,
 	}
 }
@@ -1,13 +1,18 @@
 package presenter
 
 type ProductXmlResponse struct {
-	ID          uint64  `xml:"id" example:"1"`
-	Name        string  `xml:"name" example:"Product A"`
-	Description string  `xml:"description" example:"Description of product A"`
-	Price       float64 `xml:"price" example:"99.99"`
-	CategoryID  uint64  `xml:"category_id" example:"1"`
-	CreatedAt   string  `xml:"created_at" example:"2024-02-09T10:00:00Z"`
-	UpdatedAt   string  `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+	ID             uint64                   `xml:"id" example:"1"`
+	Name           string                   `xml:"name" example:"Product A"`
+	Description    string                   `xml:"description" example:"Description of product A"`
+	Price          float64                  `xml:"price" example:"99.99"`
+	CategoryID     uint64                   `xml:"category_id" example:"1"`
+	KitchenStation *string                  `xml:"kitchen_station,omitempty" example:"GRILL"`
+	StockMode      string                   `xml:"stock_mode" example:"COUNTED"`
+	StockQuantity  int64                    `xml:"stock_quantity" example:"20"`
+	Available      bool                     `xml:"available" example:"true"`
+	Availability   *AvailabilityXmlResponse `xml:"availability,omitempty"`
+	CreatedAt      string                   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt      string                   `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
 }
 
 type ProductXmlPaginatedResponse struct {
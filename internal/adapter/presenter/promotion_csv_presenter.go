@@ -0,0 +1,51 @@
+package presenter
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+var promotionCsvHeader = []string{"id", "name", "code", "discount_type", "value", "category_id", "product_id", "starts_at", "ends_at", "happy_hour_start", "happy_hour_end", "timezone", "max_uses_per_customer", "stackable", "active"}
+
+type promotionCsvPresenter struct{}
+
+// NewPromotionCsvPresenter creates a new PromotionCsvPresenter, only the lists of promotions are presented
+func NewPromotionCsvPresenter() port.Presenter {
+	return &promotionCsvPresenter{}
+}
+
+// Present writes the response to the client
+func (p *promotionCsvPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case []*entity.Promotion:
+		records := make([][]string, len(v))
+		for i, promotion := range v {
+			output := ToPromotionJsonResponse(promotion)
+			records[i] = []string{
+				strconv.FormatUint(output.ID, 10),
+				output.Name,
+				output.Code,
+				output.DiscountType,
+				strconv.FormatFloat(output.Value, 'f', -1, 64),
+				formatCsvID(output.CategoryID),
+				formatCsvID(output.ProductID),
+				output.StartsAt,
+				output.EndsAt,
+				output.HappyHourStart,
+				output.HappyHourEnd,
+				output.Timezone,
+				strconv.FormatUint(uint64(output.MaxUsesPerCustomer), 10),
+				strconv.FormatBool(output.Stackable),
+				strconv.FormatBool(output.Active),
+			}
+		}
+		return writeCsv(promotionCsvHeader, records)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,78 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionJsonPresenter struct{}
+
+// NewPromotionJsonPresenter creates a presenter for promotions, orders with the applied discounts are also presented
+func NewPromotionJsonPresenter() port.Presenter {
+	return &promotionJsonPresenter{}
+}
+
+// ToPromotionJsonResponse convert entity.Promotion to PromotionJsonResponse
+func ToPromotionJsonResponse(promotion *entity.Promotion) PromotionJsonResponse {
+	output := PromotionJsonResponse{
+		ID:                 promotion.ID,
+		Name:               promotion.Name,
+		DiscountType:       promotion.DiscountType.String(),
+		Value:              promotion.Value,
+		CategoryID:         promotion.CategoryID,
+		ProductID:          promotion.ProductID,
+		HappyHourStart:     promotion.HappyHourStart,
+		HappyHourEnd:       promotion.HappyHourEnd,
+		Timezone:           promotion.Timezone,
+		MaxUsesPerCustomer: promotion.MaxUsesPerCustomer,
+		Stackable:          promotion.Stackable,
+		Active:             promotion.Active,
+		CreatedAt:          promotion.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:          promotion.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if promotion.Code != nil {
+		output.Code = *promotion.Code
+	}
+	if promotion.StartsAt != nil {
+		output.StartsAt = promotion.StartsAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	if promotion.EndsAt != nil {
+		output.EndsAt = promotion.EndsAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return output
+}
+
+// Present write the response to the client
+func (p *promotionJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Promotion:
+		output := ToPromotionJsonResponse(v)
+		return json.Marshal(output)
+	case []*entity.Promotion:
+		promotionOutputs := make([]PromotionJsonResponse, len(v))
+		for i, promotion := range v {
+			promotionOutputs[i] = ToPromotionJsonResponse(promotion)
+		}
+
+		output := &PromotionJsonPaginatedResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Promotions: promotionOutputs,
+		}
+
+		return json.Marshal(output)
+	case *entity.Order:
+		output := ToOrderJsonResponse(v)
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
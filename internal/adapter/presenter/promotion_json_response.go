@@ -0,0 +1,44 @@
+package presenter
+
+import "encoding/json"
+
+type PromotionJsonResponse struct {
+	ID                 uint64  `json:"id" example:"1"`
+	Name               string  `json:"name" example:"Happy hour"`
+	Code               string  `json:"code,omitempty" example:"WELCOME10"`
+	DiscountType       string  `json:"discount_type" example:"PERCENTAGE"`
+	Value              float64 `json:"value" example:"10"`
+	CategoryID         *uint64 `json:"category_id,omitempty" example:"1"`
+	ProductID          *uint64 `json:"product_id,omitempty" example:"1"`
+	StartsAt           string  `json:"starts_at,omitempty" example:"2024-02-09T00:00:00Z"`
+	EndsAt             string  `json:"ends_at,omitempty" example:"2024-03-09T00:00:00Z"`
+	HappyHourStart     string  `json:"happy_hour_start,omitempty" example:"17:00"`
+	HappyHourEnd       string  `json:"happy_hour_end,omitempty" example:"19:00"`
+	Timezone           string  `json:"timezone,omitempty" example:"America/Sao_Paulo"`
+	MaxUsesPerCustomer uint32  `json:"max_uses_per_customer" example:"1"`
+	Stackable          bool    `json:"stackable" example:"false"`
+	Active             bool    `json:"active" example:"true"`
+	CreatedAt          string  `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt          string  `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+func (r PromotionJsonResponse) String() string {
+	o, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+	return string(o)
+}
+
+type PromotionJsonPaginatedResponse struct {
+	JsonPagination
+	Promotions []PromotionJsonResponse `json:"promotions"`
+}
+
+func (r PromotionJsonPaginatedResponse) String() string {
+	o, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+	return string(o)
+}
@@ -0,0 +1,71 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionXmlPresenter struct{}
+
+// NewPromotionXmlPresenter creates a presenter for promotions, orders with the applied discounts are also presented
+func NewPromotionXmlPresenter() port.Presenter {
+	return &promotionXmlPresenter{}
+}
+
+// toPromotionXmlResponse converts a Promotion entity to a PromotionXmlResponse
+func toPromotionXmlResponse(promotion *entity.Promotion) PromotionXmlResponse {
+	output := ToPromotionJsonResponse(promotion)
+	return PromotionXmlResponse{
+		ID:                 output.ID,
+		Name:               output.Name,
+		Code:               output.Code,
+		DiscountType:       output.DiscountType,
+		Value:              output.Value,
+		CategoryID:         output.CategoryID,
+		ProductID:          output.ProductID,
+		StartsAt:           output.StartsAt,
+		EndsAt:             output.EndsAt,
+		HappyHourStart:     output.HappyHourStart,
+		HappyHourEnd:       output.HappyHourEnd,
+		Timezone:           output.Timezone,
+		MaxUsesPerCustomer: output.MaxUsesPerCustomer,
+		Stackable:          output.Stackable,
+		Active:             output.Active,
+		CreatedAt:          output.CreatedAt,
+		UpdatedAt:          output.UpdatedAt,
+	}
+}
+
+// Present writes the response to the client
+func (p *promotionXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Promotion:
+		output := toPromotionXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.Promotion:
+		promotionOutputs := make([]PromotionXmlResponse, len(v))
+		for i, promotion := range v {
+			promotionOutputs[i] = toPromotionXmlResponse(promotion)
+		}
+
+		output := &PromotionXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Promotions: promotionOutputs,
+		}
+		return xml.Marshal(output)
+	case *entity.Order:
+		output := toOrderXmlResponse(v)
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
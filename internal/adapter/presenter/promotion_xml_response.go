@@ -0,0 +1,30 @@
+package presenter
+
+import "encoding/xml"
+
+type PromotionXmlResponse struct {
+	XMLName            xml.Name `xml:"promotion"`
+	ID                 uint64   `xml:"id" example:"1"`
+	Name               string   `xml:"name" example:"Happy hour"`
+	Code               string   `xml:"code,omitempty" example:"WELCOME10"`
+	DiscountType       string   `xml:"discount_type" example:"PERCENTAGE"`
+	Value              float64  `xml:"value" example:"10"`
+	CategoryID         *uint64  `xml:"category_id,omitempty" example:"1"`
+	ProductID          *uint64  `xml:"product_id,omitempty" example:"1"`
+	StartsAt           string   `xml:"starts_at,omitempty" example:"2024-02-09T00:00:00Z"`
+	EndsAt             string   `xml:"ends_at,omitempty" example:"2024-03-09T00:00:00Z"`
+	HappyHourStart     string   `xml:"happy_hour_start,omitempty" example:"17:00"`
+	HappyHourEnd       string   `xml:"happy_hour_end,omitempty" example:"19:00"`
+	Timezone           string   `xml:"timezone,omitempty" example:"America/Sao_Paulo"`
+	MaxUsesPerCustomer uint32   `xml:"max_uses_per_customer" example:"1"`
+	Stackable          bool     `xml:"stackable" example:"false"`
+	Active             bool     `xml:"active" example:"true"`
+	CreatedAt          string   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt          string   `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type PromotionXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"promotions"`
+	XmlPagination
+	Promotions []PromotionXmlResponse `xml:"promotion"`
+}
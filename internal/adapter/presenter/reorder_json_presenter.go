@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type reorderJsonPresenter struct{}
+
+// NewReorderJsonPresenter creates a new ReorderJsonPresenter
+func NewReorderJsonPresenter() port.Presenter {
+	return &reorderJsonPresenter{}
+}
+
+// Present writes the new order with the warnings of the skipped items
+func (p *reorderJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Reorder:
+		warnings := make([]ReorderWarningJsonResponse, len(v.Warnings))
+		for i, warning := range v.Warnings {
+			warnings[i] = ReorderWarningJsonResponse(warning)
+		}
+
+		output := ReorderJsonResponse{
+			OrderJsonResponse: ToOrderJsonResponse(v.Order),
+			SourceOrderID:     v.SourceOrderID,
+			Warnings:          warnings,
+		}
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,13 @@
+package presenter
+
+type ReorderJsonResponse struct {
+	OrderJsonResponse
+	SourceOrderID uint64                       `json:"source_order_id" example:"1"`
+	Warnings      []ReorderWarningJsonResponse `json:"warnings"`
+}
+
+type ReorderWarningJsonResponse struct {
+	ProductID   uint64 `json:"product_id" example:"2"`
+	ProductName string `json:"product_name" example:"X-Bacon"`
+	Reason      string `json:"reason" example:"product is unavailable"`
+}
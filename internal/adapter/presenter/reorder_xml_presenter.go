@@ -0,0 +1,38 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type reorderXmlPresenter struct{}
+
+// NewReorderXmlPresenter creates a new ReorderXmlPresenter
+func NewReorderXmlPresenter() port.Presenter {
+	return &reorderXmlPresenter{}
+}
+
+// Present writes the new order with the warnings of the skipped items
+func (p *reorderXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.Reorder:
+		warnings := make([]ReorderWarningXmlResponse, len(v.Warnings))
+		for i, warning := range v.Warnings {
+			warnings[i] = ReorderWarningXmlResponse(warning)
+		}
+
+		output := ReorderXmlResponse{
+			OrderXmlResponse: toOrderXmlResponse(v.Order),
+			SourceOrderID:    v.SourceOrderID,
+			Warnings:         warnings,
+		}
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
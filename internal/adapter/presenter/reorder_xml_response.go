@@ -0,0 +1,13 @@
+package presenter
+
+type ReorderXmlResponse struct {
+	OrderXmlResponse
+	SourceOrderID uint64                      `xml:"source_order_id" example:"1"`
+	Warnings      []ReorderWarningXmlResponse `xml:"warnings>warning"`
+}
+
+type ReorderWarningXmlResponse struct {
+	ProductID   uint64 `xml:"product_id" example:"2"`
+	ProductName string `xml:"product_name" example:"X-Bacon"`
+	Reason      string `xml:"reason" example:"product is unavailable"`
+}
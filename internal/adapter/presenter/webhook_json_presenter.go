@@ -0,0 +1,119 @@
+package presenter
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookJsonPresenter struct{}
+
+// NewWebhookJsonPresenter creates a presenter for the webhook subscriptions and their deliveries
+func NewWebhookJsonPresenter() port.Presenter {
+	return &webhookJsonPresenter{}
+}
+
+// ToWebhookSubscriptionJsonResponse converts entity.WebhookSubscription to WebhookSubscriptionJsonResponse, the secret is never presented
+func ToWebhookSubscriptionJsonResponse(subscription *entity.WebhookSubscription) WebhookSubscriptionJsonResponse {
+	output := WebhookSubscriptionJsonResponse{
+		ID:                  subscription.ID,
+		URL:                 subscription.URL,
+		EventTypes:          nonNilStrings(subscription.EventTypes),
+		Statuses:            nonNilStrings(subscription.Statuses),
+		Channels:            nonNilStrings(subscription.Channels),
+		FulfilmentModes:     nonNilStrings(subscription.FulfilmentModes),
+		Active:              subscription.Active,
+		ConsecutiveFailures: subscription.ConsecutiveFailures,
+		CreatedAt:           subscription.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:           subscription.UpdatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if subscription.DisabledAt != nil {
+		output.DisabledAt = subscription.DisabledAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return output
+}
+
+// ToWebhookDeliveryJsonResponse converts entity.WebhookDelivery to WebhookDeliveryJsonResponse,
+// the next attempt is only presented while the delivery is pending
+func ToWebhookDeliveryJsonResponse(delivery *entity.WebhookDelivery) WebhookDeliveryJsonResponse {
+	output := WebhookDeliveryJsonResponse{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventType:      delivery.EventType.String(),
+		Payload:        json.RawMessage(delivery.Payload),
+		Status:         delivery.Status.String(),
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt.UTC().Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if !json.Valid(output.Payload) {
+		output.Payload = nil
+	}
+	if delivery.Status == valueobject.DeliveryPending {
+		output.NextAttemptAt = delivery.NextAttemptAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	if delivery.DeliveredAt != nil {
+		output.DeliveredAt = delivery.DeliveredAt.UTC().Format("2006-01-02T15:04:05Z07:00")
+	}
+	return output
+}
+
+// Present writes the response to the client
+func (p *webhookJsonPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.WebhookSubscription:
+		output := ToWebhookSubscriptionJsonResponse(v)
+		return json.Marshal(output)
+	case []*entity.WebhookSubscription:
+		subscriptionOutputs := make([]WebhookSubscriptionJsonResponse, len(v))
+		for i, subscription := range v {
+			subscriptionOutputs[i] = ToWebhookSubscriptionJsonResponse(subscription)
+		}
+
+		output := &WebhookSubscriptionJsonPaginatedResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Subscriptions: subscriptionOutputs,
+		}
+
+		return json.Marshal(output)
+	case *entity.WebhookDelivery:
+		output := ToWebhookDeliveryJsonResponse(v)
+		return json.Marshal(output)
+	case []*entity.WebhookDelivery:
+		deliveryOutputs := make([]WebhookDeliveryJsonResponse, len(v))
+		for i, delivery := range v {
+			deliveryOutputs[i] = ToWebhookDeliveryJsonResponse(delivery)
+		}
+
+		output := &WebhookDeliveryJsonPaginatedResponse{
+			JsonPagination: JsonPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Deliveries: deliveryOutputs,
+		}
+
+		return json.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
+
+// nonNilStrings presents the empty lists as [] instead of null
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
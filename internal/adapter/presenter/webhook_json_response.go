@@ -0,0 +1,49 @@
+package presenter
+
+import "encoding/json"
+
+type WebhookSubscriptionJsonResponse struct {
+	ID                  uint64   `json:"id" example:"1"`
+	URL                 string   `json:"url" example:"https://partner.example.com/webhooks/orders"`
+	EventTypes          []string `json:"event_types" example:"order.status_changed"`
+	Statuses            []string `json:"statuses" example:"READY"`
+	Channels            []string `json:"channels" example:"APP"`
+	FulfilmentModes     []string `json:"fulfilment_modes" example:"DELIVERY"`
+	Active              bool     `json:"active" example:"true"`
+	ConsecutiveFailures uint32   `json:"consecutive_failures" example:"0"`
+	DisabledAt          string   `json:"disabled_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	CreatedAt           string   `json:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt           string   `json:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+func (r WebhookSubscriptionJsonResponse) String() string {
+	o, err := json.Marshal(r)
+	if err != nil {
+		return ""
+	}
+	return string(o)
+}
+
+type WebhookSubscriptionJsonPaginatedResponse struct {
+	JsonPagination
+	Subscriptions []WebhookSubscriptionJsonResponse `json:"subscriptions"`
+}
+
+type WebhookDeliveryJsonResponse struct {
+	ID             uint64          `json:"id" example:"1"`
+	SubscriptionID uint64          `json:"subscription_id" example:"1"`
+	EventType      string          `json:"event_type" example:"order.status_changed"`
+	Payload        json.RawMessage `json:"payload" swaggertype:"object"`
+	Status         string          `json:"status" example:"DELIVERED"`
+	Attempts       uint32          `json:"attempts" example:"1"`
+	NextAttemptAt  string          `json:"next_attempt_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	ResponseStatus int             `json:"response_status,omitempty" example:"200"`
+	LastError      string          `json:"last_error,omitempty" example:"webhook subscriber responded with status 503"`
+	DeliveredAt    string          `json:"delivered_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	CreatedAt      string          `json:"created_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type WebhookDeliveryJsonPaginatedResponse struct {
+	JsonPagination
+	Deliveries []WebhookDeliveryJsonResponse `json:"deliveries"`
+}
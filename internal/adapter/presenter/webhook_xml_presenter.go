@@ -0,0 +1,100 @@
+package presenter
+
+import (
+	"encoding/xml"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookXmlPresenter struct{}
+
+// NewWebhookXmlPresenter creates a presenter for the webhook subscriptions and their deliveries
+func NewWebhookXmlPresenter() port.Presenter {
+	return &webhookXmlPresenter{}
+}
+
+// toWebhookSubscriptionXmlResponse converts a WebhookSubscription entity to a WebhookSubscriptionXmlResponse
+func toWebhookSubscriptionXmlResponse(subscription *entity.WebhookSubscription) WebhookSubscriptionXmlResponse {
+	output := ToWebhookSubscriptionJsonResponse(subscription)
+	return WebhookSubscriptionXmlResponse{
+		ID:                  output.ID,
+		URL:                 output.URL,
+		EventTypes:          output.EventTypes,
+		Statuses:            output.Statuses,
+		Channels:            output.Channels,
+		FulfilmentModes:     output.FulfilmentModes,
+		Active:              output.Active,
+		ConsecutiveFailures: output.ConsecutiveFailures,
+		DisabledAt:          output.DisabledAt,
+		CreatedAt:           output.CreatedAt,
+		UpdatedAt:           output.UpdatedAt,
+	}
+}
+
+// toWebhookDeliveryXmlResponse converts a WebhookDelivery entity to a WebhookDeliveryXmlResponse, the payload is kept as JSON
+func toWebhookDeliveryXmlResponse(delivery *entity.WebhookDelivery) WebhookDeliveryXmlResponse {
+	output := ToWebhookDeliveryJsonResponse(delivery)
+	return WebhookDeliveryXmlResponse{
+		ID:             output.ID,
+		SubscriptionID: output.SubscriptionID,
+		EventType:      output.EventType,
+		Payload:        delivery.Payload,
+		Status:         output.Status,
+		Attempts:       output.Attempts,
+		NextAttemptAt:  output.NextAttemptAt,
+		ResponseStatus: output.ResponseStatus,
+		LastError:      output.LastError,
+		DeliveredAt:    output.DeliveredAt,
+		CreatedAt:      output.CreatedAt,
+	}
+}
+
+// Present writes the response to the client
+func (p *webhookXmlPresenter) Present(pp dto.PresenterInput) ([]byte, error) {
+	switch v := pp.Result.(type) {
+	case *entity.WebhookSubscription:
+		output := toWebhookSubscriptionXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.WebhookSubscription:
+		subscriptionOutputs := make([]WebhookSubscriptionXmlResponse, len(v))
+		for i, subscription := range v {
+			subscriptionOutputs[i] = toWebhookSubscriptionXmlResponse(subscription)
+		}
+
+		output := &WebhookSubscriptionXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Subscriptions: subscriptionOutputs,
+		}
+
+		return xml.Marshal(output)
+	case *entity.WebhookDelivery:
+		output := toWebhookDeliveryXmlResponse(v)
+		return xml.Marshal(output)
+	case []*entity.WebhookDelivery:
+		deliveryOutputs := make([]WebhookDeliveryXmlResponse, len(v))
+		for i, delivery := range v {
+			deliveryOutputs[i] = toWebhookDeliveryXmlResponse(delivery)
+		}
+
+		output := &WebhookDeliveryXmlPaginatedResponse{
+			XmlPagination: XmlPagination{
+				Total: pp.Total,
+				Page:  pp.Page,
+				Limit: pp.Limit,
+			},
+			Deliveries: deliveryOutputs,
+		}
+
+		return xml.Marshal(output)
+	default:
+		return nil, domain.NewInternalError(errors.New(domain.ErrInternalError))
+	}
+}
@@ -0,0 +1,45 @@
+package presenter
+
+import "encoding/xml"
+
+type WebhookSubscriptionXmlResponse struct {
+	XMLName             xml.Name `xml:"subscription"`
+	ID                  uint64   `xml:"id" example:"1"`
+	URL                 string   `xml:"url" example:"https://partner.example.com/webhooks/orders"`
+	EventTypes          []string `xml:"event_types>event_type" example:"order.status_changed"`
+	Statuses            []string `xml:"statuses>status" example:"READY"`
+	Channels            []string `xml:"channels>channel" example:"APP"`
+	FulfilmentModes     []string `xml:"fulfilment_modes>fulfilment_mode" example:"DELIVERY"`
+	Active              bool     `xml:"active" example:"true"`
+	ConsecutiveFailures uint32   `xml:"consecutive_failures" example:"0"`
+	DisabledAt          string   `xml:"disabled_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	CreatedAt           string   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+	UpdatedAt           string   `xml:"updated_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type WebhookSubscriptionXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"subscriptions"`
+	XmlPagination
+	Subscriptions []WebhookSubscriptionXmlResponse `xml:"subscription"`
+}
+
+type WebhookDeliveryXmlResponse struct {
+	XMLName        xml.Name `xml:"delivery"`
+	ID             uint64   `xml:"id" example:"1"`
+	SubscriptionID uint64   `xml:"subscription_id" example:"1"`
+	EventType      string   `xml:"event_type" example:"order.status_changed"`
+	Payload        string   `xml:"payload" example:"{\"type\":\"order.status_changed\",\"order_id\":1}"`
+	Status         string   `xml:"status" example:"DELIVERED"`
+	Attempts       uint32   `xml:"attempts" example:"1"`
+	NextAttemptAt  string   `xml:"next_attempt_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	ResponseStatus int      `xml:"response_status,omitempty" example:"200"`
+	LastError      string   `xml:"last_error,omitempty" example:"webhook subscriber responded with status 503"`
+	DeliveredAt    string   `xml:"delivered_at,omitempty" example:"2024-02-09T10:00:00Z"`
+	CreatedAt      string   `xml:"created_at" example:"2024-02-09T10:00:00Z"`
+}
+
+type WebhookDeliveryXmlPaginatedResponse struct {
+	XMLName xml.Name `xml:"deliveries"`
+	XmlPagination
+	Deliveries []WebhookDeliveryXmlResponse `xml:"delivery"`
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const availabilityTimeLayout = "15:04"
+
+// locations caches the timezones by name, the windows are checked on every product of the menu
+var locations sync.Map
+
+// loadLocation returns the timezone of the name, loading it only the first time
+func loadLocation(name string) (*time.Location, error) {
+	if location, ok := locations.Load(name); ok {
+		return location.(*time.Location), nil
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, err
+	}
+	locations.Store(name, location)
+	return location, nil
+}
+
+// AvailabilityWindow is a time range of a weekday when an item is sold, ex: breakfast on monday from 06:00 to 10:30.
+// The times are read on the timezone of the window, a window that ends before it starts crosses midnight
+type AvailabilityWindow struct {
+	Weekday   time.Weekday
+	StartTime string
+	EndTime   string
+	Timezone  string
+}
+
+// CategoryAvailabilityWindow restricts a category and all of its products to the window
+type CategoryAvailabilityWindow struct {
+	ID         uint64
+	CategoryID uint64
+	AvailabilityWindow
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProductAvailabilityWindow restricts a product to the window
+type ProductAvailabilityWindow struct {
+	ID        uint64
+	ProductID uint64
+	AvailabilityWindow
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Validate checks the weekday, the times and the timezone of the window
+func (w *AvailabilityWindow) Validate() error {
+	if w.Weekday < time.Sunday || w.Weekday > time.Saturday {
+		return errors.New("availability weekday must be between 0 (sunday) and 6 (saturday)")
+	}
+	start, errStart := time.Parse(availabilityTimeLayout, w.StartTime)
+	end, errEnd := time.Parse(availabilityTimeLayout, w.EndTime)
+	if errStart != nil || errEnd != nil {
+		return errors.New("availability start and end times must be in the HH:MM format")
+	}
+	if start.Equal(end) {
+		return errors.New("availability start and end times must be different")
+	}
+	if _, err := loadLocation(w.Timezone); err != nil || w.Timezone == "" {
+		return errors.New("availability timezone must be a valid IANA timezone, ex: America/Sao_Paulo")
+	}
+	return nil
+}
+
+// Contains returns true when the moment falls inside the window, a window crossing midnight
+// keeps going on the first hours of the next weekday
+func (w *AvailabilityWindow) Contains(moment time.Time) bool {
+	location, err := loadLocation(w.Timezone)
+	if err != nil {
+		return false
+	}
+	start, errStart := time.Parse(availabilityTimeLayout, w.StartTime)
+	end, errEnd := time.Parse(availabilityTimeLayout, w.EndTime)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+
+	local := moment.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+
+	if startMinute < endMinute {
+		return local.Weekday() == w.Weekday && minute >= startMinute && minute < endMinute
+	}
+	if local.Weekday() == w.Weekday && minute >= startMinute {
+		return true
+	}
+	previousWeekday := (local.Weekday() + 6) % 7
+	return previousWeekday == w.Weekday && minute < endMinute
+}
+
+// IsOpenAt returns true when the category has no windows or the moment falls inside one of them
+func (c *Category) IsOpenAt(moment time.Time) bool {
+	if len(c.AvailabilityWindows) == 0 {
+		return true
+	}
+	for i := range c.AvailabilityWindows {
+		if c.AvailabilityWindows[i].Contains(moment) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpenAt returns true when the product has no windows or the moment falls inside one of them,
+// the windows of the category are checked apart
+func (p *Product) IsOpenAt(moment time.Time) bool {
+	if len(p.AvailabilityWindows) == 0 {
+		return true
+	}
+	for i := range p.AvailabilityWindows {
+		if p.AvailabilityWindows[i].Contains(moment) {
+			return true
+		}
+	}
+	return false
+}
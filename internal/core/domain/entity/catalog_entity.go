@@ -0,0 +1,375 @@
+package entity
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// CatalogRow is a line of the catalog file, categories and products are matched by name ignoring the case.
+// A row without a product name only upserts its category
+type CatalogRow struct {
+	// Row is the position of the line in the file, starting at 1
+	Row            int
+	Category       string
+	ParentCategory string
+	Name           string
+	Description    string
+	Price          float64
+	// StockMode, StockQuantity and Available keep the current values when empty
+	StockMode     valueobject.StockMode
+	StockQuantity *int64
+	Available     *bool
+}
+
+// Catalog is the flat list of the categories and products, as exported and imported in bulk
+type Catalog struct {
+	Rows        []CatalogRow
+	GeneratedAt time.Time
+}
+
+// CatalogImportError is a validation error of a row, the import is only applied when there are none
+type CatalogImportError struct {
+	Row     int
+	Message string
+}
+
+// CatalogCategory is a category to be created or updated by the import
+type CatalogCategory struct {
+	Row      int
+	Category *Category
+	// Parent is resolved on the import, the ID of a new parent is only known after it's created
+	Parent  *Category
+	New     bool
+	Changed bool
+}
+
+// CatalogProduct is a product to be created or updated by the import
+type CatalogProduct struct {
+	Row      int
+	Product  *Product
+	Category *Category
+	New      bool
+	Changed  bool
+	// PriceChanged starts a new price record for the product, its price history is kept
+	PriceChanged bool
+	// StockModeChanged, StockQuantityChanged and AvailableChanged are set when the row changes them, the others
+	// are not written so the stock taken by the orders since the catalog was read is kept
+	StockModeChanged     bool
+	StockQuantityChanged bool
+	AvailableChanged     bool
+}
+
+// CatalogImport is the plan of an import, the categories are ordered so the parents are written first
+type CatalogImport struct {
+	DryRun     bool
+	Applied    bool
+	Rows       int
+	Categories []*CatalogCategory
+	Products   []*CatalogProduct
+	Errors     []CatalogImportError
+}
+
+// NewCatalog builds the catalog rows from the categories and their products, the categories
+// without products are exported as rows without a product
+func NewCatalog(categories []*Category, products []*Product) *Catalog {
+	byID := make(map[uint64]*Category, len(categories))
+	for _, category := range categories {
+		byID[category.ID] = category
+	}
+
+	productsByCategory := make(map[uint64][]*Product)
+	for _, product := range products {
+		productsByCategory[product.CategoryID] = append(productsByCategory[product.CategoryID], product)
+	}
+
+	sorted := append([]*Category(nil), categories...)
+	sortCategories(sorted)
+
+	var rows []CatalogRow
+	for _, category := range sorted {
+		var parent string
+		if category.ParentID != nil && byID[*category.ParentID] != nil {
+			parent = byID[*category.ParentID].Name
+		}
+
+		categoryProducts := productsByCategory[category.ID]
+		sort.SliceStable(categoryProducts, func(i, j int) bool { return categoryProducts[i].Name < categoryProducts[j].Name })
+
+		if len(categoryProducts) == 0 {
+			rows = append(rows, CatalogRow{Row: len(rows) + 1, Category: category.Name, ParentCategory: parent})
+			continue
+		}
+		for _, product := range categoryProducts {
+			quantity := product.StockQuantity
+			available := product.Available
+			rows = append(rows, CatalogRow{
+				Row:            len(rows) + 1,
+				Category:       category.Name,
+				ParentCategory: parent,
+				Name:           product.Name,
+				Description:    product.Description,
+				Price:          product.Price,
+				StockMode:      product.StockMode,
+				StockQuantity:  &quantity,
+				Available:      &available,
+			})
+		}
+	}
+
+	return &Catalog{Rows: rows, GeneratedAt: time.Now()}
+}
+
+// NewCatalogImport validates the rows and plans the changes against the current categories and products,
+// the products of the file must have unique names and the categories can't change their parent between rows
+func NewCatalogImport(rows []CatalogRow, categories []*Category, products []*Product, dryRun bool) *CatalogImport {
+	plan := &CatalogImport{DryRun: dryRun, Rows: len(rows)}
+
+	existingCategories := make(map[string]*Category, len(categories))
+	categoriesByID := make(map[uint64]*Category, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+		if _, ok := existingCategories[catalogKey(category.Name)]; !ok {
+			existingCategories[catalogKey(category.Name)] = category
+		}
+	}
+	existingProducts := make(map[string]*Product, len(products))
+	for _, product := range products {
+		if _, ok := existingProducts[catalogKey(product.Name)]; !ok {
+			existingProducts[catalogKey(product.Name)] = product
+		}
+	}
+
+	planned := make(map[string]*CatalogCategory)
+	parents := make(map[string]string)
+	seenProducts := make(map[string]bool)
+
+	for _, row := range rows {
+		if message := row.validate(); message != "" {
+			plan.Errors = append(plan.Errors, CatalogImportError{Row: row.Row, Message: message})
+			continue
+		}
+
+		key := catalogKey(row.Category)
+		category, ok := planned[key]
+		if !ok {
+			category = plan.planCategory(row, existingCategories[key])
+			planned[key] = category
+		}
+		if row.ParentCategory != "" {
+			parentKey := catalogKey(row.ParentCategory)
+			if current, ok := parents[key]; ok && current != parentKey {
+				plan.Errors = append(plan.Errors, CatalogImportError{Row: row.Row, Message: fmt.Sprintf("category %q has conflicting parents", row.Category)})
+				continue
+			}
+			parents[key] = parentKey
+		}
+
+		if row.Name == "" {
+			continue
+		}
+		productKey := catalogKey(row.Name)
+		if seenProducts[productKey] {
+			plan.Errors = append(plan.Errors, CatalogImportError{Row: row.Row, Message: fmt.Sprintf("product %q is duplicated", row.Name)})
+			continue
+		}
+		seenProducts[productKey] = true
+		plan.Products = append(plan.Products, newCatalogProduct(row, existingProducts[productKey], category.Category))
+	}
+
+	plan.resolveParents(planned, parents, existingCategories, categoriesByID)
+	for _, product := range plan.Products {
+		if product.Product.CategoryID != product.Category.ID {
+			product.Changed = true
+		}
+	}
+
+	return plan
+}
+
+// HasErrors returns true when a row is invalid
+func (c *CatalogImport) HasErrors() bool {
+	return len(c.Errors) > 0
+}
+
+// CatalogImportSummary counts the changes of an import
+type CatalogImportSummary struct {
+	CategoriesCreated int
+	CategoriesUpdated int
+	ProductsCreated   int
+	ProductsUpdated   int
+	Unchanged         int
+}
+
+// Summary counts the created, updated and unchanged categories and products
+func (c *CatalogImport) Summary() CatalogImportSummary {
+	var summary CatalogImportSummary
+	for _, category := range c.Categories {
+		switch {
+		case category.New:
+			summary.CategoriesCreated++
+		case category.Changed:
+			summary.CategoriesUpdated++
+		default:
+			summary.Unchanged++
+		}
+	}
+	for _, product := range c.Products {
+		switch {
+		case product.New:
+			summary.ProductsCreated++
+		case product.Changed:
+			summary.ProductsUpdated++
+		default:
+			summary.Unchanged++
+		}
+	}
+	return summary
+}
+
+// validate checks the required fields of the row, returning the error message
+func (r *CatalogRow) validate() string {
+	switch {
+	case len(r.Category) < 3 || len(r.Category) > 100:
+		return "category must have between 3 and 100 characters"
+	case catalogKey(r.ParentCategory) == catalogKey(r.Category):
+		return "category can not be its own parent"
+	case r.Name == "":
+		return ""
+	case len(r.Name) < 3 || len(r.Name) > 100:
+		return "name must have between 3 and 100 characters"
+	case len(r.Description) > 500:
+		return "description must have at most 500 characters"
+	case r.Price <= 0:
+		return "price must be greater than zero"
+	case r.StockMode != "" && !valueobject.IsValidStockMode(r.StockMode.String()):
+		return "stock_mode must be UNLIMITED or COUNTED"
+	case r.StockQuantity != nil && *r.StockQuantity < 0:
+		return "stock_quantity must not be negative"
+	}
+	return ""
+}
+
+// planCategory returns the category of the row, new categories are active and go to the end of the menu
+func (c *CatalogImport) planCategory(row CatalogRow, existing *Category) *CatalogCategory {
+	category := &CatalogCategory{Row: row.Row, Category: existing, New: existing == nil}
+	if existing == nil {
+		category.Category = &Category{Name: row.Category, Active: true}
+	} else if existing.Name != row.Category {
+		existing.Name = row.Category
+		category.Changed = true
+	}
+	c.Categories = append(c.Categories, category)
+	return category
+}
+
+// newCatalogProduct returns the product of the row, new products are available with unlimited stock
+func newCatalogProduct(row CatalogRow, existing *Product, category *Category) *CatalogProduct {
+	product := existing
+	if product == nil {
+		product = &Product{StockMode: valueobject.StockUnlimited, Available: true}
+	}
+	priceChanged := existing != nil && product.Price != row.Price
+	changed := priceChanged || product.Name != row.Name || product.Description != row.Description
+	product.Name = row.Name
+	product.Description = row.Description
+	product.Price = row.Price
+
+	modeChanged, quantityChanged, availableChanged := false, false, false
+	if row.StockMode != "" && product.StockMode != row.StockMode {
+		product.StockMode = row.StockMode
+		modeChanged = true
+	}
+	if row.StockQuantity != nil && product.StockQuantity != *row.StockQuantity {
+		product.StockQuantity = *row.StockQuantity
+		quantityChanged = true
+	}
+	if row.Available != nil && product.Available != *row.Available {
+		product.Available = *row.Available
+		availableChanged = true
+	}
+
+	return &CatalogProduct{
+		Row:                  row.Row,
+		Product:              product,
+		Category:             category,
+		New:                  existing == nil,
+		Changed:              changed || modeChanged || quantityChanged || availableChanged,
+		PriceChanged:         priceChanged,
+		StockModeChanged:     modeChanged,
+		StockQuantityChanged: quantityChanged,
+		AvailableChanged:     availableChanged,
+	}
+}
+
+// resolveParents links the categories to their parents, reporting the unknown parents and the cycles,
+// and orders the categories so each parent is written before its children
+func (c *CatalogImport) resolveParents(planned map[string]*CatalogCategory, parents map[string]string, existing map[string]*Category, byID map[uint64]*Category) {
+	for key, parentKey := range parents {
+		category := planned[key]
+		if parent, ok := planned[parentKey]; ok {
+			category.Parent = parent.Category
+		} else if parent, ok := existing[parentKey]; ok {
+			category.Parent = parent
+		} else {
+			c.Errors = append(c.Errors, CatalogImportError{Row: category.Row, Message: fmt.Sprintf("parent category of %q not found", category.Category.Name)})
+			continue
+		}
+		if category.Category.ParentID == nil || category.Parent.ID == 0 || *category.Category.ParentID != category.Parent.ID {
+			category.Changed = !category.New
+		}
+	}
+
+	// parentOf follows the new parents of the file, falling back to the current ones
+	parentOf := func(category *Category) *Category {
+		if planned, ok := planned[catalogKey(category.Name)]; ok && planned.Category == category && planned.Parent != nil {
+			return planned.Parent
+		}
+		if category.ParentID != nil {
+			return byID[*category.ParentID]
+		}
+		return nil
+	}
+
+	for _, category := range c.Categories {
+		visited := map[*Category]bool{category.Category: true}
+		for parent := parentOf(category.Category); parent != nil; parent = parentOf(parent) {
+			if visited[parent] {
+				c.Errors = append(c.Errors, CatalogImportError{Row: category.Row, Message: fmt.Sprintf("category %q can not be its own ancestor", category.Category.Name)})
+				break
+			}
+			visited[parent] = true
+		}
+	}
+	if c.HasErrors() {
+		sort.SliceStable(c.Errors, func(i, j int) bool { return c.Errors[i].Row < c.Errors[j].Row })
+		return
+	}
+
+	ordered := make([]*CatalogCategory, 0, len(c.Categories))
+	added := make(map[*CatalogCategory]bool, len(c.Categories))
+	var add func(category *CatalogCategory)
+	add = func(category *CatalogCategory) {
+		if added[category] {
+			return
+		}
+		added[category] = true
+		if category.Parent != nil {
+			if parent, ok := planned[catalogKey(category.Parent.Name)]; ok && parent.Category == category.Parent {
+				add(parent)
+			}
+		}
+		ordered = append(ordered, category)
+	}
+	for _, category := range c.Categories {
+		add(category)
+	}
+	c.Categories = ordered
+}
+
+func catalogKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -1,15 +1,33 @@
 package entity
 
-import "time"
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
 
 type Category struct {
-	ID        uint64
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID   uint64
+	Name string
+	// ParentID is nil on the top level categories of the menu
+	ParentID     *uint64
+	DisplayOrder int
+	Active       bool
+	ImageURL     string
+	// KitchenStation prepares the products of the category, nil inherits the station of the parent
+	KitchenStation *valueobject.KitchenStation
+	// AvailabilityWindows restrict the category to some times of the week, empty means always available
+	AvailabilityWindows []CategoryAvailabilityWindow
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
 }
 
-func (p *Category) Update(name string) {
+func (p *Category) Update(name string, parentID *uint64, displayOrder int, active bool, imageURL string, kitchenStation *valueobject.KitchenStation) {
 	p.Name = name
+	p.ParentID = parentID
+	p.DisplayOrder = displayOrder
+	p.Active = active
+	p.ImageURL = imageURL
+	p.KitchenStation = kitchenStation
 	p.UpdatedAt = time.Now()
 }
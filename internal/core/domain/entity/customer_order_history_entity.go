@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// CustomerOrderHistory is a page of the orders a customer placed in the period, the newest first.
+// TotalSpent sums the orders paid in the whole period, not only the ones in the page
+type CustomerOrderHistory struct {
+	CustomerID uint64
+	From       time.Time
+	To         time.Time
+	Orders     []*Order
+	TotalSpent float64
+}
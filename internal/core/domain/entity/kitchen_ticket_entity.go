@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// KitchenTicket is the part of an order prepared on one kitchen station
+type KitchenTicket struct {
+	ID      uint64
+	OrderID uint64
+	Station valueobject.KitchenStation
+	Status  valueobject.KitchenTicketStatus
+	// StaffID is the staff who last changed the status of the ticket
+	StaffID   *uint64
+	StartedAt *time.Time
+	DoneAt    *time.Time
+	Items     []KitchenTicketItem
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// KitchenTicketItem is a product to prepare on the station, names are copied from the order
+type KitchenTicketItem struct {
+	ID              uint64
+	KitchenTicketID uint64
+	OrderProductID  uint64
+	ProductID       uint64
+	Name            string
+	Quantity        uint32
+	// Modifiers are the names of the modifiers chosen for the line item, separated by comma
+	Modifiers string
+	Notes     string
+	CreatedAt time.Time
+}
+
+// UpdateStatus moves the ticket forward, a ticket is started before it is done
+func (t *KitchenTicket) UpdateStatus(status valueobject.KitchenTicketStatus, staffID uint64) error {
+	now := time.Now()
+	switch {
+	case t.Status == valueobject.TicketQueued && status == valueobject.TicketInProgress:
+		t.StartedAt = &now
+	case t.Status == valueobject.TicketInProgress && status == valueobject.TicketDone:
+		t.DoneAt = &now
+	default:
+		return errors.New("kitchen ticket can't move from " + t.Status.String() + " to " + status.String())
+	}
+
+	t.Status = status
+	t.StaffID = &staffID
+	t.UpdatedAt = now
+	return nil
+}
+
+// AllKitchenTicketsDone returns true when every ticket of an order is done
+func AllKitchenTicketsDone(tickets []*KitchenTicket) bool {
+	for _, ticket := range tickets {
+		if ticket.Status != valueobject.TicketDone {
+			return false
+		}
+	}
+	return len(tickets) > 0
+}
+
+// KitchenStationFrom returns the station of the product, inherited from its category and the
+// ancestors of the category when it has none. The categories are indexed by ID
+func (p *Product) KitchenStationFrom(categories map[uint64]*Category) valueobject.KitchenStation {
+	if p.KitchenStation != nil {
+		return *p.KitchenStation
+	}
+
+	visited := make(map[uint64]bool)
+	for categoryID := &p.CategoryID; categoryID != nil && !visited[*categoryID]; {
+		visited[*categoryID] = true
+		category, ok := categories[*categoryID]
+		if !ok {
+			break
+		}
+		if category.KitchenStation != nil {
+			return *category.KitchenStation
+		}
+		categoryID = category.ParentID
+	}
+	return valueobject.DefaultKitchenStation
+}
+
+// NewKitchenTickets splits the order into one ticket per station. The products of the line items are
+// prepared on their station, bundles are split into their components. The stations are indexed by product ID
+func NewKitchenTickets(order *Order, stations map[uint64]valueobject.KitchenStation) []*KitchenTicket {
+	var tickets []*KitchenTicket
+	byStation := make(map[valueobject.KitchenStation]*KitchenTicket)
+	add := func(productID uint64, item KitchenTicketItem) {
+		station, ok := stations[productID]
+		if !ok {
+			station = valueobject.DefaultKitchenStation
+		}
+		ticket, ok := byStation[station]
+		if !ok {
+			ticket = &KitchenTicket{OrderID: order.ID, Station: station, Status: valueobject.TicketQueued}
+			byStation[station] = ticket
+			tickets = append(tickets, ticket)
+		}
+		ticket.Items = append(ticket.Items, item)
+	}
+
+	for _, orderProduct := range order.OrderProducts {
+		if len(orderProduct.Components) == 0 {
+			modifiers := make([]string, len(orderProduct.Modifiers))
+			for i, modifier := range orderProduct.Modifiers {
+				modifiers[i] = modifier.Name
+			}
+			add(orderProduct.ProductID, KitchenTicketItem{
+				OrderProductID: orderProduct.ID,
+				ProductID:      orderProduct.ProductID,
+				Name:           orderProduct.Product.Name,
+				Quantity:       orderProduct.Quantity,
+				Modifiers:      strings.Join(modifiers, ", "),
+				Notes:          orderProduct.Notes,
+			})
+			continue
+		}
+
+		for _, component := range orderProduct.Components {
+			add(component.ProductID, KitchenTicketItem{
+				OrderProductID: orderProduct.ID,
+				ProductID:      component.ProductID,
+				Name:           component.Name,
+				Quantity:       component.Quantity * orderProduct.Quantity,
+				Notes:          orderProduct.Notes,
+			})
+		}
+	}
+
+	return tickets
+}
+
+// KitchenProductIDs returns the IDs of the products prepared by the kitchen for the order, bundles are
+// replaced by their components
+func (o *Order) KitchenProductIDs() []uint64 {
+	var ids []uint64
+	seen := make(map[uint64]bool)
+	add := func(id uint64) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, orderProduct := range o.OrderProducts {
+		if len(orderProduct.Components) == 0 {
+			add(orderProduct.ProductID)
+			continue
+		}
+		for _, component := range orderProduct.Components {
+			add(component.ProductID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,95 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// Menu is the tree of the active categories with their products, as shown on the totem
+type Menu struct {
+	Categories  []MenuCategory
+	GeneratedAt time.Time
+}
+
+type MenuCategory struct {
+	Category
+	Products      []*Product
+	Subcategories []MenuCategory
+}
+
+// NewMenu builds the menu tree from the active categories, the subcategories of a category that is not on the
+// list are left out, so disabling a category hides its whole branch
+func NewMenu(categories []*Category, products []*Product) *Menu {
+	children := make(map[uint64][]*Category)
+	var roots []*Category
+	for _, category := range categories {
+		if category.ParentID == nil {
+			roots = append(roots, category)
+			continue
+		}
+		children[*category.ParentID] = append(children[*category.ParentID], category)
+	}
+
+	productsByCategory := make(map[uint64][]*Product)
+	for _, product := range products {
+		productsByCategory[product.CategoryID] = append(productsByCategory[product.CategoryID], product)
+	}
+
+	var build func(categories []*Category) []MenuCategory
+	build = func(categories []*Category) []MenuCategory {
+		sortCategories(categories)
+		output := make([]MenuCategory, len(categories))
+		for i, category := range categories {
+			output[i] = MenuCategory{
+				Category:      *category,
+				Products:      productsByCategory[category.ID],
+				Subcategories: build(children[category.ID]),
+			}
+		}
+		return output
+	}
+
+	return &Menu{
+		Categories:  build(roots),
+		GeneratedAt: time.Now(),
+	}
+}
+
+// sortCategories sorts the categories by display order, ties are kept by ID
+func sortCategories(categories []*Category) {
+	sort.SliceStable(categories, func(i, j int) bool {
+		if categories[i].DisplayOrder != categories[j].DisplayOrder {
+			return categories[i].DisplayOrder < categories[j].DisplayOrder
+		}
+		return categories[i].ID < categories[j].ID
+	})
+}
+
+// OpenAt returns a copy of the menu with only the categories and products available at the moment, priced at
+// the moment from their loaded prices. A category out of its windows hides its whole branch
+func (m *Menu) OpenAt(moment time.Time) *Menu {
+	var filter func(categories []MenuCategory) []MenuCategory
+	filter = func(categories []MenuCategory) []MenuCategory {
+		output := make([]MenuCategory, 0, len(categories))
+		for _, category := range categories {
+			if !category.IsOpenAt(moment) {
+				continue
+			}
+			products := make([]*Product, 0, len(category.Products))
+			for _, product := range category.Products {
+				if product.IsOpenAt(moment) {
+					products = append(products, product.PricedAt(moment))
+				}
+			}
+			category.Products = products
+			category.Subcategories = filter(category.Subcategories)
+			output = append(output, category)
+		}
+		return output
+	}
+
+	return &Menu{
+		Categories:  filter(m.Categories),
+		GeneratedAt: m.GeneratedAt,
+	}
+}
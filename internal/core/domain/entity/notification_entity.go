@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// Notification is a message sent to a customer about an order
+type Notification struct {
+	CustomerID uint64                          `json:"customer_id"`
+	OrderID    uint64                          `json:"order_id"`
+	Status     valueobject.OrderStatus         `json:"status"`
+	Channel    valueobject.NotificationChannel `json:"channel"`
+	Recipient  string                          `json:"recipient"`
+	Locale     valueobject.Locale              `json:"locale"`
+	Subject    string                          `json:"subject,omitempty"`
+	Body       string                          `json:"body"`
+	CreatedAt  time.Time                       `json:"created_at"`
+}
+
+// NewNotification creates the notification of the order event to the recipient of the channel
+func NewNotification(preference *NotificationPreference, channel valueobject.NotificationChannel, event OrderEvent, subject, body string) *Notification {
+	return &Notification{
+		CustomerID: preference.CustomerID,
+		OrderID:    event.OrderID,
+		Status:     event.Status,
+		Channel:    channel,
+		Recipient:  preference.Recipient(channel),
+		Locale:     preference.Locale,
+		Subject:    subject,
+		Body:       body,
+		CreatedAt:  time.Now(),
+	}
+}
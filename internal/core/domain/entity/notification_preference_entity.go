@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// NotificationPreference is the opt-in of a customer to the order notifications,
+// the customer is only notified on the chosen channels and an empty list opts out of all of them
+type NotificationPreference struct {
+	ID         uint64
+	CustomerID uint64
+	Locale     valueobject.Locale
+	Channels   StringList
+	// Email, Phone and PushToken are the recipients of the channels, the one of each chosen channel is mandatory
+	Email     string
+	Phone     string
+	PushToken string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Update changes the preference of the customer
+func (p *NotificationPreference) Update(changes *NotificationPreference) {
+	p.Locale = changes.Locale
+	p.Channels = changes.Channels
+	p.Email = changes.Email
+	p.Phone = changes.Phone
+	p.PushToken = changes.PushToken
+	p.UpdatedAt = time.Now()
+}
+
+// Validate checks the locale and that every chosen channel has a recipient
+func (p *NotificationPreference) Validate() error {
+	if !valueobject.IsValidLocale(p.Locale.String()) {
+		return fmt.Errorf("notification locale %q is invalid", p.Locale)
+	}
+	for _, c := range p.Channels {
+		channel, ok := valueobject.ToNotificationChannel(c)
+		if !ok {
+			return fmt.Errorf("notification channel %q is invalid", c)
+		}
+		if p.Recipient(channel) == "" {
+			return errors.New(recipientMissingError(channel))
+		}
+	}
+	return nil
+}
+
+// OptedIn returns true when the customer chose the channel
+func (p *NotificationPreference) OptedIn(channel valueobject.NotificationChannel) bool {
+	return p.Channels.Contains(channel.String())
+}
+
+// Recipient returns where the notifications of the channel are sent
+func (p *NotificationPreference) Recipient(channel valueobject.NotificationChannel) string {
+	switch channel {
+	case valueobject.NotificationSMS:
+		return p.Phone
+	case valueobject.NotificationEmail:
+		return p.Email
+	case valueobject.NotificationPush:
+		return p.PushToken
+	default:
+		return ""
+	}
+}
+
+func recipientMissingError(channel valueobject.NotificationChannel) string {
+	switch channel {
+	case valueobject.NotificationSMS:
+		return "phone is mandatory to be notified by SMS"
+	case valueobject.NotificationEmail:
+		return "email is mandatory to be notified by EMAIL"
+	default:
+		return "push token is mandatory to be notified by PUSH"
+	}
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// OrderCoupon is a coupon applied to an order by the customer
+type OrderCoupon struct {
+	ID          uint64
+	OrderID     uint64
+	PromotionID uint64
+	Code        string
+	CreatedAt   time.Time
+}
+
+// OrderDiscount is the discount of a promotion on an order, name and code are copied from the promotion
+type OrderDiscount struct {
+	ID          uint64
+	OrderID     uint64
+	PromotionID uint64
+	Name        string
+	Code        string
+	Amount      float64
+	CreatedAt   time.Time
+}
+
+// ApplyPromotions recalculates the discounts and totals of the order, non stackable promotions
+// can't be combined so the best of the stackable ones together or a single non stackable one wins
+func (o *Order) ApplyPromotions(promotions []*Promotion, now time.Time) {
+	var subtotal float64
+	for i := range o.OrderProducts {
+		subtotal += o.OrderProducts[i].Total()
+	}
+	subtotal = roundCents(subtotal)
+
+	var stackable []OrderDiscount
+	var stackableTotal float64
+	var best *OrderDiscount
+	for _, promotion := range promotions {
+		if !promotion.IsAvailableAt(now) {
+			continue
+		}
+		amount := promotion.DiscountFor(o)
+		if amount <= 0 {
+			continue
+		}
+
+		discount := OrderDiscount{
+			OrderID:     o.ID,
+			PromotionID: promotion.ID,
+			Name:        promotion.Name,
+			Amount:      amount,
+		}
+		if promotion.Code != nil {
+			discount.Code = *promotion.Code
+		}
+
+		if promotion.Stackable {
+			stackable = append(stackable, discount)
+			stackableTotal += amount
+			continue
+		}
+		if best == nil || amount > best.Amount {
+			best = &discount
+		}
+	}
+
+	discounts := stackable
+	if best != nil && best.Amount > stackableTotal {
+		discounts = []OrderDiscount{*best}
+	}
+
+	// The discounts can't be greater than the subtotal
+	var discountTotal float64
+	for i := range discounts {
+		discounts[i].Amount = roundCents(math.Min(discounts[i].Amount, subtotal-discountTotal))
+		discountTotal += discounts[i].Amount
+	}
+
+	o.Discounts = discounts
+	o.Subtotal = subtotal
+	o.DiscountTotal = roundCents(discountTotal)
+	o.Total = roundCents(subtotal - discountTotal)
+}
@@ -1,18 +1,43 @@
 package entity
 
 import (
+	"fmt"
 	"time"
 
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 )
 
 type Order struct {
-	ID            uint64
-	CustomerID    uint64
-	Status        valueobject.OrderStatus
-	OrderProducts []OrderProduct
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID uint64
+	// CustomerID is zero on guest orders, they have a GuestToken to track the order and an optional GuestName
+	CustomerID uint64
+	GuestName  string
+	GuestToken string
+	Status     valueobject.OrderStatus
+	// Channel is where the order was placed and FulfilmentMode how it is handed to the customer,
+	// the TableNumber is only set for dine-in orders and the DeliveryAddress for delivery orders
+	Channel         valueobject.OrderChannel
+	FulfilmentMode  valueobject.FulfilmentMode
+	TableNumber     *uint32
+	DeliveryAddress *OrderDeliveryAddress
+	OrderProducts   []OrderProduct
+	// Subtotal, DiscountTotal and Total are recalculated when the promotions are applied to the order
+	Subtotal      float64
+	DiscountTotal float64
+	Total         float64
+	Coupons       []OrderCoupon
+	Discounts     []OrderDiscount
+	// Histories are the status timeline of the order, they are only loaded when the order is found by ID
+	Histories []OrderHistory
+	// PickupCode is the short code the customer is called by, it is assigned when the order is RECEIVED
+	// and is unique on its PickupDate
+	PickupCode string
+	PickupDate *time.Time
+	// PaymentReference identifies the payment created on the payment service when the order was checked out
+	PaymentReference string
+	Version          uint32
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 func (p *Order) Update(customerID uint64, status valueobject.OrderStatus) {
@@ -25,3 +50,23 @@ func (p *Order) Update(customerID uint64, status valueobject.OrderStatus) {
 	p.OrderProducts = nil
 	p.UpdatedAt = time.Now()
 }
+
+// pickupCodeNumbers is how many numbers each letter of the pickup codes has
+const pickupCodeNumbers = 99
+
+// NewPickupCode formats the n-th pickup code of a day: A1 to A99, then B1 to B99 and so on.
+// The last letter keeps counting past 99, so the codes never repeat on the same day
+func NewPickupCode(number uint32) string {
+	if number == 0 {
+		number = 1
+	}
+	letter := min((number-1)/pickupCodeNumbers, 'Z'-'A')
+	return fmt.Sprintf("%c%d", 'A'+letter, number-letter*pickupCodeNumbers)
+}
+
+// AssignPickupCode gives the order the n-th pickup code of the day
+func (p *Order) AssignPickupCode(day time.Time, number uint32) {
+	date := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	p.PickupCode = NewPickupCode(number)
+	p.PickupDate = &date
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// OrderEvent is published when an order is created or changes status
+type OrderEvent struct {
+	Type           valueobject.OrderEventType `json:"type"`
+	OrderID        uint64                     `json:"order_id"`
+	CustomerID     uint64                     `json:"customer_id,omitempty"`
+	Status         valueobject.OrderStatus    `json:"status"`
+	PreviousStatus valueobject.OrderStatus    `json:"previous_status,omitempty"`
+	Channel        valueobject.OrderChannel   `json:"channel"`
+	FulfilmentMode valueobject.FulfilmentMode `json:"fulfilment_mode"`
+	Total          float64                    `json:"total"`
+	PickupCode     string                     `json:"pickup_code,omitempty"`
+	ReasonCode     string                     `json:"reason_code,omitempty"`
+	Version        uint32                     `json:"version"`
+	OccurredAt     time.Time                  `json:"occurred_at"`
+}
+
+// NewOrderEvent creates the event with the current state of the order, the previous status is empty on creation
+func NewOrderEvent(eventType valueobject.OrderEventType, order *Order, previousStatus valueobject.OrderStatus, reasonCode string) OrderEvent {
+	return OrderEvent{
+		Type:           eventType,
+		OrderID:        order.ID,
+		CustomerID:     order.CustomerID,
+		Status:         order.Status,
+		PreviousStatus: previousStatus,
+		Channel:        order.Channel,
+		FulfilmentMode: order.FulfilmentMode,
+		Total:          order.Total,
+		PickupCode:     order.PickupCode,
+		ReasonCode:     reasonCode,
+		Version:        order.Version,
+		OccurredAt:     time.Now(),
+	}
+}
@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// OrderDeliveryAddress is the address a delivery order is taken to, copied when the order is created
+type OrderDeliveryAddress struct {
+	ID           uint64
+	OrderID      uint64
+	Street       string
+	Number       string
+	Complement   string
+	Neighborhood string
+	City         string
+	State        string
+	ZipCode      string
+	CreatedAt    time.Time
+}
+
+// Validate checks the fields the courier needs to find the address
+func (a *OrderDeliveryAddress) Validate() error {
+	if a.Street == "" || a.Number == "" || a.City == "" || a.State == "" || a.ZipCode == "" {
+		return errors.New("delivery address must have street, number, city, state and zip code")
+	}
+	return nil
+}
+
+// ValidateFulfilment checks the details required by the fulfilment mode of the order: dine-in orders
+// need the table number, delivery orders the address and takeaway orders none of them
+func (o *Order) ValidateFulfilment() error {
+	if o.FulfilmentMode != valueobject.FulfilmentDineIn && o.TableNumber != nil {
+		return errors.New("table number is only allowed for dine-in orders")
+	}
+	if o.FulfilmentMode != valueobject.FulfilmentDelivery && o.DeliveryAddress != nil {
+		return errors.New("delivery address is only allowed for delivery orders")
+	}
+
+	switch o.FulfilmentMode {
+	case valueobject.FulfilmentDineIn:
+		if o.TableNumber == nil || *o.TableNumber == 0 {
+			return errors.New("table number is mandatory for dine-in orders")
+		}
+	case valueobject.FulfilmentDelivery:
+		if o.DeliveryAddress == nil {
+			return errors.New("delivery address is mandatory for delivery orders")
+		}
+		return o.DeliveryAddress.Validate()
+	}
+	return nil
+}
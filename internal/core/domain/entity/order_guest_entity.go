@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// NewGuestToken generates the secret given to a guest to track the order
+func NewGuestToken() string {
+	return rand.Text()
+}
+
+// IsGuest reports whether the order was placed without identifying the customer
+func (o *Order) IsGuest() bool {
+	return o.CustomerID == 0
+}
+
+// StartGuest gives a guest order its token, the name is only shown to call the customer
+func (o *Order) StartGuest(name string) error {
+	if !o.IsGuest() {
+		if name != "" {
+			return errors.New("guest name is only allowed for guest orders")
+		}
+		return nil
+	}
+
+	o.GuestName = name
+	o.GuestToken = NewGuestToken()
+	return nil
+}
+
+// HasGuestToken compares the token in constant time, orders of identified customers have no token
+func (o *Order) HasGuestToken(token string) bool {
+	return o.GuestToken != "" && subtle.ConstantTimeCompare([]byte(o.GuestToken), []byte(token)) == 1
+}
+
+// IsPaid reports whether the order went past the payment, orders are paid when they are RECEIVED
+func (o *Order) IsPaid() bool {
+	return o.Status != valueobject.OPEN && o.Status != valueobject.PENDING
+}
+
+// AttachCustomer gives a guest order to the customer that signed in, the guest token keeps working
+func (o *Order) AttachCustomer(customerID uint64) {
+	o.CustomerID = customerID
+	o.UpdatedAt = time.Now()
+}
@@ -1,6 +1,10 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
 	"time"
 
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
@@ -11,8 +15,17 @@ type OrderHistory struct {
 	OrderID   uint64
 	StaffID   *uint64
 	Status    valueobject.OrderStatus
-	CreatedAt time.Time
-	Order     Order
+	ActorType valueobject.ActorType
+	ActorID   string
+	// ReasonCode explains why the status changed, ex: EXPIRED
+	ReasonCode string
+	ReasonText string
+	Source     valueobject.OrderUpdateSource
+	// PreviousHash and Hash chain the histories of an order, rows created before the chain have no hash
+	PreviousHash *string
+	Hash         *string
+	CreatedAt    time.Time
+	Order        Order
 }
 
 func NewOrderHistory(orderID uint64, status valueobject.OrderStatus, staffID *uint64) *OrderHistory {
@@ -22,3 +35,87 @@ func NewOrderHistory(orderID uint64, status valueobject.OrderStatus, staffID *ui
 		StaffID: staffID,
 	}
 }
+
+// Seal chains the history to the previous one, the CreatedAt must be set before sealing
+func (h *OrderHistory) Seal(previousHash string) {
+	hash := h.ComputeHash(previousHash)
+	h.PreviousHash = &previousHash
+	h.Hash = &hash
+}
+
+// IsSealed returns true if the history is part of the hash chain
+func (h *OrderHistory) IsSealed() bool {
+	return h.Hash != nil && h.PreviousHash != nil
+}
+
+// ComputeHash returns the SHA-256 of the history fields chained to the previous hash
+func (h *OrderHistory) ComputeHash(previousHash string) string {
+	var staffID string
+	if h.StaffID != nil {
+		staffID = strconv.FormatUint(*h.StaffID, 10)
+	}
+
+	payload := strings.Join([]string{
+		previousHash,
+		strconv.FormatUint(h.OrderID, 10),
+		string(h.Status),
+		staffID,
+		string(h.ActorType),
+		h.ActorID,
+		h.ReasonCode,
+		h.ReasonText,
+		string(h.Source),
+		h.CreatedAt.UTC().Format(time.RFC3339Nano),
+	}, "|")
+
+	sum := sha256.Sum256([]byte(payload))
+	return hex.EncodeToString(sum[:])
+}
+
+// OrderHistoryVerification is the result of the verification of the hash chain of an order
+type OrderHistoryVerification struct {
+	OrderID  uint64
+	Valid    bool
+	Total    int
+	Sealed   int
+	Unsealed int
+	// BrokenAt is the first history that does not match the chain
+	BrokenAt *uint64
+	Reason   string
+}
+
+// VerifyOrderHistories checks the hash chain of the histories of an order, ordered by creation
+func VerifyOrderHistories(orderID uint64, histories []*OrderHistory) OrderHistoryVerification {
+	result := OrderHistoryVerification{OrderID: orderID, Valid: true, Total: len(histories)}
+
+	previousHash := ""
+	for _, h := range histories {
+		if !h.IsSealed() {
+			// Histories created before the chain can only appear before the first sealed one
+			if result.Sealed > 0 {
+				return result.broken(h.ID, "unsealed history after the chain started")
+			}
+			result.Unsealed++
+			continue
+		}
+
+		if *h.PreviousHash != previousHash {
+			return result.broken(h.ID, "previous hash does not match")
+		}
+		if h.ComputeHash(previousHash) != *h.Hash {
+			return result.broken(h.ID, "hash does not match the history content")
+		}
+
+		previousHash = *h.Hash
+		result.Sealed++
+	}
+
+	return result
+}
+
+func (v OrderHistoryVerification) broken(id uint64, reason string) OrderHistoryVerification {
+	v.Valid = false
+	v.BrokenAt = &id
+	v.Reason = reason
+	return v
+}
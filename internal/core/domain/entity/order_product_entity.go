@@ -4,19 +4,70 @@ import (
 	"time"
 )
 
+// OrderProduct is a line item of an order, the same product can be ordered in many lines with different customizations
 type OrderProduct struct {
+	ID        uint64
 	OrderID   uint64
 	ProductID uint64
 	Quantity  uint32
-	Order     Order   // Virtual field
-	Product   Product // Virtual field
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Notes     string
+	// Price is copied from the price record of the product effective when the line was created, referenced by ProductPriceID
+	Price          float64
+	ProductPriceID *uint64
+	Modifiers      []OrderProductModifier
+	// Components are the products inside a bundle line item
+	Components []OrderProductComponent
+	Order      Order   // Virtual field
+	Product    Product // Virtual field
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
 }
 
-func (p *OrderProduct) Update(quantity uint32) {
+// OrderProductModifier is a modifier chosen for a line item, name and price are copied from the catalog
+type OrderProductModifier struct {
+	ID                uint64
+	OrderProductID    uint64
+	ProductModifierID uint64
+	GroupName         string
+	Name              string
+	PriceDelta        float64
+	CreatedAt         time.Time
+}
+
+// OrderProductComponent is a product chosen for a bundle slot of a line item, names are copied from the catalog
+type OrderProductComponent struct {
+	ID                  uint64
+	OrderProductID      uint64
+	ProductBundleSlotID uint64
+	SlotName            string
+	ProductID           uint64
+	Name                string
+	Quantity            uint32
+	PriceDelta          float64
+	CreatedAt           time.Time
+}
+
+func (p *OrderProduct) Update(quantity uint32, notes string) {
 	p.Quantity = quantity
+	p.Notes = notes
 	p.UpdatedAt = time.Now()
 	p.Order = Order{}
 	p.Product = Product{}
 }
+
+// UnitPrice returns the price copied to the line plus the price of the chosen modifiers and bundle components
+func (p *OrderProduct) UnitPrice() float64 {
+	price := p.Price
+	for _, modifier := range p.Modifiers {
+		price += modifier.PriceDelta
+	}
+	for _, component := range p.Components {
+		price += component.PriceDelta
+	}
+	return price
+}
+
+// Total returns the unit price multiplied by the quantity
+func (p *OrderProduct) Total() float64 {
+	return p.UnitPrice() * float64(p.Quantity)
+}
@@ -6,4 +6,8 @@ type OrderStatusUpdated struct {
 	OrderID uint64                  `json:"order_id"`
 	Status  valueobject.OrderStatus `json:"status"`
 	StaffID *uint64                 `json:"staff_id,omitempty"` // Optional field for staff ID
+	// Optional actor and reason of the update, recorded in the order history
+	ActorID    string `json:"actor_id,omitempty"`
+	ReasonCode string `json:"reason_code,omitempty"`
+	ReasonText string `json:"reason_text,omitempty"`
 }
@@ -0,0 +1,9 @@
+package entity
+
+// Payment is the charge of an order created on the payment service
+type Payment struct {
+	OrderID uint64
+	// Reference is the ID of the payment on the payment service
+	Reference string
+	Amount    float64
+}
@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// PickupBoard is what the lobby monitor shows, only the pickup codes so no customer data is exposed
+type PickupBoard struct {
+	Preparing   []string
+	Ready       []string
+	GeneratedAt time.Time
+}
+
+// NewPickupBoard splits the pickup codes of the orders by their status, keeping the order of the list
+func NewPickupBoard(orders []*Order, generatedAt time.Time) *PickupBoard {
+	board := &PickupBoard{Preparing: []string{}, Ready: []string{}, GeneratedAt: generatedAt}
+	for _, order := range orders {
+		if order.PickupCode == "" {
+			continue
+		}
+		switch order.Status {
+		case valueobject.PREPARING:
+			board.Preparing = append(board.Preparing, order.PickupCode)
+		case valueobject.READY:
+			board.Ready = append(board.Ready, order.PickupCode)
+		}
+	}
+	return board
+}
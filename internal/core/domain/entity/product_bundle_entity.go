@@ -0,0 +1,128 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ProductBundleSlot is a component slot of a bundle product, ex: "Drink" that can be filled by any of its options
+type ProductBundleSlot struct {
+	ID        uint64
+	ProductID uint64
+	Name      string
+	Quantity  uint32
+	Options   []ProductBundleOption
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProductBundleOption is a product that can fill a bundle slot, the first option of a slot is its default
+type ProductBundleOption struct {
+	ID                  uint64
+	ProductBundleSlotID uint64
+	ComponentProductID  uint64
+	ComponentProduct    Product // Virtual field
+	PriceDelta          float64
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// Validate checks the quantity and options of the slot
+func (s *ProductBundleSlot) Validate() error {
+	if s.Quantity == 0 {
+		return fmt.Errorf("bundle slot %q must have a quantity greater than zero", s.Name)
+	}
+	if len(s.Options) == 0 {
+		return fmt.Errorf("bundle slot %q has no options", s.Name)
+	}
+	seen := make(map[uint64]bool, len(s.Options))
+	for _, option := range s.Options {
+		if seen[option.ComponentProductID] {
+			return fmt.Errorf("bundle slot %q has product %d more than once", s.Name, option.ComponentProductID)
+		}
+		seen[option.ComponentProductID] = true
+	}
+	return nil
+}
+
+// IsBundle returns true when the product is composed of other products
+func (p *Product) IsBundle() bool {
+	return len(p.BundleSlots) > 0
+}
+
+// ComponentsPrice returns the price of the default components of the bundle if bought separately
+func (p *Product) ComponentsPrice() float64 {
+	var total float64
+	for _, slot := range p.BundleSlots {
+		if len(slot.Options) == 0 {
+			continue
+		}
+		total += slot.Options[0].ComponentProduct.Price * float64(slot.Quantity)
+	}
+	return total
+}
+
+// Savings returns how much the customer saves buying the bundle instead of its default components
+func (p *Product) Savings() float64 {
+	savings := p.ComponentsPrice() - p.Price
+	if savings < 0 {
+		return 0
+	}
+	return savings
+}
+
+// SelectBundleComponents resolves the component of each slot of the bundle, selections maps a slot ID to
+// the chosen component product ID, slots with a single option don't need a selection
+func (p *Product) SelectBundleComponents(selections map[uint64]uint64) ([]OrderProductComponent, error) {
+	if !p.IsBundle() {
+		if len(selections) > 0 {
+			return nil, fmt.Errorf("product %d is not a bundle", p.ID)
+		}
+		return nil, nil
+	}
+
+	slots := make(map[uint64]bool, len(p.BundleSlots))
+	components := make([]OrderProductComponent, 0, len(p.BundleSlots))
+	for _, slot := range p.BundleSlots {
+		slots[slot.ID] = true
+
+		option, err := slot.selectOption(selections)
+		if err != nil {
+			return nil, err
+		}
+
+		components = append(components, OrderProductComponent{
+			ProductBundleSlotID: slot.ID,
+			SlotName:            slot.Name,
+			ProductID:           option.ComponentProductID,
+			Name:                option.ComponentProduct.Name,
+			Quantity:            slot.Quantity,
+			PriceDelta:          option.PriceDelta,
+		})
+	}
+
+	for slotID := range selections {
+		if !slots[slotID] {
+			return nil, fmt.Errorf("bundle slot %d does not belong to product %d", slotID, p.ID)
+		}
+	}
+
+	return components, nil
+}
+
+func (s *ProductBundleSlot) selectOption(selections map[uint64]uint64) (*ProductBundleOption, error) {
+	productID, ok := selections[s.ID]
+	if !ok {
+		if len(s.Options) == 1 {
+			return &s.Options[0], nil
+		}
+		return nil, fmt.Errorf("bundle slot %q requires a choice", s.Name)
+	}
+
+	for i := range s.Options {
+		if s.Options[i].ComponentProductID == productID {
+			return &s.Options[i], nil
+		}
+	}
+	return nil, fmt.Errorf("product %d is not an option of bundle slot %q", productID, s.Name)
+}
@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 )
 
 type Product struct {
@@ -10,14 +12,29 @@ type Product struct {
 	Description string
 	Price       float64
 	CategoryID  uint64
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// KitchenStation prepares the product, nil inherits the station of the category
+	KitchenStation *valueobject.KitchenStation
+	// ModifierGroups are the customizations available for the product
+	ModifierGroups []ProductModifierGroup
+	// BundleSlots are the components of a bundle product, empty for regular products
+	BundleSlots []ProductBundleSlot
+	// StockQuantity is only tracked on the COUNTED stock mode, Available is switched off by the staff
+	StockMode     valueobject.StockMode
+	StockQuantity int64
+	Available     bool
+	// AvailabilityWindows restrict the product to some times of the week, empty means always available
+	AvailabilityWindows []ProductAvailabilityWindow
+	// Prices are the current and scheduled price records, Price is resolved from them when the product is read
+	Prices    []ProductPrice
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
-func (p *Product) Update(name string, description string, price float64, categoryID uint64) {
+func (p *Product) Update(name string, description string, price float64, categoryID uint64, kitchenStation *valueobject.KitchenStation) {
 	p.Name = name
 	p.Description = description
 	p.Price = price
 	p.CategoryID = categoryID
+	p.KitchenStation = kitchenStation
 	p.UpdatedAt = time.Now()
 }
@@ -0,0 +1,98 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ProductModifierGroup groups the modifiers a customer can choose for a product, ex: "Extras"
+type ProductModifierGroup struct {
+	ID         uint64
+	ProductID  uint64
+	Name       string
+	MinChoices uint32
+	MaxChoices uint32
+	Modifiers  []ProductModifier
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ProductModifier is an option of a modifier group, ex: "No tomato" or "Extra cheese"
+type ProductModifier struct {
+	ID                     uint64
+	ProductModifierGroupID uint64
+	Name                   string
+	PriceDelta             float64
+	CreatedAt              time.Time
+	UpdatedAt              time.Time
+}
+
+// Validate checks the choice limits of the group against its modifiers
+func (g *ProductModifierGroup) Validate() error {
+	if len(g.Modifiers) == 0 {
+		return fmt.Errorf("modifier group %q has no modifiers", g.Name)
+	}
+	if g.MaxChoices == 0 || g.MinChoices > g.MaxChoices {
+		return fmt.Errorf("modifier group %q must have 0 <= min_choices <= max_choices and max_choices > 0", g.Name)
+	}
+	if int(g.MinChoices) > len(g.Modifiers) {
+		return fmt.Errorf("modifier group %q requires more choices than it has modifiers", g.Name)
+	}
+	return nil
+}
+
+// SelectModifiers validates the chosen modifiers against the modifier groups of the product
+// and returns them as order product modifiers, keeping the name and price of the moment of the order
+func (p *Product) SelectModifiers(modifierIDs []uint64) ([]OrderProductModifier, error) {
+	type choice struct {
+		group    *ProductModifierGroup
+		modifier ProductModifier
+	}
+
+	available := make(map[uint64]choice)
+	for i := range p.ModifierGroups {
+		group := &p.ModifierGroups[i]
+		for _, modifier := range group.Modifiers {
+			available[modifier.ID] = choice{group, modifier}
+		}
+	}
+
+	selected := make([]OrderProductModifier, 0, len(modifierIDs))
+	chosen := make(map[uint64]bool, len(modifierIDs))
+	perGroup := make(map[uint64]uint32)
+	for _, id := range modifierIDs {
+		c, ok := available[id]
+		if !ok {
+			return nil, fmt.Errorf("modifier %d is not available for product %d", id, p.ID)
+		}
+		if chosen[id] {
+			return nil, fmt.Errorf("modifier %d was chosen more than once", id)
+		}
+		chosen[id] = true
+		perGroup[c.group.ID]++
+
+		selected = append(selected, OrderProductModifier{
+			ProductModifierID: c.modifier.ID,
+			Name:              c.modifier.Name,
+			GroupName:         c.group.Name,
+			PriceDelta:        c.modifier.PriceDelta,
+		})
+	}
+
+	for _, group := range p.ModifierGroups {
+		count := perGroup[group.ID]
+		if count < group.MinChoices || count > group.MaxChoices {
+			return nil, errors.New(modifierChoicesError(group))
+		}
+	}
+
+	return selected, nil
+}
+
+func modifierChoicesError(group ProductModifierGroup) string {
+	if group.MinChoices == group.MaxChoices {
+		return fmt.Sprintf("modifier group %q requires %d choices", group.Name, group.MinChoices)
+	}
+	return fmt.Sprintf("modifier group %q requires between %d and %d choices", group.Name, group.MinChoices, group.MaxChoices)
+}
@@ -0,0 +1,149 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// ProductPrice is the price of a product from EffectiveFrom until EffectiveTo, a nil EffectiveTo
+// keeps the price until the next change. The records of a product never overlap
+type ProductPrice struct {
+	ID            uint64
+	ProductID     uint64
+	Price         float64
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+	CreatedAt     time.Time
+}
+
+// ProductPriceChanges are the records to save and the IDs of the records to delete when a price is scheduled
+type ProductPriceChanges struct {
+	Save   []*ProductPrice
+	Delete []uint64
+}
+
+// Validate checks the price and its range, past prices can't be changed
+func (p *ProductPrice) Validate(now time.Time) error {
+	if p.Price <= 0 {
+		return errors.New("price must be greater than zero")
+	}
+	if p.EffectiveFrom.Before(now) {
+		return errors.New("price effective_from must not be in the past")
+	}
+	if p.EffectiveTo != nil && !p.EffectiveTo.After(p.EffectiveFrom) {
+		return errors.New("price effective_to must be after effective_from")
+	}
+	return nil
+}
+
+// IsEffectiveAt returns true when the moment falls inside the range of the price
+func (p *ProductPrice) IsEffectiveAt(moment time.Time) bool {
+	return !p.EffectiveFrom.After(moment) && (p.EffectiveTo == nil || p.EffectiveTo.After(moment))
+}
+
+// endsAfter returns true when the price is still effective after the moment, a nil moment is the end of time
+func (p *ProductPrice) endsAfter(moment *time.Time) bool {
+	if p.EffectiveTo == nil {
+		return true
+	}
+	return moment != nil && p.EffectiveTo.After(*moment)
+}
+
+// ScheduleProductPrice makes room for the price on the current records of the product: the records overlapping
+// its range are trimmed, a record covering the whole range is split so it resumes at the end of the new price,
+// and the future records inside the range are deleted
+func ScheduleProductPrice(current []*ProductPrice, price *ProductPrice) ProductPriceChanges {
+	var changes ProductPriceChanges
+	from, to := price.EffectiveFrom, price.EffectiveTo
+
+	for _, record := range current {
+		if (record.EffectiveTo != nil && !record.EffectiveTo.After(from)) || (to != nil && !record.EffectiveFrom.Before(*to)) {
+			continue
+		}
+
+		if record.EffectiveFrom.Before(from) {
+			if to != nil && record.endsAfter(to) {
+				changes.Save = append(changes.Save, &ProductPrice{
+					ProductID:     record.ProductID,
+					Price:         record.Price,
+					EffectiveFrom: *to,
+					EffectiveTo:   record.EffectiveTo,
+				})
+			}
+			end := from
+			record.EffectiveTo = &end
+			changes.Save = append(changes.Save, record)
+			continue
+		}
+
+		if to != nil && record.endsAfter(to) {
+			record.EffectiveFrom = *to
+			changes.Save = append(changes.Save, record)
+			continue
+		}
+		changes.Delete = append(changes.Delete, record.ID)
+	}
+
+	changes.Save = append(changes.Save, price)
+	return changes
+}
+
+// PriceAt returns the price record effective at the moment, nil when the loaded records don't cover it
+func (p *Product) PriceAt(moment time.Time) *ProductPrice {
+	for i := range p.Prices {
+		if p.Prices[i].IsEffectiveAt(moment) {
+			return &p.Prices[i]
+		}
+	}
+	return nil
+}
+
+// ResolvePrice sets the price of the product to the record effective at the moment, keeping the stored
+// price when there is none
+func (p *Product) ResolvePrice(moment time.Time) {
+	if price := p.PriceAt(moment); price != nil {
+		p.Price = price.Price
+	}
+}
+
+// PricedAt returns a copy of the product and its bundle components priced at the moment, the product is left
+// untouched so it can be shared, ex: by the cached menu
+func (p *Product) PricedAt(moment time.Time) *Product {
+	priced := *p
+	priced.ResolvePrice(moment)
+	if len(p.BundleSlots) == 0 {
+		return &priced
+	}
+
+	priced.BundleSlots = make([]ProductBundleSlot, len(p.BundleSlots))
+	for i, slot := range p.BundleSlots {
+		slot.Options = append([]ProductBundleOption(nil), slot.Options...)
+		for j := range slot.Options {
+			slot.Options[j].ComponentProduct.ResolvePrice(moment)
+		}
+		priced.BundleSlots[i] = slot
+	}
+	return &priced
+}
+
+// NewCurrentPrice returns the record that changes the price of the product at the moment,
+// it lasts until the next scheduled change so the prices already scheduled are kept
+func (p *Product) NewCurrentPrice(price float64, moment time.Time) *ProductPrice {
+	current := &ProductPrice{ProductID: p.ID, Price: price, EffectiveFrom: moment}
+	if next := p.nextPriceChange(moment); next != nil {
+		end := next.EffectiveFrom
+		current.EffectiveTo = &end
+	}
+	return current
+}
+
+// nextPriceChange returns the first price scheduled after the moment, nil when there is none
+func (p *Product) nextPriceChange(moment time.Time) *ProductPrice {
+	var next *ProductPrice
+	for i := range p.Prices {
+		if p.Prices[i].EffectiveFrom.After(moment) && (next == nil || p.Prices[i].EffectiveFrom.Before(next.EffectiveFrom)) {
+			next = &p.Prices[i]
+		}
+	}
+	return next
+}
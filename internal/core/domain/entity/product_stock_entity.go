@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// StockChangedEvent is the type of the event published when the stock of a product changes
+const StockChangedEvent = "product.stock_changed"
+
+const (
+	StockReasonOrderReceived  = "ORDER_RECEIVED"
+	StockReasonOrderCancelled = "ORDER_CANCELLED"
+	StockReasonManual         = "MANUAL"
+)
+
+// StockChanged is published when the stock or the availability of a product changes
+type StockChanged struct {
+	ProductID     uint64                `json:"product_id"`
+	Change        int64                 `json:"change"`
+	StockMode     valueobject.StockMode `json:"stock_mode"`
+	StockQuantity int64                 `json:"stock_quantity"`
+	Available     bool                  `json:"available"`
+	OrderID       uint64                `json:"order_id,omitempty"`
+	Reason        string                `json:"reason"`
+	OccurredAt    time.Time             `json:"occurred_at"`
+}
+
+// NewStockChanged creates the event with the current stock of the product
+func NewStockChanged(product *Product, change int64, orderID uint64, reason string) StockChanged {
+	return StockChanged{
+		ProductID:     product.ID,
+		Change:        change,
+		StockMode:     product.StockMode,
+		StockQuantity: product.StockQuantity,
+		Available:     product.Available,
+		OrderID:       orderID,
+		Reason:        reason,
+		OccurredAt:    time.Now(),
+	}
+}
+
+// IsAvailable returns true when the product can be ordered on the quantity
+func (p *Product) IsAvailable(quantity uint32) bool {
+	if !p.Available {
+		return false
+	}
+	return p.StockMode != valueobject.StockCounted || p.StockQuantity >= int64(quantity)
+}
+
+// IsAvailableWith returns true when the product and the selected bundle components can be ordered on the quantity
+func (p *Product) IsAvailableWith(quantity uint32, components []OrderProductComponent) bool {
+	if !p.IsAvailable(quantity) {
+		return false
+	}
+	for _, component := range components {
+		product := p.bundleComponent(component.ProductBundleSlotID, component.ProductID)
+		if product != nil && !product.IsAvailable(component.Quantity*quantity) {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Product) bundleComponent(slotID, productID uint64) *Product {
+	for i := range p.BundleSlots {
+		if p.BundleSlots[i].ID != slotID {
+			continue
+		}
+		for j := range p.BundleSlots[i].Options {
+			if p.BundleSlots[i].Options[j].ComponentProductID == productID {
+				return &p.BundleSlots[i].Options[j].ComponentProduct
+			}
+		}
+	}
+	return nil
+}
+
+// UpdateStock changes the stock settings of the product, nil values keep the current ones
+func (p *Product) UpdateStock(mode valueobject.StockMode, quantity *int64, available *bool) error {
+	if mode != "" {
+		p.StockMode = mode
+	}
+	if quantity != nil {
+		if *quantity < 0 {
+			return errors.New("stock quantity can't be negative")
+		}
+		p.StockQuantity = *quantity
+	}
+	if available != nil {
+		p.Available = *available
+	}
+	p.UpdatedAt = time.Now()
+	return nil
+}
+
+// StockQuantities returns the quantity of each product used by the order, bundle components included
+func (o *Order) StockQuantities() map[uint64]int64 {
+	quantities := make(map[uint64]int64)
+	for _, orderProduct := range o.OrderProducts {
+		quantities[orderProduct.ProductID] += int64(orderProduct.Quantity)
+		for _, component := range orderProduct.Components {
+			quantities[component.ProductID] += int64(component.Quantity * orderProduct.Quantity)
+		}
+	}
+	return quantities
+}
@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+const happyHourLayout = "15:04"
+
+// Promotion is a discount applied to OPEN orders, coupons are applied by code and
+// promotions without a code are applied automatically
+type Promotion struct {
+	ID           uint64
+	Name         string
+	Code         *string
+	DiscountType valueobject.DiscountType
+	Value        float64
+	// CategoryID and ProductID restrict the discount to the matching line items, nil for the whole order
+	CategoryID *uint64
+	ProductID  *uint64
+	StartsAt   *time.Time
+	EndsAt     *time.Time
+	// HappyHourStart and HappyHourEnd restrict the promotion to a time of the day, ex: 17:00 to 19:00,
+	// on the Timezone of the promotion
+	HappyHourStart     string
+	HappyHourEnd       string
+	Timezone           string
+	MaxUsesPerCustomer uint32
+	Stackable          bool
+	Active             bool
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// NormalizeCouponCode returns the code as it is stored, coupon codes are case insensitive
+func NormalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func (p *Promotion) Update(changes *Promotion) {
+	p.Name = changes.Name
+	p.Code = changes.Code
+	p.DiscountType = changes.DiscountType
+	p.Value = changes.Value
+	p.CategoryID = changes.CategoryID
+	p.ProductID = changes.ProductID
+	p.StartsAt = changes.StartsAt
+	p.EndsAt = changes.EndsAt
+	p.HappyHourStart = changes.HappyHourStart
+	p.HappyHourEnd = changes.HappyHourEnd
+	p.Timezone = changes.Timezone
+	p.MaxUsesPerCustomer = changes.MaxUsesPerCustomer
+	p.Stackable = changes.Stackable
+	p.Active = changes.Active
+	p.UpdatedAt = time.Now()
+}
+
+// Validate checks the value, scope and time windows of the promotion
+func (p *Promotion) Validate() error {
+	if p.Value <= 0 {
+		return errors.New("promotion value must be greater than zero")
+	}
+	if p.DiscountType == valueobject.DiscountPercentage && p.Value > 100 {
+		return errors.New("percentage promotion value must be at most 100")
+	}
+	if p.CategoryID != nil && p.ProductID != nil {
+		return errors.New("promotion can be restricted to a category or to a product, not both")
+	}
+	if p.StartsAt != nil && p.EndsAt != nil && !p.StartsAt.Before(*p.EndsAt) {
+		return errors.New("promotion must start before it ends")
+	}
+	if (p.HappyHourStart == "") != (p.HappyHourEnd == "") {
+		return errors.New("promotion happy hour requires a start and an end")
+	}
+	if p.HappyHourStart != "" {
+		if _, err := time.Parse(happyHourLayout, p.HappyHourStart); err != nil {
+			return errors.New("promotion happy hour start must be in the HH:MM format")
+		}
+		if _, err := time.Parse(happyHourLayout, p.HappyHourEnd); err != nil {
+			return errors.New("promotion happy hour end must be in the HH:MM format")
+		}
+		if _, err := loadLocation(p.Timezone); err != nil || p.Timezone == "" {
+			return errors.New("promotion happy hour timezone must be a valid IANA timezone, ex: America/Sao_Paulo")
+		}
+	}
+	return nil
+}
+
+// IsCoupon returns true when the promotion is applied by code
+func (p *Promotion) IsCoupon() bool {
+	return p.Code != nil && *p.Code != ""
+}
+
+// IsAvailableAt returns true when the promotion is active and now is inside its time windows,
+// a happy hour that ends before it starts crosses midnight. The promotions created before the
+// timezones read the happy hour on UTC
+func (p *Promotion) IsAvailableAt(now time.Time) bool {
+	if !p.Active {
+		return false
+	}
+	if p.StartsAt != nil && now.Before(*p.StartsAt) {
+		return false
+	}
+	if p.EndsAt != nil && !now.Before(*p.EndsAt) {
+		return false
+	}
+	if p.HappyHourStart == "" {
+		return true
+	}
+
+	location, err := loadLocation(p.Timezone)
+	if err != nil {
+		return false
+	}
+	start, errStart := time.Parse(happyHourLayout, p.HappyHourStart)
+	end, errEnd := time.Parse(happyHourLayout, p.HappyHourEnd)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+	local := now.In(location)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
+
+// DiscountFor returns the discount of the promotion for the line items of the order it applies to
+func (p *Promotion) DiscountFor(order *Order) float64 {
+	var eligible float64
+	for i := range order.OrderProducts {
+		orderProduct := &order.OrderProducts[i]
+		if p.ProductID != nil && orderProduct.ProductID != *p.ProductID {
+			continue
+		}
+		if p.CategoryID != nil && orderProduct.Product.CategoryID != *p.CategoryID {
+			continue
+		}
+		eligible += orderProduct.Total()
+	}
+	if eligible <= 0 {
+		return 0
+	}
+
+	switch p.DiscountType {
+	case valueobject.DiscountPercentage:
+		return roundCents(eligible * p.Value / 100)
+	case valueobject.DiscountFixed:
+		return roundCents(math.Min(p.Value, eligible))
+	default:
+		return 0
+	}
+}
+
+func roundCents(value float64) float64 {
+	return math.Round(value*100) / 100
+}
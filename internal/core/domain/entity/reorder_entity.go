@@ -0,0 +1,24 @@
+package entity
+
+// Reorder is the new OPEN order created by repeating the items of a previous order at the current prices
+type Reorder struct {
+	SourceOrderID uint64
+	Order         *Order
+	// Warnings are the items of the previous order that could not be repeated
+	Warnings []ReorderWarning
+}
+
+type ReorderWarning struct {
+	ProductID   uint64
+	ProductName string
+	Reason      string
+}
+
+// Skip records an item of the previous order that was left out of the new order
+func (r *Reorder) Skip(item OrderProduct, reason string) {
+	r.Warnings = append(r.Warnings, ReorderWarning{
+		ProductID:   item.ProductID,
+		ProductName: item.Product.Name,
+		Reason:      reason,
+	})
+}
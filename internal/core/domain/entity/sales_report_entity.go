@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"sort"
+	"time"
+)
+
+// SalesReport summarizes the products sold in a period, bundles are expanded into their components
+type SalesReport struct {
+	From  time.Time
+	To    time.Time
+	Lines []SalesReportLine
+}
+
+// SalesReportLine is the quantity sold of a product, alone and as a component of bundles
+type SalesReportLine struct {
+	ProductID      uint64
+	Name           string
+	Quantity       uint32
+	BundleQuantity uint32
+	Revenue        float64
+	// Prices break the quantity sold alone down by the price record used on the orders
+	Prices []SalesReportPrice
+}
+
+// SalesReportPrice is the quantity of a product sold with a price record
+type SalesReportPrice struct {
+	ProductPriceID *uint64
+	Price          float64
+	Quantity       uint32
+}
+
+// addPrice adds the quantity to the breakdown of the price record
+func (l *SalesReportLine) addPrice(productPriceID *uint64, price float64, quantity uint32) {
+	for i := range l.Prices {
+		if samePriceRecord(l.Prices[i].ProductPriceID, productPriceID) && l.Prices[i].Price == price {
+			l.Prices[i].Quantity += quantity
+			return
+		}
+	}
+	l.Prices = append(l.Prices, SalesReportPrice{ProductPriceID: productPriceID, Price: price, Quantity: quantity})
+}
+
+func samePriceRecord(a, b *uint64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// TotalQuantity returns the quantity sold alone plus the quantity sold inside bundles
+func (l *SalesReportLine) TotalQuantity() uint32 {
+	return l.Quantity + l.BundleQuantity
+}
+
+// NewSalesReport aggregates the line items by product, the revenue of a bundle is kept on the bundle line
+func NewSalesReport(from, to time.Time, orderProducts []*OrderProduct) *SalesReport {
+	lines := make(map[uint64]*SalesReportLine)
+	line := func(productID uint64, name string) *SalesReportLine {
+		l, ok := lines[productID]
+		if !ok {
+			l = &SalesReportLine{ProductID: productID, Name: name}
+			lines[productID] = l
+		}
+		return l
+	}
+
+	for _, orderProduct := range orderProducts {
+		l := line(orderProduct.ProductID, orderProduct.Product.Name)
+		l.Quantity += orderProduct.Quantity
+		l.Revenue += orderProduct.Total()
+		l.addPrice(orderProduct.ProductPriceID, orderProduct.Price, orderProduct.Quantity)
+
+		for _, component := range orderProduct.Components {
+			line(component.ProductID, component.Name).BundleQuantity += component.Quantity * orderProduct.Quantity
+		}
+	}
+
+	report := &SalesReport{From: from, To: to, Lines: make([]SalesReportLine, 0, len(lines))}
+	for _, l := range lines {
+		report.Lines = append(report.Lines, *l)
+	}
+	sort.Slice(report.Lines, func(i, j int) bool {
+		return report.Lines[i].ProductID < report.Lines[j].ProductID
+	})
+
+	return report
+}
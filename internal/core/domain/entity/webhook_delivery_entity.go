@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// maxLastErrorLength is the size of the last error column, longer errors are truncated
+const maxLastErrorLength = 500
+
+// WebhookDelivery is the delivery of an event to a subscriber, the deliveries are kept as the log of what was sent
+type WebhookDelivery struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventType      valueobject.OrderEventType
+	// Payload is the JSON body sent to the subscriber, it is kept so the event can be delivered again
+	Payload       string
+	Status        valueobject.WebhookDeliveryStatus
+	Attempts      uint32
+	NextAttemptAt time.Time
+	// ResponseStatus and LastError are the outcome of the last attempt, the status is zero when there was no response
+	ResponseStatus int
+	LastError      string
+	DeliveredAt    *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Subscription   WebhookSubscription
+}
+
+// NewWebhookDelivery creates a delivery to be sent on the next dispatch
+func NewWebhookDelivery(subscriptionID uint64, eventType valueobject.OrderEventType, payload string) *WebhookDelivery {
+	return &WebhookDelivery{
+		SubscriptionID: subscriptionID,
+		EventType:      eventType,
+		Payload:        payload,
+		Status:         valueobject.DeliveryPending,
+		NextAttemptAt:  time.Now(),
+	}
+}
+
+// Redeliver creates a new delivery of the same event, the original one is kept on the log
+func (d *WebhookDelivery) Redeliver() *WebhookDelivery {
+	return NewWebhookDelivery(d.SubscriptionID, d.EventType, d.Payload)
+}
+
+// MarkDelivered records the attempt accepted by the subscriber
+func (d *WebhookDelivery) MarkDelivered(responseStatus int) {
+	now := time.Now()
+	d.Attempts++
+	d.Status = valueobject.DeliveryDelivered
+	d.ResponseStatus = responseStatus
+	d.LastError = ""
+	d.DeliveredAt = &now
+	d.UpdatedAt = now
+}
+
+// MarkAttemptFailed records a failed attempt, the next one waits twice as long as the previous one.
+// It returns true when the delivery ran out of attempts and failed
+func (d *WebhookDelivery) MarkAttemptFailed(responseStatus int, lastError string, maxAttempts uint32, backoff time.Duration) bool {
+	now := time.Now()
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.LastError = lastError
+	if len(d.LastError) > maxLastErrorLength {
+		d.LastError = d.LastError[:maxLastErrorLength]
+	}
+	d.UpdatedAt = now
+	if d.Attempts >= maxAttempts {
+		d.Status = valueobject.DeliveryFailed
+		return true
+	}
+	d.NextAttemptAt = now.Add(backoff << (d.Attempts - 1))
+	return false
+}
+
+// Cancel fails the delivery without sending it, ex: the subscription was disabled
+func (d *WebhookDelivery) Cancel(reason string) {
+	d.Status = valueobject.DeliveryFailed
+	d.LastError = reason
+	d.UpdatedAt = time.Now()
+}
@@ -0,0 +1,190 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// WebhookSubscription is a partner URL that receives the order events, the deliveries are signed with its secret
+type WebhookSubscription struct {
+	ID  uint64
+	URL string
+	// EventTypes are the events sent to the subscriber, the Statuses, Channels and FulfilmentModes
+	// restrict them to the matching orders and an empty filter matches all of them
+	EventTypes      StringList
+	Statuses        StringList
+	Channels        StringList
+	FulfilmentModes StringList
+	// Secret signs the deliveries, it is never returned by the API
+	Secret string
+	Active bool
+	// ConsecutiveFailures counts the deliveries that ran out of attempts in a row,
+	// the subscription is disabled when it reaches the limit and enabled again by an update
+	ConsecutiveFailures uint32
+	DisabledAt          *time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// Update changes the subscription, the secret is kept when it is not informed.
+// Activating the subscription clears its failures
+func (s *WebhookSubscription) Update(changes *WebhookSubscription) {
+	s.URL = changes.URL
+	s.EventTypes = changes.EventTypes
+	s.Statuses = changes.Statuses
+	s.Channels = changes.Channels
+	s.FulfilmentModes = changes.FulfilmentModes
+	if changes.Secret != "" {
+		s.Secret = changes.Secret
+	}
+	if changes.Active && !s.Active {
+		s.ConsecutiveFailures = 0
+		s.DisabledAt = nil
+	}
+	s.Active = changes.Active
+	s.UpdatedAt = time.Now()
+}
+
+// Validate checks the URL, the secret and the event types of the subscription.
+// The URL must be https and can't point to the local or private networks of the service
+func (s *WebhookSubscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return errors.New("webhook url must be an absolute https url")
+	}
+	if !isPublicHost(u.Hostname()) {
+		return errors.New("webhook url must not point to a local or private address")
+	}
+	if s.Secret == "" {
+		return errors.New("webhook secret is mandatory")
+	}
+	if len(s.EventTypes) == 0 {
+		return errors.New("webhook must subscribe to at least one event type")
+	}
+	for _, eventType := range s.EventTypes {
+		if !valueobject.IsValidOrderEventType(eventType) {
+			return fmt.Errorf("webhook event type %q is invalid", eventType)
+		}
+	}
+	return nil
+}
+
+// Subscribes returns true when the event is of a subscribed type and the order matches the filters
+func (s *WebhookSubscription) Subscribes(event OrderEvent) bool {
+	return s.Active &&
+		slices.Contains(s.EventTypes, event.Type.String()) &&
+		s.Statuses.Matches(event.Status.String()) &&
+		s.Channels.Matches(event.Channel.String()) &&
+		s.FulfilmentModes.Matches(event.FulfilmentMode.String())
+}
+
+// RecordDelivery clears the failures of the subscription, it returns true when there were failures to clear
+func (s *WebhookSubscription) RecordDelivery() bool {
+	if s.ConsecutiveFailures == 0 {
+		return false
+	}
+	s.ConsecutiveFailures = 0
+	s.UpdatedAt = time.Now()
+	return true
+}
+
+// RecordFailure counts a delivery that ran out of attempts, the subscription is disabled
+// when the failures reach the limit and true is returned
+func (s *WebhookSubscription) RecordFailure(maxFailures uint32) bool {
+	now := time.Now()
+	s.ConsecutiveFailures++
+	s.UpdatedAt = now
+	if !s.Active || maxFailures == 0 || s.ConsecutiveFailures < maxFailures {
+		return false
+	}
+	s.Active = false
+	s.DisabledAt = &now
+	return true
+}
+
+// nonPublicPrefixes are the ranges not reached through the internet that IsPublicAddress doesn't cover
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+}
+
+// IsPublicAddress returns true when the address is reachable through the internet, the loopback, private,
+// link-local (ex: the cloud metadata 169.254.169.254) and shared addresses are not
+func IsPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// isPublicHost returns false for the local host names and the addresses that are not public,
+// the other names are only resolved when the deliveries are sent
+func isPublicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") || strings.HasSuffix(host, ".local") || strings.HasSuffix(host, ".internal") {
+		return false
+	}
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return IsPublicAddress(addr)
+	}
+	return true
+}
+
+// StringList is a list of values stored as a comma separated column
+type StringList []string
+
+// NewStringList returns the list of the trimmed, non empty and unique values
+func NewStringList(values ...string) StringList {
+	var list StringList
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value != "" && !slices.Contains(list, value) {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+// Contains returns true when the list contains the value
+func (l StringList) Contains(value string) bool {
+	return slices.Contains(l, value)
+}
+
+// Matches returns true when the list is empty or contains the value
+func (l StringList) Matches(value string) bool {
+	return len(l) == 0 || slices.Contains(l, value)
+}
+
+// Value implements driver.Valuer
+func (l StringList) Value() (driver.Value, error) {
+	return strings.Join(l, ","), nil
+}
+
+// Scan implements sql.Scanner
+func (l *StringList) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*l = nil
+	case string:
+		*l = NewStringList(strings.Split(v, ",")...)
+	case []byte:
+		*l = NewStringList(strings.Split(string(v), ",")...)
+	default:
+		return fmt.Errorf("unsupported type %T for StringList", value)
+	}
+	return nil
+}
@@ -1,26 +1,65 @@
 package domain
 
+import "time"
+
 var (
 	ErrConflict           = "data conflicts with existing data"
 	ErrNotFound           = "data not found"
 	ErrUnauthorized       = "unauthorized"
+	ErrForbidden          = "forbidden"
 	ErrInvalidParam       = "invalid parameter"
 	ErrInvalidQueryParams = "invalid query parameters"
 	ErrInvalidBody        = "invalid body"
 
-	ErrInvalidToken      = "access token is invalid"
-	ErrMissingAuthHeader = "authorization header is required"
-	ErrInvalidAuthHeader = "invalid authorization header format"
-
-	ErrOrderInvalidStatusTransition = "invalid status transition"
-	ErrOrderWithoutProducts         = "order without products"
-	ErrProductIsMandatory           = "product is mandatory"
-	ErrStaffIdIsMandatory           = "staff is mandatory"
-	ErrOrderIsMandatory             = "order is mandatory"
-	ErrOrderIsNotOpen               = "order is not on status open"
-	ErrRoleInvalid                  = "invalid role"
-	ErrStatusIsMandatory            = "status is mandatory"
-
+	ErrInvalidToken        = "access token is invalid"
+	ErrMissingAuthHeader   = "authorization header is required"
+	ErrInvalidAuthHeader   = "invalid authorization header format"
+	ErrCustomerMismatch    = "access token does not belong to the customer"
+	ErrMissingAPIKey       = "api key header is required"
+	ErrInvalidAPIKey       = "api key is invalid"
+	ErrStaffRequiresAPIKey = "staff actor requires an api key"
+	ErrTooManyRequests     = "too many requests"
+
+	ErrMissingWebhookSignature = "webhook partner, timestamp and signature headers are required"
+	ErrInvalidWebhookSignature = "webhook signature is invalid"
+	ErrWebhookSignatureExpired = "webhook timestamp is outside the accepted window"
+	ErrWebhookReplayed         = "webhook request was already received"
+	ErrWebhookBodyTooLarge     = "webhook body is too large"
+
+	ErrOrderInvalidStatusTransition      = "invalid status transition"
+	ErrOrderTransitionNotAllowedForActor = "status transition not allowed for this actor"
+	ErrOrderWithoutProducts              = "order without products"
+	ErrProductIsMandatory                = "product is mandatory"
+	ErrProductNotFound                   = "product not found"
+	ErrProductUnavailable                = "product is unavailable"
+	ErrProductOutOfStock                 = "product out of stock"
+	ErrProductNotAvailableNow            = "product is not available at this time"
+	ErrBundleComponentNotFound           = "bundle component product not found"
+	ErrBundleContainsBundle              = "bundle can not contain itself or other bundles"
+	ErrStaffIdIsMandatory                = "staff is mandatory"
+	ErrOrderIsMandatory                  = "order is mandatory"
+	ErrOrderIsNotOpen                    = "order is not on status open"
+	ErrRoleInvalid                       = "invalid role"
+	ErrStatusIsMandatory                 = "status is mandatory"
+	ErrOrderVersionConflict              = "order was modified by another request"
+	ErrOrderVersionMismatch              = "order version does not match"
+	ErrCouponNotFound                    = "coupon not found"
+	ErrCouponNotAvailable                = "coupon is not available"
+	ErrCouponNotApplied                  = "coupon is not applied to the order"
+	ErrCouponUsageLimitReached           = "coupon usage limit reached for the customer"
+	ErrPromotionCodeInUse                = "promotion code already in use"
+	ErrCategoryParentNotFound            = "parent category not found"
+	ErrCategoryParentCycle               = "category can not be its own ancestor"
+	ErrCatalogEmpty                      = "catalog has no rows"
+	ErrOrderIsNotInKitchen               = "order is not being prepared by the kitchen"
+	ErrInvalidGuestToken                 = "guest token is invalid"
+	ErrOrderHasCustomer                  = "order already belongs to a customer"
+	ErrGuestOrderReorder                 = "guest orders must be attached to the customer before the reorder"
+	ErrOrderIsPaid                       = "order was already paid"
+	ErrPaymentStatusInvalid              = "invalid payment status"
+	ErrWebhookSubscriptionDisabled       = "webhook subscription is disabled"
+
+	ErrInvalidPeriod             = "from must be before to"
 	ErrPageMustBeGreaterThanZero = "page must be greater than zero"
 	ErrLimitMustBeBetween1And100 = "limit must be between 1 and 100"
 
@@ -72,6 +111,22 @@ func (e *InvalidInputError) Error() string {
 	return e.Message
 }
 
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+type PreconditionFailedError struct {
+	Message string
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return e.Message
+}
+
 type UnauthorizedError struct {
 	Message string
 }
@@ -80,6 +135,24 @@ func (e *UnauthorizedError) Error() string {
 	return e.Message
 }
 
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// TooManyRequestsError is returned when a client exceeds its rate limit, it can retry after RetryAfter
+type TooManyRequestsError struct {
+	Message    string
+	RetryAfter time.Duration
+}
+
+func (e *TooManyRequestsError) Error() string {
+	return e.Message
+}
+
 func NewValidationError(err error) *ValidationError {
 	return &ValidationError{
 		Message: ErrValidationError,
@@ -111,3 +184,28 @@ func NewUnauthorizedError(message string) *UnauthorizedError {
 		Message: message,
 	}
 }
+
+func NewForbiddenError(message string) *ForbiddenError {
+	return &ForbiddenError{
+		Message: message,
+	}
+}
+
+func NewConflictError(message string) *ConflictError {
+	return &ConflictError{
+		Message: message,
+	}
+}
+
+func NewPreconditionFailedError(message string) *PreconditionFailedError {
+	return &PreconditionFailedError{
+		Message: message,
+	}
+}
+
+func NewTooManyRequestsError(message string, retryAfter time.Duration) *TooManyRequestsError {
+	return &TooManyRequestsError{
+		Message:    message,
+		RetryAfter: retryAfter,
+	}
+}
@@ -0,0 +1,49 @@
+package valueobject
+
+import "strings"
+
+// ActorType identifies who triggered a change on an order
+type ActorType string
+
+const (
+	ActorCustomer ActorType = "CUSTOMER"
+	ActorStaff    ActorType = "STAFF"
+	ActorSystem   ActorType = "SYSTEM"
+	ActorService  ActorType = "SERVICE"
+	ActorPartner  ActorType = "PARTNER"
+)
+
+// String returns the string representation of the ActorType
+func (a ActorType) String() string {
+	return string(a)
+}
+
+// ToActorType converts a string to an ActorType
+func ToActorType(actorType string) (ActorType, bool) {
+	switch strings.ToUpper(actorType) {
+	case "CUSTOMER":
+		return ActorCustomer, true
+	case "STAFF":
+		return ActorStaff, true
+	case "SYSTEM":
+		return ActorSystem, true
+	case "SERVICE":
+		return ActorService, true
+	case "PARTNER":
+		return ActorPartner, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidActorType returns true if the actor type is known
+func IsValidActorType(actorType string) bool {
+	_, ok := ToActorType(actorType)
+	return ok
+}
+
+// IsAPIActorType returns true if the actor type can be informed on the API, SYSTEM, SERVICE and PARTNER are only set internally
+func IsAPIActorType(actorType string) bool {
+	actor, ok := ToActorType(actorType)
+	return ok && (actor == ActorCustomer || actor == ActorStaff)
+}
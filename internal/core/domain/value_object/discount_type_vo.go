@@ -0,0 +1,34 @@
+package valueobject
+
+import "strings"
+
+// DiscountType defines how the value of a promotion is applied
+type DiscountType string
+
+const (
+	DiscountPercentage DiscountType = "PERCENTAGE"
+	DiscountFixed      DiscountType = "FIXED"
+)
+
+// String returns the string representation of the DiscountType
+func (d DiscountType) String() string {
+	return string(d)
+}
+
+// ToDiscountType converts a string to a DiscountType
+func ToDiscountType(discountType string) (DiscountType, bool) {
+	switch strings.ToUpper(discountType) {
+	case "PERCENTAGE":
+		return DiscountPercentage, true
+	case "FIXED":
+		return DiscountFixed, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidDiscountType returns true if the discount type is known
+func IsValidDiscountType(discountType string) bool {
+	_, ok := ToDiscountType(discountType)
+	return ok
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import "strings"
+
+// FulfilmentMode is how the order is handed to the customer
+type FulfilmentMode string
+
+const (
+	FulfilmentDineIn   FulfilmentMode = "DINE_IN"
+	FulfilmentTakeaway FulfilmentMode = "TAKEAWAY"
+	FulfilmentDelivery FulfilmentMode = "DELIVERY"
+)
+
+// DefaultFulfilmentMode is used when the mode is not informed, the orders were picked up at the counter before
+const DefaultFulfilmentMode = FulfilmentTakeaway
+
+// String returns the string representation of the FulfilmentMode
+func (m FulfilmentMode) String() string {
+	return string(m)
+}
+
+// ToFulfilmentMode converts a string to a FulfilmentMode
+func ToFulfilmentMode(mode string) (FulfilmentMode, bool) {
+	switch strings.ToUpper(mode) {
+	case "DINE_IN":
+		return FulfilmentDineIn, true
+	case "TAKEAWAY":
+		return FulfilmentTakeaway, true
+	case "DELIVERY":
+		return FulfilmentDelivery, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidFulfilmentMode returns true if the fulfilment mode is known
+func IsValidFulfilmentMode(mode string) bool {
+	_, ok := ToFulfilmentMode(mode)
+	return ok
+}
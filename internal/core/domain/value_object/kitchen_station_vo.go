@@ -0,0 +1,43 @@
+package valueobject
+
+import "strings"
+
+// KitchenStation is the area of the kitchen where an item is prepared
+type KitchenStation string
+
+const (
+	StationGrill    KitchenStation = "GRILL"
+	StationFryer    KitchenStation = "FRYER"
+	StationDrinks   KitchenStation = "DRINKS"
+	StationDesserts KitchenStation = "DESSERTS"
+)
+
+// DefaultKitchenStation prepares the items of the products and categories without a station
+const DefaultKitchenStation = StationGrill
+
+// String returns the string representation of the KitchenStation
+func (s KitchenStation) String() string {
+	return string(s)
+}
+
+// ToKitchenStation converts a string to a KitchenStation
+func ToKitchenStation(station string) (KitchenStation, bool) {
+	switch strings.ToUpper(station) {
+	case "GRILL":
+		return StationGrill, true
+	case "FRYER":
+		return StationFryer, true
+	case "DRINKS":
+		return StationDrinks, true
+	case "DESSERTS":
+		return StationDesserts, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidKitchenStation returns true if the kitchen station is known
+func IsValidKitchenStation(station string) bool {
+	_, ok := ToKitchenStation(station)
+	return ok
+}
@@ -0,0 +1,37 @@
+package valueobject
+
+import "strings"
+
+// KitchenTicketStatus is the preparation status of a kitchen ticket
+type KitchenTicketStatus string
+
+const (
+	TicketQueued     KitchenTicketStatus = "QUEUED"
+	TicketInProgress KitchenTicketStatus = "IN_PROGRESS"
+	TicketDone       KitchenTicketStatus = "DONE"
+)
+
+// String returns the string representation of the KitchenTicketStatus
+func (s KitchenTicketStatus) String() string {
+	return string(s)
+}
+
+// ToKitchenTicketStatus converts a string to a KitchenTicketStatus
+func ToKitchenTicketStatus(status string) (KitchenTicketStatus, bool) {
+	switch strings.ToUpper(status) {
+	case "QUEUED":
+		return TicketQueued, true
+	case "IN_PROGRESS":
+		return TicketInProgress, true
+	case "DONE":
+		return TicketDone, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidKitchenTicketStatus returns true if the kitchen ticket status is known
+func IsValidKitchenTicketStatus(status string) bool {
+	_, ok := ToKitchenTicketStatus(status)
+	return ok
+}
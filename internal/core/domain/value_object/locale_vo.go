@@ -0,0 +1,37 @@
+package valueobject
+
+import "strings"
+
+// Locale is the language of the messages sent to a customer
+type Locale string
+
+const (
+	LocalePtBR Locale = "pt-BR"
+	LocaleEn   Locale = "en"
+)
+
+// DefaultLocale is used when the customer doesn't choose one
+const DefaultLocale = LocalePtBR
+
+// String returns the string representation of the Locale
+func (l Locale) String() string {
+	return string(l)
+}
+
+// ToLocale converts a string to a Locale, ex: pt-br, pt_BR and EN
+func ToLocale(locale string) (Locale, bool) {
+	switch strings.ToLower(strings.ReplaceAll(locale, "_", "-")) {
+	case "pt-br":
+		return LocalePtBR, true
+	case "en":
+		return LocaleEn, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidLocale returns true if the locale is known
+func IsValidLocale(locale string) bool {
+	_, ok := ToLocale(locale)
+	return ok
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import "strings"
+
+// NotificationChannel is how a customer is notified about the orders
+type NotificationChannel string
+
+const (
+	NotificationSMS   NotificationChannel = "SMS"
+	NotificationEmail NotificationChannel = "EMAIL"
+	NotificationPush  NotificationChannel = "PUSH"
+)
+
+// NotificationChannels are all the known notification channels
+var NotificationChannels = []NotificationChannel{NotificationSMS, NotificationEmail, NotificationPush}
+
+// String returns the string representation of the NotificationChannel
+func (c NotificationChannel) String() string {
+	return string(c)
+}
+
+// ToNotificationChannel converts a string to a NotificationChannel
+func ToNotificationChannel(channel string) (NotificationChannel, bool) {
+	switch strings.ToUpper(channel) {
+	case "SMS":
+		return NotificationSMS, true
+	case "EMAIL":
+		return NotificationEmail, true
+	case "PUSH":
+		return NotificationPush, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidNotificationChannel returns true if the notification channel is known
+func IsValidNotificationChannel(channel string) bool {
+	_, ok := ToNotificationChannel(channel)
+	return ok
+}
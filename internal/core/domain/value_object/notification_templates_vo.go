@@ -0,0 +1,118 @@
+package valueobject
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/template"
+)
+
+// defaultNotificationTemplates are the templates used when no custom file is configured
+//
+//go:embed notification_templates.json
+var defaultNotificationTemplates []byte
+
+// NotificationTemplate is the message sent to the customers when the order reaches a status,
+// the subject and the body are text/template executed with the order event
+type NotificationTemplate struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+
+	subject *template.Template
+	body    *template.Template
+}
+
+// Render executes the template with the data
+func (t *NotificationTemplate) Render(data any) (subject, body string, err error) {
+	var buf bytes.Buffer
+	if err := t.subject.Execute(&buf, data); err != nil {
+		return "", "", fmt.Errorf("error rendering notification subject: %w", err)
+	}
+	subject = buf.String()
+
+	buf.Reset()
+	if err := t.body.Execute(&buf, data); err != nil {
+		return "", "", fmt.Errorf("error rendering notification body: %w", err)
+	}
+	return subject, buf.String(), nil
+}
+
+// NotificationTemplates are the templates of each locale by order status,
+// the customers are only notified of the statuses with a template
+type NotificationTemplates map[Locale]map[OrderStatus]*NotificationTemplate
+
+// NewNotificationTemplates parses and validates a JSON notification templates definition
+func NewNotificationTemplates(data []byte) (NotificationTemplates, error) {
+	var t NotificationTemplates
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("invalid notification templates: %w", err)
+	}
+
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// DefaultNotificationTemplates returns the embedded notification templates
+func DefaultNotificationTemplates() NotificationTemplates {
+	t, err := NewNotificationTemplates(defaultNotificationTemplates)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// Validate checks the locales and statuses and parses the templates
+func (t NotificationTemplates) Validate() error {
+	if _, ok := t[DefaultLocale]; !ok {
+		return fmt.Errorf("notification templates of the default locale %q are missing", DefaultLocale)
+	}
+
+	for locale, statuses := range t {
+		if l, ok := ToLocale(string(locale)); !ok || l != locale {
+			return fmt.Errorf("unknown notification locale %q", string(locale))
+		}
+		for status, tmpl := range statuses {
+			if s, ok := ToOrderStatus(string(status)); !ok || s != status {
+				return fmt.Errorf("unknown notification status %q on locale %q", string(status), string(locale))
+			}
+			if tmpl == nil || tmpl.Body == "" {
+				return fmt.Errorf("notification of %q on locale %q has no body", string(status), string(locale))
+			}
+
+			var err error
+			name := string(locale) + "." + string(status)
+			if tmpl.subject, err = template.New(name + ".subject").Parse(tmpl.Subject); err != nil {
+				return errors.Join(fmt.Errorf("invalid notification subject of %q on locale %q", string(status), string(locale)), err)
+			}
+			if tmpl.body, err = template.New(name + ".body").Parse(tmpl.Body); err != nil {
+				return errors.Join(fmt.Errorf("invalid notification body of %q on locale %q", string(status), string(locale)), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Notifies returns true when the customers are notified of the status on any locale
+func (t NotificationTemplates) Notifies(status OrderStatus) bool {
+	for _, statuses := range t {
+		if _, ok := statuses[status]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Template returns the template of the status on the locale, falling back to the default locale
+func (t NotificationTemplates) Template(locale Locale, status OrderStatus) (*NotificationTemplate, bool) {
+	if tmpl, ok := t[locale][status]; ok {
+		return tmpl, true
+	}
+	tmpl, ok := t[DefaultLocale][status]
+	return tmpl, ok
+}
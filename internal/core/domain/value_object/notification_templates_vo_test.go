@@ -0,0 +1,104 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+func TestDefaultNotificationTemplates(t *testing.T) {
+	templates := valueobject.DefaultNotificationTemplates()
+
+	// Every locale must notify the same statuses
+	for locale, statuses := range templates {
+		assert.Len(t, statuses, len(templates[valueobject.DefaultLocale]), "locale %s", locale)
+		for status := range templates[valueobject.DefaultLocale] {
+			assert.Contains(t, statuses, status, "locale %s", locale)
+		}
+	}
+
+	assert.True(t, templates.Notifies(valueobject.READY))
+	assert.False(t, templates.Notifies(valueobject.OPEN))
+
+	ready, ok := templates.Template(valueobject.LocaleEn, valueobject.READY)
+	require.True(t, ok)
+	subject, body, err := ready.Render(struct {
+		OrderID    uint64
+		PickupCode string
+	}{OrderID: 42, PickupCode: "A42"})
+	require.NoError(t, err)
+	assert.Equal(t, "Order #42 is ready", subject)
+	assert.Equal(t, "Your order #42 is ready! Pick it up with the code A42.", body)
+}
+
+func TestNewNotificationTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"pt-BR": {"READY": {"subject": "Pedido {{.OrderID}}", "body": "Pronto"}}}`,
+		},
+		{
+			name:    "default locale is missing",
+			data:    `{"en": {"READY": {"body": "Ready"}}}`,
+			wantErr: "default locale",
+		},
+		{
+			name:    "unknown locale",
+			data:    `{"pt-BR": {"READY": {"body": "Pronto"}}, "es": {"READY": {"body": "Listo"}}}`,
+			wantErr: `unknown notification locale "es"`,
+		},
+		{
+			name:    "unknown status",
+			data:    `{"pt-BR": {"SERVED": {"body": "Servido"}}}`,
+			wantErr: `unknown notification status "SERVED"`,
+		},
+		{
+			name:    "missing body",
+			data:    `{"pt-BR": {"READY": {"subject": "Pronto"}}}`,
+			wantErr: "has no body",
+		},
+		{
+			name:    "invalid template",
+			data:    `{"pt-BR": {"READY": {"body": "Pedido {{.OrderID"}}}`,
+			wantErr: "invalid notification body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := valueobject.NewNotificationTemplates([]byte(tt.data))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestNotificationTemplates_Template(t *testing.T) {
+	templates, err := valueobject.NewNotificationTemplates([]byte(`{
+		"pt-BR": {"READY": {"body": "Pronto"}, "CANCELLED": {"body": "Cancelado"}},
+		"en": {"READY": {"body": "Ready"}}
+	}`))
+	require.NoError(t, err)
+
+	ready, ok := templates.Template(valueobject.LocaleEn, valueobject.READY)
+	require.True(t, ok)
+	assert.Equal(t, "Ready", ready.Body)
+
+	// The default locale is used when the locale has no template of the status
+	cancelled, ok := templates.Template(valueobject.LocaleEn, valueobject.CANCELLED)
+	require.True(t, ok)
+	assert.Equal(t, "Cancelado", cancelled.Body)
+
+	_, ok = templates.Template(valueobject.LocaleEn, valueobject.PREPARING)
+	assert.False(t, ok)
+}
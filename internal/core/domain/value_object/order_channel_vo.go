@@ -0,0 +1,40 @@
+package valueobject
+
+import "strings"
+
+// OrderChannel is where the order was placed
+type OrderChannel string
+
+const (
+	ChannelTotem   OrderChannel = "TOTEM"
+	ChannelCounter OrderChannel = "COUNTER"
+	ChannelApp     OrderChannel = "APP"
+)
+
+// DefaultOrderChannel is used when the channel is not informed, the totem was the only channel before
+const DefaultOrderChannel = ChannelTotem
+
+// String returns the string representation of the OrderChannel
+func (c OrderChannel) String() string {
+	return string(c)
+}
+
+// ToOrderChannel converts a string to an OrderChannel
+func ToOrderChannel(channel string) (OrderChannel, bool) {
+	switch strings.ToUpper(channel) {
+	case "TOTEM":
+		return ChannelTotem, true
+	case "COUNTER":
+		return ChannelCounter, true
+	case "APP":
+		return ChannelApp, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidOrderChannel returns true if the order channel is known
+func IsValidOrderChannel(channel string) bool {
+	_, ok := ToOrderChannel(channel)
+	return ok
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+import "strings"
+
+// OrderEventType is the type of the events published when an order changes, the webhook subscribers choose the ones they receive
+type OrderEventType string
+
+const (
+	OrderCreatedEvent       OrderEventType = "order.created"
+	OrderStatusChangedEvent OrderEventType = "order.status_changed"
+)
+
+// String returns the string representation of the OrderEventType
+func (t OrderEventType) String() string {
+	return string(t)
+}
+
+// ToOrderEventType converts a string to an OrderEventType
+func ToOrderEventType(eventType string) (OrderEventType, bool) {
+	switch strings.ToLower(eventType) {
+	case "order.created":
+		return OrderCreatedEvent, true
+	case "order.status_changed":
+		return OrderStatusChangedEvent, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidOrderEventType returns true if the order event type is known
+func IsValidOrderEventType(eventType string) bool {
+	_, ok := ToOrderEventType(eventType)
+	return ok
+}
@@ -0,0 +1,181 @@
+package valueobject
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// defaultOrderStatusMachine is the state machine used when no custom file is configured
+//
+//go:embed order_status_machine.json
+var defaultOrderStatusMachine []byte
+
+// OrderStatusGuard is a condition the order must satisfy before a transition
+type OrderStatusGuard string
+
+const (
+	GuardHasProducts OrderStatusGuard = "has_products"
+)
+
+// OrderStatusRequiredField is an input field that must be informed on a transition
+type OrderStatusRequiredField string
+
+const (
+	RequiredFieldStaffID OrderStatusRequiredField = "staff_id"
+)
+
+// OrderStatusState describes a state of the order status machine
+type OrderStatusState struct {
+	Name        OrderStatus `json:"name"`
+	Description string      `json:"description"`
+	Final       bool        `json:"final"`
+}
+
+// OrderStatusTransition describes an allowed transition between two order statuses
+type OrderStatusTransition struct {
+	From OrderStatus `json:"from"`
+	To   OrderStatus `json:"to"`
+	// Roles restricts which actors can perform the transition, empty means anyone
+	Roles []ActorType `json:"roles,omitempty"`
+	// Modes restricts which fulfilment modes the transition applies to, empty means all of them
+	Modes          []FulfilmentMode           `json:"modes,omitempty"`
+	RequiredFields []OrderStatusRequiredField `json:"required_fields,omitempty"`
+	Guards         []OrderStatusGuard         `json:"guards,omitempty"`
+}
+
+// AllowsActor returns true if the actor can perform the transition
+func (t OrderStatusTransition) AllowsActor(actor ActorType) bool {
+	return len(t.Roles) == 0 || slices.Contains(t.Roles, actor)
+}
+
+// AllowsMode returns true if the transition applies to orders of the fulfilment mode
+func (t OrderStatusTransition) AllowsMode(mode FulfilmentMode) bool {
+	return len(t.Modes) == 0 || slices.Contains(t.Modes, mode)
+}
+
+// OrderStatusMachine defines the states of an order and how it moves between them
+type OrderStatusMachine struct {
+	Initial     OrderStatus             `json:"initial"`
+	States      []OrderStatusState      `json:"states"`
+	Transitions []OrderStatusTransition `json:"transitions"`
+}
+
+// NewOrderStatusMachine parses and validates a JSON state machine definition
+func NewOrderStatusMachine(data []byte) (*OrderStatusMachine, error) {
+	var m OrderStatusMachine
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("invalid order status machine: %w", err)
+	}
+
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// DefaultOrderStatusMachine returns the embedded order status machine
+func DefaultOrderStatusMachine() *OrderStatusMachine {
+	m, err := NewOrderStatusMachine(defaultOrderStatusMachine)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Validate checks that the states and transitions are consistent
+func (m *OrderStatusMachine) Validate() error {
+	if len(m.States) == 0 {
+		return errors.New("order status machine has no states")
+	}
+
+	states := make(map[OrderStatus]OrderStatusState, len(m.States))
+	for _, state := range m.States {
+		if status, ok := ToOrderStatus(string(state.Name)); !ok || status != state.Name {
+			return fmt.Errorf("unknown state %q", string(state.Name))
+		}
+		if _, ok := states[state.Name]; ok {
+			return fmt.Errorf("duplicated state %q", string(state.Name))
+		}
+		states[state.Name] = state
+	}
+
+	if _, ok := states[m.Initial]; !ok {
+		return fmt.Errorf("initial state %q is not declared", string(m.Initial))
+	}
+
+	seen := make(map[[2]OrderStatus]bool, len(m.Transitions))
+	for _, t := range m.Transitions {
+		from, ok := states[t.From]
+		if !ok {
+			return fmt.Errorf("transition from undeclared state %q", string(t.From))
+		}
+		if _, ok := states[t.To]; !ok {
+			return fmt.Errorf("transition to undeclared state %q", string(t.To))
+		}
+		if from.Final {
+			return fmt.Errorf("transition from final state %q", string(t.From))
+		}
+		if t.From == t.To {
+			return fmt.Errorf("transition from %q to itself", string(t.From))
+		}
+		key := [2]OrderStatus{t.From, t.To}
+		if seen[key] {
+			return fmt.Errorf("duplicated transition from %q to %q", string(t.From), string(t.To))
+		}
+		seen[key] = true
+
+		for _, role := range t.Roles {
+			if actor, ok := ToActorType(string(role)); !ok || actor != role {
+				return fmt.Errorf("unknown role %q on transition from %q to %q", role, string(t.From), string(t.To))
+			}
+		}
+		for _, mode := range t.Modes {
+			if fulfilmentMode, ok := ToFulfilmentMode(string(mode)); !ok || fulfilmentMode != mode {
+				return fmt.Errorf("unknown mode %q on transition from %q to %q", mode, string(t.From), string(t.To))
+			}
+		}
+		for _, field := range t.RequiredFields {
+			if field != RequiredFieldStaffID {
+				return fmt.Errorf("unknown required field %q on transition from %q to %q", field, string(t.From), string(t.To))
+			}
+		}
+		for _, guard := range t.Guards {
+			if guard != GuardHasProducts {
+				return fmt.Errorf("unknown guard %q on transition from %q to %q", guard, string(t.From), string(t.To))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Transition returns the transition between two statuses, if it is allowed
+func (m *OrderStatusMachine) Transition(from, to OrderStatus) (OrderStatusTransition, bool) {
+	for _, t := range m.Transitions {
+		if t.From == from && t.To == to {
+			return t, true
+		}
+	}
+	return OrderStatusTransition{}, false
+}
+
+// CanTransition returns true if the transition between the statuses is allowed
+func (m *OrderStatusMachine) CanTransition(from, to OrderStatus) bool {
+	_, ok := m.Transition(from, to)
+	return ok
+}
+
+// TransitionsFrom returns the statuses reachable from the given status
+func (m *OrderStatusMachine) TransitionsFrom(from OrderStatus) []OrderStatus {
+	var statuses []OrderStatus
+	for _, t := range m.Transitions {
+		if t.From == from {
+			statuses = append(statuses, t.To)
+		}
+	}
+	return statuses
+}
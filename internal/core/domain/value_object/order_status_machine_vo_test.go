@@ -0,0 +1,168 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+func TestDefaultOrderStatusMachine(t *testing.T) {
+	m := valueobject.DefaultOrderStatusMachine()
+	require.NoError(t, m.Validate())
+
+	assert.Equal(t, valueobject.OPEN, m.Initial)
+	assert.ElementsMatch(t,
+		[]valueobject.OrderStatus{valueobject.CANCELLED, valueobject.PENDING, valueobject.RECEIVED},
+		m.TransitionsFrom(valueobject.OPEN),
+	)
+	assert.ElementsMatch(t,
+		[]valueobject.OrderStatus{valueobject.OPEN, valueobject.RECEIVED, valueobject.CANCELLED},
+		m.TransitionsFrom(valueobject.PENDING),
+	)
+	assert.Empty(t, m.TransitionsFrom(valueobject.CANCELLED))
+	assert.Empty(t, m.TransitionsFrom(valueobject.COMPLETED))
+	assert.Empty(t, m.TransitionsFrom(valueobject.DELIVERED))
+
+	// Delivery orders leave with the courier instead of being picked up
+	completed, ok := m.Transition(valueobject.READY, valueobject.COMPLETED)
+	require.True(t, ok)
+	assert.True(t, completed.AllowsMode(valueobject.FulfilmentTakeaway))
+	assert.False(t, completed.AllowsMode(valueobject.FulfilmentDelivery))
+	for _, transition := range []struct{ from, to valueobject.OrderStatus }{
+		{valueobject.READY, valueobject.OUT_FOR_DELIVERY},
+		{valueobject.OUT_FOR_DELIVERY, valueobject.DELIVERED},
+	} {
+		delivery, ok := m.Transition(transition.from, transition.to)
+		require.True(t, ok)
+		assert.True(t, delivery.AllowsMode(valueobject.FulfilmentDelivery))
+		assert.False(t, delivery.AllowsMode(valueobject.FulfilmentDineIn))
+	}
+
+	// Every non final state must be able to leave
+	for _, state := range m.States {
+		if !state.Final {
+			assert.NotEmpty(t, m.TransitionsFrom(state.Name), "state %s has no transitions", state.Name)
+		}
+	}
+
+	// Only the payment service and the system confirm the payments
+	for _, from := range []valueobject.OrderStatus{valueobject.OPEN, valueobject.PENDING} {
+		received, ok := m.Transition(from, valueobject.RECEIVED)
+		require.True(t, ok)
+		assert.True(t, received.AllowsActor(valueobject.ActorService))
+		assert.False(t, received.AllowsActor(valueobject.ActorPartner))
+		assert.False(t, received.AllowsActor(valueobject.ActorStaff))
+		assert.False(t, received.AllowsActor(valueobject.ActorCustomer))
+	}
+
+	// Kitchen transitions are performed by staff, or by partners on behalf of the staff
+	for _, to := range []valueobject.OrderStatus{valueobject.PREPARING, valueobject.READY, valueobject.COMPLETED, valueobject.OUT_FOR_DELIVERY, valueobject.DELIVERED} {
+		for _, from := range []valueobject.OrderStatus{valueobject.RECEIVED, valueobject.PREPARING, valueobject.READY, valueobject.OUT_FOR_DELIVERY} {
+			transition, ok := m.Transition(from, to)
+			if !ok {
+				continue
+			}
+			assert.Contains(t, transition.RequiredFields, valueobject.RequiredFieldStaffID)
+			assert.True(t, transition.AllowsActor(valueobject.ActorStaff))
+			assert.True(t, transition.AllowsActor(valueobject.ActorPartner))
+			assert.False(t, transition.AllowsActor(valueobject.ActorCustomer))
+		}
+	}
+}
+
+func TestNewOrderStatusMachine(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name: "valid",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"CANCELLED","final":true}],
+				"transitions":[{"from":"OPEN","to":"CANCELLED","roles":["CUSTOMER"],"guards":["has_products"]}]}`,
+		},
+		{
+			name:    "invalid json",
+			data:    `{`,
+			wantErr: "invalid order status machine",
+		},
+		{
+			name:    "without states",
+			data:    `{"initial":"OPEN"}`,
+			wantErr: "no states",
+		},
+		{
+			name:    "unknown state",
+			data:    `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"LOST"}]}`,
+			wantErr: `unknown state "LOST"`,
+		},
+		{
+			name:    "duplicated state",
+			data:    `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"OPEN"}]}`,
+			wantErr: `duplicated state "OPEN"`,
+		},
+		{
+			name:    "undeclared initial state",
+			data:    `{"initial":"PENDING","states":[{"name":"OPEN"}]}`,
+			wantErr: `initial state "PENDING"`,
+		},
+		{
+			name:    "transition to undeclared state",
+			data:    `{"initial":"OPEN","states":[{"name":"OPEN"}],"transitions":[{"from":"OPEN","to":"READY"}]}`,
+			wantErr: `transition to undeclared state "READY"`,
+		},
+		{
+			name: "transition from final state",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"CANCELLED","final":true}],
+				"transitions":[{"from":"CANCELLED","to":"OPEN"}]}`,
+			wantErr: `transition from final state "CANCELLED"`,
+		},
+		{
+			name: "duplicated transition",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"PENDING"}],
+				"transitions":[{"from":"OPEN","to":"PENDING"},{"from":"OPEN","to":"PENDING"}]}`,
+			wantErr: "duplicated transition",
+		},
+		{
+			name: "unknown role",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"PENDING"}],
+				"transitions":[{"from":"OPEN","to":"PENDING","roles":["MANAGER"]}]}`,
+			wantErr: `unknown role "MANAGER"`,
+		},
+		{
+			name: "unknown guard",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"PENDING"}],
+				"transitions":[{"from":"OPEN","to":"PENDING","guards":["is_paid"]}]}`,
+			wantErr: `unknown guard "is_paid"`,
+		},
+		{
+			name: "unknown mode",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"PENDING"}],
+				"transitions":[{"from":"OPEN","to":"PENDING","modes":["DRIVE_THRU"]}]}`,
+			wantErr: `unknown mode "DRIVE_THRU"`,
+		},
+		{
+			name: "unknown required field",
+			data: `{"initial":"OPEN","states":[{"name":"OPEN"},{"name":"PENDING"}],
+				"transitions":[{"from":"OPEN","to":"PENDING","required_fields":["table"]}]}`,
+			wantErr: `unknown required field "table"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := valueobject.NewOrderStatusMachine([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.Nil(t, m)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, m.CanTransition(valueobject.OPEN, valueobject.CANCELLED))
+			assert.False(t, m.CanTransition(valueobject.CANCELLED, valueobject.OPEN))
+		})
+	}
+}
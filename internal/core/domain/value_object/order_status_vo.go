@@ -1,21 +1,23 @@
 package valueobject
 
 import (
-	"slices"
 	"strings"
 )
 
 type OrderStatus string
 
 const (
-	OPEN       OrderStatus = "OPEN"
-	CANCELLED  OrderStatus = "CANCELLED"
-	PENDING    OrderStatus = "PENDING"
-	RECEIVED   OrderStatus = "RECEIVED"
-	PREPARING  OrderStatus = "PREPARING"
-	READY      OrderStatus = "READY"
-	COMPLETED  OrderStatus = "COMPLETED"
-	UNDEFINDED OrderStatus = "UNDEFINDED"
+	OPEN      OrderStatus = "OPEN"
+	CANCELLED OrderStatus = "CANCELLED"
+	PENDING   OrderStatus = "PENDING"
+	RECEIVED  OrderStatus = "RECEIVED"
+	PREPARING OrderStatus = "PREPARING"
+	READY     OrderStatus = "READY"
+	COMPLETED OrderStatus = "COMPLETED"
+	// OUT_FOR_DELIVERY and DELIVERED are only reached by delivery orders
+	OUT_FOR_DELIVERY OrderStatus = "OUT_FOR_DELIVERY"
+	DELIVERED        OrderStatus = "DELIVERED"
+	UNDEFINDED       OrderStatus = "UNDEFINDED"
 )
 
 func IsValidOrderStatus(status string) bool {
@@ -40,6 +42,10 @@ func (o OrderStatus) String() string {
 		return "READY"
 	case COMPLETED:
 		return "COMPLETED"
+	case OUT_FOR_DELIVERY:
+		return "OUT_FOR_DELIVERY"
+	case DELIVERED:
+		return "DELIVERED"
 	default:
 		return "UNDEFINDED"
 	}
@@ -62,36 +68,11 @@ func ToOrderStatus(status string) (OrderStatus, bool) {
 		return READY, true
 	case "COMPLETED":
 		return COMPLETED, true
+	case "OUT_FOR_DELIVERY":
+		return OUT_FOR_DELIVERY, true
+	case "DELIVERED":
+		return DELIVERED, true
 	default:
 		return UNDEFINDED, false
 	}
 }
-
-// OrderStatusTransitions defines the allowed transitions between OrderStatuses
-var OrderStatusTransitions = map[OrderStatus][]OrderStatus{
-	OPEN:      {CANCELLED, PENDING, RECEIVED},
-	CANCELLED: {},
-	PENDING:   {OPEN, RECEIVED, CANCELLED},
-	RECEIVED:  {PREPARING, CANCELLED},
-	PREPARING: {READY, CANCELLED},
-	READY:     {COMPLETED},
-	COMPLETED: {},
-}
-
-// StatusCanTransitionTo returns true if the transition from oldStatus to newStatus is allowed
-func StatusCanTransitionTo(oldStatus, newStatus OrderStatus) bool {
-	allowedStatuses := OrderStatusTransitions[oldStatus]
-	return slices.Contains(allowedStatuses, newStatus)
-}
-
-// StatusTransitionNeedsStaffID returns true if the new status requires a staff ID
-func StatusTransitionNeedsStaffID(newStatus OrderStatus) bool {
-	switch newStatus {
-	case OPEN, CANCELLED, PENDING, RECEIVED:
-		return false
-	case PREPARING, READY, COMPLETED:
-		return true
-	default:
-		return false
-	}
-}
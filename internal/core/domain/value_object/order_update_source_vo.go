@@ -0,0 +1,16 @@
+package valueobject
+
+// OrderUpdateSource identifies through which channel an order was changed
+type OrderUpdateSource string
+
+const (
+	SourceAPI       OrderUpdateSource = "API"
+	SourceSQS       OrderUpdateSource = "SQS"
+	SourceScheduler OrderUpdateSource = "SCHEDULER"
+	SourceWebhook   OrderUpdateSource = "WEBHOOK"
+)
+
+// String returns the string representation of the OrderUpdateSource
+func (s OrderUpdateSource) String() string {
+	return string(s)
+}
@@ -0,0 +1,46 @@
+package valueobject
+
+import "strings"
+
+// PaymentStatus is the outcome of a payment informed by the payment service
+type PaymentStatus string
+
+const (
+	PaymentApproved  PaymentStatus = "APPROVED"
+	PaymentRejected  PaymentStatus = "REJECTED"
+	PaymentCancelled PaymentStatus = "CANCELLED"
+)
+
+// String returns the string representation of the PaymentStatus
+func (s PaymentStatus) String() string {
+	return string(s)
+}
+
+// ToPaymentStatus converts a string to a PaymentStatus
+func ToPaymentStatus(status string) (PaymentStatus, bool) {
+	switch strings.ToUpper(status) {
+	case "APPROVED":
+		return PaymentApproved, true
+	case "REJECTED":
+		return PaymentRejected, true
+	case "CANCELLED":
+		return PaymentCancelled, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidPaymentStatus returns true if the payment status is known
+func IsValidPaymentStatus(status string) bool {
+	_, ok := ToPaymentStatus(status)
+	return ok
+}
+
+// OrderStatus returns the status the order goes to with the payment outcome,
+// approved orders are sent to the kitchen and the others are cancelled
+func (s PaymentStatus) OrderStatus() OrderStatus {
+	if s == PaymentApproved {
+		return RECEIVED
+	}
+	return CANCELLED
+}
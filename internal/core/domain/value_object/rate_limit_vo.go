@@ -0,0 +1,90 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RateLimit is a token bucket, it holds up to Burst requests and is refilled with Requests every Period
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+	Burst    int
+}
+
+// ParseRateLimit parses a limit as requests/period[/burst], ex: 60/1m or 60/1m/10, the burst defaults to the requests
+func ParseRateLimit(value string) (RateLimit, error) {
+	parts := strings.Split(strings.TrimSpace(value), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return RateLimit{}, fmt.Errorf("rate limit %q is not requests/period[/burst]", value)
+	}
+
+	requests, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("rate limit %q has invalid requests: %w", value, err)
+	}
+	period, err := time.ParseDuration(parts[1])
+	if err != nil {
+		return RateLimit{}, fmt.Errorf("rate limit %q has invalid period: %w", value, err)
+	}
+	limit := RateLimit{Requests: requests, Period: period, Burst: requests}
+	if len(parts) == 3 {
+		if limit.Burst, err = strconv.Atoi(parts[2]); err != nil {
+			return RateLimit{}, fmt.Errorf("rate limit %q has invalid burst: %w", value, err)
+		}
+	}
+
+	return limit, limit.Validate()
+}
+
+// Validate checks the limit refills and holds at least one request
+func (l RateLimit) Validate() error {
+	if l.Requests <= 0 || l.Period <= 0 || l.Burst <= 0 {
+		return errors.New("rate limit requests, period and burst must be greater than zero")
+	}
+	return nil
+}
+
+// String returns the limit as parsed by ParseRateLimit
+func (l RateLimit) String() string {
+	return fmt.Sprintf("%d/%s/%d", l.Requests, l.Period, l.Burst)
+}
+
+// RateLimitResult is the outcome of taking a request from a bucket
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is how long until the next request is allowed, zero when this one was
+	RetryAfter time.Duration
+	// ResetAfter is how long until the bucket is full again
+	ResetAfter time.Duration
+}
+
+// Take takes a request from a bucket that had the tokens elapsed ago, a new bucket is full.
+// It returns the tokens left now to be stored and the result of the request
+func (l RateLimit) Take(tokens float64, elapsed time.Duration) (float64, RateLimitResult) {
+	rate := float64(l.Requests) / l.Period.Seconds()
+	burst := float64(l.Burst)
+
+	tokens = math.Min(burst, tokens+math.Max(elapsed.Seconds(), 0)*rate)
+
+	var result RateLimitResult
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - tokens) / rate)
+	}
+	result.Remaining = int(math.Floor(tokens))
+	result.ResetAfter = secondsToDuration((burst - tokens) / rate)
+
+	return tokens, result
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,93 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+func TestParseRateLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    valueobject.RateLimit
+		wantErr bool
+	}{
+		{
+			name:  "should default the burst to the requests",
+			value: "60/1m",
+			want:  valueobject.RateLimit{Requests: 60, Period: time.Minute, Burst: 60},
+		},
+		{
+			name:  "should parse the burst",
+			value: " 10/1s/20 ",
+			want:  valueobject.RateLimit{Requests: 10, Period: time.Second, Burst: 20},
+		},
+		{
+			name:    "should reject the limit without period",
+			value:   "60",
+			wantErr: true,
+		},
+		{
+			name:    "should reject the invalid period",
+			value:   "60/minute",
+			wantErr: true,
+		},
+		{
+			name:    "should reject the zero requests",
+			value:   "0/1m",
+			wantErr: true,
+		},
+		{
+			name:    "should reject the negative burst",
+			value:   "60/1m/-1",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, err := valueobject.ParseRateLimit(tt.value)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, limit)
+		})
+	}
+}
+
+func TestRateLimit_Take(t *testing.T) {
+	// 1 request per second, up to 2 in a row
+	limit := valueobject.RateLimit{Requests: 60, Period: time.Minute, Burst: 2}
+
+	tokens, result := limit.Take(float64(limit.Burst), 0)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1, result.Remaining)
+	assert.Equal(t, time.Second, result.ResetAfter)
+
+	tokens, result = limit.Take(tokens, 0)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+
+	tokens, result = limit.Take(tokens, 500*time.Millisecond)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, 0, result.Remaining)
+	assert.Equal(t, 500*time.Millisecond, result.RetryAfter)
+	assert.Equal(t, 1500*time.Millisecond, result.ResetAfter)
+
+	_, result = limit.Take(tokens, 500*time.Millisecond)
+	assert.True(t, result.Allowed)
+	assert.Zero(t, result.RetryAfter)
+
+	// The bucket never holds more than the burst
+	_, result = limit.Take(0, time.Hour)
+	assert.True(t, result.Allowed)
+	assert.Equal(t, 1, result.Remaining)
+}
@@ -0,0 +1,34 @@
+package valueobject
+
+import "strings"
+
+// StockMode defines if the stock of a product is counted
+type StockMode string
+
+const (
+	StockUnlimited StockMode = "UNLIMITED"
+	StockCounted   StockMode = "COUNTED"
+)
+
+// String returns the string representation of the StockMode
+func (s StockMode) String() string {
+	return string(s)
+}
+
+// ToStockMode converts a string to a StockMode
+func ToStockMode(mode string) (StockMode, bool) {
+	switch strings.ToUpper(mode) {
+	case "UNLIMITED":
+		return StockUnlimited, true
+	case "COUNTED":
+		return StockCounted, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidStockMode returns true if the stock mode is known
+func IsValidStockMode(mode string) bool {
+	_, ok := ToStockMode(mode)
+	return ok
+}
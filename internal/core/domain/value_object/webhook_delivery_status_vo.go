@@ -0,0 +1,38 @@
+package valueobject
+
+import "strings"
+
+// WebhookDeliveryStatus is the status of the delivery of an event to a webhook subscriber
+type WebhookDeliveryStatus string
+
+const (
+	// DeliveryPending deliveries are sent by the dispatcher, again after each failed attempt until they run out of attempts
+	DeliveryPending   WebhookDeliveryStatus = "PENDING"
+	DeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	DeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// String returns the string representation of the WebhookDeliveryStatus
+func (s WebhookDeliveryStatus) String() string {
+	return string(s)
+}
+
+// ToWebhookDeliveryStatus converts a string to a WebhookDeliveryStatus
+func ToWebhookDeliveryStatus(status string) (WebhookDeliveryStatus, bool) {
+	switch strings.ToUpper(status) {
+	case "PENDING":
+		return DeliveryPending, true
+	case "DELIVERED":
+		return DeliveryDelivered, true
+	case "FAILED":
+		return DeliveryFailed, true
+	default:
+		return "", false
+	}
+}
+
+// IsValidWebhookDeliveryStatus returns true if the webhook delivery status is known
+func IsValidWebhookDeliveryStatus(status string) bool {
+	_, ok := ToWebhookDeliveryStatus(status)
+	return ok
+}
@@ -0,0 +1,47 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type AvailabilityWindowInput struct {
+	Weekday   time.Weekday
+	StartTime string
+	EndTime   string
+	Timezone  string
+}
+
+func (i AvailabilityWindowInput) toEntity() entity.AvailabilityWindow {
+	return entity.AvailabilityWindow{
+		Weekday:   i.Weekday,
+		StartTime: i.StartTime,
+		EndTime:   i.EndTime,
+		Timezone:  i.Timezone,
+	}
+}
+
+// ToCategoryAvailabilityWindowEntities converts the availability windows input to category entities
+func ToCategoryAvailabilityWindowEntities(windows []AvailabilityWindowInput) []entity.CategoryAvailabilityWindow {
+	if windows == nil {
+		return nil
+	}
+	output := make([]entity.CategoryAvailabilityWindow, len(windows))
+	for i, window := range windows {
+		output[i] = entity.CategoryAvailabilityWindow{AvailabilityWindow: window.toEntity()}
+	}
+	return output
+}
+
+// ToProductAvailabilityWindowEntities converts the availability windows input to product entities
+func ToProductAvailabilityWindowEntities(windows []AvailabilityWindowInput) []entity.ProductAvailabilityWindow {
+	if windows == nil {
+		return nil
+	}
+	output := make([]entity.ProductAvailabilityWindow, len(windows))
+	for i, window := range windows {
+		output[i] = entity.ProductAvailabilityWindow{AvailabilityWindow: window.toEntity()}
+	}
+	return output
+}
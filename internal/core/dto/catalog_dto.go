@@ -0,0 +1,45 @@
+package dto
+
+import (
+	"strings"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type ImportCatalogInput struct {
+	Rows []CatalogRowInput
+	// DryRun validates the rows and reports the changes without writing them
+	DryRun bool
+}
+
+type CatalogRowInput struct {
+	Row            int
+	Category       string
+	ParentCategory string
+	Name           string
+	Description    string
+	Price          float64
+	StockMode      string
+	StockQuantity  *int64
+	Available      *bool
+}
+
+// ToCatalogRowEntities converts the catalog rows input to entities, the names are trimmed
+func ToCatalogRowEntities(rows []CatalogRowInput) []entity.CatalogRow {
+	output := make([]entity.CatalogRow, len(rows))
+	for i, row := range rows {
+		output[i] = entity.CatalogRow{
+			Row:            row.Row,
+			Category:       strings.TrimSpace(row.Category),
+			ParentCategory: strings.TrimSpace(row.ParentCategory),
+			Name:           strings.TrimSpace(row.Name),
+			Description:    row.Description,
+			Price:          row.Price,
+			StockMode:      valueobject.StockMode(strings.ToUpper(row.StockMode)),
+			StockQuantity:  row.StockQuantity,
+			Available:      row.Available,
+		}
+	}
+	return output
+}
@@ -1,6 +1,9 @@
 package dto
 
-import "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+import (
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
 
 type GetCategoryInput struct {
 	ID uint64
@@ -13,8 +16,16 @@ type ListCategoriesInput struct {
 }
 
 type UpdateCategoryInput struct {
-	ID   uint64
-	Name string
+	ID           uint64
+	Name         string
+	ParentID     *uint64
+	DisplayOrder int
+	Active       bool
+	ImageURL     string
+	// KitchenStation is nil to inherit the station of the parent category
+	KitchenStation *valueobject.KitchenStation
+	// AvailabilityWindows replaces the availability windows of the category, nil keeps the current ones
+	AvailabilityWindows []AvailabilityWindowInput
 }
 
 type DeleteCategoryInput struct {
@@ -22,11 +33,25 @@ type DeleteCategoryInput struct {
 }
 
 type CreateCategoryInput struct {
-	Name string
+	Name         string
+	ParentID     *uint64
+	DisplayOrder int
+	Active       bool
+	ImageURL     string
+	// KitchenStation is nil to inherit the station of the parent category
+	KitchenStation *valueobject.KitchenStation
+	// AvailabilityWindows are empty for the categories available all the time
+	AvailabilityWindows []AvailabilityWindowInput
 }
 
 func (c CreateCategoryInput) ToEntity() *entity.Category {
 	return &entity.Category{
-		Name: c.Name,
+		Name:                c.Name,
+		ParentID:            c.ParentID,
+		DisplayOrder:        c.DisplayOrder,
+		Active:              c.Active,
+		ImageURL:            c.ImageURL,
+		KitchenStation:      c.KitchenStation,
+		AvailabilityWindows: ToCategoryAvailabilityWindowEntities(c.AvailabilityWindows),
 	}
 }
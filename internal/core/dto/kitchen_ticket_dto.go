@@ -0,0 +1,17 @@
+package dto
+
+import valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+
+type ListKitchenTicketsInput struct {
+	// Station and Status are empty to list the tickets of every station and status
+	Station valueobject.KitchenStation
+	Status  valueobject.KitchenTicketStatus
+	Page    int
+	Limit   int
+}
+
+type UpdateKitchenTicketInput struct {
+	ID      uint64
+	Status  valueobject.KitchenTicketStatus
+	StaffID uint64
+}
@@ -0,0 +1,8 @@
+package dto
+
+import "time"
+
+type GetMenuInput struct {
+	// At is the moment the menu is shown for, zero means now
+	At time.Time
+}
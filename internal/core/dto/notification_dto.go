@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type GetNotificationPreferenceInput struct {
+	CustomerID uint64
+}
+
+type UpdateNotificationPreferenceInput struct {
+	CustomerID uint64
+	Locale     valueobject.Locale
+	Channels   []string
+	Email      string
+	Phone      string
+	PushToken  string
+}
+
+func (i UpdateNotificationPreferenceInput) ToEntity() *entity.NotificationPreference {
+	return &entity.NotificationPreference{
+		CustomerID: i.CustomerID,
+		Locale:     i.Locale,
+		Channels:   entity.NewStringList(i.Channels...),
+		Email:      i.Email,
+		Phone:      i.Phone,
+		PushToken:  i.PushToken,
+	}
+}
+
+type DeleteNotificationPreferenceInput struct {
+	CustomerID uint64
+}
@@ -1,11 +1,48 @@
 package dto
 
 import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 )
 
 type CreateOrderInput struct {
+	// CustomerID is zero for guest orders, GuestName is only informed for them
 	CustomerID uint64
+	GuestName  string
+	// Channel and FulfilmentMode fall back to the defaults when empty
+	Channel        valueobject.OrderChannel
+	FulfilmentMode valueobject.FulfilmentMode
+	// TableNumber is only informed for dine-in orders and DeliveryAddress for delivery orders
+	TableNumber     *uint32
+	DeliveryAddress *DeliveryAddressInput
+}
+
+type DeliveryAddressInput struct {
+	Street       string
+	Number       string
+	Complement   string
+	Neighborhood string
+	City         string
+	State        string
+	ZipCode      string
+}
+
+// ToEntity converts the delivery address input to the snapshot saved with the order
+func (i *DeliveryAddressInput) ToEntity() *entity.OrderDeliveryAddress {
+	if i == nil {
+		return nil
+	}
+	return &entity.OrderDeliveryAddress{
+		Street:       i.Street,
+		Number:       i.Number,
+		Complement:   i.Complement,
+		Neighborhood: i.Neighborhood,
+		City:         i.City,
+		State:        i.State,
+		ZipCode:      i.ZipCode,
+	}
 }
 
 type UpdateOrderInput struct {
@@ -13,21 +50,71 @@ type UpdateOrderInput struct {
 	CustomerID uint64
 	Status     valueobject.OrderStatus
 	StaffID    uint64
+	// ActorType is who is performing the update, CUSTOMER when empty
+	ActorType valueobject.ActorType
+	ActorID   string
+	// ReasonCode, ReasonText and Source are recorded in the order history when the status changes
+	ReasonCode string
+	ReasonText string
+	Source     valueobject.OrderUpdateSource
+	// Version is the expected order version (If-Match), 0 skips the check
+	Version uint32
+}
+
+// ProcessOrderStatusUpdatedInput is a status update sent by another service or partner through the Source
+type ProcessOrderStatusUpdatedInput struct {
+	Event  entity.OrderStatusUpdated
+	Source valueobject.OrderUpdateSource
+	// ActorID identifies the sender of the events without an actor, ex: the webhook partner
+	ActorID string
 }
 
 type GetOrderInput struct {
 	ID uint64
 }
 
+// ListCustomerOrdersInput filters the orders of the customer by the creation date, zero From or To leaves the period open
+type ListCustomerOrdersInput struct {
+	CustomerID uint64
+	From       time.Time
+	To         time.Time
+	Page       int
+	Limit      int
+}
+
+type ReorderInput struct {
+	ID         uint64
+	CustomerID uint64
+}
+
+type GetGuestOrderInput struct {
+	GuestToken string
+}
+
+type AttachOrderCustomerInput struct {
+	ID         uint64
+	CustomerID uint64
+	// GuestToken proves the customer is the guest that placed the order
+	GuestToken string
+}
+
 type DeleteOrderInput struct {
 	ID uint64
 }
 
 type ListOrdersInput struct {
-	CustomerID    uint64
-	Status        []valueobject.OrderStatus
-	StatusExclude []valueobject.OrderStatus
-	Page          int
-	Limit         int
-	Sort          string
+	CustomerID      uint64
+	Status          []valueobject.OrderStatus
+	StatusExclude   []valueobject.OrderStatus
+	Channels        []valueobject.OrderChannel
+	FulfilmentModes []valueobject.FulfilmentMode
+	Page            int
+	Limit           int
+	Sort            string
+}
+
+type ExpireIdleOrdersInput struct {
+	// TTLs is how long an order can stay idle on each status, statuses without TTL never expire
+	TTLs  map[valueobject.OrderStatus]time.Duration
+	Limit int
 }
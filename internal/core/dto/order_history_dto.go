@@ -6,18 +6,12 @@ type CreateFirstOrderHistoryInput struct {
 	OrderID uint64
 }
 
-type CreateOrderHistoryInput struct {
-	OrderID uint64
-	StaffID *uint64
-	Status  valueobject.OrderStatus
-}
-
 type GetOrderHistoryInput struct {
 	ID uint64
 }
 
-type DeleteOrderHistoryInput struct {
-	ID uint64
+type VerifyOrderHistoriesInput struct {
+	OrderID uint64
 }
 
 type ListOrderHistoriesInput struct {
@@ -1,13 +1,33 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 )
 
 type CreateOrderProductInput struct {
-	OrderID   uint64
+	OrderID     uint64
+	ProductID   uint64
+	Quantity    uint32
+	Notes       string
+	ModifierIDs []uint64
+	// BundleSelections chooses the component of the bundle slots with more than one option
+	BundleSelections []BundleSelectionInput
+}
+
+type BundleSelectionInput struct {
+	SlotID    uint64
 	ProductID uint64
-	Quantity  uint32
+}
+
+// ToBundleSelections converts the selections to a map of slot ID to component product ID
+func ToBundleSelections(selections []BundleSelectionInput) map[uint64]uint64 {
+	output := make(map[uint64]uint64, len(selections))
+	for _, selection := range selections {
+		output[selection.SlotID] = selection.ProductID
+	}
+	return output
 }
 
 func (i CreateOrderProductInput) ToEntity() *entity.OrderProduct {
@@ -15,23 +35,26 @@ func (i CreateOrderProductInput) ToEntity() *entity.OrderProduct {
 		OrderID:   i.OrderID,
 		ProductID: i.ProductID,
 		Quantity:  i.Quantity,
+		Notes:     i.Notes,
 	}
 }
 
 type UpdateOrderProductInput struct {
-	OrderID   uint64
-	ProductID uint64
-	Quantity  uint32
+	ID       uint64
+	Quantity uint32
+	Notes    string
+	// ModifierIDs replaces the chosen modifiers, nil keeps the current ones
+	ModifierIDs []uint64
+	// BundleSelections replaces the chosen bundle components, nil keeps the current ones
+	BundleSelections []BundleSelectionInput
 }
 
 type GetOrderProductInput struct {
-	OrderID   uint64
-	ProductID uint64
+	ID uint64
 }
 
 type DeleteOrderProductInput struct {
-	OrderID   uint64
-	ProductID uint64
+	ID uint64
 }
 
 type ListOrderProductsInput struct {
@@ -40,3 +63,8 @@ type ListOrderProductsInput struct {
 	Page      int
 	Limit     int
 }
+
+type GetSalesReportInput struct {
+	From time.Time
+	To   time.Time
+}
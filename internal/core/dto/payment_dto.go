@@ -0,0 +1,13 @@
+package dto
+
+import valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+
+type CheckoutInput struct {
+	ID uint64
+}
+
+// PaymentCallbackInput is the outcome of a payment sent by the payment service
+type PaymentCallbackInput struct {
+	Reference string
+	Status    valueobject.PaymentStatus
+}
@@ -1,20 +1,37 @@
 package dto
 
-import "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
 
 type CreateProductInput struct {
 	Name        string
 	Description string
 	Price       float64
 	CategoryID  uint64
+	// KitchenStation is nil to inherit the station of the category
+	KitchenStation *valueobject.KitchenStation
+	ModifierGroups []ProductModifierGroupInput
+	BundleSlots    []ProductBundleSlotInput
+	// AvailabilityWindows are empty for the products available all the time
+	AvailabilityWindows []AvailabilityWindowInput
 }
 
 func (i CreateProductInput) ToEntity() *entity.Product {
 	return &entity.Product{
-		Name:        i.Name,
-		Description: i.Description,
-		Price:       i.Price,
-		CategoryID:  i.CategoryID,
+		Name:                i.Name,
+		Description:         i.Description,
+		Price:               i.Price,
+		CategoryID:          i.CategoryID,
+		KitchenStation:      i.KitchenStation,
+		ModifierGroups:      ToProductModifierGroupEntities(i.ModifierGroups),
+		BundleSlots:         ToProductBundleSlotEntities(i.BundleSlots),
+		AvailabilityWindows: ToProductAvailabilityWindowEntities(i.AvailabilityWindows),
+		StockMode:           valueobject.StockUnlimited,
+		Available:           true,
 	}
 }
 
@@ -24,6 +41,50 @@ type UpdateProductInput struct {
 	Description string
 	Price       float64
 	CategoryID  uint64
+	// KitchenStation is nil to inherit the station of the category
+	KitchenStation *valueobject.KitchenStation
+	// ModifierGroups replaces the modifier groups of the product, nil keeps the current ones
+	ModifierGroups []ProductModifierGroupInput
+	// BundleSlots replaces the bundle slots of the product, nil keeps the current ones
+	BundleSlots []ProductBundleSlotInput
+	// AvailabilityWindows replaces the availability windows of the product, nil keeps the current ones
+	AvailabilityWindows []AvailabilityWindowInput
+}
+
+type ProductModifierGroupInput struct {
+	Name       string
+	MinChoices uint32
+	MaxChoices uint32
+	Modifiers  []ProductModifierInput
+}
+
+type ProductModifierInput struct {
+	Name       string
+	PriceDelta float64
+}
+
+// ToProductModifierGroupEntities converts the modifier groups input to entities
+func ToProductModifierGroupEntities(groups []ProductModifierGroupInput) []entity.ProductModifierGroup {
+	if groups == nil {
+		return nil
+	}
+	output := make([]entity.ProductModifierGroup, len(groups))
+	for i, group := range groups {
+		modifiers := make([]entity.ProductModifier, len(group.Modifiers))
+		for j, modifier := range group.Modifiers {
+			modifiers[j] = entity.ProductModifier{
+				Name:       modifier.Name,
+				PriceDelta: modifier.PriceDelta,
+			}
+		}
+		output[i] = entity.ProductModifierGroup{
+			Name:       group.Name,
+			MinChoices: group.MinChoices,
+			MaxChoices: group.MaxChoices,
+			Modifiers:  modifiers,
+		}
+	}
+	return output
 }
 
 type GetProductInput struct {
@@ -37,6 +98,50 @@ type DeleteProductInput struct {
 type ListProductsInput struct {
 	Name       string
 	CategoryID uint64
-	Page       int
-	Limit      int
+	// AvailableAt filters the products sold at the moment, zero means now
+	AvailableAt time.Time
+	Page        int
+	Limit       int
+}
+
+type ProductBundleSlotInput struct {
+	Name     string
+	Quantity uint32
+	Options  []ProductBundleOptionInput
+}
+
+type ProductBundleOptionInput struct {
+	ProductID  uint64
+	PriceDelta float64
+}
+
+// ToProductBundleSlotEntities converts the bundle slots input to entities
+func ToProductBundleSlotEntities(slots []ProductBundleSlotInput) []entity.ProductBundleSlot {
+	if slots == nil {
+		return nil
+	}
+	output := make([]entity.ProductBundleSlot, len(slots))
+	for i, slot := range slots {
+		options := make([]entity.ProductBundleOption, len(slot.Options))
+		for j, option := range slot.Options {
+			options[j] = entity.ProductBundleOption{
+				ComponentProductID: option.ProductID,
+				PriceDelta:         option.PriceDelta,
+			}
+		}
+		output[i] = entity.ProductBundleSlot{
+			Name:     slot.Name,
+			Quantity: slot.Quantity,
+			Options:  options,
+		}
+	}
+	return output
+}
+
+type UpdateProductStockInput struct {
+	ProductID uint64
+	// StockMode, StockQuantity and Available keep the current values when empty
+	StockMode     valueobject.StockMode
+	StockQuantity *int64
+	Available     *bool
 }
@@ -0,0 +1,30 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type ListProductPricesInput struct {
+	ProductID uint64
+	Page      int
+	Limit     int
+}
+
+type ScheduleProductPriceInput struct {
+	ProductID uint64
+	Price     float64
+	// EffectiveFrom is now when zero, a nil EffectiveTo keeps the price until the next change
+	EffectiveFrom time.Time
+	EffectiveTo   *time.Time
+}
+
+func (i ScheduleProductPriceInput) ToEntity() *entity.ProductPrice {
+	return &entity.ProductPrice{
+		ProductID:     i.ProductID,
+		Price:         i.Price,
+		EffectiveFrom: i.EffectiveFrom,
+		EffectiveTo:   i.EffectiveTo,
+	}
+}
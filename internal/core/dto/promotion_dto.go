@@ -0,0 +1,77 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type CreatePromotionInput struct {
+	Name               string
+	Code               string
+	DiscountType       valueobject.DiscountType
+	Value              float64
+	CategoryID         *uint64
+	ProductID          *uint64
+	StartsAt           *time.Time
+	EndsAt             *time.Time
+	HappyHourStart     string
+	HappyHourEnd       string
+	Timezone           string
+	MaxUsesPerCustomer uint32
+	Stackable          bool
+	Active             bool
+}
+
+func (i CreatePromotionInput) ToEntity() *entity.Promotion {
+	promotion := &entity.Promotion{
+		Name:               i.Name,
+		DiscountType:       i.DiscountType,
+		Value:              i.Value,
+		CategoryID:         i.CategoryID,
+		ProductID:          i.ProductID,
+		StartsAt:           i.StartsAt,
+		EndsAt:             i.EndsAt,
+		HappyHourStart:     i.HappyHourStart,
+		HappyHourEnd:       i.HappyHourEnd,
+		Timezone:           i.Timezone,
+		MaxUsesPerCustomer: i.MaxUsesPerCustomer,
+		Stackable:          i.Stackable,
+		Active:             i.Active,
+	}
+	if code := entity.NormalizeCouponCode(i.Code); code != "" {
+		promotion.Code = &code
+	}
+	return promotion
+}
+
+type UpdatePromotionInput struct {
+	ID uint64
+	CreatePromotionInput
+}
+
+type GetPromotionInput struct {
+	ID uint64
+}
+
+type DeletePromotionInput struct {
+	ID uint64
+}
+
+type ListPromotionsInput struct {
+	Name  string
+	Page  int
+	Limit int
+}
+
+type ApplyOrderPromotionsInput struct {
+	OrderID uint64
+	// CouponCode is added to the coupons of the order, empty only recalculates the discounts
+	CouponCode string
+}
+
+type RemoveOrderCouponInput struct {
+	OrderID    uint64
+	CouponCode string
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type CreateWebhookSubscriptionInput struct {
+	URL             string
+	EventTypes      []string
+	Statuses        []string
+	Channels        []string
+	FulfilmentModes []string
+	Secret          string
+	Active          bool
+}
+
+func (i CreateWebhookSubscriptionInput) ToEntity() *entity.WebhookSubscription {
+	return &entity.WebhookSubscription{
+		URL:             i.URL,
+		EventTypes:      entity.NewStringList(i.EventTypes...),
+		Statuses:        entity.NewStringList(i.Statuses...),
+		Channels:        entity.NewStringList(i.Channels...),
+		FulfilmentModes: entity.NewStringList(i.FulfilmentModes...),
+		Secret:          i.Secret,
+		Active:          i.Active,
+	}
+}
+
+type UpdateWebhookSubscriptionInput struct {
+	ID uint64
+	CreateWebhookSubscriptionInput
+}
+
+type GetWebhookSubscriptionInput struct {
+	ID uint64
+}
+
+type DeleteWebhookSubscriptionInput struct {
+	ID uint64
+}
+
+type ListWebhookSubscriptionsInput struct {
+	Page  int
+	Limit int
+}
+
+type ListWebhookDeliveriesInput struct {
+	SubscriptionID uint64
+	Status         valueobject.WebhookDeliveryStatus
+	Page           int
+	Limit          int
+}
+
+type RedeliverWebhookInput struct {
+	SubscriptionID uint64
+	DeliveryID     uint64
+}
+
+// DispatchWebhooksInput configures a dispatch of the pending deliveries
+type DispatchWebhooksInput struct {
+	// Limit is how many deliveries are sent on the dispatch
+	Limit int
+	// Lease is how long the claimed deliveries are hidden from the other dispatchers, they are sent
+	// again after it if the dispatcher stops before recording the outcome
+	Lease time.Duration
+	// MaxAttempts is how many times a delivery is sent, Backoff is the wait after the first failed attempt
+	// and it doubles after each new one
+	MaxAttempts uint32
+	Backoff     time.Duration
+	// MaxFailures is how many deliveries in a row can fail before the subscription is disabled
+	MaxFailures uint32
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type CatalogController interface {
+	Import(ctx context.Context, presenter Presenter, input dto.ImportCatalogInput) ([]byte, error)
+	Export(ctx context.Context, presenter Presenter) ([]byte, error)
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type CatalogGateway interface {
+	FindAllCategories(ctx context.Context) ([]*entity.Category, error)
+	FindAllProducts(ctx context.Context) ([]*entity.Product, error)
+	Apply(ctx context.Context, catalogImport *entity.CatalogImport) error
+}
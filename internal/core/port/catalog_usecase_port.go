@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type CatalogUseCase interface {
+	Import(ctx context.Context, input dto.ImportCatalogInput) (*entity.CatalogImport, error)
+	Export(ctx context.Context) (*entity.Catalog, error)
+}
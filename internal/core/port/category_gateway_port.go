@@ -9,7 +9,9 @@ import (
 type CategoryGateway interface {
 	FindByID(ctx context.Context, id uint64) (*entity.Category, error)
 	FindAll(ctx context.Context, name string, page, limit int) ([]*entity.Category, int64, error)
+	FindAllActive(ctx context.Context) ([]*entity.Category, error)
 	Create(ctx context.Context, category *entity.Category) error
 	Update(ctx context.Context, category *entity.Category) error
+	ReplaceAvailabilityWindows(ctx context.Context, categoryID uint64, windows []entity.CategoryAvailabilityWindow) error
 	Delete(ctx context.Context, id uint64) error
 }
@@ -0,0 +1,9 @@
+package port
+
+import "context"
+
+// EventPublisher publishes domain events to the other services
+type EventPublisher interface {
+	// Publish sends the payload, serialized by the implementation, with the event type
+	Publish(ctx context.Context, eventType string, payload any) error
+}
@@ -7,4 +7,7 @@ type JWTService interface {
 
 	// ValidateToken verifies if a token is valid without extracting data
 	ValidateToken(token string) error
+
+	// ParseToken verifies the token and returns the ID of the customer it was generated for
+	ParseToken(token string) (uint64, error)
 }
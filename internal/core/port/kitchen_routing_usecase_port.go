@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type KitchenRoutingUseCase interface {
+	Route(ctx context.Context, order *entity.Order) ([]*entity.KitchenTicket, error)
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type KitchenTicketController interface {
+	List(ctx context.Context, presenter Presenter, input dto.ListKitchenTicketsInput) ([]byte, error)
+	Update(ctx context.Context, presenter Presenter, input dto.UpdateKitchenTicketInput) ([]byte, error)
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type KitchenTicketGateway interface {
+	FindByID(ctx context.Context, id uint64) (*entity.KitchenTicket, error)
+	FindAll(ctx context.Context, station valueobject.KitchenStation, status valueobject.KitchenTicketStatus, page, limit int) ([]*entity.KitchenTicket, int64, error)
+	FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.KitchenTicket, error)
+	CreateAll(ctx context.Context, tickets []*entity.KitchenTicket) error
+	Update(ctx context.Context, ticket *entity.KitchenTicket) error
+}
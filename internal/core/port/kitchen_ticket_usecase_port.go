@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type KitchenTicketUseCase interface {
+	List(ctx context.Context, input dto.ListKitchenTicketsInput) ([]*entity.KitchenTicket, int64, error)
+	Update(ctx context.Context, input dto.UpdateKitchenTicketInput) (*entity.KitchenTicket, error)
+}
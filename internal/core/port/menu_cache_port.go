@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+// MenuCache keeps the built menu between the requests, it must be invalidated on every catalog write
+type MenuCache interface {
+	Get(ctx context.Context) (*entity.Menu, bool)
+	Set(ctx context.Context, menu *entity.Menu)
+	Invalidate(ctx context.Context)
+}
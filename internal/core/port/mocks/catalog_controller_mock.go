@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/catalog_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/catalog_controller_port.go -destination=internal/core/port/mocks/catalog_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogController is a mock of CatalogController interface.
+type MockCatalogController struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogControllerMockRecorder is the mock recorder for MockCatalogController.
+type MockCatalogControllerMockRecorder struct {
+	mock *MockCatalogController
+}
+
+// NewMockCatalogController creates a new mock instance.
+func NewMockCatalogController(ctrl *gomock.Controller) *MockCatalogController {
+	mock := &MockCatalogController{ctrl: ctrl}
+	mock.recorder = &MockCatalogControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogController) EXPECT() *MockCatalogControllerMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockCatalogController) Export(ctx context.Context, presenter port.Presenter) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, presenter)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockCatalogControllerMockRecorder) Export(ctx, presenter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockCatalogController)(nil).Export), ctx, presenter)
+}
+
+// Import mocks base method.
+func (m *MockCatalogController) Import(ctx context.Context, presenter port.Presenter, input dto.ImportCatalogInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockCatalogControllerMockRecorder) Import(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockCatalogController)(nil).Import), ctx, presenter, input)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/catalog_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/catalog_datasource_port.go -destination=internal/core/port/mocks/catalog_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogDataSource is a mock of CatalogDataSource interface.
+type MockCatalogDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogDataSourceMockRecorder is the mock recorder for MockCatalogDataSource.
+type MockCatalogDataSourceMockRecorder struct {
+	mock *MockCatalogDataSource
+}
+
+// NewMockCatalogDataSource creates a new mock instance.
+func NewMockCatalogDataSource(ctrl *gomock.Controller) *MockCatalogDataSource {
+	mock := &MockCatalogDataSource{ctrl: ctrl}
+	mock.recorder = &MockCatalogDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogDataSource) EXPECT() *MockCatalogDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockCatalogDataSource) Apply(ctx context.Context, catalogImport *entity.CatalogImport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, catalogImport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockCatalogDataSourceMockRecorder) Apply(ctx, catalogImport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockCatalogDataSource)(nil).Apply), ctx, catalogImport)
+}
+
+// FindAllCategories mocks base method.
+func (m *MockCatalogDataSource) FindAllCategories(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllCategories", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllCategories indicates an expected call of FindAllCategories.
+func (mr *MockCatalogDataSourceMockRecorder) FindAllCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllCategories", reflect.TypeOf((*MockCatalogDataSource)(nil).FindAllCategories), ctx)
+}
+
+// FindAllProducts mocks base method.
+func (m *MockCatalogDataSource) FindAllProducts(ctx context.Context) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProducts", ctx)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProducts indicates an expected call of FindAllProducts.
+func (mr *MockCatalogDataSourceMockRecorder) FindAllProducts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProducts", reflect.TypeOf((*MockCatalogDataSource)(nil).FindAllProducts), ctx)
+}
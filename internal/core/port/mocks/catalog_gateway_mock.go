@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/catalog_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/catalog_gateway_port.go -destination=internal/core/port/mocks/catalog_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogGateway is a mock of CatalogGateway interface.
+type MockCatalogGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogGatewayMockRecorder is the mock recorder for MockCatalogGateway.
+type MockCatalogGatewayMockRecorder struct {
+	mock *MockCatalogGateway
+}
+
+// NewMockCatalogGateway creates a new mock instance.
+func NewMockCatalogGateway(ctrl *gomock.Controller) *MockCatalogGateway {
+	mock := &MockCatalogGateway{ctrl: ctrl}
+	mock.recorder = &MockCatalogGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogGateway) EXPECT() *MockCatalogGatewayMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockCatalogGateway) Apply(ctx context.Context, catalogImport *entity.CatalogImport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", ctx, catalogImport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockCatalogGatewayMockRecorder) Apply(ctx, catalogImport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockCatalogGateway)(nil).Apply), ctx, catalogImport)
+}
+
+// FindAllCategories mocks base method.
+func (m *MockCatalogGateway) FindAllCategories(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllCategories", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllCategories indicates an expected call of FindAllCategories.
+func (mr *MockCatalogGatewayMockRecorder) FindAllCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllCategories", reflect.TypeOf((*MockCatalogGateway)(nil).FindAllCategories), ctx)
+}
+
+// FindAllProducts mocks base method.
+func (m *MockCatalogGateway) FindAllProducts(ctx context.Context) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllProducts", ctx)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllProducts indicates an expected call of FindAllProducts.
+func (mr *MockCatalogGatewayMockRecorder) FindAllProducts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllProducts", reflect.TypeOf((*MockCatalogGateway)(nil).FindAllProducts), ctx)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/catalog_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/catalog_usecase_port.go -destination=internal/core/port/mocks/catalog_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCatalogUseCase is a mock of CatalogUseCase interface.
+type MockCatalogUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogUseCaseMockRecorder is the mock recorder for MockCatalogUseCase.
+type MockCatalogUseCaseMockRecorder struct {
+	mock *MockCatalogUseCase
+}
+
+// NewMockCatalogUseCase creates a new mock instance.
+func NewMockCatalogUseCase(ctrl *gomock.Controller) *MockCatalogUseCase {
+	mock := &MockCatalogUseCase{ctrl: ctrl}
+	mock.recorder = &MockCatalogUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCatalogUseCase) EXPECT() *MockCatalogUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Export mocks base method.
+func (m *MockCatalogUseCase) Export(ctx context.Context) (*entity.Catalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx)
+	ret0, _ := ret[0].(*entity.Catalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockCatalogUseCaseMockRecorder) Export(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockCatalogUseCase)(nil).Export), ctx)
+}
+
+// Import mocks base method.
+func (m *MockCatalogUseCase) Import(ctx context.Context, input dto.ImportCatalogInput) (*entity.CatalogImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, input)
+	ret0, _ := ret[0].(*entity.CatalogImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockCatalogUseCaseMockRecorder) Import(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockCatalogUseCase)(nil).Import), ctx, input)
+}
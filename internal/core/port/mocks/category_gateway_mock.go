@@ -85,6 +85,21 @@ func (mr *MockCategoryGatewayMockRecorder) FindAll(ctx, name, page, limit any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockCategoryGateway)(nil).FindAll), ctx, name, page, limit)
 }
 
+// FindAllActive mocks base method.
+func (m *MockCategoryGateway) FindAllActive(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockCategoryGatewayMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockCategoryGateway)(nil).FindAllActive), ctx)
+}
+
 // FindByID mocks base method.
 func (m *MockCategoryGateway) FindByID(ctx context.Context, id uint64) (*entity.Category, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +115,20 @@ func (mr *MockCategoryGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockCategoryGateway)(nil).FindByID), ctx, id)
 }
 
+// ReplaceAvailabilityWindows mocks base method.
+func (m *MockCategoryGateway) ReplaceAvailabilityWindows(ctx context.Context, categoryID uint64, windows []entity.CategoryAvailabilityWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAvailabilityWindows", ctx, categoryID, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAvailabilityWindows indicates an expected call of ReplaceAvailabilityWindows.
+func (mr *MockCategoryGatewayMockRecorder) ReplaceAvailabilityWindows(ctx, categoryID, windows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAvailabilityWindows", reflect.TypeOf((*MockCategoryGateway)(nil).ReplaceAvailabilityWindows), ctx, categoryID, windows)
+}
+
 // Update mocks base method.
 func (m *MockCategoryGateway) Update(ctx context.Context, category *entity.Category) error {
 	m.ctrl.T.Helper()
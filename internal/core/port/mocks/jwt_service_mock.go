@@ -54,6 +54,21 @@ func (mr *MockJWTServiceMockRecorder) GenerateToken(customerID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockJWTService)(nil).GenerateToken), customerID)
 }
 
+// ParseToken mocks base method.
+func (m *MockJWTService) ParseToken(token string) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken.
+func (mr *MockJWTServiceMockRecorder) ParseToken(token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockJWTService)(nil).ParseToken), token)
+}
+
 // ValidateToken mocks base method.
 func (m *MockJWTService) ValidateToken(token string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/kitchen_routing_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/kitchen_routing_usecase_port.go -destination=internal/core/port/mocks/kitchen_routing_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKitchenRoutingUseCase is a mock of KitchenRoutingUseCase interface.
+type MockKitchenRoutingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitchenRoutingUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockKitchenRoutingUseCaseMockRecorder is the mock recorder for MockKitchenRoutingUseCase.
+type MockKitchenRoutingUseCaseMockRecorder struct {
+	mock *MockKitchenRoutingUseCase
+}
+
+// NewMockKitchenRoutingUseCase creates a new mock instance.
+func NewMockKitchenRoutingUseCase(ctrl *gomock.Controller) *MockKitchenRoutingUseCase {
+	mock := &MockKitchenRoutingUseCase{ctrl: ctrl}
+	mock.recorder = &MockKitchenRoutingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKitchenRoutingUseCase) EXPECT() *MockKitchenRoutingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Route mocks base method.
+func (m *MockKitchenRoutingUseCase) Route(ctx context.Context, order *entity.Order) ([]*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Route", ctx, order)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Route indicates an expected call of Route.
+func (mr *MockKitchenRoutingUseCaseMockRecorder) Route(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Route", reflect.TypeOf((*MockKitchenRoutingUseCase)(nil).Route), ctx, order)
+}
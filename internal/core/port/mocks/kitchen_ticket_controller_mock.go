@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/kitchen_ticket_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/kitchen_ticket_controller_port.go -destination=internal/core/port/mocks/kitchen_ticket_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKitchenTicketController is a mock of KitchenTicketController interface.
+type MockKitchenTicketController struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitchenTicketControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockKitchenTicketControllerMockRecorder is the mock recorder for MockKitchenTicketController.
+type MockKitchenTicketControllerMockRecorder struct {
+	mock *MockKitchenTicketController
+}
+
+// NewMockKitchenTicketController creates a new mock instance.
+func NewMockKitchenTicketController(ctrl *gomock.Controller) *MockKitchenTicketController {
+	mock := &MockKitchenTicketController{ctrl: ctrl}
+	mock.recorder = &MockKitchenTicketControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKitchenTicketController) EXPECT() *MockKitchenTicketControllerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockKitchenTicketController) List(ctx context.Context, presenter port.Presenter, input dto.ListKitchenTicketsInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockKitchenTicketControllerMockRecorder) List(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKitchenTicketController)(nil).List), ctx, presenter, input)
+}
+
+// Update mocks base method.
+func (m *MockKitchenTicketController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateKitchenTicketInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKitchenTicketControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKitchenTicketController)(nil).Update), ctx, presenter, input)
+}
@@ -0,0 +1,116 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/kitchen_ticket_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/kitchen_ticket_datasource_port.go -destination=internal/core/port/mocks/kitchen_ticket_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKitchenTicketDataSource is a mock of KitchenTicketDataSource interface.
+type MockKitchenTicketDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitchenTicketDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockKitchenTicketDataSourceMockRecorder is the mock recorder for MockKitchenTicketDataSource.
+type MockKitchenTicketDataSourceMockRecorder struct {
+	mock *MockKitchenTicketDataSource
+}
+
+// NewMockKitchenTicketDataSource creates a new mock instance.
+func NewMockKitchenTicketDataSource(ctrl *gomock.Controller) *MockKitchenTicketDataSource {
+	mock := &MockKitchenTicketDataSource{ctrl: ctrl}
+	mock.recorder = &MockKitchenTicketDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKitchenTicketDataSource) EXPECT() *MockKitchenTicketDataSourceMockRecorder {
+	return m.recorder
+}
+
+// CreateAll mocks base method.
+func (m *MockKitchenTicketDataSource) CreateAll(ctx context.Context, tickets []*entity.KitchenTicket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, tickets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockKitchenTicketDataSourceMockRecorder) CreateAll(ctx, tickets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockKitchenTicketDataSource)(nil).CreateAll), ctx, tickets)
+}
+
+// FindAll mocks base method.
+func (m *MockKitchenTicketDataSource) FindAll(ctx context.Context, filters map[string]any, page, limit int) ([]*entity.KitchenTicket, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filters, page, limit)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockKitchenTicketDataSourceMockRecorder) FindAll(ctx, filters, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockKitchenTicketDataSource)(nil).FindAll), ctx, filters, page, limit)
+}
+
+// FindAllByOrderID mocks base method.
+func (m *MockKitchenTicketDataSource) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByOrderID indicates an expected call of FindAllByOrderID.
+func (mr *MockKitchenTicketDataSourceMockRecorder) FindAllByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByOrderID", reflect.TypeOf((*MockKitchenTicketDataSource)(nil).FindAllByOrderID), ctx, orderID)
+}
+
+// FindByID mocks base method.
+func (m *MockKitchenTicketDataSource) FindByID(ctx context.Context, id uint64) (*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockKitchenTicketDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockKitchenTicketDataSource)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockKitchenTicketDataSource) Update(ctx context.Context, ticket *entity.KitchenTicket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ticket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKitchenTicketDataSourceMockRecorder) Update(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKitchenTicketDataSource)(nil).Update), ctx, ticket)
+}
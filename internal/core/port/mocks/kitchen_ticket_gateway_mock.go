@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/kitchen_ticket_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/kitchen_ticket_gateway_port.go -destination=internal/core/port/mocks/kitchen_ticket_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKitchenTicketGateway is a mock of KitchenTicketGateway interface.
+type MockKitchenTicketGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitchenTicketGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockKitchenTicketGatewayMockRecorder is the mock recorder for MockKitchenTicketGateway.
+type MockKitchenTicketGatewayMockRecorder struct {
+	mock *MockKitchenTicketGateway
+}
+
+// NewMockKitchenTicketGateway creates a new mock instance.
+func NewMockKitchenTicketGateway(ctrl *gomock.Controller) *MockKitchenTicketGateway {
+	mock := &MockKitchenTicketGateway{ctrl: ctrl}
+	mock.recorder = &MockKitchenTicketGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKitchenTicketGateway) EXPECT() *MockKitchenTicketGatewayMockRecorder {
+	return m.recorder
+}
+
+// CreateAll mocks base method.
+func (m *MockKitchenTicketGateway) CreateAll(ctx context.Context, tickets []*entity.KitchenTicket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAll", ctx, tickets)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAll indicates an expected call of CreateAll.
+func (mr *MockKitchenTicketGatewayMockRecorder) CreateAll(ctx, tickets any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAll", reflect.TypeOf((*MockKitchenTicketGateway)(nil).CreateAll), ctx, tickets)
+}
+
+// FindAll mocks base method.
+func (m *MockKitchenTicketGateway) FindAll(ctx context.Context, station valueobject.KitchenStation, status valueobject.KitchenTicketStatus, page, limit int) ([]*entity.KitchenTicket, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, station, status, page, limit)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockKitchenTicketGatewayMockRecorder) FindAll(ctx, station, status, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockKitchenTicketGateway)(nil).FindAll), ctx, station, status, page, limit)
+}
+
+// FindAllByOrderID mocks base method.
+func (m *MockKitchenTicketGateway) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByOrderID indicates an expected call of FindAllByOrderID.
+func (mr *MockKitchenTicketGatewayMockRecorder) FindAllByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByOrderID", reflect.TypeOf((*MockKitchenTicketGateway)(nil).FindAllByOrderID), ctx, orderID)
+}
+
+// FindByID mocks base method.
+func (m *MockKitchenTicketGateway) FindByID(ctx context.Context, id uint64) (*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockKitchenTicketGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockKitchenTicketGateway)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockKitchenTicketGateway) Update(ctx context.Context, ticket *entity.KitchenTicket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, ticket)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKitchenTicketGatewayMockRecorder) Update(ctx, ticket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKitchenTicketGateway)(nil).Update), ctx, ticket)
+}
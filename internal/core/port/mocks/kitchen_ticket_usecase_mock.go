@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/kitchen_ticket_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/kitchen_ticket_usecase_port.go -destination=internal/core/port/mocks/kitchen_ticket_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockKitchenTicketUseCase is a mock of KitchenTicketUseCase interface.
+type MockKitchenTicketUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockKitchenTicketUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockKitchenTicketUseCaseMockRecorder is the mock recorder for MockKitchenTicketUseCase.
+type MockKitchenTicketUseCaseMockRecorder struct {
+	mock *MockKitchenTicketUseCase
+}
+
+// NewMockKitchenTicketUseCase creates a new mock instance.
+func NewMockKitchenTicketUseCase(ctrl *gomock.Controller) *MockKitchenTicketUseCase {
+	mock := &MockKitchenTicketUseCase{ctrl: ctrl}
+	mock.recorder = &MockKitchenTicketUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKitchenTicketUseCase) EXPECT() *MockKitchenTicketUseCaseMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockKitchenTicketUseCase) List(ctx context.Context, input dto.ListKitchenTicketsInput) ([]*entity.KitchenTicket, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	ret0, _ := ret[0].([]*entity.KitchenTicket)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockKitchenTicketUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKitchenTicketUseCase)(nil).List), ctx, input)
+}
+
+// Update mocks base method.
+func (m *MockKitchenTicketUseCase) Update(ctx context.Context, input dto.UpdateKitchenTicketInput) (*entity.KitchenTicket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*entity.KitchenTicket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKitchenTicketUseCaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKitchenTicketUseCase)(nil).Update), ctx, input)
+}
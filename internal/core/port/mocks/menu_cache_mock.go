@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/menu_cache_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/menu_cache_port.go -destination=internal/core/port/mocks/menu_cache_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuCache is a mock of MenuCache interface.
+type MockMenuCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockMenuCacheMockRecorder is the mock recorder for MockMenuCache.
+type MockMenuCacheMockRecorder struct {
+	mock *MockMenuCache
+}
+
+// NewMockMenuCache creates a new mock instance.
+func NewMockMenuCache(ctrl *gomock.Controller) *MockMenuCache {
+	mock := &MockMenuCache{ctrl: ctrl}
+	mock.recorder = &MockMenuCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuCache) EXPECT() *MockMenuCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockMenuCache) Get(ctx context.Context) (*entity.Menu, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*entity.Menu)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMenuCacheMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMenuCache)(nil).Get), ctx)
+}
+
+// Invalidate mocks base method.
+func (m *MockMenuCache) Invalidate(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", ctx)
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockMenuCacheMockRecorder) Invalidate(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockMenuCache)(nil).Invalidate), ctx)
+}
+
+// Set mocks base method.
+func (m *MockMenuCache) Set(ctx context.Context, menu *entity.Menu) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", ctx, menu)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockMenuCacheMockRecorder) Set(ctx, menu any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockMenuCache)(nil).Set), ctx, menu)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/menu_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/menu_controller_port.go -destination=internal/core/port/mocks/menu_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuController is a mock of MenuController interface.
+type MockMenuController struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockMenuControllerMockRecorder is the mock recorder for MockMenuController.
+type MockMenuControllerMockRecorder struct {
+	mock *MockMenuController
+}
+
+// NewMockMenuController creates a new mock instance.
+func NewMockMenuController(ctrl *gomock.Controller) *MockMenuController {
+	mock := &MockMenuController{ctrl: ctrl}
+	mock.recorder = &MockMenuControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuController) EXPECT() *MockMenuControllerMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockMenuController) Get(ctx context.Context, presenter port.Presenter, input dto.GetMenuInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMenuControllerMockRecorder) Get(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMenuController)(nil).Get), ctx, presenter, input)
+}
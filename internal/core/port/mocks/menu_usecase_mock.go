@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/menu_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/menu_usecase_port.go -destination=internal/core/port/mocks/menu_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMenuUseCase is a mock of MenuUseCase interface.
+type MockMenuUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockMenuUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockMenuUseCaseMockRecorder is the mock recorder for MockMenuUseCase.
+type MockMenuUseCaseMockRecorder struct {
+	mock *MockMenuUseCase
+}
+
+// NewMockMenuUseCase creates a new mock instance.
+func NewMockMenuUseCase(ctrl *gomock.Controller) *MockMenuUseCase {
+	mock := &MockMenuUseCase{ctrl: ctrl}
+	mock.recorder = &MockMenuUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMenuUseCase) EXPECT() *MockMenuUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockMenuUseCase) Get(ctx context.Context, input dto.GetMenuInput) (*entity.Menu, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, input)
+	ret0, _ := ret[0].(*entity.Menu)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockMenuUseCaseMockRecorder) Get(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockMenuUseCase)(nil).Get), ctx, input)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/notification_preference_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/notification_preference_controller_port.go -destination=internal/core/port/mocks/notification_preference_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceController is a mock of NotificationPreferenceController interface.
+type MockNotificationPreferenceController struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceControllerMockRecorder is the mock recorder for MockNotificationPreferenceController.
+type MockNotificationPreferenceControllerMockRecorder struct {
+	mock *MockNotificationPreferenceController
+}
+
+// NewMockNotificationPreferenceController creates a new mock instance.
+func NewMockNotificationPreferenceController(ctrl *gomock.Controller) *MockNotificationPreferenceController {
+	mock := &MockNotificationPreferenceController{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceController) EXPECT() *MockNotificationPreferenceControllerMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNotificationPreferenceController) Delete(ctx context.Context, presenter port.Presenter, input dto.DeleteNotificationPreferenceInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationPreferenceControllerMockRecorder) Delete(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationPreferenceController)(nil).Delete), ctx, presenter, input)
+}
+
+// Get mocks base method.
+func (m *MockNotificationPreferenceController) Get(ctx context.Context, presenter port.Presenter, input dto.GetNotificationPreferenceInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNotificationPreferenceControllerMockRecorder) Get(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNotificationPreferenceController)(nil).Get), ctx, presenter, input)
+}
+
+// Update mocks base method.
+func (m *MockNotificationPreferenceController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateNotificationPreferenceInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationPreferenceControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationPreferenceController)(nil).Update), ctx, presenter, input)
+}
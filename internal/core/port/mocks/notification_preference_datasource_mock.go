@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/notification_preference_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/notification_preference_datasource_port.go -destination=internal/core/port/mocks/notification_preference_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceDataSource is a mock of NotificationPreferenceDataSource interface.
+type MockNotificationPreferenceDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceDataSourceMockRecorder is the mock recorder for MockNotificationPreferenceDataSource.
+type MockNotificationPreferenceDataSourceMockRecorder struct {
+	mock *MockNotificationPreferenceDataSource
+}
+
+// NewMockNotificationPreferenceDataSource creates a new mock instance.
+func NewMockNotificationPreferenceDataSource(ctrl *gomock.Controller) *MockNotificationPreferenceDataSource {
+	mock := &MockNotificationPreferenceDataSource{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceDataSource) EXPECT() *MockNotificationPreferenceDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationPreferenceDataSource) Create(ctx context.Context, preference *entity.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationPreferenceDataSourceMockRecorder) Create(ctx, preference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationPreferenceDataSource)(nil).Create), ctx, preference)
+}
+
+// Delete mocks base method.
+func (m *MockNotificationPreferenceDataSource) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationPreferenceDataSourceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationPreferenceDataSource)(nil).Delete), ctx, id)
+}
+
+// FindByCustomerID mocks base method.
+func (m *MockNotificationPreferenceDataSource) FindByCustomerID(ctx context.Context, customerID uint64) (*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomerID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCustomerID indicates an expected call of FindByCustomerID.
+func (mr *MockNotificationPreferenceDataSourceMockRecorder) FindByCustomerID(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomerID", reflect.TypeOf((*MockNotificationPreferenceDataSource)(nil).FindByCustomerID), ctx, customerID)
+}
+
+// Update mocks base method.
+func (m *MockNotificationPreferenceDataSource) Update(ctx context.Context, preference *entity.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationPreferenceDataSourceMockRecorder) Update(ctx, preference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationPreferenceDataSource)(nil).Update), ctx, preference)
+}
@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/notification_preference_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/notification_preference_gateway_port.go -destination=internal/core/port/mocks/notification_preference_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceGateway is a mock of NotificationPreferenceGateway interface.
+type MockNotificationPreferenceGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceGatewayMockRecorder is the mock recorder for MockNotificationPreferenceGateway.
+type MockNotificationPreferenceGatewayMockRecorder struct {
+	mock *MockNotificationPreferenceGateway
+}
+
+// NewMockNotificationPreferenceGateway creates a new mock instance.
+func NewMockNotificationPreferenceGateway(ctrl *gomock.Controller) *MockNotificationPreferenceGateway {
+	mock := &MockNotificationPreferenceGateway{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceGateway) EXPECT() *MockNotificationPreferenceGatewayMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockNotificationPreferenceGateway) Create(ctx context.Context, preference *entity.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockNotificationPreferenceGatewayMockRecorder) Create(ctx, preference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNotificationPreferenceGateway)(nil).Create), ctx, preference)
+}
+
+// Delete mocks base method.
+func (m *MockNotificationPreferenceGateway) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationPreferenceGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationPreferenceGateway)(nil).Delete), ctx, id)
+}
+
+// FindByCustomerID mocks base method.
+func (m *MockNotificationPreferenceGateway) FindByCustomerID(ctx context.Context, customerID uint64) (*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomerID", ctx, customerID)
+	ret0, _ := ret[0].(*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCustomerID indicates an expected call of FindByCustomerID.
+func (mr *MockNotificationPreferenceGatewayMockRecorder) FindByCustomerID(ctx, customerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomerID", reflect.TypeOf((*MockNotificationPreferenceGateway)(nil).FindByCustomerID), ctx, customerID)
+}
+
+// Update mocks base method.
+func (m *MockNotificationPreferenceGateway) Update(ctx context.Context, preference *entity.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, preference)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationPreferenceGatewayMockRecorder) Update(ctx, preference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationPreferenceGateway)(nil).Update), ctx, preference)
+}
@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/notification_preference_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/notification_preference_usecase_port.go -destination=internal/core/port/mocks/notification_preference_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationPreferenceUseCase is a mock of NotificationPreferenceUseCase interface.
+type MockNotificationPreferenceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationPreferenceUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationPreferenceUseCaseMockRecorder is the mock recorder for MockNotificationPreferenceUseCase.
+type MockNotificationPreferenceUseCaseMockRecorder struct {
+	mock *MockNotificationPreferenceUseCase
+}
+
+// NewMockNotificationPreferenceUseCase creates a new mock instance.
+func NewMockNotificationPreferenceUseCase(ctrl *gomock.Controller) *MockNotificationPreferenceUseCase {
+	mock := &MockNotificationPreferenceUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationPreferenceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationPreferenceUseCase) EXPECT() *MockNotificationPreferenceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNotificationPreferenceUseCase) Delete(ctx context.Context, input dto.DeleteNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, input)
+	ret0, _ := ret[0].(*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNotificationPreferenceUseCaseMockRecorder) Delete(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNotificationPreferenceUseCase)(nil).Delete), ctx, input)
+}
+
+// Get mocks base method.
+func (m *MockNotificationPreferenceUseCase) Get(ctx context.Context, input dto.GetNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, input)
+	ret0, _ := ret[0].(*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNotificationPreferenceUseCaseMockRecorder) Get(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNotificationPreferenceUseCase)(nil).Get), ctx, input)
+}
+
+// Update mocks base method.
+func (m *MockNotificationPreferenceUseCase) Update(ctx context.Context, input dto.UpdateNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*entity.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNotificationPreferenceUseCaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNotificationPreferenceUseCase)(nil).Update), ctx, input)
+}
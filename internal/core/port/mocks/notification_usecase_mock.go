@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/notification_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/notification_usecase_port.go -destination=internal/core/port/mocks/notification_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNotificationUseCase is a mock of NotificationUseCase interface.
+type MockNotificationUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockNotificationUseCaseMockRecorder is the mock recorder for MockNotificationUseCase.
+type MockNotificationUseCaseMockRecorder struct {
+	mock *MockNotificationUseCase
+}
+
+// NewMockNotificationUseCase creates a new mock instance.
+func NewMockNotificationUseCase(ctrl *gomock.Controller) *MockNotificationUseCase {
+	mock := &MockNotificationUseCase{ctrl: ctrl}
+	mock.recorder = &MockNotificationUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationUseCase) EXPECT() *MockNotificationUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Notify mocks base method.
+func (m *MockNotificationUseCase) Notify(ctx context.Context, event entity.OrderEvent) ([]*entity.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", ctx, event)
+	ret0, _ := ret[0].([]*entity.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationUseCaseMockRecorder) Notify(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationUseCase)(nil).Notify), ctx, event)
+}
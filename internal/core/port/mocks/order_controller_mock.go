@@ -42,13 +42,30 @@ func (m *MockOrderController) EXPECT() *MockOrderControllerMockRecorder {
 	return m.recorder
 }
 
+// AttachCustomer mocks base method.
+func (m *MockOrderController) AttachCustomer(ctx context.Context, presenter port.Presenter, input dto.AttachOrderCustomerInput) ([]byte, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCustomer", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// AttachCustomer indicates an expected call of AttachCustomer.
+func (mr *MockOrderControllerMockRecorder) AttachCustomer(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCustomer", reflect.TypeOf((*MockOrderController)(nil).AttachCustomer), ctx, presenter, input)
+}
+
 // Create mocks base method.
-func (m *MockOrderController) Create(ctx context.Context, presenter port.Presenter, input dto.CreateOrderInput) ([]byte, error) {
+func (m *MockOrderController) Create(ctx context.Context, presenter port.Presenter, input dto.CreateOrderInput) ([]byte, uint32, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, presenter, input)
 	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Create indicates an expected call of Create.
@@ -73,12 +90,13 @@ func (mr *MockOrderControllerMockRecorder) Delete(ctx, presenter, input any) *go
 }
 
 // Get mocks base method.
-func (m *MockOrderController) Get(ctx context.Context, presenter port.Presenter, input dto.GetOrderInput) ([]byte, error) {
+func (m *MockOrderController) Get(ctx context.Context, presenter port.Presenter, input dto.GetOrderInput) ([]byte, uint32, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, presenter, input)
 	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // Get indicates an expected call of Get.
@@ -87,6 +105,37 @@ func (mr *MockOrderControllerMockRecorder) Get(ctx, presenter, input any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrderController)(nil).Get), ctx, presenter, input)
 }
 
+// GetByGuestToken mocks base method.
+func (m *MockOrderController) GetByGuestToken(ctx context.Context, presenter port.Presenter, input dto.GetGuestOrderInput) ([]byte, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByGuestToken", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetByGuestToken indicates an expected call of GetByGuestToken.
+func (mr *MockOrderControllerMockRecorder) GetByGuestToken(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByGuestToken", reflect.TypeOf((*MockOrderController)(nil).GetByGuestToken), ctx, presenter, input)
+}
+
+// GetStatusMachine mocks base method.
+func (m *MockOrderController) GetStatusMachine(ctx context.Context, presenter port.Presenter) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusMachine", ctx, presenter)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusMachine indicates an expected call of GetStatusMachine.
+func (mr *MockOrderControllerMockRecorder) GetStatusMachine(ctx, presenter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusMachine", reflect.TypeOf((*MockOrderController)(nil).GetStatusMachine), ctx, presenter)
+}
+
 // List mocks base method.
 func (m *MockOrderController) List(ctx context.Context, presenter port.Presenter, input dto.ListOrdersInput) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -102,15 +151,31 @@ func (mr *MockOrderControllerMockRecorder) List(ctx, presenter, input any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderController)(nil).List), ctx, presenter, input)
 }
 
-// Update mocks base method.
-func (m *MockOrderController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateOrderInput) ([]byte, error) {
+// ListByCustomer mocks base method.
+func (m *MockOrderController) ListByCustomer(ctx context.Context, presenter port.Presenter, input dto.ListCustomerOrdersInput) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret := m.ctrl.Call(m, "ListByCustomer", ctx, presenter, input)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// ListByCustomer indicates an expected call of ListByCustomer.
+func (mr *MockOrderControllerMockRecorder) ListByCustomer(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCustomer", reflect.TypeOf((*MockOrderController)(nil).ListByCustomer), ctx, presenter, input)
+}
+
+// Update mocks base method.
+func (m *MockOrderController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateOrderInput) ([]byte, uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(uint32)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
 // Update indicates an expected call of Update.
 func (mr *MockOrderControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mock_port
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -100,6 +101,36 @@ func (mr *MockOrderDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderDataSource)(nil).FindByID), ctx, id)
 }
 
+// NextPickupNumber mocks base method.
+func (m *MockOrderDataSource) NextPickupNumber(ctx context.Context, day time.Time) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextPickupNumber", ctx, day)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextPickupNumber indicates an expected call of NextPickupNumber.
+func (mr *MockOrderDataSourceMockRecorder) NextPickupNumber(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextPickupNumber", reflect.TypeOf((*MockOrderDataSource)(nil).NextPickupNumber), ctx, day)
+}
+
+// SumTotals mocks base method.
+func (m *MockOrderDataSource) SumTotals(ctx context.Context, filters map[string]any) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumTotals", ctx, filters)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumTotals indicates an expected call of SumTotals.
+func (mr *MockOrderDataSourceMockRecorder) SumTotals(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumTotals", reflect.TypeOf((*MockOrderDataSource)(nil).SumTotals), ctx, filters)
+}
+
 // Transaction mocks base method.
 func (m *MockOrderDataSource) Transaction(ctx context.Context, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
@@ -127,3 +158,17 @@ func (mr *MockOrderDataSourceMockRecorder) Update(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrderDataSource)(nil).Update), ctx, order)
 }
+
+// UpdateTotals mocks base method.
+func (m *MockOrderDataSource) UpdateTotals(ctx context.Context, order *entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTotals", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTotals indicates an expected call of UpdateTotals.
+func (mr *MockOrderDataSourceMockRecorder) UpdateTotals(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTotals", reflect.TypeOf((*MockOrderDataSource)(nil).UpdateTotals), ctx, order)
+}
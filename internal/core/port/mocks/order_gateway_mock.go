@@ -12,6 +12,7 @@ package mock_port
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
@@ -71,9 +72,9 @@ func (mr *MockOrderGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // FindAll mocks base method.
-func (m *MockOrderGateway) FindAll(ctx context.Context, customerId uint64, status, statusExclude []valueobject.OrderStatus, page, limit int, sort string) ([]*entity.Order, int64, error) {
+func (m *MockOrderGateway) FindAll(ctx context.Context, customerId uint64, status, statusExclude []valueobject.OrderStatus, channels []valueobject.OrderChannel, fulfilmentModes []valueobject.FulfilmentMode, page, limit int, sort string) ([]*entity.Order, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, customerId, status, statusExclude, page, limit, sort)
+	ret := m.ctrl.Call(m, "FindAll", ctx, customerId, status, statusExclude, channels, fulfilmentModes, page, limit, sort)
 	ret0, _ := ret[0].([]*entity.Order)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -81,9 +82,40 @@ func (m *MockOrderGateway) FindAll(ctx context.Context, customerId uint64, statu
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockOrderGatewayMockRecorder) FindAll(ctx, customerId, status, statusExclude, page, limit, sort any) *gomock.Call {
+func (mr *MockOrderGatewayMockRecorder) FindAll(ctx, customerId, status, statusExclude, channels, fulfilmentModes, page, limit, sort any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderGateway)(nil).FindAll), ctx, customerId, status, statusExclude, page, limit, sort)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderGateway)(nil).FindAll), ctx, customerId, status, statusExclude, channels, fulfilmentModes, page, limit, sort)
+}
+
+// FindByCustomer mocks base method.
+func (m *MockOrderGateway) FindByCustomer(ctx context.Context, customerID uint64, from, to time.Time, page, limit int) ([]*entity.Order, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCustomer", ctx, customerID, from, to, page, limit)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByCustomer indicates an expected call of FindByCustomer.
+func (mr *MockOrderGatewayMockRecorder) FindByCustomer(ctx, customerID, from, to, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCustomer", reflect.TypeOf((*MockOrderGateway)(nil).FindByCustomer), ctx, customerID, from, to, page, limit)
+}
+
+// FindByGuestToken mocks base method.
+func (m *MockOrderGateway) FindByGuestToken(ctx context.Context, guestToken string) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByGuestToken", ctx, guestToken)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByGuestToken indicates an expected call of FindByGuestToken.
+func (mr *MockOrderGatewayMockRecorder) FindByGuestToken(ctx, guestToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByGuestToken", reflect.TypeOf((*MockOrderGateway)(nil).FindByGuestToken), ctx, guestToken)
 }
 
 // FindByID mocks base method.
@@ -101,6 +133,95 @@ func (mr *MockOrderGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderGateway)(nil).FindByID), ctx, id)
 }
 
+// FindByPaymentReference mocks base method.
+func (m *MockOrderGateway) FindByPaymentReference(ctx context.Context, paymentReference string) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPaymentReference", ctx, paymentReference)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPaymentReference indicates an expected call of FindByPaymentReference.
+func (mr *MockOrderGatewayMockRecorder) FindByPaymentReference(ctx, paymentReference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPaymentReference", reflect.TypeOf((*MockOrderGateway)(nil).FindByPaymentReference), ctx, paymentReference)
+}
+
+// FindIdle mocks base method.
+func (m *MockOrderGateway) FindIdle(ctx context.Context, status valueobject.OrderStatus, updatedBefore time.Time, limit int) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindIdle", ctx, status, updatedBefore, limit)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindIdle indicates an expected call of FindIdle.
+func (mr *MockOrderGatewayMockRecorder) FindIdle(ctx, status, updatedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIdle", reflect.TypeOf((*MockOrderGateway)(nil).FindIdle), ctx, status, updatedBefore, limit)
+}
+
+// FindOnPickupBoard mocks base method.
+func (m *MockOrderGateway) FindOnPickupBoard(ctx context.Context, day time.Time, limit int) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOnPickupBoard", ctx, day, limit)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOnPickupBoard indicates an expected call of FindOnPickupBoard.
+func (mr *MockOrderGatewayMockRecorder) FindOnPickupBoard(ctx, day, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOnPickupBoard", reflect.TypeOf((*MockOrderGateway)(nil).FindOnPickupBoard), ctx, day, limit)
+}
+
+// NextPickupNumber mocks base method.
+func (m *MockOrderGateway) NextPickupNumber(ctx context.Context, day time.Time) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextPickupNumber", ctx, day)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextPickupNumber indicates an expected call of NextPickupNumber.
+func (mr *MockOrderGatewayMockRecorder) NextPickupNumber(ctx, day any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextPickupNumber", reflect.TypeOf((*MockOrderGateway)(nil).NextPickupNumber), ctx, day)
+}
+
+// SumCustomerSpending mocks base method.
+func (m *MockOrderGateway) SumCustomerSpending(ctx context.Context, customerID uint64, from, to time.Time) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SumCustomerSpending", ctx, customerID, from, to)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SumCustomerSpending indicates an expected call of SumCustomerSpending.
+func (mr *MockOrderGatewayMockRecorder) SumCustomerSpending(ctx, customerID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SumCustomerSpending", reflect.TypeOf((*MockOrderGateway)(nil).SumCustomerSpending), ctx, customerID, from, to)
+}
+
+// Transaction mocks base method.
+func (m *MockOrderGateway) Transaction(ctx context.Context, fn func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transaction", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transaction indicates an expected call of Transaction.
+func (mr *MockOrderGatewayMockRecorder) Transaction(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transaction", reflect.TypeOf((*MockOrderGateway)(nil).Transaction), ctx, fn)
+}
+
 // Update mocks base method.
 func (m *MockOrderGateway) Update(ctx context.Context, order *entity.Order) error {
 	m.ctrl.T.Helper()
@@ -114,3 +235,17 @@ func (mr *MockOrderGatewayMockRecorder) Update(ctx, order any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrderGateway)(nil).Update), ctx, order)
 }
+
+// UpdateTotals mocks base method.
+func (m *MockOrderGateway) UpdateTotals(ctx context.Context, order *entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTotals", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTotals indicates an expected call of UpdateTotals.
+func (mr *MockOrderGatewayMockRecorder) UpdateTotals(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTotals", reflect.TypeOf((*MockOrderGateway)(nil).UpdateTotals), ctx, order)
+}
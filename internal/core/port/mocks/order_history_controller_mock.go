@@ -42,36 +42,6 @@ func (m *MockOrderHistoryController) EXPECT() *MockOrderHistoryControllerMockRec
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockOrderHistoryController) Create(ctx context.Context, presenter port.Presenter, input dto.CreateOrderHistoryInput) ([]byte, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, presenter, input)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Create indicates an expected call of Create.
-func (mr *MockOrderHistoryControllerMockRecorder) Create(ctx, presenter, input any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryController)(nil).Create), ctx, presenter, input)
-}
-
-// Delete mocks base method.
-func (m *MockOrderHistoryController) Delete(ctx context.Context, presenter port.Presenter, input dto.DeleteOrderHistoryInput) ([]byte, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, presenter, input)
-	ret0, _ := ret[0].([]byte)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockOrderHistoryControllerMockRecorder) Delete(ctx, presenter, input any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderHistoryController)(nil).Delete), ctx, presenter, input)
-}
-
 // Get mocks base method.
 func (m *MockOrderHistoryController) Get(ctx context.Context, presenter port.Presenter, input dto.GetOrderHistoryInput) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -101,3 +71,18 @@ func (mr *MockOrderHistoryControllerMockRecorder) List(ctx, presenter, input any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderHistoryController)(nil).List), ctx, presenter, input)
 }
+
+// Verify mocks base method.
+func (m *MockOrderHistoryController) Verify(ctx context.Context, presenter port.Presenter, input dto.VerifyOrderHistoriesInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockOrderHistoryControllerMockRecorder) Verify(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockOrderHistoryController)(nil).Verify), ctx, presenter, input)
+}
@@ -42,31 +42,17 @@ func (m *MockOrderHistoryDataSource) EXPECT() *MockOrderHistoryDataSourceMockRec
 }
 
 // Create mocks base method.
-func (m *MockOrderHistoryDataSource) Create(ctx context.Context, entity *entity.OrderHistory) error {
+func (m *MockOrderHistoryDataSource) Create(ctx context.Context, arg1 *entity.OrderHistory) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOrderHistoryDataSourceMockRecorder) Create(ctx, entity any) *gomock.Call {
+func (mr *MockOrderHistoryDataSourceMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).Create), ctx, entity)
-}
-
-// Delete mocks base method.
-func (m *MockOrderHistoryDataSource) Delete(ctx context.Context, id uint64) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockOrderHistoryDataSourceMockRecorder) Delete(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).Create), ctx, arg1)
 }
 
 // FindAll mocks base method.
@@ -85,6 +71,21 @@ func (mr *MockOrderHistoryDataSourceMockRecorder) FindAll(ctx, filters, page, li
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).FindAll), ctx, filters, page, limit)
 }
 
+// FindAllByOrderID mocks base method.
+func (m *MockOrderHistoryDataSource) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*entity.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByOrderID indicates an expected call of FindAllByOrderID.
+func (mr *MockOrderHistoryDataSourceMockRecorder) FindAllByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByOrderID", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).FindAllByOrderID), ctx, orderID)
+}
+
 // FindByID mocks base method.
 func (m *MockOrderHistoryDataSource) FindByID(ctx context.Context, id uint64) (*entity.OrderHistory, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +101,21 @@ func (mr *MockOrderHistoryDataSourceMockRecorder) FindByID(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).FindByID), ctx, id)
 }
 
+// FindLastSealedByOrderID mocks base method.
+func (m *MockOrderHistoryDataSource) FindLastSealedByOrderID(ctx context.Context, orderID uint64) (*entity.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLastSealedByOrderID", ctx, orderID)
+	ret0, _ := ret[0].(*entity.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLastSealedByOrderID indicates an expected call of FindLastSealedByOrderID.
+func (mr *MockOrderHistoryDataSourceMockRecorder) FindLastSealedByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLastSealedByOrderID", reflect.TypeOf((*MockOrderHistoryDataSource)(nil).FindLastSealedByOrderID), ctx, orderID)
+}
+
 // Transaction mocks base method.
 func (m *MockOrderHistoryDataSource) Transaction(ctx context.Context, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
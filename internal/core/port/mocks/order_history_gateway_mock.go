@@ -43,31 +43,17 @@ func (m *MockOrderHistoryGateway) EXPECT() *MockOrderHistoryGatewayMockRecorder
 }
 
 // Create mocks base method.
-func (m *MockOrderHistoryGateway) Create(ctx context.Context, entity *entity.OrderHistory) error {
+func (m *MockOrderHistoryGateway) Create(ctx context.Context, arg1 *entity.OrderHistory) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, entity)
+	ret := m.ctrl.Call(m, "Create", ctx, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockOrderHistoryGatewayMockRecorder) Create(ctx, entity any) *gomock.Call {
+func (mr *MockOrderHistoryGatewayMockRecorder) Create(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryGateway)(nil).Create), ctx, entity)
-}
-
-// Delete mocks base method.
-func (m *MockOrderHistoryGateway) Delete(ctx context.Context, id uint64) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockOrderHistoryGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderHistoryGateway)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryGateway)(nil).Create), ctx, arg1)
 }
 
 // FindAll mocks base method.
@@ -86,6 +72,21 @@ func (mr *MockOrderHistoryGatewayMockRecorder) FindAll(ctx, orderID, status, pag
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderHistoryGateway)(nil).FindAll), ctx, orderID, status, page, limit)
 }
 
+// FindAllByOrderID mocks base method.
+func (m *MockOrderHistoryGateway) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByOrderID", ctx, orderID)
+	ret0, _ := ret[0].([]*entity.OrderHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByOrderID indicates an expected call of FindAllByOrderID.
+func (mr *MockOrderHistoryGatewayMockRecorder) FindAllByOrderID(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByOrderID", reflect.TypeOf((*MockOrderHistoryGateway)(nil).FindAllByOrderID), ctx, orderID)
+}
+
 // FindByID mocks base method.
 func (m *MockOrderHistoryGateway) FindByID(ctx context.Context, id uint64) (*entity.OrderHistory, error) {
 	m.ctrl.T.Helper()
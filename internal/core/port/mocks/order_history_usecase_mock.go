@@ -42,36 +42,6 @@ func (m *MockOrderHistoryUseCase) EXPECT() *MockOrderHistoryUseCaseMockRecorder
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockOrderHistoryUseCase) Create(ctx context.Context, input dto.CreateOrderHistoryInput) (*entity.OrderHistory, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, input)
-	ret0, _ := ret[0].(*entity.OrderHistory)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Create indicates an expected call of Create.
-func (mr *MockOrderHistoryUseCaseMockRecorder) Create(ctx, input any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrderHistoryUseCase)(nil).Create), ctx, input)
-}
-
-// Delete mocks base method.
-func (m *MockOrderHistoryUseCase) Delete(ctx context.Context, input dto.DeleteOrderHistoryInput) (*entity.OrderHistory, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, input)
-	ret0, _ := ret[0].(*entity.OrderHistory)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Delete indicates an expected call of Delete.
-func (mr *MockOrderHistoryUseCaseMockRecorder) Delete(ctx, input any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderHistoryUseCase)(nil).Delete), ctx, input)
-}
-
 // Get mocks base method.
 func (m *MockOrderHistoryUseCase) Get(ctx context.Context, input dto.GetOrderHistoryInput) (*entity.OrderHistory, error) {
 	m.ctrl.T.Helper()
@@ -102,3 +72,18 @@ func (mr *MockOrderHistoryUseCaseMockRecorder) List(ctx, input any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderHistoryUseCase)(nil).List), ctx, input)
 }
+
+// Verify mocks base method.
+func (m *MockOrderHistoryUseCase) Verify(ctx context.Context, input dto.VerifyOrderHistoriesInput) (*entity.OrderHistoryVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", ctx, input)
+	ret0, _ := ret[0].(*entity.OrderHistoryVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockOrderHistoryUseCaseMockRecorder) Verify(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockOrderHistoryUseCase)(nil).Verify), ctx, input)
+}
@@ -102,6 +102,21 @@ func (mr *MockOrderProductControllerMockRecorder) List(ctx, presenter, input any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderProductController)(nil).List), ctx, presenter, input)
 }
 
+// SalesReport mocks base method.
+func (m *MockOrderProductController) SalesReport(ctx context.Context, presenter port.Presenter, input dto.GetSalesReportInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesReport", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesReport indicates an expected call of SalesReport.
+func (mr *MockOrderProductControllerMockRecorder) SalesReport(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesReport", reflect.TypeOf((*MockOrderProductController)(nil).SalesReport), ctx, presenter, input)
+}
+
 // Update mocks base method.
 func (m *MockOrderProductController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateOrderProductInput) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -12,6 +12,7 @@ package mock_port
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -56,17 +57,17 @@ func (mr *MockOrderProductGatewayMockRecorder) Create(ctx, orderProduct any) *go
 }
 
 // Delete mocks base method.
-func (m *MockOrderProductGateway) Delete(ctx context.Context, orderId, productId uint64) error {
+func (m *MockOrderProductGateway) Delete(ctx context.Context, id uint64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, orderId, productId)
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockOrderProductGatewayMockRecorder) Delete(ctx, orderId, productId any) *gomock.Call {
+func (mr *MockOrderProductGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderProductGateway)(nil).Delete), ctx, orderId, productId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderProductGateway)(nil).Delete), ctx, id)
 }
 
 // FindAll mocks base method.
@@ -85,19 +86,34 @@ func (mr *MockOrderProductGatewayMockRecorder) FindAll(ctx, orderId, productId,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockOrderProductGateway)(nil).FindAll), ctx, orderId, productId, page, limit)
 }
 
+// FindAllSold mocks base method.
+func (m *MockOrderProductGateway) FindAllSold(ctx context.Context, from, to time.Time) ([]*entity.OrderProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllSold", ctx, from, to)
+	ret0, _ := ret[0].([]*entity.OrderProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllSold indicates an expected call of FindAllSold.
+func (mr *MockOrderProductGatewayMockRecorder) FindAllSold(ctx, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllSold", reflect.TypeOf((*MockOrderProductGateway)(nil).FindAllSold), ctx, from, to)
+}
+
 // FindByID mocks base method.
-func (m *MockOrderProductGateway) FindByID(ctx context.Context, orderId, productId uint64) (*entity.OrderProduct, error) {
+func (m *MockOrderProductGateway) FindByID(ctx context.Context, id uint64) (*entity.OrderProduct, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByID", ctx, orderId, productId)
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
 	ret0, _ := ret[0].(*entity.OrderProduct)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindByID indicates an expected call of FindByID.
-func (mr *MockOrderProductGatewayMockRecorder) FindByID(ctx, orderId, productId any) *gomock.Call {
+func (mr *MockOrderProductGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderProductGateway)(nil).FindByID), ctx, orderId, productId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockOrderProductGateway)(nil).FindByID), ctx, id)
 }
 
 // Update mocks base method.
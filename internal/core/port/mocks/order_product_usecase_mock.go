@@ -103,6 +103,21 @@ func (mr *MockOrderProductUseCaseMockRecorder) List(ctx, input any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderProductUseCase)(nil).List), ctx, input)
 }
 
+// SalesReport mocks base method.
+func (m *MockOrderProductUseCase) SalesReport(ctx context.Context, input dto.GetSalesReportInput) (*entity.SalesReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SalesReport", ctx, input)
+	ret0, _ := ret[0].(*entity.SalesReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SalesReport indicates an expected call of SalesReport.
+func (mr *MockOrderProductUseCaseMockRecorder) SalesReport(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SalesReport", reflect.TypeOf((*MockOrderProductUseCase)(nil).SalesReport), ctx, input)
+}
+
 // Update mocks base method.
 func (m *MockOrderProductUseCase) Update(ctx context.Context, input dto.UpdateOrderProductInput) (*entity.OrderProduct, error) {
 	m.ctrl.T.Helper()
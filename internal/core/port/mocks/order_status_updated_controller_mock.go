@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/order_status_updated_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/order_status_updated_controller_port.go -destination=internal/core/port/mocks/order_status_updated_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrderStatusUpdatedController is a mock of OrderStatusUpdatedController interface.
+type MockOrderStatusUpdatedController struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderStatusUpdatedControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderStatusUpdatedControllerMockRecorder is the mock recorder for MockOrderStatusUpdatedController.
+type MockOrderStatusUpdatedControllerMockRecorder struct {
+	mock *MockOrderStatusUpdatedController
+}
+
+// NewMockOrderStatusUpdatedController creates a new mock instance.
+func NewMockOrderStatusUpdatedController(ctrl *gomock.Controller) *MockOrderStatusUpdatedController {
+	mock := &MockOrderStatusUpdatedController{ctrl: ctrl}
+	mock.recorder = &MockOrderStatusUpdatedControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderStatusUpdatedController) EXPECT() *MockOrderStatusUpdatedControllerMockRecorder {
+	return m.recorder
+}
+
+// Process mocks base method.
+func (m *MockOrderStatusUpdatedController) Process(ctx context.Context, presenter port.Presenter, input dto.ProcessOrderStatusUpdatedInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockOrderStatusUpdatedControllerMockRecorder) Process(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockOrderStatusUpdatedController)(nil).Process), ctx, presenter, input)
+}
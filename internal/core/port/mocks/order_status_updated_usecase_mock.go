@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/order_status_updated_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/order_status_updated_usecase_port.go -destination=internal/core/port/mocks/order_status_updated_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrderStatusUpdatedUseCase is a mock of OrderStatusUpdatedUseCase interface.
+type MockOrderStatusUpdatedUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrderStatusUpdatedUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockOrderStatusUpdatedUseCaseMockRecorder is the mock recorder for MockOrderStatusUpdatedUseCase.
+type MockOrderStatusUpdatedUseCaseMockRecorder struct {
+	mock *MockOrderStatusUpdatedUseCase
+}
+
+// NewMockOrderStatusUpdatedUseCase creates a new mock instance.
+func NewMockOrderStatusUpdatedUseCase(ctrl *gomock.Controller) *MockOrderStatusUpdatedUseCase {
+	mock := &MockOrderStatusUpdatedUseCase{ctrl: ctrl}
+	mock.recorder = &MockOrderStatusUpdatedUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrderStatusUpdatedUseCase) EXPECT() *MockOrderStatusUpdatedUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Process mocks base method.
+func (m *MockOrderStatusUpdatedUseCase) Process(ctx context.Context, input dto.ProcessOrderStatusUpdatedInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Process", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Process indicates an expected call of Process.
+func (mr *MockOrderStatusUpdatedUseCaseMockRecorder) Process(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Process", reflect.TypeOf((*MockOrderStatusUpdatedUseCase)(nil).Process), ctx, input)
+}
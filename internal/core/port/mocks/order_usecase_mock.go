@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +43,21 @@ func (m *MockOrderUseCase) EXPECT() *MockOrderUseCaseMockRecorder {
 	return m.recorder
 }
 
+// AttachCustomer mocks base method.
+func (m *MockOrderUseCase) AttachCustomer(ctx context.Context, input dto.AttachOrderCustomerInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachCustomer", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachCustomer indicates an expected call of AttachCustomer.
+func (mr *MockOrderUseCaseMockRecorder) AttachCustomer(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachCustomer", reflect.TypeOf((*MockOrderUseCase)(nil).AttachCustomer), ctx, input)
+}
+
 // Create mocks base method.
 func (m *MockOrderUseCase) Create(ctx context.Context, input dto.CreateOrderInput) (*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +88,21 @@ func (mr *MockOrderUseCaseMockRecorder) Delete(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrderUseCase)(nil).Delete), ctx, input)
 }
 
+// ExpireIdle mocks base method.
+func (m *MockOrderUseCase) ExpireIdle(ctx context.Context, input dto.ExpireIdleOrdersInput) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireIdle", ctx, input)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireIdle indicates an expected call of ExpireIdle.
+func (mr *MockOrderUseCaseMockRecorder) ExpireIdle(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireIdle", reflect.TypeOf((*MockOrderUseCase)(nil).ExpireIdle), ctx, input)
+}
+
 // Get mocks base method.
 func (m *MockOrderUseCase) Get(ctx context.Context, input dto.GetOrderInput) (*entity.Order, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +118,35 @@ func (mr *MockOrderUseCaseMockRecorder) Get(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockOrderUseCase)(nil).Get), ctx, input)
 }
 
+// GetByGuestToken mocks base method.
+func (m *MockOrderUseCase) GetByGuestToken(ctx context.Context, input dto.GetGuestOrderInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByGuestToken", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByGuestToken indicates an expected call of GetByGuestToken.
+func (mr *MockOrderUseCaseMockRecorder) GetByGuestToken(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByGuestToken", reflect.TypeOf((*MockOrderUseCase)(nil).GetByGuestToken), ctx, input)
+}
+
+// GetStatusMachine mocks base method.
+func (m *MockOrderUseCase) GetStatusMachine(ctx context.Context) *valueobject.OrderStatusMachine {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusMachine", ctx)
+	ret0, _ := ret[0].(*valueobject.OrderStatusMachine)
+	return ret0
+}
+
+// GetStatusMachine indicates an expected call of GetStatusMachine.
+func (mr *MockOrderUseCaseMockRecorder) GetStatusMachine(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusMachine", reflect.TypeOf((*MockOrderUseCase)(nil).GetStatusMachine), ctx)
+}
+
 // List mocks base method.
 func (m *MockOrderUseCase) List(ctx context.Context, input dto.ListOrdersInput) ([]*entity.Order, int64, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +163,22 @@ func (mr *MockOrderUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockOrderUseCase)(nil).List), ctx, input)
 }
 
+// ListByCustomer mocks base method.
+func (m *MockOrderUseCase) ListByCustomer(ctx context.Context, input dto.ListCustomerOrdersInput) (*entity.CustomerOrderHistory, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByCustomer", ctx, input)
+	ret0, _ := ret[0].(*entity.CustomerOrderHistory)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListByCustomer indicates an expected call of ListByCustomer.
+func (mr *MockOrderUseCaseMockRecorder) ListByCustomer(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByCustomer", reflect.TypeOf((*MockOrderUseCase)(nil).ListByCustomer), ctx, input)
+}
+
 // Update mocks base method.
 func (m *MockOrderUseCase) Update(ctx context.Context, input dto.UpdateOrderInput) (*entity.Order, error) {
 	m.ctrl.T.Helper()
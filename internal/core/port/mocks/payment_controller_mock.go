@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/payment_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/payment_controller_port.go -destination=internal/core/port/mocks/payment_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentController is a mock of PaymentController interface.
+type MockPaymentController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentControllerMockRecorder is the mock recorder for MockPaymentController.
+type MockPaymentControllerMockRecorder struct {
+	mock *MockPaymentController
+}
+
+// NewMockPaymentController creates a new mock instance.
+func NewMockPaymentController(ctrl *gomock.Controller) *MockPaymentController {
+	mock := &MockPaymentController{ctrl: ctrl}
+	mock.recorder = &MockPaymentControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentController) EXPECT() *MockPaymentControllerMockRecorder {
+	return m.recorder
+}
+
+// Callback mocks base method.
+func (m *MockPaymentController) Callback(ctx context.Context, presenter port.Presenter, input dto.PaymentCallbackInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockPaymentControllerMockRecorder) Callback(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockPaymentController)(nil).Callback), ctx, presenter, input)
+}
+
+// Checkout mocks base method.
+func (m *MockPaymentController) Checkout(ctx context.Context, presenter port.Presenter, input dto.CheckoutInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockPaymentControllerMockRecorder) Checkout(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockPaymentController)(nil).Checkout), ctx, presenter, input)
+}
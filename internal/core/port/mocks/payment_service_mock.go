@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/payment_service_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/payment_service_port.go -destination=internal/core/port/mocks/payment_service_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentService is a mock of PaymentService interface.
+type MockPaymentService struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentServiceMockRecorder is the mock recorder for MockPaymentService.
+type MockPaymentServiceMockRecorder struct {
+	mock *MockPaymentService
+}
+
+// NewMockPaymentService creates a new mock instance.
+func NewMockPaymentService(ctrl *gomock.Controller) *MockPaymentService {
+	mock := &MockPaymentService{ctrl: ctrl}
+	mock.recorder = &MockPaymentServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentService) EXPECT() *MockPaymentServiceMockRecorder {
+	return m.recorder
+}
+
+// CreatePayment mocks base method.
+func (m *MockPaymentService) CreatePayment(ctx context.Context, order *entity.Order) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", ctx, order)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockPaymentServiceMockRecorder) CreatePayment(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockPaymentService)(nil).CreatePayment), ctx, order)
+}
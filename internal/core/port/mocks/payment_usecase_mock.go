@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/payment_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/payment_usecase_port.go -destination=internal/core/port/mocks/payment_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPaymentUseCase is a mock of PaymentUseCase interface.
+type MockPaymentUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPaymentUseCaseMockRecorder is the mock recorder for MockPaymentUseCase.
+type MockPaymentUseCaseMockRecorder struct {
+	mock *MockPaymentUseCase
+}
+
+// NewMockPaymentUseCase creates a new mock instance.
+func NewMockPaymentUseCase(ctrl *gomock.Controller) *MockPaymentUseCase {
+	mock := &MockPaymentUseCase{ctrl: ctrl}
+	mock.recorder = &MockPaymentUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentUseCase) EXPECT() *MockPaymentUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Callback mocks base method.
+func (m *MockPaymentUseCase) Callback(ctx context.Context, input dto.PaymentCallbackInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Callback", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Callback indicates an expected call of Callback.
+func (mr *MockPaymentUseCaseMockRecorder) Callback(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Callback", reflect.TypeOf((*MockPaymentUseCase)(nil).Callback), ctx, input)
+}
+
+// Checkout mocks base method.
+func (m *MockPaymentUseCase) Checkout(ctx context.Context, input dto.CheckoutInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkout", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Checkout indicates an expected call of Checkout.
+func (mr *MockPaymentUseCaseMockRecorder) Checkout(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkout", reflect.TypeOf((*MockPaymentUseCase)(nil).Checkout), ctx, input)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/pickup_board_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/pickup_board_controller_port.go -destination=internal/core/port/mocks/pickup_board_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPickupBoardController is a mock of PickupBoardController interface.
+type MockPickupBoardController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupBoardControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupBoardControllerMockRecorder is the mock recorder for MockPickupBoardController.
+type MockPickupBoardControllerMockRecorder struct {
+	mock *MockPickupBoardController
+}
+
+// NewMockPickupBoardController creates a new mock instance.
+func NewMockPickupBoardController(ctrl *gomock.Controller) *MockPickupBoardController {
+	mock := &MockPickupBoardController{ctrl: ctrl}
+	mock.recorder = &MockPickupBoardControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupBoardController) EXPECT() *MockPickupBoardControllerMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPickupBoardController) Get(ctx context.Context, presenter port.Presenter) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presenter)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPickupBoardControllerMockRecorder) Get(ctx, presenter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPickupBoardController)(nil).Get), ctx, presenter)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/pickup_board_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/pickup_board_usecase_port.go -destination=internal/core/port/mocks/pickup_board_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPickupBoardUseCase is a mock of PickupBoardUseCase interface.
+type MockPickupBoardUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPickupBoardUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPickupBoardUseCaseMockRecorder is the mock recorder for MockPickupBoardUseCase.
+type MockPickupBoardUseCaseMockRecorder struct {
+	mock *MockPickupBoardUseCase
+}
+
+// NewMockPickupBoardUseCase creates a new mock instance.
+func NewMockPickupBoardUseCase(ctrl *gomock.Controller) *MockPickupBoardUseCase {
+	mock := &MockPickupBoardUseCase{ctrl: ctrl}
+	mock.recorder = &MockPickupBoardUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPickupBoardUseCase) EXPECT() *MockPickupBoardUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockPickupBoardUseCase) Get(ctx context.Context) (*entity.PickupBoard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].(*entity.PickupBoard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPickupBoardUseCaseMockRecorder) Get(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPickupBoardUseCase)(nil).Get), ctx)
+}
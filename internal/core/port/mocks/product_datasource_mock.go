@@ -85,6 +85,21 @@ func (mr *MockProductDataSourceMockRecorder) FindAll(ctx, filters, page, limit a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductDataSource)(nil).FindAll), ctx, filters, page, limit)
 }
 
+// FindAllByCategoryIDs mocks base method.
+func (m *MockProductDataSource) FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCategoryIDs", ctx, categoryIDs)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCategoryIDs indicates an expected call of FindAllByCategoryIDs.
+func (mr *MockProductDataSourceMockRecorder) FindAllByCategoryIDs(ctx, categoryIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCategoryIDs", reflect.TypeOf((*MockProductDataSource)(nil).FindAllByCategoryIDs), ctx, categoryIDs)
+}
+
 // FindByID mocks base method.
 func (m *MockProductDataSource) FindByID(ctx context.Context, id uint64) (*entity.Product, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +115,78 @@ func (mr *MockProductDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockProductDataSource)(nil).FindByID), ctx, id)
 }
 
+// FindPrices mocks base method.
+func (m *MockProductDataSource) FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrices", ctx, productID, page, limit)
+	ret0, _ := ret[0].([]*entity.ProductPrice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPrices indicates an expected call of FindPrices.
+func (mr *MockProductDataSourceMockRecorder) FindPrices(ctx, productID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrices", reflect.TypeOf((*MockProductDataSource)(nil).FindPrices), ctx, productID, page, limit)
+}
+
+// ReplaceAvailabilityWindows mocks base method.
+func (m *MockProductDataSource) ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAvailabilityWindows", ctx, productID, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAvailabilityWindows indicates an expected call of ReplaceAvailabilityWindows.
+func (mr *MockProductDataSourceMockRecorder) ReplaceAvailabilityWindows(ctx, productID, windows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAvailabilityWindows", reflect.TypeOf((*MockProductDataSource)(nil).ReplaceAvailabilityWindows), ctx, productID, windows)
+}
+
+// ReplaceBundleSlots mocks base method.
+func (m *MockProductDataSource) ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBundleSlots", ctx, productID, slots)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceBundleSlots indicates an expected call of ReplaceBundleSlots.
+func (mr *MockProductDataSourceMockRecorder) ReplaceBundleSlots(ctx, productID, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBundleSlots", reflect.TypeOf((*MockProductDataSource)(nil).ReplaceBundleSlots), ctx, productID, slots)
+}
+
+// ReplaceModifierGroups mocks base method.
+func (m *MockProductDataSource) ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceModifierGroups", ctx, productID, groups)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceModifierGroups indicates an expected call of ReplaceModifierGroups.
+func (mr *MockProductDataSourceMockRecorder) ReplaceModifierGroups(ctx, productID, groups any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceModifierGroups", reflect.TypeOf((*MockProductDataSource)(nil).ReplaceModifierGroups), ctx, productID, groups)
+}
+
+// SchedulePrice mocks base method.
+func (m *MockProductDataSource) SchedulePrice(ctx context.Context, price *entity.ProductPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePrice", ctx, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulePrice indicates an expected call of SchedulePrice.
+func (mr *MockProductDataSourceMockRecorder) SchedulePrice(ctx, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePrice", reflect.TypeOf((*MockProductDataSource)(nil).SchedulePrice), ctx, price)
+}
+
 // Transaction mocks base method.
 func (m *MockProductDataSource) Transaction(ctx context.Context, fn func(context.Context) error) error {
 	m.ctrl.T.Helper()
@@ -127,3 +214,18 @@ func (mr *MockProductDataSourceMockRecorder) Update(ctx, product any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductDataSource)(nil).Update), ctx, product)
 }
+
+// UpdateStock mocks base method.
+func (m *MockProductDataSource) UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStock", ctx, changes)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStock indicates an expected call of UpdateStock.
+func (mr *MockProductDataSourceMockRecorder) UpdateStock(ctx, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStock", reflect.TypeOf((*MockProductDataSource)(nil).UpdateStock), ctx, changes)
+}
@@ -12,6 +12,7 @@ package mock_port
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -70,9 +71,9 @@ func (mr *MockProductGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
 }
 
 // FindAll mocks base method.
-func (m *MockProductGateway) FindAll(ctx context.Context, name string, categoryID uint64, page, limit int) ([]*entity.Product, int64, error) {
+func (m *MockProductGateway) FindAll(ctx context.Context, name string, categoryID uint64, availableAt time.Time, page, limit int) ([]*entity.Product, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAll", ctx, name, categoryID, page, limit)
+	ret := m.ctrl.Call(m, "FindAll", ctx, name, categoryID, availableAt, page, limit)
 	ret0, _ := ret[0].([]*entity.Product)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -80,9 +81,24 @@ func (m *MockProductGateway) FindAll(ctx context.Context, name string, categoryI
 }
 
 // FindAll indicates an expected call of FindAll.
-func (mr *MockProductGatewayMockRecorder) FindAll(ctx, name, categoryID, page, limit any) *gomock.Call {
+func (mr *MockProductGatewayMockRecorder) FindAll(ctx, name, categoryID, availableAt, page, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductGateway)(nil).FindAll), ctx, name, categoryID, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProductGateway)(nil).FindAll), ctx, name, categoryID, availableAt, page, limit)
+}
+
+// FindAllByCategoryIDs mocks base method.
+func (m *MockProductGateway) FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllByCategoryIDs", ctx, categoryIDs)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllByCategoryIDs indicates an expected call of FindAllByCategoryIDs.
+func (mr *MockProductGatewayMockRecorder) FindAllByCategoryIDs(ctx, categoryIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByCategoryIDs", reflect.TypeOf((*MockProductGateway)(nil).FindAllByCategoryIDs), ctx, categoryIDs)
 }
 
 // FindByID mocks base method.
@@ -100,6 +116,78 @@ func (mr *MockProductGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockProductGateway)(nil).FindByID), ctx, id)
 }
 
+// FindPrices mocks base method.
+func (m *MockProductGateway) FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrices", ctx, productID, page, limit)
+	ret0, _ := ret[0].([]*entity.ProductPrice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPrices indicates an expected call of FindPrices.
+func (mr *MockProductGatewayMockRecorder) FindPrices(ctx, productID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrices", reflect.TypeOf((*MockProductGateway)(nil).FindPrices), ctx, productID, page, limit)
+}
+
+// ReplaceAvailabilityWindows mocks base method.
+func (m *MockProductGateway) ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceAvailabilityWindows", ctx, productID, windows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceAvailabilityWindows indicates an expected call of ReplaceAvailabilityWindows.
+func (mr *MockProductGatewayMockRecorder) ReplaceAvailabilityWindows(ctx, productID, windows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceAvailabilityWindows", reflect.TypeOf((*MockProductGateway)(nil).ReplaceAvailabilityWindows), ctx, productID, windows)
+}
+
+// ReplaceBundleSlots mocks base method.
+func (m *MockProductGateway) ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceBundleSlots", ctx, productID, slots)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceBundleSlots indicates an expected call of ReplaceBundleSlots.
+func (mr *MockProductGatewayMockRecorder) ReplaceBundleSlots(ctx, productID, slots any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceBundleSlots", reflect.TypeOf((*MockProductGateway)(nil).ReplaceBundleSlots), ctx, productID, slots)
+}
+
+// ReplaceModifierGroups mocks base method.
+func (m *MockProductGateway) ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceModifierGroups", ctx, productID, groups)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceModifierGroups indicates an expected call of ReplaceModifierGroups.
+func (mr *MockProductGatewayMockRecorder) ReplaceModifierGroups(ctx, productID, groups any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceModifierGroups", reflect.TypeOf((*MockProductGateway)(nil).ReplaceModifierGroups), ctx, productID, groups)
+}
+
+// SchedulePrice mocks base method.
+func (m *MockProductGateway) SchedulePrice(ctx context.Context, price *entity.ProductPrice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SchedulePrice", ctx, price)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SchedulePrice indicates an expected call of SchedulePrice.
+func (mr *MockProductGatewayMockRecorder) SchedulePrice(ctx, price any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SchedulePrice", reflect.TypeOf((*MockProductGateway)(nil).SchedulePrice), ctx, price)
+}
+
 // Update mocks base method.
 func (m *MockProductGateway) Update(ctx context.Context, product *entity.Product) error {
 	m.ctrl.T.Helper()
@@ -113,3 +201,18 @@ func (mr *MockProductGatewayMockRecorder) Update(ctx, product any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockProductGateway)(nil).Update), ctx, product)
 }
+
+// UpdateStock mocks base method.
+func (m *MockProductGateway) UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStock", ctx, changes)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStock indicates an expected call of UpdateStock.
+func (mr *MockProductGatewayMockRecorder) UpdateStock(ctx, changes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStock", reflect.TypeOf((*MockProductGateway)(nil).UpdateStock), ctx, changes)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/product_price_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/product_price_controller_port.go -destination=internal/core/port/mocks/product_price_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductPriceController is a mock of ProductPriceController interface.
+type MockProductPriceController struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductPriceControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockProductPriceControllerMockRecorder is the mock recorder for MockProductPriceController.
+type MockProductPriceControllerMockRecorder struct {
+	mock *MockProductPriceController
+}
+
+// NewMockProductPriceController creates a new mock instance.
+func NewMockProductPriceController(ctrl *gomock.Controller) *MockProductPriceController {
+	mock := &MockProductPriceController{ctrl: ctrl}
+	mock.recorder = &MockProductPriceControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductPriceController) EXPECT() *MockProductPriceControllerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockProductPriceController) List(ctx context.Context, presenter port.Presenter, input dto.ListProductPricesInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockProductPriceControllerMockRecorder) List(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductPriceController)(nil).List), ctx, presenter, input)
+}
+
+// Schedule mocks base method.
+func (m *MockProductPriceController) Schedule(ctx context.Context, presenter port.Presenter, input dto.ScheduleProductPriceInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockProductPriceControllerMockRecorder) Schedule(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockProductPriceController)(nil).Schedule), ctx, presenter, input)
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/product_price_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/product_price_usecase_port.go -destination=internal/core/port/mocks/product_price_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductPriceUseCase is a mock of ProductPriceUseCase interface.
+type MockProductPriceUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductPriceUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockProductPriceUseCaseMockRecorder is the mock recorder for MockProductPriceUseCase.
+type MockProductPriceUseCaseMockRecorder struct {
+	mock *MockProductPriceUseCase
+}
+
+// NewMockProductPriceUseCase creates a new mock instance.
+func NewMockProductPriceUseCase(ctrl *gomock.Controller) *MockProductPriceUseCase {
+	mock := &MockProductPriceUseCase{ctrl: ctrl}
+	mock.recorder = &MockProductPriceUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductPriceUseCase) EXPECT() *MockProductPriceUseCaseMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockProductPriceUseCase) List(ctx context.Context, input dto.ListProductPricesInput) ([]*entity.ProductPrice, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	ret0, _ := ret[0].([]*entity.ProductPrice)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockProductPriceUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProductPriceUseCase)(nil).List), ctx, input)
+}
+
+// Schedule mocks base method.
+func (m *MockProductPriceUseCase) Schedule(ctx context.Context, input dto.ScheduleProductPriceInput) (*entity.ProductPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", ctx, input)
+	ret0, _ := ret[0].(*entity.ProductPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockProductPriceUseCaseMockRecorder) Schedule(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockProductPriceUseCase)(nil).Schedule), ctx, input)
+}
@@ -0,0 +1,148 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/promotion_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/promotion_controller_port.go -destination=internal/core/port/mocks/promotion_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromotionController is a mock of PromotionController interface.
+type MockPromotionController struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockPromotionControllerMockRecorder is the mock recorder for MockPromotionController.
+type MockPromotionControllerMockRecorder struct {
+	mock *MockPromotionController
+}
+
+// NewMockPromotionController creates a new mock instance.
+func NewMockPromotionController(ctrl *gomock.Controller) *MockPromotionController {
+	mock := &MockPromotionController{ctrl: ctrl}
+	mock.recorder = &MockPromotionControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionController) EXPECT() *MockPromotionControllerMockRecorder {
+	return m.recorder
+}
+
+// ApplyToOrder mocks base method.
+func (m *MockPromotionController) ApplyToOrder(ctx context.Context, presenter port.Presenter, input dto.ApplyOrderPromotionsInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyToOrder", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyToOrder indicates an expected call of ApplyToOrder.
+func (mr *MockPromotionControllerMockRecorder) ApplyToOrder(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyToOrder", reflect.TypeOf((*MockPromotionController)(nil).ApplyToOrder), ctx, presenter, input)
+}
+
+// Create mocks base method.
+func (m *MockPromotionController) Create(ctx context.Context, presenter port.Presenter, input dto.CreatePromotionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionControllerMockRecorder) Create(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionController)(nil).Create), ctx, presenter, input)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionController) Delete(ctx context.Context, presenter port.Presenter, input dto.DeletePromotionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionControllerMockRecorder) Delete(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionController)(nil).Delete), ctx, presenter, input)
+}
+
+// Get mocks base method.
+func (m *MockPromotionController) Get(ctx context.Context, presenter port.Presenter, input dto.GetPromotionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPromotionControllerMockRecorder) Get(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPromotionController)(nil).Get), ctx, presenter, input)
+}
+
+// List mocks base method.
+func (m *MockPromotionController) List(ctx context.Context, presenter port.Presenter, input dto.ListPromotionsInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPromotionControllerMockRecorder) List(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPromotionController)(nil).List), ctx, presenter, input)
+}
+
+// RemoveFromOrder mocks base method.
+func (m *MockPromotionController) RemoveFromOrder(ctx context.Context, presenter port.Presenter, input dto.RemoveOrderCouponInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromOrder", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromOrder indicates an expected call of RemoveFromOrder.
+func (mr *MockPromotionControllerMockRecorder) RemoveFromOrder(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromOrder", reflect.TypeOf((*MockPromotionController)(nil).RemoveFromOrder), ctx, presenter, input)
+}
+
+// Update mocks base method.
+func (m *MockPromotionController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdatePromotionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionController)(nil).Update), ctx, presenter, input)
+}
@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/promotion_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/promotion_datasource_port.go -destination=internal/core/port/mocks/promotion_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromotionDataSource is a mock of PromotionDataSource interface.
+type MockPromotionDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockPromotionDataSourceMockRecorder is the mock recorder for MockPromotionDataSource.
+type MockPromotionDataSourceMockRecorder struct {
+	mock *MockPromotionDataSource
+}
+
+// NewMockPromotionDataSource creates a new mock instance.
+func NewMockPromotionDataSource(ctrl *gomock.Controller) *MockPromotionDataSource {
+	mock := &MockPromotionDataSource{ctrl: ctrl}
+	mock.recorder = &MockPromotionDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionDataSource) EXPECT() *MockPromotionDataSourceMockRecorder {
+	return m.recorder
+}
+
+// CountCustomerUsage mocks base method.
+func (m *MockPromotionDataSource) CountCustomerUsage(ctx context.Context, promotionID, customerID, excludeOrderID uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomerUsage", ctx, promotionID, customerID, excludeOrderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomerUsage indicates an expected call of CountCustomerUsage.
+func (mr *MockPromotionDataSourceMockRecorder) CountCustomerUsage(ctx, promotionID, customerID, excludeOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerUsage", reflect.TypeOf((*MockPromotionDataSource)(nil).CountCustomerUsage), ctx, promotionID, customerID, excludeOrderID)
+}
+
+// Create mocks base method.
+func (m *MockPromotionDataSource) Create(ctx context.Context, promotion *entity.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionDataSourceMockRecorder) Create(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionDataSource)(nil).Create), ctx, promotion)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionDataSource) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionDataSourceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionDataSource)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPromotionDataSource) FindAll(ctx context.Context, filters map[string]any, page, limit int) ([]*entity.Promotion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filters, page, limit)
+	ret0, _ := ret[0].([]*entity.Promotion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromotionDataSourceMockRecorder) FindAll(ctx, filters, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromotionDataSource)(nil).FindAll), ctx, filters, page, limit)
+}
+
+// FindAllAutomatic mocks base method.
+func (m *MockPromotionDataSource) FindAllAutomatic(ctx context.Context) ([]*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllAutomatic", ctx)
+	ret0, _ := ret[0].([]*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllAutomatic indicates an expected call of FindAllAutomatic.
+func (mr *MockPromotionDataSourceMockRecorder) FindAllAutomatic(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllAutomatic", reflect.TypeOf((*MockPromotionDataSource)(nil).FindAllAutomatic), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockPromotionDataSource) FindByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockPromotionDataSourceMockRecorder) FindByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockPromotionDataSource)(nil).FindByCode), ctx, code)
+}
+
+// FindByID mocks base method.
+func (m *MockPromotionDataSource) FindByID(ctx context.Context, id uint64) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPromotionDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPromotionDataSource)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPromotionDataSource) Update(ctx context.Context, promotion *entity.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionDataSourceMockRecorder) Update(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionDataSource)(nil).Update), ctx, promotion)
+}
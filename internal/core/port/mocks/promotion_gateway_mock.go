@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/promotion_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/promotion_gateway_port.go -destination=internal/core/port/mocks/promotion_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromotionGateway is a mock of PromotionGateway interface.
+type MockPromotionGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockPromotionGatewayMockRecorder is the mock recorder for MockPromotionGateway.
+type MockPromotionGatewayMockRecorder struct {
+	mock *MockPromotionGateway
+}
+
+// NewMockPromotionGateway creates a new mock instance.
+func NewMockPromotionGateway(ctrl *gomock.Controller) *MockPromotionGateway {
+	mock := &MockPromotionGateway{ctrl: ctrl}
+	mock.recorder = &MockPromotionGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionGateway) EXPECT() *MockPromotionGatewayMockRecorder {
+	return m.recorder
+}
+
+// CountCustomerUsage mocks base method.
+func (m *MockPromotionGateway) CountCustomerUsage(ctx context.Context, promotionID, customerID, excludeOrderID uint64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountCustomerUsage", ctx, promotionID, customerID, excludeOrderID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountCustomerUsage indicates an expected call of CountCustomerUsage.
+func (mr *MockPromotionGatewayMockRecorder) CountCustomerUsage(ctx, promotionID, customerID, excludeOrderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountCustomerUsage", reflect.TypeOf((*MockPromotionGateway)(nil).CountCustomerUsage), ctx, promotionID, customerID, excludeOrderID)
+}
+
+// Create mocks base method.
+func (m *MockPromotionGateway) Create(ctx context.Context, promotion *entity.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionGatewayMockRecorder) Create(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionGateway)(nil).Create), ctx, promotion)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionGateway) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionGateway)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockPromotionGateway) FindAll(ctx context.Context, name string, page, limit int) ([]*entity.Promotion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, name, page, limit)
+	ret0, _ := ret[0].([]*entity.Promotion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockPromotionGatewayMockRecorder) FindAll(ctx, name, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockPromotionGateway)(nil).FindAll), ctx, name, page, limit)
+}
+
+// FindAllAutomatic mocks base method.
+func (m *MockPromotionGateway) FindAllAutomatic(ctx context.Context) ([]*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllAutomatic", ctx)
+	ret0, _ := ret[0].([]*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllAutomatic indicates an expected call of FindAllAutomatic.
+func (mr *MockPromotionGatewayMockRecorder) FindAllAutomatic(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllAutomatic", reflect.TypeOf((*MockPromotionGateway)(nil).FindAllAutomatic), ctx)
+}
+
+// FindByCode mocks base method.
+func (m *MockPromotionGateway) FindByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", ctx, code)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockPromotionGatewayMockRecorder) FindByCode(ctx, code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockPromotionGateway)(nil).FindByCode), ctx, code)
+}
+
+// FindByID mocks base method.
+func (m *MockPromotionGateway) FindByID(ctx context.Context, id uint64) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockPromotionGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockPromotionGateway)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockPromotionGateway) Update(ctx context.Context, promotion *entity.Promotion) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, promotion)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionGatewayMockRecorder) Update(ctx, promotion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionGateway)(nil).Update), ctx, promotion)
+}
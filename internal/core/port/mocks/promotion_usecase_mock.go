@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/promotion_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/promotion_usecase_port.go -destination=internal/core/port/mocks/promotion_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPromotionUseCase is a mock of PromotionUseCase interface.
+type MockPromotionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPromotionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPromotionUseCaseMockRecorder is the mock recorder for MockPromotionUseCase.
+type MockPromotionUseCaseMockRecorder struct {
+	mock *MockPromotionUseCase
+}
+
+// NewMockPromotionUseCase creates a new mock instance.
+func NewMockPromotionUseCase(ctrl *gomock.Controller) *MockPromotionUseCase {
+	mock := &MockPromotionUseCase{ctrl: ctrl}
+	mock.recorder = &MockPromotionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPromotionUseCase) EXPECT() *MockPromotionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ApplyToOrder mocks base method.
+func (m *MockPromotionUseCase) ApplyToOrder(ctx context.Context, input dto.ApplyOrderPromotionsInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyToOrder", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyToOrder indicates an expected call of ApplyToOrder.
+func (mr *MockPromotionUseCaseMockRecorder) ApplyToOrder(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyToOrder", reflect.TypeOf((*MockPromotionUseCase)(nil).ApplyToOrder), ctx, input)
+}
+
+// Create mocks base method.
+func (m *MockPromotionUseCase) Create(ctx context.Context, input dto.CreatePromotionInput) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPromotionUseCaseMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPromotionUseCase)(nil).Create), ctx, input)
+}
+
+// Delete mocks base method.
+func (m *MockPromotionUseCase) Delete(ctx context.Context, input dto.DeletePromotionInput) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, input)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPromotionUseCaseMockRecorder) Delete(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPromotionUseCase)(nil).Delete), ctx, input)
+}
+
+// Get mocks base method.
+func (m *MockPromotionUseCase) Get(ctx context.Context, input dto.GetPromotionInput) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, input)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPromotionUseCaseMockRecorder) Get(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPromotionUseCase)(nil).Get), ctx, input)
+}
+
+// List mocks base method.
+func (m *MockPromotionUseCase) List(ctx context.Context, input dto.ListPromotionsInput) ([]*entity.Promotion, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	ret0, _ := ret[0].([]*entity.Promotion)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockPromotionUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPromotionUseCase)(nil).List), ctx, input)
+}
+
+// RemoveFromOrder mocks base method.
+func (m *MockPromotionUseCase) RemoveFromOrder(ctx context.Context, input dto.RemoveOrderCouponInput) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromOrder", ctx, input)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromOrder indicates an expected call of RemoveFromOrder.
+func (mr *MockPromotionUseCaseMockRecorder) RemoveFromOrder(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromOrder", reflect.TypeOf((*MockPromotionUseCase)(nil).RemoveFromOrder), ctx, input)
+}
+
+// RepriceOrder mocks base method.
+func (m *MockPromotionUseCase) RepriceOrder(ctx context.Context, orderID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepriceOrder", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RepriceOrder indicates an expected call of RepriceOrder.
+func (mr *MockPromotionUseCaseMockRecorder) RepriceOrder(ctx, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepriceOrder", reflect.TypeOf((*MockPromotionUseCase)(nil).RepriceOrder), ctx, orderID)
+}
+
+// Update mocks base method.
+func (m *MockPromotionUseCase) Update(ctx context.Context, input dto.UpdatePromotionInput) (*entity.Promotion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*entity.Promotion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPromotionUseCaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPromotionUseCase)(nil).Update), ctx, input)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/rate_limit_store_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/rate_limit_store_port.go -destination=internal/core/port/mocks/rate_limit_store_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRateLimitStore is a mock of RateLimitStore interface.
+type MockRateLimitStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimitStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimitStoreMockRecorder is the mock recorder for MockRateLimitStore.
+type MockRateLimitStoreMockRecorder struct {
+	mock *MockRateLimitStore
+}
+
+// NewMockRateLimitStore creates a new mock instance.
+func NewMockRateLimitStore(ctrl *gomock.Controller) *MockRateLimitStore {
+	mock := &MockRateLimitStore{ctrl: ctrl}
+	mock.recorder = &MockRateLimitStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimitStore) EXPECT() *MockRateLimitStoreMockRecorder {
+	return m.recorder
+}
+
+// Take mocks base method.
+func (m *MockRateLimitStore) Take(ctx context.Context, key string, limit valueobject.RateLimit) (valueobject.RateLimitResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, limit)
+	ret0, _ := ret[0].(valueobject.RateLimitResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockRateLimitStoreMockRecorder) Take(ctx, key, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockRateLimitStore)(nil).Take), ctx, key, limit)
+}
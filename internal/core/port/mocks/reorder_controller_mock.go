@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/reorder_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/reorder_controller_port.go -destination=internal/core/port/mocks/reorder_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReorderController is a mock of ReorderController interface.
+type MockReorderController struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderControllerMockRecorder is the mock recorder for MockReorderController.
+type MockReorderControllerMockRecorder struct {
+	mock *MockReorderController
+}
+
+// NewMockReorderController creates a new mock instance.
+func NewMockReorderController(ctrl *gomock.Controller) *MockReorderController {
+	mock := &MockReorderController{ctrl: ctrl}
+	mock.recorder = &MockReorderControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderController) EXPECT() *MockReorderControllerMockRecorder {
+	return m.recorder
+}
+
+// Reorder mocks base method.
+func (m *MockReorderController) Reorder(ctx context.Context, presenter port.Presenter, input dto.ReorderInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockReorderControllerMockRecorder) Reorder(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockReorderController)(nil).Reorder), ctx, presenter, input)
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/reorder_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/reorder_usecase_port.go -destination=internal/core/port/mocks/reorder_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReorderUseCase is a mock of ReorderUseCase interface.
+type MockReorderUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReorderUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReorderUseCaseMockRecorder is the mock recorder for MockReorderUseCase.
+type MockReorderUseCaseMockRecorder struct {
+	mock *MockReorderUseCase
+}
+
+// NewMockReorderUseCase creates a new mock instance.
+func NewMockReorderUseCase(ctrl *gomock.Controller) *MockReorderUseCase {
+	mock := &MockReorderUseCase{ctrl: ctrl}
+	mock.recorder = &MockReorderUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReorderUseCase) EXPECT() *MockReorderUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Reorder mocks base method.
+func (m *MockReorderUseCase) Reorder(ctx context.Context, input dto.ReorderInput) (*entity.Reorder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reorder", ctx, input)
+	ret0, _ := ret[0].(*entity.Reorder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reorder indicates an expected call of Reorder.
+func (mr *MockReorderUseCaseMockRecorder) Reorder(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reorder", reflect.TypeOf((*MockReorderUseCase)(nil).Reorder), ctx, input)
+}
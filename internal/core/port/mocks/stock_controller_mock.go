@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/stock_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/stock_controller_port.go -destination=internal/core/port/mocks/stock_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStockController is a mock of StockController interface.
+type MockStockController struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockStockControllerMockRecorder is the mock recorder for MockStockController.
+type MockStockControllerMockRecorder struct {
+	mock *MockStockController
+}
+
+// NewMockStockController creates a new mock instance.
+func NewMockStockController(ctrl *gomock.Controller) *MockStockController {
+	mock := &MockStockController{ctrl: ctrl}
+	mock.recorder = &MockStockControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockController) EXPECT() *MockStockControllerMockRecorder {
+	return m.recorder
+}
+
+// Update mocks base method.
+func (m *MockStockController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateProductStockInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStockControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStockController)(nil).Update), ctx, presenter, input)
+}
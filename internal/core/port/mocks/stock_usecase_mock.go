@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/stock_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/stock_usecase_port.go -destination=internal/core/port/mocks/stock_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStockUseCase is a mock of StockUseCase interface.
+type MockStockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockStockUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockStockUseCaseMockRecorder is the mock recorder for MockStockUseCase.
+type MockStockUseCaseMockRecorder struct {
+	mock *MockStockUseCase
+}
+
+// NewMockStockUseCase creates a new mock instance.
+func NewMockStockUseCase(ctrl *gomock.Controller) *MockStockUseCase {
+	mock := &MockStockUseCase{ctrl: ctrl}
+	mock.recorder = &MockStockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStockUseCase) EXPECT() *MockStockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Release mocks base method.
+func (m *MockStockUseCase) Release(ctx context.Context, order *entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockStockUseCaseMockRecorder) Release(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockStockUseCase)(nil).Release), ctx, order)
+}
+
+// Reserve mocks base method.
+func (m *MockStockUseCase) Reserve(ctx context.Context, order *entity.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, order)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockStockUseCaseMockRecorder) Reserve(ctx, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockStockUseCase)(nil).Reserve), ctx, order)
+}
+
+// Update mocks base method.
+func (m *MockStockUseCase) Update(ctx context.Context, input dto.UpdateProductStockInput) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStockUseCaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStockUseCase)(nil).Update), ctx, input)
+}
@@ -0,0 +1,73 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_delivery_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_delivery_controller_port.go -destination=internal/core/port/mocks/webhook_delivery_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryController is a mock of WebhookDeliveryController interface.
+type MockWebhookDeliveryController struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryControllerMockRecorder is the mock recorder for MockWebhookDeliveryController.
+type MockWebhookDeliveryControllerMockRecorder struct {
+	mock *MockWebhookDeliveryController
+}
+
+// NewMockWebhookDeliveryController creates a new mock instance.
+func NewMockWebhookDeliveryController(ctrl *gomock.Controller) *MockWebhookDeliveryController {
+	mock := &MockWebhookDeliveryController{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryController) EXPECT() *MockWebhookDeliveryControllerMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockWebhookDeliveryController) List(ctx context.Context, presenter port.Presenter, input dto.ListWebhookDeliveriesInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookDeliveryControllerMockRecorder) List(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookDeliveryController)(nil).List), ctx, presenter, input)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookDeliveryController) Redeliver(ctx context.Context, presenter port.Presenter, input dto.RedeliverWebhookInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookDeliveryControllerMockRecorder) Redeliver(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookDeliveryController)(nil).Redeliver), ctx, presenter, input)
+}
@@ -0,0 +1,117 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_delivery_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_delivery_datasource_port.go -destination=internal/core/port/mocks/webhook_delivery_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryDataSource is a mock of WebhookDeliveryDataSource interface.
+type MockWebhookDeliveryDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryDataSourceMockRecorder is the mock recorder for MockWebhookDeliveryDataSource.
+type MockWebhookDeliveryDataSourceMockRecorder struct {
+	mock *MockWebhookDeliveryDataSource
+}
+
+// NewMockWebhookDeliveryDataSource creates a new mock instance.
+func NewMockWebhookDeliveryDataSource(ctrl *gomock.Controller) *MockWebhookDeliveryDataSource {
+	mock := &MockWebhookDeliveryDataSource{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryDataSource) EXPECT() *MockWebhookDeliveryDataSourceMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhookDeliveryDataSource) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookDeliveryDataSourceMockRecorder) ClaimDue(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookDeliveryDataSource)(nil).ClaimDue), ctx, now, lease, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhookDeliveryDataSource) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookDeliveryDataSourceMockRecorder) Create(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryDataSource)(nil).Create), ctx, delivery)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookDeliveryDataSource) FindAll(ctx context.Context, filters map[string]any, page, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filters, page, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookDeliveryDataSourceMockRecorder) FindAll(ctx, filters, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookDeliveryDataSource)(nil).FindAll), ctx, filters, page, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookDeliveryDataSource) FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookDeliveryDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookDeliveryDataSource)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockWebhookDeliveryDataSource) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookDeliveryDataSourceMockRecorder) Update(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryDataSource)(nil).Update), ctx, delivery)
+}
@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_delivery_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_delivery_gateway_port.go -destination=internal/core/port/mocks/webhook_delivery_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryGateway is a mock of WebhookDeliveryGateway interface.
+type MockWebhookDeliveryGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryGatewayMockRecorder is the mock recorder for MockWebhookDeliveryGateway.
+type MockWebhookDeliveryGatewayMockRecorder struct {
+	mock *MockWebhookDeliveryGateway
+}
+
+// NewMockWebhookDeliveryGateway creates a new mock instance.
+func NewMockWebhookDeliveryGateway(ctrl *gomock.Controller) *MockWebhookDeliveryGateway {
+	mock := &MockWebhookDeliveryGateway{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryGateway) EXPECT() *MockWebhookDeliveryGatewayMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhookDeliveryGateway) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, lease, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookDeliveryGatewayMockRecorder) ClaimDue(ctx, now, lease, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhookDeliveryGateway)(nil).ClaimDue), ctx, now, lease, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhookDeliveryGateway) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookDeliveryGatewayMockRecorder) Create(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryGateway)(nil).Create), ctx, delivery)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookDeliveryGateway) FindAll(ctx context.Context, subscriptionID uint64, status valueobject.WebhookDeliveryStatus, page, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, subscriptionID, status, page, limit)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookDeliveryGatewayMockRecorder) FindAll(ctx, subscriptionID, status, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookDeliveryGateway)(nil).FindAll), ctx, subscriptionID, status, page, limit)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookDeliveryGateway) FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookDeliveryGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookDeliveryGateway)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockWebhookDeliveryGateway) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookDeliveryGatewayMockRecorder) Update(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryGateway)(nil).Update), ctx, delivery)
+}
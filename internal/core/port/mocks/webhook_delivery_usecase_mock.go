@@ -0,0 +1,104 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_delivery_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_delivery_usecase_port.go -destination=internal/core/port/mocks/webhook_delivery_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookDeliveryUseCase is a mock of WebhookDeliveryUseCase interface.
+type MockWebhookDeliveryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookDeliveryUseCaseMockRecorder is the mock recorder for MockWebhookDeliveryUseCase.
+type MockWebhookDeliveryUseCaseMockRecorder struct {
+	mock *MockWebhookDeliveryUseCase
+}
+
+// NewMockWebhookDeliveryUseCase creates a new mock instance.
+func NewMockWebhookDeliveryUseCase(ctrl *gomock.Controller) *MockWebhookDeliveryUseCase {
+	mock := &MockWebhookDeliveryUseCase{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDeliveryUseCase) EXPECT() *MockWebhookDeliveryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Dispatch mocks base method.
+func (m *MockWebhookDeliveryUseCase) Dispatch(ctx context.Context, input dto.DispatchWebhooksInput) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dispatch", ctx, input)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dispatch indicates an expected call of Dispatch.
+func (mr *MockWebhookDeliveryUseCaseMockRecorder) Dispatch(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dispatch", reflect.TypeOf((*MockWebhookDeliveryUseCase)(nil).Dispatch), ctx, input)
+}
+
+// Enqueue mocks base method.
+func (m *MockWebhookDeliveryUseCase) Enqueue(ctx context.Context, event entity.OrderEvent) ([]*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, event)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockWebhookDeliveryUseCaseMockRecorder) Enqueue(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockWebhookDeliveryUseCase)(nil).Enqueue), ctx, event)
+}
+
+// List mocks base method.
+func (m *MockWebhookDeliveryUseCase) List(ctx context.Context, input dto.ListWebhookDeliveriesInput) ([]*entity.WebhookDelivery, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	ret0, _ := ret[0].([]*entity.WebhookDelivery)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookDeliveryUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookDeliveryUseCase)(nil).List), ctx, input)
+}
+
+// Redeliver mocks base method.
+func (m *MockWebhookDeliveryUseCase) Redeliver(ctx context.Context, input dto.RedeliverWebhookInput) (*entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockWebhookDeliveryUseCaseMockRecorder) Redeliver(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockWebhookDeliveryUseCase)(nil).Redeliver), ctx, input)
+}
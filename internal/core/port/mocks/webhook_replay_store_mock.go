@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_replay_store_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_replay_store_port.go -destination=internal/core/port/mocks/webhook_replay_store_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookReplayStore is a mock of WebhookReplayStore interface.
+type MockWebhookReplayStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookReplayStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookReplayStoreMockRecorder is the mock recorder for MockWebhookReplayStore.
+type MockWebhookReplayStoreMockRecorder struct {
+	mock *MockWebhookReplayStore
+}
+
+// NewMockWebhookReplayStore creates a new mock instance.
+func NewMockWebhookReplayStore(ctrl *gomock.Controller) *MockWebhookReplayStore {
+	mock := &MockWebhookReplayStore{ctrl: ctrl}
+	mock.recorder = &MockWebhookReplayStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookReplayStore) EXPECT() *MockWebhookReplayStoreMockRecorder {
+	return m.recorder
+}
+
+// Seen mocks base method.
+func (m *MockWebhookReplayStore) Seen(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seen", ctx, key, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seen indicates an expected call of Seen.
+func (mr *MockWebhookReplayStoreMockRecorder) Seen(ctx, key, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seen", reflect.TypeOf((*MockWebhookReplayStore)(nil).Seen), ctx, key, ttl)
+}
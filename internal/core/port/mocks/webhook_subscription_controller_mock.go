@@ -0,0 +1,118 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_subscription_controller_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_subscription_controller_port.go -destination=internal/core/port/mocks/webhook_subscription_controller_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	port "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSubscriptionController is a mock of WebhookSubscriptionController interface.
+type MockWebhookSubscriptionController struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionControllerMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionControllerMockRecorder is the mock recorder for MockWebhookSubscriptionController.
+type MockWebhookSubscriptionControllerMockRecorder struct {
+	mock *MockWebhookSubscriptionController
+}
+
+// NewMockWebhookSubscriptionController creates a new mock instance.
+func NewMockWebhookSubscriptionController(ctrl *gomock.Controller) *MockWebhookSubscriptionController {
+	mock := &MockWebhookSubscriptionController{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionControllerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionController) EXPECT() *MockWebhookSubscriptionControllerMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionController) Create(ctx context.Context, presenter port.Presenter, input dto.CreateWebhookSubscriptionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionControllerMockRecorder) Create(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionController)(nil).Create), ctx, presenter, input)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionController) Delete(ctx context.Context, presenter port.Presenter, input dto.DeleteWebhookSubscriptionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionControllerMockRecorder) Delete(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionController)(nil).Delete), ctx, presenter, input)
+}
+
+// Get mocks base method.
+func (m *MockWebhookSubscriptionController) Get(ctx context.Context, presenter port.Presenter, input dto.GetWebhookSubscriptionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookSubscriptionControllerMockRecorder) Get(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookSubscriptionController)(nil).Get), ctx, presenter, input)
+}
+
+// List mocks base method.
+func (m *MockWebhookSubscriptionController) List(ctx context.Context, presenter port.Presenter, input dto.ListWebhookSubscriptionsInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookSubscriptionControllerMockRecorder) List(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookSubscriptionController)(nil).List), ctx, presenter, input)
+}
+
+// Update mocks base method.
+func (m *MockWebhookSubscriptionController) Update(ctx context.Context, presenter port.Presenter, input dto.UpdateWebhookSubscriptionInput) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, presenter, input)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookSubscriptionControllerMockRecorder) Update(ctx, presenter, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionController)(nil).Update), ctx, presenter, input)
+}
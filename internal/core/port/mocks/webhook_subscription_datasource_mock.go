@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_subscription_datasource_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_subscription_datasource_port.go -destination=internal/core/port/mocks/webhook_subscription_datasource_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSubscriptionDataSource is a mock of WebhookSubscriptionDataSource interface.
+type MockWebhookSubscriptionDataSource struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionDataSourceMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionDataSourceMockRecorder is the mock recorder for MockWebhookSubscriptionDataSource.
+type MockWebhookSubscriptionDataSourceMockRecorder struct {
+	mock *MockWebhookSubscriptionDataSource
+}
+
+// NewMockWebhookSubscriptionDataSource creates a new mock instance.
+func NewMockWebhookSubscriptionDataSource(ctrl *gomock.Controller) *MockWebhookSubscriptionDataSource {
+	mock := &MockWebhookSubscriptionDataSource{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionDataSourceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionDataSource) EXPECT() *MockWebhookSubscriptionDataSourceMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionDataSource) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) Create(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionDataSource) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookSubscriptionDataSource) FindAll(ctx context.Context, filters map[string]any, page, limit int) ([]*entity.WebhookSubscription, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, filters, page, limit)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) FindAll(ctx, filters, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).FindAll), ctx, filters, page, limit)
+}
+
+// FindAllActive mocks base method.
+func (m *MockWebhookSubscriptionDataSource) FindAllActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).FindAllActive), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookSubscriptionDataSource) FindByID(ctx context.Context, id uint64) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockWebhookSubscriptionDataSource) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookSubscriptionDataSourceMockRecorder) Update(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionDataSource)(nil).Update), ctx, subscription)
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_subscription_gateway_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_subscription_gateway_port.go -destination=internal/core/port/mocks/webhook_subscription_gateway_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSubscriptionGateway is a mock of WebhookSubscriptionGateway interface.
+type MockWebhookSubscriptionGateway struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionGatewayMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionGatewayMockRecorder is the mock recorder for MockWebhookSubscriptionGateway.
+type MockWebhookSubscriptionGatewayMockRecorder struct {
+	mock *MockWebhookSubscriptionGateway
+}
+
+// NewMockWebhookSubscriptionGateway creates a new mock instance.
+func NewMockWebhookSubscriptionGateway(ctrl *gomock.Controller) *MockWebhookSubscriptionGateway {
+	mock := &MockWebhookSubscriptionGateway{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionGatewayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionGateway) EXPECT() *MockWebhookSubscriptionGatewayMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionGateway) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) Create(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).Create), ctx, subscription)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionGateway) Delete(ctx context.Context, id uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).Delete), ctx, id)
+}
+
+// FindAll mocks base method.
+func (m *MockWebhookSubscriptionGateway) FindAll(ctx context.Context, page, limit int) ([]*entity.WebhookSubscription, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", ctx, page, limit)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) FindAll(ctx, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).FindAll), ctx, page, limit)
+}
+
+// FindAllActive mocks base method.
+func (m *MockWebhookSubscriptionGateway) FindAllActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllActive", ctx)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAllActive indicates an expected call of FindAllActive.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) FindAllActive(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllActive", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).FindAllActive), ctx)
+}
+
+// FindByID mocks base method.
+func (m *MockWebhookSubscriptionGateway) FindByID(ctx context.Context, id uint64) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByID", ctx, id)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByID indicates an expected call of FindByID.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) FindByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).FindByID), ctx, id)
+}
+
+// Update mocks base method.
+func (m *MockWebhookSubscriptionGateway) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookSubscriptionGatewayMockRecorder) Update(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionGateway)(nil).Update), ctx, subscription)
+}
@@ -0,0 +1,119 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/core/port/webhook_subscription_usecase_port.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/core/port/webhook_subscription_usecase_port.go -destination=internal/core/port/mocks/webhook_subscription_usecase_mock.go
+//
+
+// Package mock_port is a generated GoMock package.
+package mock_port
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	dto "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWebhookSubscriptionUseCase is a mock of WebhookSubscriptionUseCase interface.
+type MockWebhookSubscriptionUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSubscriptionUseCaseMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookSubscriptionUseCaseMockRecorder is the mock recorder for MockWebhookSubscriptionUseCase.
+type MockWebhookSubscriptionUseCaseMockRecorder struct {
+	mock *MockWebhookSubscriptionUseCase
+}
+
+// NewMockWebhookSubscriptionUseCase creates a new mock instance.
+func NewMockWebhookSubscriptionUseCase(ctrl *gomock.Controller) *MockWebhookSubscriptionUseCase {
+	mock := &MockWebhookSubscriptionUseCase{ctrl: ctrl}
+	mock.recorder = &MockWebhookSubscriptionUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookSubscriptionUseCase) EXPECT() *MockWebhookSubscriptionUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhookSubscriptionUseCase) Create(ctx context.Context, input dto.CreateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookSubscriptionUseCaseMockRecorder) Create(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookSubscriptionUseCase)(nil).Create), ctx, input)
+}
+
+// Delete mocks base method.
+func (m *MockWebhookSubscriptionUseCase) Delete(ctx context.Context, input dto.DeleteWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookSubscriptionUseCaseMockRecorder) Delete(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookSubscriptionUseCase)(nil).Delete), ctx, input)
+}
+
+// Get mocks base method.
+func (m *MockWebhookSubscriptionUseCase) Get(ctx context.Context, input dto.GetWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockWebhookSubscriptionUseCaseMockRecorder) Get(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockWebhookSubscriptionUseCase)(nil).Get), ctx, input)
+}
+
+// List mocks base method.
+func (m *MockWebhookSubscriptionUseCase) List(ctx context.Context, input dto.ListWebhookSubscriptionsInput) ([]*entity.WebhookSubscription, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, input)
+	ret0, _ := ret[0].([]*entity.WebhookSubscription)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockWebhookSubscriptionUseCaseMockRecorder) List(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookSubscriptionUseCase)(nil).List), ctx, input)
+}
+
+// Update mocks base method.
+func (m *MockWebhookSubscriptionUseCase) Update(ctx context.Context, input dto.UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, input)
+	ret0, _ := ret[0].(*entity.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWebhookSubscriptionUseCaseMockRecorder) Update(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookSubscriptionUseCase)(nil).Update), ctx, input)
+}
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type NotificationPreferenceController interface {
+	Get(ctx context.Context, presenter Presenter, input dto.GetNotificationPreferenceInput) ([]byte, error)
+	Update(ctx context.Context, presenter Presenter, input dto.UpdateNotificationPreferenceInput) ([]byte, error)
+	Delete(ctx context.Context, presenter Presenter, input dto.DeleteNotificationPreferenceInput) ([]byte, error)
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type NotificationPreferenceDataSource interface {
+	FindByCustomerID(ctx context.Context, customerID uint64) (*entity.NotificationPreference, error)
+	Create(ctx context.Context, preference *entity.NotificationPreference) error
+	Update(ctx context.Context, preference *entity.NotificationPreference) error
+	Delete(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type NotificationPreferenceUseCase interface {
+	Get(ctx context.Context, input dto.GetNotificationPreferenceInput) (*entity.NotificationPreference, error)
+	Update(ctx context.Context, input dto.UpdateNotificationPreferenceInput) (*entity.NotificationPreference, error)
+	Delete(ctx context.Context, input dto.DeleteNotificationPreferenceInput) (*entity.NotificationPreference, error)
+}
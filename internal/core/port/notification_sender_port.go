@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+// NotificationSender sends the notifications to the customers on a channel, ex: SMS, email or push
+type NotificationSender interface {
+	// Send delivers the notification to its recipient, it returns an error when the channel did not accept it
+	Send(ctx context.Context, notification *entity.Notification) error
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type NotificationUseCase interface {
+	// Notify sends the notifications of the order event to the customer, it returns the notifications that were sent
+	Notify(ctx context.Context, event entity.OrderEvent) ([]*entity.Notification, error)
+}
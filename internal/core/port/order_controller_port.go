@@ -8,8 +8,12 @@ import (
 
 type OrderController interface {
 	List(ctx context.Context, presenter Presenter, input dto.ListOrdersInput) ([]byte, error)
-	Create(ctx context.Context, presenter Presenter, input dto.CreateOrderInput) ([]byte, error)
-	Get(ctx context.Context, presenter Presenter, input dto.GetOrderInput) ([]byte, error)
-	Update(ctx context.Context, presenter Presenter, input dto.UpdateOrderInput) ([]byte, error)
+	ListByCustomer(ctx context.Context, presenter Presenter, input dto.ListCustomerOrdersInput) ([]byte, error)
+	Create(ctx context.Context, presenter Presenter, input dto.CreateOrderInput) ([]byte, uint32, error)
+	Get(ctx context.Context, presenter Presenter, input dto.GetOrderInput) ([]byte, uint32, error)
+	GetByGuestToken(ctx context.Context, presenter Presenter, input dto.GetGuestOrderInput) ([]byte, uint32, error)
+	AttachCustomer(ctx context.Context, presenter Presenter, input dto.AttachOrderCustomerInput) ([]byte, uint32, error)
+	Update(ctx context.Context, presenter Presenter, input dto.UpdateOrderInput) ([]byte, uint32, error)
 	Delete(ctx context.Context, presenter Presenter, input dto.DeleteOrderInput) ([]byte, error)
+	GetStatusMachine(ctx context.Context, presenter Presenter) ([]byte, error)
 }
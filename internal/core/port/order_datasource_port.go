@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 )
@@ -9,8 +10,11 @@ import (
 type OrderDataSource interface {
 	FindByID(ctx context.Context, id uint64) (*entity.Order, error)
 	FindAll(ctx context.Context, filters map[string]any, sort string, page, limit int) ([]*entity.Order, int64, error)
+	SumTotals(ctx context.Context, filters map[string]any) (float64, error)
 	Create(ctx context.Context, order *entity.Order) error
 	Update(ctx context.Context, order *entity.Order) error
+	UpdateTotals(ctx context.Context, order *entity.Order) error
+	NextPickupNumber(ctx context.Context, day time.Time) (uint32, error)
 	Delete(ctx context.Context, id uint64) error
 	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
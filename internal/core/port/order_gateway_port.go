@@ -2,6 +2,7 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
@@ -9,8 +10,17 @@ import (
 
 type OrderGateway interface {
 	FindByID(ctx context.Context, id uint64) (*entity.Order, error)
-	FindAll(ctx context.Context, customerId uint64, status []valueobject.OrderStatus, statusExclude []valueobject.OrderStatus, page, limit int, sort string) ([]*entity.Order, int64, error)
+	FindByGuestToken(ctx context.Context, guestToken string) (*entity.Order, error)
+	FindByPaymentReference(ctx context.Context, paymentReference string) (*entity.Order, error)
+	FindAll(ctx context.Context, customerId uint64, status []valueobject.OrderStatus, statusExclude []valueobject.OrderStatus, channels []valueobject.OrderChannel, fulfilmentModes []valueobject.FulfilmentMode, page, limit int, sort string) ([]*entity.Order, int64, error)
+	FindByCustomer(ctx context.Context, customerID uint64, from, to time.Time, page, limit int) ([]*entity.Order, int64, error)
+	SumCustomerSpending(ctx context.Context, customerID uint64, from, to time.Time) (float64, error)
+	FindIdle(ctx context.Context, status valueobject.OrderStatus, updatedBefore time.Time, limit int) ([]*entity.Order, error)
+	FindOnPickupBoard(ctx context.Context, day time.Time, limit int) ([]*entity.Order, error)
+	NextPickupNumber(ctx context.Context, day time.Time) (uint32, error)
 	Create(ctx context.Context, order *entity.Order) error
 	Update(ctx context.Context, order *entity.Order) error
+	UpdateTotals(ctx context.Context, order *entity.Order) error
 	Delete(ctx context.Context, id uint64) error
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
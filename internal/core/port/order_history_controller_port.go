@@ -8,7 +8,6 @@ import (
 
 type OrderHistoryController interface {
 	List(ctx context.Context, presenter Presenter, input dto.ListOrderHistoriesInput) ([]byte, error)
-	Create(ctx context.Context, presenter Presenter, input dto.CreateOrderHistoryInput) ([]byte, error)
 	Get(ctx context.Context, presenter Presenter, input dto.GetOrderHistoryInput) ([]byte, error)
-	Delete(ctx context.Context, presenter Presenter, input dto.DeleteOrderHistoryInput) ([]byte, error)
+	Verify(ctx context.Context, presenter Presenter, input dto.VerifyOrderHistoriesInput) ([]byte, error)
 }
@@ -9,7 +9,8 @@ import (
 type OrderHistoryDataSource interface {
 	FindByID(ctx context.Context, id uint64) (*entity.OrderHistory, error)
 	FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.OrderHistory, int64, error)
+	FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error)
+	FindLastSealedByOrderID(ctx context.Context, orderID uint64) (*entity.OrderHistory, error)
 	Create(ctx context.Context, entity *entity.OrderHistory) error
-	Delete(ctx context.Context, id uint64) error
 	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
@@ -10,6 +10,6 @@ import (
 type OrderHistoryGateway interface {
 	FindByID(ctx context.Context, id uint64) (*entity.OrderHistory, error)
 	FindAll(ctx context.Context, orderID uint64, status valueobject.OrderStatus, page, limit int) ([]*entity.OrderHistory, int64, error)
+	FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error)
 	Create(ctx context.Context, entity *entity.OrderHistory) error
-	Delete(ctx context.Context, id uint64) error
 }
@@ -10,7 +10,6 @@ import (
 
 type OrderHistoryUseCase interface {
 	List(ctx context.Context, input dto.ListOrderHistoriesInput) ([]*entity.OrderHistory, int64, error)
-	Create(ctx context.Context, input dto.CreateOrderHistoryInput) (*entity.OrderHistory, error)
 	Get(ctx context.Context, input dto.GetOrderHistoryInput) (*entity.OrderHistory, error)
-	Delete(ctx context.Context, input dto.DeleteOrderHistoryInput) (*entity.OrderHistory, error)
+	Verify(ctx context.Context, input dto.VerifyOrderHistoriesInput) (*entity.OrderHistoryVerification, error)
 }
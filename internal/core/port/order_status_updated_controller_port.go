@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type OrderStatusUpdatedController interface {
+	Process(ctx context.Context, presenter Presenter, input dto.ProcessOrderStatusUpdatedInput) ([]byte, error)
+}
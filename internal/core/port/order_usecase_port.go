@@ -4,13 +4,19 @@ import (
 	"context"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 )
 
 type OrderUseCase interface {
 	List(ctx context.Context, input dto.ListOrdersInput) ([]*entity.Order, int64, error)
+	ListByCustomer(ctx context.Context, input dto.ListCustomerOrdersInput) (*entity.CustomerOrderHistory, int64, error)
 	Create(ctx context.Context, input dto.CreateOrderInput) (*entity.Order, error)
 	Get(ctx context.Context, input dto.GetOrderInput) (*entity.Order, error)
+	GetByGuestToken(ctx context.Context, input dto.GetGuestOrderInput) (*entity.Order, error)
+	AttachCustomer(ctx context.Context, input dto.AttachOrderCustomerInput) (*entity.Order, error)
 	Update(ctx context.Context, input dto.UpdateOrderInput) (*entity.Order, error)
 	Delete(ctx context.Context, input dto.DeleteOrderInput) (*entity.Order, error)
+	GetStatusMachine(ctx context.Context) *valueobject.OrderStatusMachine
+	ExpireIdle(ctx context.Context, input dto.ExpireIdleOrdersInput) ([]*entity.Order, error)
 }
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type PaymentController interface {
+	Checkout(ctx context.Context, presenter Presenter, input dto.CheckoutInput) ([]byte, error)
+	Callback(ctx context.Context, presenter Presenter, input dto.PaymentCallbackInput) ([]byte, error)
+}
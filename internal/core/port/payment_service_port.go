@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+// PaymentService creates the payments of the orders on the payment service
+type PaymentService interface {
+	// CreatePayment charges the total of the order, the result is informed later by a callback or event
+	CreatePayment(ctx context.Context, order *entity.Order) (*entity.Payment, error)
+}
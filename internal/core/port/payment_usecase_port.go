@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type PaymentUseCase interface {
+	Checkout(ctx context.Context, input dto.CheckoutInput) (*entity.Order, error)
+	Callback(ctx context.Context, input dto.PaymentCallbackInput) (*entity.Order, error)
+}
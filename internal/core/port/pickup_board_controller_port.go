@@ -0,0 +1,9 @@
+package port
+
+import (
+	"context"
+)
+
+type PickupBoardController interface {
+	Get(ctx context.Context, presenter Presenter) ([]byte, error)
+}
@@ -0,0 +1,11 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type PickupBoardUseCase interface {
+	Get(ctx context.Context) (*entity.PickupBoard, error)
+}
@@ -9,8 +9,15 @@ import (
 type ProductDataSource interface {
 	FindByID(ctx context.Context, id uint64) (*entity.Product, error)
 	FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.Product, int64, error)
+	FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error)
 	Create(ctx context.Context, product *entity.Product) error
 	Update(ctx context.Context, product *entity.Product) error
+	ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error
+	ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error
+	ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error
+	UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error)
+	FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error)
+	SchedulePrice(ctx context.Context, price *entity.ProductPrice) error
 	Delete(ctx context.Context, id uint64) error
 	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
@@ -2,14 +2,22 @@ package port
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 )
 
 type ProductGateway interface {
 	FindByID(ctx context.Context, id uint64) (*entity.Product, error)
-	FindAll(ctx context.Context, name string, categoryID uint64, page, limit int) ([]*entity.Product, int64, error)
+	FindAll(ctx context.Context, name string, categoryID uint64, availableAt time.Time, page, limit int) ([]*entity.Product, int64, error)
+	FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error)
 	Create(ctx context.Context, product *entity.Product) error
 	Update(ctx context.Context, product *entity.Product) error
+	ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error
+	ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error
+	ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error
+	UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error)
+	FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error)
+	SchedulePrice(ctx context.Context, price *entity.ProductPrice) error
 	Delete(ctx context.Context, id uint64) error
 }
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type ProductPriceController interface {
+	List(ctx context.Context, presenter Presenter, input dto.ListProductPricesInput) ([]byte, error)
+	Schedule(ctx context.Context, presenter Presenter, input dto.ScheduleProductPriceInput) ([]byte, error)
+}
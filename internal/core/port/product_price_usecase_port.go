@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type ProductPriceUseCase interface {
+	List(ctx context.Context, input dto.ListProductPricesInput) ([]*entity.ProductPrice, int64, error)
+	Schedule(ctx context.Context, input dto.ScheduleProductPriceInput) (*entity.ProductPrice, error)
+}
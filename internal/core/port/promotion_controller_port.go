@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type PromotionController interface {
+	List(ctx context.Context, presenter Presenter, input dto.ListPromotionsInput) ([]byte, error)
+	Create(ctx context.Context, presenter Presenter, input dto.CreatePromotionInput) ([]byte, error)
+	Get(ctx context.Context, presenter Presenter, input dto.GetPromotionInput) ([]byte, error)
+	Update(ctx context.Context, presenter Presenter, input dto.UpdatePromotionInput) ([]byte, error)
+	Delete(ctx context.Context, presenter Presenter, input dto.DeletePromotionInput) ([]byte, error)
+	ApplyToOrder(ctx context.Context, presenter Presenter, input dto.ApplyOrderPromotionsInput) ([]byte, error)
+	RemoveFromOrder(ctx context.Context, presenter Presenter, input dto.RemoveOrderCouponInput) ([]byte, error)
+}
@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type PromotionDataSource interface {
+	FindByID(ctx context.Context, id uint64) (*entity.Promotion, error)
+	FindByCode(ctx context.Context, code string) (*entity.Promotion, error)
+	FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.Promotion, int64, error)
+	FindAllAutomatic(ctx context.Context) ([]*entity.Promotion, error)
+	CountCustomerUsage(ctx context.Context, promotionID, customerID, excludeOrderID uint64) (int64, error)
+	Create(ctx context.Context, promotion *entity.Promotion) error
+	Update(ctx context.Context, promotion *entity.Promotion) error
+	Delete(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,19 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type PromotionUseCase interface {
+	List(ctx context.Context, input dto.ListPromotionsInput) ([]*entity.Promotion, int64, error)
+	Create(ctx context.Context, input dto.CreatePromotionInput) (*entity.Promotion, error)
+	Get(ctx context.Context, input dto.GetPromotionInput) (*entity.Promotion, error)
+	Update(ctx context.Context, input dto.UpdatePromotionInput) (*entity.Promotion, error)
+	Delete(ctx context.Context, input dto.DeletePromotionInput) (*entity.Promotion, error)
+	ApplyToOrder(ctx context.Context, input dto.ApplyOrderPromotionsInput) (*entity.Order, error)
+	RemoveFromOrder(ctx context.Context, input dto.RemoveOrderCouponInput) (*entity.Order, error)
+	RepriceOrder(ctx context.Context, orderID uint64) error
+}
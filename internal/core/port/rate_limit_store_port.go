@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// RateLimitStore keeps the token buckets of the rate limited clients, the buckets are shared by all the
+// instances of the service when the store is
+type RateLimitStore interface {
+	// Take takes a request from the bucket of the key, the bucket is created full on the first request
+	Take(ctx context.Context, key string, limit valueobject.RateLimit) (valueobject.RateLimitResult, error)
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type ReorderUseCase interface {
+	Reorder(ctx context.Context, input dto.ReorderInput) (*entity.Reorder, error)
+}
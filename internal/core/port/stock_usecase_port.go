@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type StockUseCase interface {
+	Update(ctx context.Context, input dto.UpdateProductStockInput) (*entity.Product, error)
+	Reserve(ctx context.Context, order *entity.Order) error
+	Release(ctx context.Context, order *entity.Order) error
+}
@@ -0,0 +1,12 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type WebhookDeliveryController interface {
+	List(ctx context.Context, presenter Presenter, input dto.ListWebhookDeliveriesInput) ([]byte, error)
+	Redeliver(ctx context.Context, presenter Presenter, input dto.RedeliverWebhookInput) ([]byte, error)
+}
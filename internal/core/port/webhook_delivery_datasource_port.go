@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type WebhookDeliveryDataSource interface {
+	FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error)
+	FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.WebhookDelivery, int64, error)
+	// ClaimDue returns the pending deliveries due at now with their subscriptions,
+	// their next attempt is moved to the end of the lease so no other dispatcher claims them
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error)
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
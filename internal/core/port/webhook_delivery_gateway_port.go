@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+type WebhookDeliveryGateway interface {
+	FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error)
+	FindAll(ctx context.Context, subscriptionID uint64, status valueobject.WebhookDeliveryStatus, page, limit int) ([]*entity.WebhookDelivery, int64, error)
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error)
+	Create(ctx context.Context, delivery *entity.WebhookDelivery) error
+	Update(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type WebhookDeliveryUseCase interface {
+	List(ctx context.Context, input dto.ListWebhookDeliveriesInput) ([]*entity.WebhookDelivery, int64, error)
+	Redeliver(ctx context.Context, input dto.RedeliverWebhookInput) (*entity.WebhookDelivery, error)
+	// Enqueue creates the deliveries of the event to the subscribers, they are sent by Dispatch
+	Enqueue(ctx context.Context, event entity.OrderEvent) ([]*entity.WebhookDelivery, error)
+	// Dispatch sends the pending deliveries that are due and returns them with the outcome
+	Dispatch(ctx context.Context, input dto.DispatchWebhooksInput) ([]*entity.WebhookDelivery, error)
+}
@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+	"time"
+)
+
+// WebhookReplayStore remembers the signed webhook requests already received, so a captured request can't be
+// replayed while its timestamp is still accepted. The requests are shared by all the instances of the service
+// when the store is
+type WebhookReplayStore interface {
+	// Seen records the key for the ttl and returns true when it was already recorded
+	Seen(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
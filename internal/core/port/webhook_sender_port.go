@@ -0,0 +1,14 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+// WebhookSender sends the deliveries to the subscribers
+type WebhookSender interface {
+	// Send posts the payload of the delivery signed with the secret of the subscription, it returns the status
+	// of the response, zero when there was none, and an error when the subscriber did not accept the delivery
+	Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error)
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type WebhookSubscriptionController interface {
+	List(ctx context.Context, presenter Presenter, input dto.ListWebhookSubscriptionsInput) ([]byte, error)
+	Create(ctx context.Context, presenter Presenter, input dto.CreateWebhookSubscriptionInput) ([]byte, error)
+	Get(ctx context.Context, presenter Presenter, input dto.GetWebhookSubscriptionInput) ([]byte, error)
+	Update(ctx context.Context, presenter Presenter, input dto.UpdateWebhookSubscriptionInput) ([]byte, error)
+	Delete(ctx context.Context, presenter Presenter, input dto.DeleteWebhookSubscriptionInput) ([]byte, error)
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+type WebhookSubscriptionDataSource interface {
+	FindByID(ctx context.Context, id uint64) (*entity.WebhookSubscription, error)
+	FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.WebhookSubscription, int64, error)
+	FindAllActive(ctx context.Context) ([]*entity.WebhookSubscription, error)
+	Create(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Update(ctx context.Context, subscription *entity.WebhookSubscription) error
+	Delete(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+type WebhookSubscriptionUseCase interface {
+	List(ctx context.Context, input dto.ListWebhookSubscriptionsInput) ([]*entity.WebhookSubscription, int64, error)
+	Create(ctx context.Context, input dto.CreateWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	Get(ctx context.Context, input dto.GetWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	Update(ctx context.Context, input dto.UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+	Delete(ctx context.Context, input dto.DeleteWebhookSubscriptionInput) (*entity.WebhookSubscription, error)
+}
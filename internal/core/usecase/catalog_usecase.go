@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogUseCase struct {
+	gateway   port.CatalogGateway
+	menuCache port.MenuCache
+}
+
+// NewCatalogUseCase creates a new CatalogUseCase
+func NewCatalogUseCase(gateway port.CatalogGateway, menuCache port.MenuCache) port.CatalogUseCase {
+	return &catalogUseCase{gateway, menuCache}
+}
+
+// Import upserts the categories and products of the rows in a single transaction, nothing is written
+// when a row is invalid or on a dry run. The validation errors of the rows are part of the result
+func (uc *catalogUseCase) Import(ctx context.Context, i dto.ImportCatalogInput) (*entity.CatalogImport, error) {
+	if len(i.Rows) == 0 {
+		return nil, domain.NewInvalidInputError(domain.ErrCatalogEmpty)
+	}
+
+	categories, err := uc.gateway.FindAllCategories(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	products, err := uc.gateway.FindAllProducts(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	catalogImport := entity.NewCatalogImport(dto.ToCatalogRowEntities(i.Rows), categories, products, i.DryRun)
+	if catalogImport.HasErrors() || catalogImport.DryRun {
+		return catalogImport, nil
+	}
+
+	if err := uc.gateway.Apply(ctx, catalogImport); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	catalogImport.Applied = true
+	uc.menuCache.Invalidate(ctx)
+
+	return catalogImport, nil
+}
+
+// Export returns all the categories and products as catalog rows
+func (uc *catalogUseCase) Export(ctx context.Context) (*entity.Catalog, error) {
+	categories, err := uc.gateway.FindAllCategories(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	products, err := uc.gateway.FindAllProducts(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return entity.NewCatalog(categories, products), nil
+}
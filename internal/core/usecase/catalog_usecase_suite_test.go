@@ -0,0 +1,52 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type CatalogUsecaseSuiteTest struct {
+	suite.Suite
+	mockGateway   *mockport.MockCatalogGateway
+	mockMenuCache *mockport.MockMenuCache
+	useCase       port.CatalogUseCase
+	ctx           context.Context
+}
+
+func (s *CatalogUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockCatalogGateway(ctrl)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewCatalogUseCase(s.mockGateway, s.mockMenuCache)
+	s.ctx = context.Background()
+}
+
+// mockCatalogCategories returns new categories on each call, the import plan changes them in place
+func (s *CatalogUsecaseSuiteTest) mockCatalogCategories() []*entity.Category {
+	foodsID := uint64(1)
+	return []*entity.Category{
+		{ID: 1, Name: "Foods", DisplayOrder: 1, Active: true},
+		{ID: 2, Name: "Burgers", ParentID: &foodsID, DisplayOrder: 1, Active: true},
+		{ID: 3, Name: "Beverages", DisplayOrder: 2, Active: true},
+	}
+}
+
+func (s *CatalogUsecaseSuiteTest) mockCatalogProducts() []*entity.Product {
+	return []*entity.Product{
+		{ID: 1, Name: "X-Burger", Description: "Hamburger with cheese", Price: 25.9, CategoryID: 2, StockMode: valueobject.StockUnlimited, Available: true},
+		{ID: 2, Name: "Coca-Cola 350ml", Description: "Soda", Price: 6.9, CategoryID: 3, StockMode: valueobject.StockCounted, StockQuantity: 10, Available: true},
+	}
+}
+
+func TestCatalogUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(CatalogUsecaseSuiteTest))
+}
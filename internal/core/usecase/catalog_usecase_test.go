@@ -0,0 +1,242 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *CatalogUsecaseSuiteTest) TestCatalogUseCase_Import() {
+	quantity := int64(5)
+	rows := []dto.CatalogRowInput{
+		{Row: 1, Category: "Burgers", ParentCategory: "Foods", Name: "X-Burger", Description: "Hamburger with cheese", Price: 27.9},
+		{Row: 2, Category: "Beverages", Name: "Coca-Cola 350ml", Description: "Soda", Price: 6.9, StockMode: "counted", StockQuantity: &quantity},
+		{Row: 3, Category: "Desserts", Name: "Brownie", Description: "Chocolate brownie", Price: 12},
+		{Row: 4, Category: "Ice creams", ParentCategory: "Desserts"},
+	}
+
+	tests := []struct {
+		name        string
+		input       dto.ImportCatalogInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.CatalogImport, error)
+	}{
+		{
+			name:       "should return invalid input error when catalog is empty",
+			input:      dto.ImportCatalogInput{},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.Nil(t, result)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.Equal(t, domain.ErrCatalogEmpty, err.Error())
+			},
+		},
+		{
+			name:  "should report the changes without writing them on a dry run",
+			input: dto.ImportCatalogInput{Rows: rows, DryRun: true},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(s.mockCatalogProducts(), nil)
+			},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.NoError(t, err)
+				assert.True(t, result.DryRun)
+				assert.False(t, result.Applied)
+				assert.Empty(t, result.Errors)
+				assert.Equal(t, entity.CatalogImportSummary{
+					CategoriesCreated: 2,
+					ProductsCreated:   1,
+					ProductsUpdated:   2,
+					Unchanged:         2,
+				}, result.Summary())
+				// The parent is written before its child
+				assert.Equal(t, "Desserts", result.Categories[2].Category.Name)
+				assert.Equal(t, "Ice creams", result.Categories[3].Category.Name)
+			},
+		},
+		{
+			name: "should report the invalid rows without writing them",
+			input: dto.ImportCatalogInput{Rows: []dto.CatalogRowInput{
+				{Row: 1, Category: "Burgers", Name: "X-Burger", Price: 0},
+				{Row: 2, Category: "Beverages", Name: "Water", Price: 3, StockMode: "SOMETIMES"},
+				{Row: 3, Category: "Desserts", ParentCategory: "Sweets"},
+				{Row: 4, Category: "Foods", ParentCategory: "Burgers"},
+				{Row: 5, Category: "Beverages", Name: "water", Price: 3},
+			}},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(s.mockCatalogProducts(), nil)
+			},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.NoError(t, err)
+				assert.False(t, result.Applied)
+				assert.Equal(t, []entity.CatalogImportError{
+					{Row: 1, Message: "price must be greater than zero"},
+					{Row: 2, Message: "stock_mode must be UNLIMITED or COUNTED"},
+					{Row: 3, Message: `parent category of "Desserts" not found`},
+					{Row: 4, Message: `category "Foods" can not be its own ancestor`},
+				}, result.Errors)
+			},
+		},
+		{
+			name:  "should apply the changes and invalidate the menu cache",
+			input: dto.ImportCatalogInput{Rows: rows},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(s.mockCatalogProducts(), nil)
+
+				s.mockGateway.EXPECT().
+					Apply(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ any, catalogImport *entity.CatalogImport) error {
+						assert.Len(s.T(), catalogImport.Products, 3)
+						assert.Equal(s.T(), valueobject.StockCounted, catalogImport.Products[1].Product.StockMode)
+						assert.Equal(s.T(), int64(5), catalogImport.Products[1].Product.StockQuantity)
+						assert.False(s.T(), catalogImport.Products[1].StockModeChanged)
+						assert.True(s.T(), catalogImport.Products[1].StockQuantityChanged)
+						assert.False(s.T(), catalogImport.Products[1].AvailableChanged)
+						// The rows without stock keep the stock of the products
+						assert.False(s.T(), catalogImport.Products[0].StockModeChanged)
+						assert.False(s.T(), catalogImport.Products[0].StockQuantityChanged)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.NoError(t, err)
+				assert.True(t, result.Applied)
+				assert.Equal(t, 4, result.Rows)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails to apply",
+			input: dto.ImportCatalogInput{Rows: rows},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(s.mockCatalogProducts(), nil)
+
+				s.mockGateway.EXPECT().
+					Apply(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.Nil(t, result)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails to find the categories",
+			input: dto.ImportCatalogInput{Rows: rows},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, result *entity.CatalogImport, err error) {
+				assert.Nil(t, result)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			result, err := s.useCase.Import(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, result, err)
+		})
+	}
+}
+
+func (s *CatalogUsecaseSuiteTest) TestCatalogUseCase_Export() {
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Catalog, error)
+	}{
+		{
+			name: "should export the products and the categories without products",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(s.mockCatalogProducts(), nil)
+			},
+			checkResult: func(t *testing.T, catalog *entity.Catalog, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, catalog.Rows, 3)
+				assert.Equal(t, "Foods", catalog.Rows[0].Category)
+				assert.Empty(t, catalog.Rows[0].Name)
+				assert.Equal(t, "Burgers", catalog.Rows[1].Category)
+				assert.Equal(t, "Foods", catalog.Rows[1].ParentCategory)
+				assert.Equal(t, "X-Burger", catalog.Rows[1].Name)
+				assert.Equal(t, "Coca-Cola 350ml", catalog.Rows[2].Name)
+				assert.Equal(t, int64(10), *catalog.Rows[2].StockQuantity)
+			},
+		},
+		{
+			name: "should return internal error when gateway fails",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllCategories(s.ctx).
+					Return(s.mockCatalogCategories(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllProducts(s.ctx).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, catalog *entity.Catalog, err error) {
+				assert.Nil(t, catalog)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			catalog, err := s.useCase.Export(s.ctx)
+
+			// Assert
+			tt.checkResult(t, catalog, err)
+		})
+	}
+}
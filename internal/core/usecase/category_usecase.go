@@ -10,12 +10,13 @@ import (
 )
 
 type categoryUseCase struct {
-	gateway port.CategoryGateway
+	gateway   port.CategoryGateway
+	menuCache port.MenuCache
 }
 
 // NewCategoryUseCase creates a new categoryUseCase
-func NewCategoryUseCase(gateway port.CategoryGateway) port.CategoryUseCase {
-	return &categoryUseCase{gateway}
+func NewCategoryUseCase(gateway port.CategoryGateway, menuCache port.MenuCache) port.CategoryUseCase {
+	return &categoryUseCase{gateway, menuCache}
 }
 
 // List returns a list of Categories
@@ -32,9 +33,18 @@ func (uc *categoryUseCase) List(ctx context.Context, i dto.ListCategoriesInput)
 func (uc *categoryUseCase) Create(ctx context.Context, i dto.CreateCategoryInput) (*entity.Category, error) {
 	category := i.ToEntity()
 
+	if err := uc.validateParent(ctx, 0, category.ParentID); err != nil {
+		return nil, err
+	}
+
+	if err := validateCategoryAvailabilityWindows(category.AvailabilityWindows); err != nil {
+		return nil, err
+	}
+
 	if err := uc.gateway.Create(ctx, category); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
+	uc.menuCache.Invalidate(ctx)
 
 	return category, nil
 }
@@ -63,12 +73,29 @@ func (uc *categoryUseCase) Update(ctx context.Context, i dto.UpdateCategoryInput
 		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
-	category.Update(i.Name)
+	if err := uc.validateParent(ctx, category.ID, i.ParentID); err != nil {
+		return nil, err
+	}
+
+	windows := dto.ToCategoryAvailabilityWindowEntities(i.AvailabilityWindows)
+	if err := validateCategoryAvailabilityWindows(windows); err != nil {
+		return nil, err
+	}
+
+	category.Update(i.Name, i.ParentID, i.DisplayOrder, i.Active, i.ImageURL, i.KitchenStation)
 
 	if err := uc.gateway.Update(ctx, category); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	if windows != nil {
+		if err := uc.gateway.ReplaceAvailabilityWindows(ctx, category.ID, windows); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		category.AvailabilityWindows = windows
+	}
+	uc.menuCache.Invalidate(ctx)
+
 	return category, nil
 }
 
@@ -85,6 +112,48 @@ func (uc *categoryUseCase) Delete(ctx context.Context, i dto.DeleteCategoryInput
 	if err := uc.gateway.Delete(ctx, i.ID); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
+	uc.menuCache.Invalidate(ctx)
 
 	return category, nil
 }
+
+// validateParent checks that the parent category exists and that the category is not one of its ancestors
+func (uc *categoryUseCase) validateParent(ctx context.Context, categoryID uint64, parentID *uint64) error {
+	if parentID == nil {
+		return nil
+	}
+
+	visited := make(map[uint64]bool)
+	for id := *parentID; !visited[id]; {
+		if id == categoryID {
+			return domain.NewInvalidInputError(domain.ErrCategoryParentCycle)
+		}
+		visited[id] = true
+
+		parent, err := uc.gateway.FindByID(ctx, id)
+		if err != nil {
+			return domain.NewInternalError(err)
+		}
+		if parent == nil {
+			if id == *parentID {
+				return domain.NewInvalidInputError(domain.ErrCategoryParentNotFound)
+			}
+			return nil
+		}
+		if parent.ParentID == nil {
+			return nil
+		}
+		id = *parent.ParentID
+	}
+	return domain.NewInvalidInputError(domain.ErrCategoryParentCycle)
+}
+
+// validateCategoryAvailabilityWindows checks the weekday, times and timezone of each window
+func validateCategoryAvailabilityWindows(windows []entity.CategoryAvailabilityWindow) error {
+	for i := range windows {
+		if err := windows[i].Validate(); err != nil {
+			return domain.NewInvalidInputError(err.Error())
+		}
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ type CategoryUsecaseSuiteTest struct {
 	// handler handler
 	mockCategories []*entity.Category
 	mockGateway    *mockport.MockCategoryGateway
+	mockMenuCache  *mockport.MockMenuCache
 	useCase        port.CategoryUseCase
 	ctx            context.Context
 }
@@ -28,7 +29,8 @@ func (s *CategoryUsecaseSuiteTest) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
 	s.mockGateway = mockport.NewMockCategoryGateway(ctrl)
-	s.useCase = usecase.NewCategoryUseCase(s.mockGateway)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewCategoryUseCase(s.mockGateway, s.mockMenuCache)
 	s.ctx = context.Background()
 	currentTime := time.Now()
 	s.mockCategories = []*entity.Category{
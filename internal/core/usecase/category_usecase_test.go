@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -105,6 +106,9 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Create() {
 				s.mockGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
 					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, category *entity.Category, err error) {
 				assert.NoError(t, err)
@@ -112,6 +116,60 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Create() {
 				assert.Equal(t, s.mockCategories[0].Name, category.Name)
 			},
 		},
+		{
+			name: "should create subcategory successfully",
+			input: dto.CreateCategoryInput{
+				Name:     "Burgers",
+				ParentID: &s.mockCategories[0].ID,
+				Active:   true,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, s.mockCategories[0].ID).
+					Return(s.mockCategories[0], nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, category *entity.Category, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockCategories[0].ID, *category.ParentID)
+			},
+		},
+		{
+			name: "should return invalid input error when parent category doesn't exist",
+			input: dto.CreateCategoryInput{
+				Name:     "Burgers",
+				ParentID: &s.mockCategories[0].ID,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, s.mockCategories[0].ID).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, category *entity.Category, err error) {
+				assert.Nil(t, category)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrCategoryParentNotFound), err)
+			},
+		},
+		{
+			name: "should return invalid input error when availability window has no duration",
+			input: dto.CreateCategoryInput{
+				Name: "Breakfast",
+				AvailabilityWindows: []dto.AvailabilityWindowInput{
+					{Weekday: time.Monday, StartTime: "06:00", EndTime: "06:00", Timezone: "America/Sao_Paulo"},
+				},
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, category *entity.Category, err error) {
+				assert.Nil(t, category)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
 		{
 			name: "should return error when gateway fails",
 			input: dto.CreateCategoryInput{
@@ -213,6 +271,8 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Get() {
 }
 
 func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Update() {
+	burgersID := uint64(3)
+
 	tests := []struct {
 		name        string
 		input       dto.UpdateCategoryInput
@@ -236,6 +296,9 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Update() {
 						assert.Equal(s.T(), "Foods UPDATED", p.Name)
 						return nil
 					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, category *entity.Category, err error) {
 				assert.NoError(t, err)
@@ -244,6 +307,59 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Update() {
 				assert.Equal(t, s.mockCategories[0].CreatedAt, category.CreatedAt)
 			},
 		},
+		{
+			name: "should replace availability windows when given",
+			input: dto.UpdateCategoryInput{
+				ID:   1,
+				Name: "Breakfast",
+				AvailabilityWindows: []dto.AvailabilityWindowInput{
+					{Weekday: time.Monday, StartTime: "06:00", EndTime: "10:30", Timezone: "America/Sao_Paulo"},
+					{Weekday: time.Tuesday, StartTime: "06:00", EndTime: "10:30", Timezone: "America/Sao_Paulo"},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCategories[0], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					ReplaceAvailabilityWindows(s.ctx, uint64(1), gomock.Len(2)).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, category *entity.Category, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, category.AvailabilityWindows, 2)
+			},
+		},
+		{
+			name: "should return invalid input error when the parent is a descendant of the category",
+			input: dto.UpdateCategoryInput{
+				ID:       1,
+				Name:     "Foods",
+				ParentID: &burgersID,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Category{ID: 1, Name: "Foods"}, nil)
+
+				parentID := uint64(1)
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(&entity.Category{ID: 3, Name: "Burgers", ParentID: &parentID}, nil)
+			},
+			checkResult: func(t *testing.T, category *entity.Category, err error) {
+				assert.Nil(t, category)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrCategoryParentCycle), err)
+			},
+		},
 		{
 			name: "should return error when category not found",
 			input: dto.UpdateCategoryInput{
@@ -333,6 +449,9 @@ func (s *CategoryUsecaseSuiteTest) TestCategoryUseCase_Delete() {
 				s.mockGateway.EXPECT().
 					Delete(s.ctx, uint64(1)).
 					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, category *entity.Category, err error) {
 				assert.NoError(t, err)
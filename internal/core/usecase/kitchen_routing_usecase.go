@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenRoutingUseCase struct {
+	gateway         port.KitchenTicketGateway
+	productGateway  port.ProductGateway
+	categoryGateway port.CategoryGateway
+}
+
+// NewKitchenRoutingUseCase creates a new KitchenRoutingUseCase, used by the orders to reach the kitchen
+func NewKitchenRoutingUseCase(
+	gateway port.KitchenTicketGateway,
+	productGateway port.ProductGateway,
+	categoryGateway port.CategoryGateway,
+) port.KitchenRoutingUseCase {
+	return &kitchenRoutingUseCase{gateway, productGateway, categoryGateway}
+}
+
+// Route splits the order into one ticket per kitchen station. The station of each product is taken from
+// the product, its category or the ancestors of the category, products without a station go to the default one
+func (uc *kitchenRoutingUseCase) Route(ctx context.Context, order *entity.Order) ([]*entity.KitchenTicket, error) {
+	stations := make(map[uint64]valueobject.KitchenStation)
+	categories := make(map[uint64]*entity.Category)
+
+	for _, productID := range order.KitchenProductIDs() {
+		product, err := uc.productGateway.FindByID(ctx, productID)
+		if err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		if product == nil {
+			continue
+		}
+
+		if product.KitchenStation == nil {
+			if err := uc.loadCategories(ctx, product.CategoryID, categories); err != nil {
+				return nil, err
+			}
+		}
+		stations[productID] = product.KitchenStationFrom(categories)
+	}
+
+	tickets := entity.NewKitchenTickets(order, stations)
+	if err := uc.gateway.CreateAll(ctx, tickets); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return tickets, nil
+}
+
+// loadCategories adds the category and its ancestors to the index, stopping at the first one already there
+func (uc *kitchenRoutingUseCase) loadCategories(ctx context.Context, categoryID uint64, categories map[uint64]*entity.Category) error {
+	for id := categoryID; id != 0; {
+		if _, ok := categories[id]; ok {
+			return nil
+		}
+
+		category, err := uc.categoryGateway.FindByID(ctx, id)
+		if err != nil {
+			return domain.NewInternalError(err)
+		}
+		if category == nil {
+			return nil
+		}
+
+		categories[id] = category
+		if category.ParentID == nil {
+			return nil
+		}
+		id = *category.ParentID
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type KitchenRoutingUsecaseSuiteTest struct {
+	suite.Suite
+	mockOrder           *entity.Order
+	mockProducts        map[uint64]*entity.Product
+	mockCategories      map[uint64]*entity.Category
+	mockGateway         *mockport.MockKitchenTicketGateway
+	mockProductGateway  *mockport.MockProductGateway
+	mockCategoryGateway *mockport.MockCategoryGateway
+	useCase             port.KitchenRoutingUseCase
+	ctx                 context.Context
+}
+
+func (s *KitchenRoutingUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockKitchenTicketGateway(ctrl)
+	s.mockProductGateway = mockport.NewMockProductGateway(ctrl)
+	s.mockCategoryGateway = mockport.NewMockCategoryGateway(ctrl)
+	s.useCase = usecase.NewKitchenRoutingUseCase(s.mockGateway, s.mockProductGateway, s.mockCategoryGateway)
+	s.ctx = context.Background()
+
+	grill, fryer, drinks := valueobject.StationGrill, valueobject.StationFryer, valueobject.StationDrinks
+	foodsID := uint64(10)
+	s.mockCategories = map[uint64]*entity.Category{
+		10: {ID: 10, Name: "Foods", KitchenStation: &grill},
+		1:  {ID: 1, Name: "Burgers", ParentID: &foodsID},
+		2:  {ID: 2, Name: "Sides", KitchenStation: &fryer},
+	}
+	s.mockProducts = map[uint64]*entity.Product{
+		1: {ID: 1, Name: "X-Burger", CategoryID: 1},
+		2: {ID: 2, Name: "Coca-Cola 350ml", CategoryID: 3, KitchenStation: &drinks},
+		4: {ID: 4, Name: "French Fries", CategoryID: 2},
+	}
+	s.mockOrder = &entity.Order{
+		ID:     1,
+		Status: valueobject.RECEIVED,
+		OrderProducts: []entity.OrderProduct{
+			{
+				ID: 1, OrderID: 1, ProductID: 1, Quantity: 2, Notes: "Well done",
+				Product:   entity.Product{ID: 1, Name: "X-Burger"},
+				Modifiers: []entity.OrderProductModifier{{Name: "Extra cheese"}, {Name: "No onion"}},
+			},
+			{
+				ID: 2, OrderID: 1, ProductID: 2, Quantity: 1,
+				Product: entity.Product{ID: 2, Name: "Coca-Cola 350ml"},
+			},
+			{
+				ID: 3, OrderID: 1, ProductID: 3, Quantity: 1,
+				Product: entity.Product{ID: 3, Name: "Combo Fries"},
+				Components: []entity.OrderProductComponent{
+					{ProductID: 4, Name: "French Fries", Quantity: 1},
+					{ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+				},
+			},
+		},
+	}
+}
+
+func TestKitchenRoutingUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(KitchenRoutingUsecaseSuiteTest))
+}
@@ -0,0 +1,141 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+func (s *KitchenRoutingUsecaseSuiteTest) TestKitchenRoutingUseCase_Route() {
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.KitchenTicket, error)
+	}{
+		{
+			name: "should split the order into one ticket per station",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id uint64) (*entity.Product, error) {
+						return s.mockProducts[id], nil
+					}).
+					Times(3)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id uint64) (*entity.Category, error) {
+						return s.mockCategories[id], nil
+					}).
+					Times(3)
+
+				s.mockGateway.EXPECT().
+					CreateAll(s.ctx, gomock.Len(3)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, tickets, 3)
+
+				assert.Equal(t, valueobject.StationGrill, tickets[0].Station)
+				assert.Equal(t, valueobject.TicketQueued, tickets[0].Status)
+				assert.Equal(t, []entity.KitchenTicketItem{
+					{OrderProductID: 1, ProductID: 1, Name: "X-Burger", Quantity: 2, Modifiers: "Extra cheese, No onion", Notes: "Well done"},
+				}, tickets[0].Items)
+
+				assert.Equal(t, valueobject.StationDrinks, tickets[1].Station)
+				assert.Equal(t, []entity.KitchenTicketItem{
+					{OrderProductID: 2, ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+					{OrderProductID: 3, ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+				}, tickets[1].Items)
+
+				assert.Equal(t, valueobject.StationFryer, tickets[2].Station)
+				assert.Equal(t, []entity.KitchenTicketItem{
+					{OrderProductID: 3, ProductID: 4, Name: "French Fries", Quantity: 1},
+				}, tickets[2].Items)
+			},
+		},
+		{
+			name: "should send the products without a station to the default station",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id uint64) (*entity.Product, error) {
+						return &entity.Product{ID: id, CategoryID: 99}, nil
+					}).
+					Times(3)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, uint64(99)).
+					Return(&entity.Category{ID: 99, Name: "Uncategorized"}, nil)
+
+				s.mockGateway.EXPECT().
+					CreateAll(s.ctx, gomock.Len(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, tickets, 1)
+				assert.Equal(t, valueobject.DefaultKitchenStation, tickets[0].Station)
+				assert.Len(t, tickets[0].Items, 4)
+			},
+		},
+		{
+			name: "should return internal error when product gateway fails",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, err error) {
+				assert.Nil(t, tickets)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return internal error when gateway create fails",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id uint64) (*entity.Product, error) {
+						return s.mockProducts[id], nil
+					}).
+					Times(3)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, id uint64) (*entity.Category, error) {
+						return s.mockCategories[id], nil
+					}).
+					Times(3)
+
+				s.mockGateway.EXPECT().
+					CreateAll(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, err error) {
+				assert.Nil(t, tickets)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			tickets, err := s.useCase.Route(s.ctx, s.mockOrder)
+
+			// Assert
+			tt.checkResult(t, tickets, err)
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+const (
+	// kitchenStartedReasonCode is recorded on orders moved to PREPARING by the first started ticket
+	kitchenStartedReasonCode = "KITCHEN_STARTED"
+	// kitchenDoneReasonCode is recorded on orders moved to READY by the last done ticket
+	kitchenDoneReasonCode = "KITCHEN_DONE"
+)
+
+type kitchenTicketUseCase struct {
+	gateway      port.KitchenTicketGateway
+	orderUseCase port.OrderUseCase
+}
+
+// NewKitchenTicketUseCase creates a new KitchenTicketUseCase.
+// The order follows its tickets: it is PREPARING once a ticket is started and READY once all of them are done
+func NewKitchenTicketUseCase(gateway port.KitchenTicketGateway, orderUseCase port.OrderUseCase) port.KitchenTicketUseCase {
+	return &kitchenTicketUseCase{gateway, orderUseCase}
+}
+
+// List returns the tickets of the orders being prepared, filtered by station and status
+func (uc *kitchenTicketUseCase) List(ctx context.Context, i dto.ListKitchenTicketsInput) ([]*entity.KitchenTicket, int64, error) {
+	tickets, total, err := uc.gateway.FindAll(ctx, i.Station, i.Status, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return tickets, total, nil
+}
+
+// Update moves a ticket forward and the order with it
+func (uc *kitchenTicketUseCase) Update(ctx context.Context, i dto.UpdateKitchenTicketInput) (*entity.KitchenTicket, error) {
+	ticket, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if ticket == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	order, err := uc.orderUseCase.Get(ctx, dto.GetOrderInput{ID: ticket.OrderID})
+	if err != nil {
+		return nil, err
+	}
+	if order.Status != valueobject.RECEIVED && order.Status != valueobject.PREPARING {
+		return nil, domain.NewInvalidInputError(domain.ErrOrderIsNotInKitchen)
+	}
+
+	if err := ticket.UpdateStatus(i.Status, i.StaffID); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.gateway.Update(ctx, ticket); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if ticket.Status == valueobject.TicketDone {
+		tickets, err := uc.gateway.FindAllByOrderID(ctx, ticket.OrderID)
+		if err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		if !entity.AllKitchenTicketsDone(tickets) {
+			return ticket, nil
+		}
+	}
+
+	if order.Status == valueobject.RECEIVED {
+		order, err = uc.moveOrder(ctx, order, valueobject.PREPARING, kitchenStartedReasonCode, i.StaffID)
+		if err != nil {
+			return nil, err
+		}
+		if order == nil {
+			return ticket, nil
+		}
+	}
+
+	if ticket.Status == valueobject.TicketDone {
+		if _, err := uc.moveOrder(ctx, order, valueobject.READY, kitchenDoneReasonCode, i.StaffID); err != nil {
+			return nil, err
+		}
+	}
+
+	return ticket, nil
+}
+
+// moveOrder changes the status of the order on behalf of the staff working on the ticket.
+// When a concurrent update changed the order in the meantime it is reloaded and retried,
+// a nil order is returned when the order left the kitchen
+func (uc *kitchenTicketUseCase) moveOrder(ctx context.Context, order *entity.Order, status valueobject.OrderStatus, reasonCode string, staffID uint64) (*entity.Order, error) {
+	var conflictErr *domain.ConflictError
+	var preconditionErr *domain.PreconditionFailedError
+	for attempt := 1; ; attempt++ {
+		moved, err := uc.orderUseCase.Update(ctx, dto.UpdateOrderInput{
+			ID:         order.ID,
+			Status:     status,
+			StaffID:    staffID,
+			ActorType:  valueobject.ActorStaff,
+			ReasonCode: reasonCode,
+			Source:     valueobject.SourceAPI,
+			Version:    order.Version,
+		})
+		if err == nil {
+			return moved, nil
+		}
+		if (!errors.As(err, &conflictErr) && !errors.As(err, &preconditionErr)) || attempt >= maxStatusUpdateAttempts {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, domain.NewInternalError(ctx.Err())
+		case <-time.After(time.Duration(attempt) * statusUpdateRetryBackoff):
+		}
+
+		order, err = uc.orderUseCase.Get(ctx, dto.GetOrderInput{ID: order.ID})
+		if err != nil {
+			return nil, err
+		}
+		// The other request may have moved the order already
+		if order.Status == status {
+			return order, nil
+		}
+		if order.Status != valueobject.RECEIVED && order.Status != valueobject.PREPARING {
+			return nil, nil
+		}
+	}
+}
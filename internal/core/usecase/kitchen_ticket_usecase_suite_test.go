@@ -0,0 +1,50 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type KitchenTicketUsecaseSuiteTest struct {
+	suite.Suite
+	mockTickets      []*entity.KitchenTicket
+	mockGateway      *mockport.MockKitchenTicketGateway
+	mockOrderUseCase *mockport.MockOrderUseCase
+	useCase          port.KitchenTicketUseCase
+	ctx              context.Context
+}
+
+func (s *KitchenTicketUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockKitchenTicketGateway(ctrl)
+	s.mockOrderUseCase = mockport.NewMockOrderUseCase(ctrl)
+	s.useCase = usecase.NewKitchenTicketUseCase(s.mockGateway, s.mockOrderUseCase)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.mockTickets = []*entity.KitchenTicket{
+		{
+			ID: 1, OrderID: 1, Station: valueobject.StationGrill, Status: valueobject.TicketQueued,
+			Items:     []entity.KitchenTicketItem{{ID: 1, KitchenTicketID: 1, OrderProductID: 1, ProductID: 1, Name: "X-Burger", Quantity: 2}},
+			CreatedAt: currentTime, UpdatedAt: currentTime,
+		},
+		{
+			ID: 2, OrderID: 1, Station: valueobject.StationDrinks, Status: valueobject.TicketQueued,
+			Items:     []entity.KitchenTicketItem{{ID: 2, KitchenTicketID: 2, OrderProductID: 2, ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1}},
+			CreatedAt: currentTime, UpdatedAt: currentTime,
+		},
+	}
+}
+
+func TestKitchenTicketUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(KitchenTicketUsecaseSuiteTest))
+}
@@ -0,0 +1,380 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *KitchenTicketUsecaseSuiteTest) TestKitchenTicketUseCase_List() {
+	tests := []struct {
+		name        string
+		input       dto.ListKitchenTicketsInput
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.KitchenTicket, int64, error)
+	}{
+		{
+			name:  "should list the tickets of the station",
+			input: dto.ListKitchenTicketsInput{Station: valueobject.StationGrill, Status: valueobject.TicketQueued, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, valueobject.StationGrill, valueobject.TicketQueued, 1, 10).
+					Return(s.mockTickets[:1], int64(1), nil)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockTickets[:1], tickets)
+				assert.Equal(t, int64(1), total)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.ListKitchenTicketsInput{Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, valueobject.KitchenStation(""), valueobject.KitchenTicketStatus(""), 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, tickets []*entity.KitchenTicket, total int64, err error) {
+				assert.Nil(t, tickets)
+				assert.Equal(t, int64(0), total)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			tickets, total, err := s.useCase.List(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, tickets, total, err)
+		})
+	}
+}
+
+func (s *KitchenTicketUsecaseSuiteTest) TestKitchenTicketUseCase_Update() {
+	tests := []struct {
+		name        string
+		input       dto.UpdateKitchenTicketInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.KitchenTicket, error)
+	}{
+		{
+			name:  "should start the ticket and move the received order to preparing",
+			input: dto.UpdateKitchenTicketInput{ID: 1, Status: valueobject.TicketInProgress, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockTickets[0], nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(&entity.Order{ID: 1, Status: valueobject.RECEIVED, Version: 3}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, s.mockTickets[0]).
+					Return(nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, dto.UpdateOrderInput{
+						ID:         1,
+						Status:     valueobject.PREPARING,
+						StaffID:    7,
+						ActorType:  valueobject.ActorStaff,
+						ReasonCode: "KITCHEN_STARTED",
+						Source:     valueobject.SourceAPI,
+						Version:    3,
+					}).
+					Return(&entity.Order{ID: 1, Status: valueobject.PREPARING, Version: 4}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.TicketInProgress, ticket.Status)
+				assert.Equal(t, uint64(7), *ticket.StaffID)
+				assert.NotNil(t, ticket.StartedAt)
+			},
+		},
+		{
+			name:  "should finish the ticket and keep the order preparing while other tickets are open",
+			input: dto.UpdateKitchenTicketInput{ID: 1, Status: valueobject.TicketDone, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockTickets[0], nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(&entity.Order{ID: 1, Status: valueobject.PREPARING, Version: 4}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, s.mockTickets[0]).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(s.mockTickets, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.TicketDone, ticket.Status)
+				assert.NotNil(t, ticket.DoneAt)
+			},
+		},
+		{
+			name:  "should move the order to ready when all the tickets are done",
+			input: dto.UpdateKitchenTicketInput{ID: 2, Status: valueobject.TicketDone, StaffID: 8},
+			setupMocks: func() {
+				s.mockTickets[1].Status = valueobject.TicketInProgress
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(2)).
+					Return(s.mockTickets[1], nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(&entity.Order{ID: 1, Status: valueobject.PREPARING, Version: 4}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, s.mockTickets[1]).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(s.mockTickets, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, dto.UpdateOrderInput{
+						ID:         1,
+						Status:     valueobject.READY,
+						StaffID:    8,
+						ActorType:  valueobject.ActorStaff,
+						ReasonCode: "KITCHEN_DONE",
+						Source:     valueobject.SourceAPI,
+						Version:    4,
+					}).
+					Return(&entity.Order{ID: 1, Status: valueobject.READY, Version: 5}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.TicketDone, ticket.Status)
+			},
+		},
+		{
+			name:  "should keep the ticket when the order was moved by a concurrent request",
+			input: dto.UpdateKitchenTicketInput{ID: 3, Status: valueobject.TicketDone, StaffID: 8},
+			setupMocks: func() {
+				ticket := &entity.KitchenTicket{ID: 3, OrderID: 2, Station: valueobject.StationFryer, Status: valueobject.TicketInProgress}
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(ticket, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 2}).
+					Return(&entity.Order{ID: 2, Status: valueobject.PREPARING, Version: 4}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, ticket).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					FindAllByOrderID(s.ctx, uint64(2)).
+					Return([]*entity.KitchenTicket{ticket}, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, dto.UpdateOrderInput{
+						ID:         2,
+						Status:     valueobject.READY,
+						StaffID:    8,
+						ActorType:  valueobject.ActorStaff,
+						ReasonCode: "KITCHEN_DONE",
+						Source:     valueobject.SourceAPI,
+						Version:    4,
+					}).
+					Return(nil, domain.NewPreconditionFailedError(domain.ErrOrderVersionMismatch))
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 2}).
+					Return(&entity.Order{ID: 2, Status: valueobject.READY, Version: 5}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.TicketDone, ticket.Status)
+			},
+		},
+		{
+			name:  "should reload and retry the order when it was changed by a concurrent request",
+			input: dto.UpdateKitchenTicketInput{ID: 3, Status: valueobject.TicketDone, StaffID: 8},
+			setupMocks: func() {
+				ticket := &entity.KitchenTicket{ID: 3, OrderID: 2, Station: valueobject.StationFryer, Status: valueobject.TicketInProgress}
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(ticket, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 2}).
+					Return(&entity.Order{ID: 2, Status: valueobject.PREPARING, Version: 4}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, ticket).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					FindAllByOrderID(s.ctx, uint64(2)).
+					Return([]*entity.KitchenTicket{ticket}, nil)
+
+				input := dto.UpdateOrderInput{
+					ID:         2,
+					Status:     valueobject.READY,
+					StaffID:    8,
+					ActorType:  valueobject.ActorStaff,
+					ReasonCode: "KITCHEN_DONE",
+					Source:     valueobject.SourceAPI,
+					Version:    4,
+				}
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, input).
+					Return(nil, domain.NewConflictError(domain.ErrOrderVersionConflict))
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 2}).
+					Return(&entity.Order{ID: 2, Status: valueobject.PREPARING, Version: 5}, nil)
+
+				input.Version = 5
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, input).
+					Return(&entity.Order{ID: 2, Status: valueobject.READY, Version: 6}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.TicketDone, ticket.Status)
+			},
+		},
+		{
+			name:  "should return conflict error when the order keeps changing",
+			input: dto.UpdateKitchenTicketInput{ID: 3, Status: valueobject.TicketDone, StaffID: 8},
+			setupMocks: func() {
+				ticket := &entity.KitchenTicket{ID: 3, OrderID: 2, Station: valueobject.StationFryer, Status: valueobject.TicketInProgress}
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(ticket, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 2}).
+					Return(&entity.Order{ID: 2, Status: valueobject.PREPARING, Version: 4}, nil).
+					Times(3)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, ticket).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					FindAllByOrderID(s.ctx, uint64(2)).
+					Return([]*entity.KitchenTicket{ticket}, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil, domain.NewConflictError(domain.ErrOrderVersionConflict)).
+					Times(3)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.Nil(t, ticket)
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when ticket doesn't exist",
+			input: dto.UpdateKitchenTicketInput{ID: 9, Status: valueobject.TicketInProgress, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(9)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.Nil(t, ticket)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when the ticket skips a status",
+			input: dto.UpdateKitchenTicketInput{ID: 4, Status: valueobject.TicketDone, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(4)).
+					Return(&entity.KitchenTicket{ID: 4, OrderID: 1, Status: valueobject.TicketQueued}, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(&entity.Order{ID: 1, Status: valueobject.PREPARING}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.Nil(t, ticket)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when the order left the kitchen",
+			input: dto.UpdateKitchenTicketInput{ID: 5, Status: valueobject.TicketInProgress, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(5)).
+					Return(&entity.KitchenTicket{ID: 5, OrderID: 3, Status: valueobject.TicketQueued}, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 3}).
+					Return(&entity.Order{ID: 3, Status: valueobject.CANCELLED}, nil)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.Nil(t, ticket)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderIsNotInKitchen), err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway update fails",
+			input: dto.UpdateKitchenTicketInput{ID: 6, Status: valueobject.TicketInProgress, StaffID: 7},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(6)).
+					Return(&entity.KitchenTicket{ID: 6, OrderID: 1, Status: valueobject.TicketQueued}, nil)
+
+				s.mockOrderUseCase.EXPECT().
+					Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(&entity.Order{ID: 1, Status: valueobject.RECEIVED}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, ticket *entity.KitchenTicket, err error) {
+				assert.Nil(t, ticket)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			ticket, err := s.useCase.Update(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, ticket, err)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type menuUseCase struct {
+	categoryGateway port.CategoryGateway
+	productGateway  port.ProductGateway
+	cache           port.MenuCache
+}
+
+// NewMenuUseCase creates a new MenuUseCase
+func NewMenuUseCase(categoryGateway port.CategoryGateway, productGateway port.ProductGateway, cache port.MenuCache) port.MenuUseCase {
+	return &menuUseCase{categoryGateway, productGateway, cache}
+}
+
+// Get returns the menu tree of the active categories with their products available and priced at the moment of
+// the input, the full menu is built once with the current and scheduled prices and served from the cache until
+// the catalog changes
+func (uc *menuUseCase) Get(ctx context.Context, i dto.GetMenuInput) (*entity.Menu, error) {
+	at := i.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	menu, ok := uc.cache.Get(ctx)
+	if !ok {
+		var err error
+		if menu, err = uc.build(ctx); err != nil {
+			return nil, err
+		}
+		uc.cache.Set(ctx, menu)
+	}
+
+	return menu.OpenAt(at), nil
+}
+
+// build loads the active categories and their products into the menu tree
+func (uc *menuUseCase) build(ctx context.Context) (*entity.Menu, error) {
+
+	categories, err := uc.categoryGateway.FindAllActive(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	categoryIDs := make([]uint64, len(categories))
+	for i, category := range categories {
+		categoryIDs[i] = category.ID
+	}
+
+	products, err := uc.productGateway.FindAllByCategoryIDs(ctx, categoryIDs)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return entity.NewMenu(categories, products), nil
+}
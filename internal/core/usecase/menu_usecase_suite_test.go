@@ -0,0 +1,55 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type MenuUsecaseSuiteTest struct {
+	suite.Suite
+	mockCategories      []*entity.Category
+	mockProducts        []*entity.Product
+	mockCategoryGateway *mockport.MockCategoryGateway
+	mockProductGateway  *mockport.MockProductGateway
+	mockMenuCache       *mockport.MockMenuCache
+	useCase             port.MenuUseCase
+	ctx                 context.Context
+}
+
+func (s *MenuUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockCategoryGateway = mockport.NewMockCategoryGateway(ctrl)
+	s.mockProductGateway = mockport.NewMockProductGateway(ctrl)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewMenuUseCase(s.mockCategoryGateway, s.mockProductGateway, s.mockMenuCache)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	foodsID := uint64(1)
+	dessertsID := uint64(9)
+	s.mockCategories = []*entity.Category{
+		{ID: 1, Name: "Foods", DisplayOrder: 1, Active: true, CreatedAt: currentTime, UpdatedAt: currentTime},
+		{ID: 2, Name: "Beverages", DisplayOrder: 2, Active: true, CreatedAt: currentTime, UpdatedAt: currentTime},
+		{ID: 3, Name: "Burgers", ParentID: &foodsID, DisplayOrder: 2, Active: true, CreatedAt: currentTime, UpdatedAt: currentTime},
+		{ID: 4, Name: "Wraps", ParentID: &foodsID, DisplayOrder: 1, Active: true, CreatedAt: currentTime, UpdatedAt: currentTime},
+		// The parent is not active, so it's left out of the menu
+		{ID: 5, Name: "Ice creams", ParentID: &dessertsID, Active: true, CreatedAt: currentTime, UpdatedAt: currentTime},
+	}
+	s.mockProducts = []*entity.Product{
+		{ID: 1, Name: "X-Burger", Price: 25.9, CategoryID: 3, Available: true},
+		{ID: 2, Name: "Coca-Cola 350ml", Price: 6.9, CategoryID: 2, Available: true},
+		{ID: 3, Name: "Chicken wrap", Price: 19.9, CategoryID: 4, Available: false},
+	}
+}
+
+func TestMenuUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(MenuUsecaseSuiteTest))
+}
@@ -0,0 +1,204 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *MenuUsecaseSuiteTest) TestMenuUseCase_Get() {
+	cachedMenu := &entity.Menu{GeneratedAt: time.Now()}
+
+	saoPaulo, _ := time.LoadLocation("America/Sao_Paulo")
+	scheduledMenu := entity.NewMenu(
+		[]*entity.Category{
+			{ID: 1, Name: "Breakfast", DisplayOrder: 1, Active: true, AvailabilityWindows: []entity.CategoryAvailabilityWindow{
+				{AvailabilityWindow: entity.AvailabilityWindow{Weekday: time.Monday, StartTime: "06:00", EndTime: "10:30", Timezone: "America/Sao_Paulo"}},
+			}},
+			{ID: 2, Name: "Beverages", DisplayOrder: 2, Active: true},
+		},
+		[]*entity.Product{
+			{ID: 1, Name: "Pancakes", CategoryID: 1, Available: true},
+			{ID: 2, Name: "Coffee", CategoryID: 2, Available: true},
+			// Late night, crosses midnight into saturday
+			{ID: 3, Name: "Beer", CategoryID: 2, Available: true, AvailabilityWindows: []entity.ProductAvailabilityWindow{
+				{AvailabilityWindow: entity.AvailabilityWindow{Weekday: time.Friday, StartTime: "18:00", EndTime: "02:00", Timezone: "America/Sao_Paulo"}},
+			}},
+		},
+	)
+
+	// The coffee goes up on march, after the menu was cached
+	priceChange := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	pricedMenu := entity.NewMenu(
+		[]*entity.Category{{ID: 2, Name: "Beverages", Active: true}},
+		[]*entity.Product{
+			{ID: 2, Name: "Coffee", Price: 5, CategoryID: 2, Available: true, Prices: []entity.ProductPrice{
+				{ProductID: 2, Price: 5, EffectiveTo: &priceChange},
+				{ProductID: 2, Price: 6, EffectiveFrom: priceChange},
+			}},
+		},
+	)
+
+	tests := []struct {
+		name        string
+		input       dto.GetMenuInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Menu, error)
+	}{
+		{
+			name: "should return the cached menu",
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(cachedMenu, true)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, cachedMenu.GeneratedAt, menu.GeneratedAt)
+				assert.Empty(t, menu.Categories)
+			},
+		},
+		{
+			name:  "should list the breakfast and hide the late night items on monday morning",
+			input: dto.GetMenuInput{At: time.Date(2024, 2, 5, 8, 0, 0, 0, saoPaulo)},
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(scheduledMenu, true)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, menu.Categories, 2)
+				assert.Equal(t, "Breakfast", menu.Categories[0].Name)
+				assert.Len(t, menu.Categories[0].Products, 1)
+				assert.Len(t, menu.Categories[1].Products, 1)
+				assert.Equal(t, "Coffee", menu.Categories[1].Products[0].Name)
+			},
+		},
+		{
+			name:  "should hide the breakfast and list the late night items after midnight",
+			input: dto.GetMenuInput{At: time.Date(2024, 2, 10, 1, 30, 0, 0, saoPaulo)},
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(scheduledMenu, true)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, menu.Categories, 1)
+				assert.Equal(t, "Beverages", menu.Categories[0].Name)
+				assert.Len(t, menu.Categories[0].Products, 2)
+				assert.Equal(t, "Beer", menu.Categories[0].Products[1].Name)
+				// The cached menu is kept whole
+				assert.Len(t, scheduledMenu.Categories, 2)
+			},
+		},
+		{
+			name:  "should price the products at the moment from the cached prices",
+			input: dto.GetMenuInput{At: priceChange.Add(time.Hour)},
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(pricedMenu, true)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 6.0, menu.Categories[0].Products[0].Price)
+				// The cached menu keeps the price it was built with
+				assert.Equal(t, 5.0, pricedMenu.Categories[0].Products[0].Price)
+			},
+		},
+		{
+			name: "should build the menu tree and cache it",
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(nil, false)
+
+				s.mockCategoryGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return(s.mockCategories, nil)
+
+				s.mockProductGateway.EXPECT().
+					FindAllByCategoryIDs(s.ctx, []uint64{1, 2, 3, 4, 5}).
+					Return(s.mockProducts, nil)
+
+				s.mockMenuCache.EXPECT().
+					Set(s.ctx, gomock.Any())
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, menu.Categories, 2)
+
+				foods := menu.Categories[0]
+				assert.Equal(t, "Foods", foods.Name)
+				assert.Empty(t, foods.Products)
+				assert.Len(t, foods.Subcategories, 2)
+				assert.Equal(t, "Wraps", foods.Subcategories[0].Name)
+				assert.Equal(t, "Chicken wrap", foods.Subcategories[0].Products[0].Name)
+				assert.Equal(t, "Burgers", foods.Subcategories[1].Name)
+				assert.Equal(t, "X-Burger", foods.Subcategories[1].Products[0].Name)
+
+				beverages := menu.Categories[1]
+				assert.Equal(t, "Beverages", beverages.Name)
+				assert.Len(t, beverages.Products, 1)
+				assert.Empty(t, beverages.Subcategories)
+			},
+		},
+		{
+			name: "should return internal error when category gateway fails",
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(nil, false)
+
+				s.mockCategoryGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.Nil(t, menu)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return internal error when product gateway fails",
+			setupMocks: func() {
+				s.mockMenuCache.EXPECT().
+					Get(s.ctx).
+					Return(nil, false)
+
+				s.mockCategoryGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return(s.mockCategories, nil)
+
+				s.mockProductGateway.EXPECT().
+					FindAllByCategoryIDs(s.ctx, gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, menu *entity.Menu, err error) {
+				assert.Nil(t, menu)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			menu, err := s.useCase.Get(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, menu, err)
+		})
+	}
+}
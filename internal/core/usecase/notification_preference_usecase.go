@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceUseCase struct {
+	gateway port.NotificationPreferenceGateway
+}
+
+// NewNotificationPreferenceUseCase creates a new NotificationPreferenceUseCase
+func NewNotificationPreferenceUseCase(gateway port.NotificationPreferenceGateway) port.NotificationPreferenceUseCase {
+	return &notificationPreferenceUseCase{gateway}
+}
+
+// Get returns the NotificationPreference of a customer
+func (uc *notificationPreferenceUseCase) Get(ctx context.Context, i dto.GetNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	preference, err := uc.gateway.FindByCustomerID(ctx, i.CustomerID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if preference == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	return preference, nil
+}
+
+// Update creates or replaces the NotificationPreference of a customer
+func (uc *notificationPreferenceUseCase) Update(ctx context.Context, i dto.UpdateNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	preference, err := uc.gateway.FindByCustomerID(ctx, i.CustomerID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if preference == nil {
+		preference = i.ToEntity()
+		if err := preference.Validate(); err != nil {
+			return nil, domain.NewInvalidInputError(err.Error())
+		}
+
+		if err := uc.gateway.Create(ctx, preference); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		return preference, nil
+	}
+
+	preference.Update(i.ToEntity())
+
+	if err := preference.Validate(); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.gateway.Update(ctx, preference); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return preference, nil
+}
+
+// Delete deletes the NotificationPreference of a customer, opting out of all the notifications
+func (uc *notificationPreferenceUseCase) Delete(ctx context.Context, i dto.DeleteNotificationPreferenceInput) (*entity.NotificationPreference, error) {
+	preference, err := uc.gateway.FindByCustomerID(ctx, i.CustomerID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if preference == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if err := uc.gateway.Delete(ctx, preference.ID); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return preference, nil
+}
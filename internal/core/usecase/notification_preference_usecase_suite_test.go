@@ -0,0 +1,47 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type NotificationPreferenceUsecaseSuiteTest struct {
+	suite.Suite
+	newPreference func() *entity.NotificationPreference
+	mockGateway   *mockport.MockNotificationPreferenceGateway
+	useCase       port.NotificationPreferenceUseCase
+	ctx           context.Context
+}
+
+func (s *NotificationPreferenceUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockNotificationPreferenceGateway(ctrl)
+	s.useCase = usecase.NewNotificationPreferenceUseCase(s.mockGateway)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.newPreference = func() *entity.NotificationPreference {
+		return &entity.NotificationPreference{
+			ID:         1,
+			CustomerID: 1,
+			Locale:     valueobject.LocalePtBR,
+			Channels:   entity.StringList{valueobject.NotificationSMS.String()},
+			Phone:      "+5511999999999",
+			CreatedAt:  currentTime,
+			UpdatedAt:  currentTime,
+		}
+	}
+}
+
+func TestNotificationPreferenceUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(NotificationPreferenceUsecaseSuiteTest))
+}
@@ -0,0 +1,326 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *NotificationPreferenceUsecaseSuiteTest) TestNotificationPreferenceUseCase_Get() {
+	tests := []struct {
+		name        string
+		input       dto.GetNotificationPreferenceInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.NotificationPreference, error)
+	}{
+		{
+			name:  "should get preference successfully",
+			input: dto.GetNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), preference.CustomerID)
+			},
+		},
+		{
+			name:  "should return not found error when customer has no preference",
+			input: dto.GetNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			input: dto.GetNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			preference, err := s.useCase.Get(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, preference, err)
+		})
+	}
+}
+
+func (s *NotificationPreferenceUsecaseSuiteTest) TestNotificationPreferenceUseCase_Update() {
+	tests := []struct {
+		name        string
+		input       dto.UpdateNotificationPreferenceInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.NotificationPreference, error)
+	}{
+		{
+			name: "should create preference when customer has none",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocaleEn,
+				Channels:   []string{valueobject.NotificationEmail.String(), valueobject.NotificationEmail.String()},
+				Email:      "john.doe@email.com",
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.LocaleEn, preference.Locale)
+				assert.Equal(t, entity.StringList{valueobject.NotificationEmail.String()}, preference.Channels)
+			},
+		},
+		{
+			name: "should replace preference of the customer",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+				Channels:   []string{valueobject.NotificationPush.String()},
+				PushToken:  "fcm-token",
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), preference.ID)
+				assert.Equal(t, entity.StringList{valueobject.NotificationPush.String()}, preference.Channels)
+				assert.Empty(t, preference.Phone)
+			},
+		},
+		{
+			name: "should opt out when channels are empty",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, preference.Channels)
+			},
+		},
+		{
+			name: "should return invalid input error when channel has no recipient",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+				Channels:   []string{valueobject.NotificationSMS.String()},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when locale is unknown",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.Locale("fr"),
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway fails to find",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway fails to create",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway fails to update",
+			input: dto.UpdateNotificationPreferenceInput{
+				CustomerID: 1,
+				Locale:     valueobject.LocalePtBR,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			preference, err := s.useCase.Update(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, preference, err)
+		})
+	}
+}
+
+func (s *NotificationPreferenceUsecaseSuiteTest) TestNotificationPreferenceUseCase_Delete() {
+	tests := []struct {
+		name        string
+		input       dto.DeleteNotificationPreferenceInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.NotificationPreference, error)
+	}{
+		{
+			name:  "should delete preference successfully",
+			input: dto.DeleteNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), preference.ID)
+			},
+		},
+		{
+			name:  "should return not found error when customer has no preference",
+			input: dto.DeleteNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return error when gateway fails to delete",
+			input: dto.DeleteNotificationPreferenceInput{CustomerID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, preference *entity.NotificationPreference, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, preference)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			preference, err := s.useCase.Delete(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, preference, err)
+		})
+	}
+}
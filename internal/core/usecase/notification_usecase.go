@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationUseCase struct {
+	gateway   port.NotificationPreferenceGateway
+	templates valueobject.NotificationTemplates
+	senders   map[valueobject.NotificationChannel]port.NotificationSender
+}
+
+// NewNotificationUseCase creates a new NotificationUseCase.
+// The customers are notified of the status changes that have a template, on the channels they opted in
+// and that have a sender, the channels without one are not notified
+func NewNotificationUseCase(
+	gateway port.NotificationPreferenceGateway,
+	templates valueobject.NotificationTemplates,
+	senders map[valueobject.NotificationChannel]port.NotificationSender,
+) port.NotificationUseCase {
+	return &notificationUseCase{gateway, templates, senders}
+}
+
+// Notify sends the notifications of a status change to the customer of the order,
+// a failure on a channel doesn't keep the notification from the others
+func (uc *notificationUseCase) Notify(ctx context.Context, event entity.OrderEvent) ([]*entity.Notification, error) {
+	if event.Type != valueobject.OrderStatusChangedEvent || event.CustomerID == 0 || !uc.templates.Notifies(event.Status) {
+		return nil, nil
+	}
+
+	preference, err := uc.gateway.FindByCustomerID(ctx, event.CustomerID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if preference == nil || len(preference.Channels) == 0 {
+		return nil, nil
+	}
+
+	tmpl, ok := uc.templates.Template(preference.Locale, event.Status)
+	if !ok {
+		return nil, nil
+	}
+
+	subject, body, err := tmpl.Render(event)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	var notifications []*entity.Notification
+	var errs []error
+	for _, channel := range valueobject.NotificationChannels {
+		sender, ok := uc.senders[channel]
+		if !ok || !preference.OptedIn(channel) {
+			continue
+		}
+
+		notification := entity.NewNotification(preference, channel, event, subject, body)
+		if err := sender.Send(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("error sending %s notification: %w", channel, err))
+			continue
+		}
+		notifications = append(notifications, notification)
+	}
+
+	if len(errs) > 0 {
+		return notifications, domain.NewInternalError(errors.Join(errs...))
+	}
+
+	return notifications, nil
+}
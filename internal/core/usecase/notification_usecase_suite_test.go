@@ -0,0 +1,73 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type NotificationUsecaseSuiteTest struct {
+	suite.Suite
+	newPreference func() *entity.NotificationPreference
+	newEvent      func(status valueobject.OrderStatus) entity.OrderEvent
+	mockGateway   *mockport.MockNotificationPreferenceGateway
+	mockSMS       *mockport.MockNotificationSender
+	mockEmail     *mockport.MockNotificationSender
+	useCase       port.NotificationUseCase
+	ctx           context.Context
+}
+
+func (s *NotificationUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockNotificationPreferenceGateway(ctrl)
+	s.mockSMS = mockport.NewMockNotificationSender(ctrl)
+	s.mockEmail = mockport.NewMockNotificationSender(ctrl)
+	// PUSH has no sender, the customers opted in to it are not notified on it
+	s.useCase = usecase.NewNotificationUseCase(s.mockGateway, valueobject.DefaultNotificationTemplates(), map[valueobject.NotificationChannel]port.NotificationSender{
+		valueobject.NotificationSMS:   s.mockSMS,
+		valueobject.NotificationEmail: s.mockEmail,
+	})
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.newPreference = func() *entity.NotificationPreference {
+		return &entity.NotificationPreference{
+			ID:         1,
+			CustomerID: 1,
+			Locale:     valueobject.LocaleEn,
+			Channels: entity.StringList{
+				valueobject.NotificationSMS.String(),
+				valueobject.NotificationEmail.String(),
+				valueobject.NotificationPush.String(),
+			},
+			Email:     "john.doe@email.com",
+			Phone:     "+5511999999999",
+			PushToken: "fcm-token",
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+		}
+	}
+	s.newEvent = func(status valueobject.OrderStatus) entity.OrderEvent {
+		return entity.OrderEvent{
+			Type:           valueobject.OrderStatusChangedEvent,
+			OrderID:        42,
+			CustomerID:     1,
+			Status:         status,
+			PreviousStatus: valueobject.PREPARING,
+			PickupCode:     "A42",
+			OccurredAt:     currentTime,
+		}
+	}
+}
+
+func TestNotificationUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(NotificationUsecaseSuiteTest))
+}
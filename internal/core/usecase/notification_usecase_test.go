@@ -0,0 +1,163 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+func (s *NotificationUsecaseSuiteTest) TestNotificationUseCase_Notify() {
+	tests := []struct {
+		name        string
+		event       func() entity.OrderEvent
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.Notification, error)
+	}{
+		{
+			name:  "should notify the opted in channels that have a sender",
+			event: func() entity.OrderEvent { return s.newEvent(valueobject.READY) },
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockSMS.EXPECT().
+					Send(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockEmail.EXPECT().
+					Send(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, notifications, 2)
+				assert.Equal(t, valueobject.NotificationSMS, notifications[0].Channel)
+				assert.Equal(t, "+5511999999999", notifications[0].Recipient)
+				assert.Equal(t, valueobject.NotificationEmail, notifications[1].Channel)
+				assert.Equal(t, "john.doe@email.com", notifications[1].Recipient)
+				assert.Equal(t, "Order #42 is ready", notifications[1].Subject)
+				assert.Equal(t, "Your order #42 is ready! Pick it up with the code A42.", notifications[1].Body)
+			},
+		},
+		{
+			name:  "should only notify the chosen channels",
+			event: func() entity.OrderEvent { return s.newEvent(valueobject.CANCELLED) },
+			setupMocks: func() {
+				preference := s.newPreference()
+				preference.Channels = entity.StringList{valueobject.NotificationEmail.String()}
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(preference, nil)
+				s.mockEmail.EXPECT().
+					Send(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, notifications, 1)
+				assert.Equal(t, valueobject.CANCELLED, notifications[0].Status)
+				assert.Equal(t, uint64(42), notifications[0].OrderID)
+			},
+		},
+		{
+			name:       "should not notify statuses without template",
+			event:      func() entity.OrderEvent { return s.newEvent(valueobject.PREPARING) },
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, notifications)
+			},
+		},
+		{
+			name: "should not notify order created events",
+			event: func() entity.OrderEvent {
+				event := s.newEvent(valueobject.READY)
+				event.Type = valueobject.OrderCreatedEvent
+				return event
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, notifications)
+			},
+		},
+		{
+			name: "should not notify guest orders",
+			event: func() entity.OrderEvent {
+				event := s.newEvent(valueobject.READY)
+				event.CustomerID = 0
+				return event
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, notifications)
+			},
+		},
+		{
+			name:  "should not notify customers without preference",
+			event: func() entity.OrderEvent { return s.newEvent(valueobject.READY) },
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, notifications)
+			},
+		},
+		{
+			name:  "should keep notifying the other channels when a sender fails",
+			event: func() entity.OrderEvent { return s.newEvent(valueobject.READY) },
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(s.newPreference(), nil)
+				s.mockSMS.EXPECT().
+					Send(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+				s.mockEmail.EXPECT().
+					Send(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.Error(t, err)
+				assert.IsType(t, &domain.InternalError{}, err)
+				assert.Len(t, notifications, 1)
+				assert.Equal(t, valueobject.NotificationEmail, notifications[0].Channel)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			event: func() entity.OrderEvent { return s.newEvent(valueobject.READY) },
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomerID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, notifications []*entity.Notification, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, notifications)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			notifications, err := s.useCase.Notify(s.ctx, tt.event())
+
+			// Assert
+			tt.checkResult(t, notifications, err)
+		})
+	}
+}
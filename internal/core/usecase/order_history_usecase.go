@@ -28,17 +28,6 @@ func (uc *orderHistoryUseCase) List(ctx context.Context, input dto.ListOrderHist
 	return orderHistories, total, nil
 }
 
-// Create creates a new orderHistory
-func (uc *orderHistoryUseCase) Create(ctx context.Context, input dto.CreateOrderHistoryInput) (*entity.OrderHistory, error) {
-	orderHistory := entity.NewOrderHistory(input.OrderID, input.Status, input.StaffID)
-
-	if err := uc.gateway.Create(ctx, orderHistory); err != nil {
-		return nil, domain.NewInternalError(err)
-	}
-
-	return orderHistory, nil
-}
-
 // Get returns a orderHistory by ID
 func (uc *orderHistoryUseCase) Get(ctx context.Context, input dto.GetOrderHistoryInput) (*entity.OrderHistory, error) {
 	orderHistory, err := uc.gateway.FindByID(ctx, input.ID)
@@ -53,19 +42,17 @@ func (uc *orderHistoryUseCase) Get(ctx context.Context, input dto.GetOrderHistor
 	return orderHistory, nil
 }
 
-// Delete deletes a orderHistory
-func (uc *orderHistoryUseCase) Delete(ctx context.Context, input dto.DeleteOrderHistoryInput) (*entity.OrderHistory, error) {
-	orderHistory, err := uc.gateway.FindByID(ctx, input.ID)
+// Verify checks the hash chain of the histories of an order
+func (uc *orderHistoryUseCase) Verify(ctx context.Context, input dto.VerifyOrderHistoriesInput) (*entity.OrderHistoryVerification, error) {
+	orderHistories, err := uc.gateway.FindAllByOrderID(ctx, input.OrderID)
 	if err != nil {
 		return nil, domain.NewInternalError(err)
 	}
-	if orderHistory == nil {
-		return nil, domain.NewNotFoundError(domain.ErrNotFound)
-	}
 
-	if err := uc.gateway.Delete(ctx, input.ID); err != nil {
-		return nil, domain.NewInternalError(err)
+	if len(orderHistories) == 0 {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
-	return orderHistory, nil
+	verification := entity.VerifyOrderHistories(input.OrderID, orderHistories)
+	return &verification, nil
 }
@@ -2,12 +2,13 @@ package usecase_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 	"github.com/stretchr/testify/assert"
-	"go.uber.org/mock/gomock"
 )
 
 func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoriesUseCase_List() {
@@ -107,63 +108,6 @@ func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoriesUseCase_List() {
 	}
 }
 
-func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Create() {
-	tests := []struct {
-		name        string
-		input       dto.CreateOrderHistoryInput
-		setupMocks  func()
-		checkResult func(*testing.T, *entity.OrderHistory, error)
-	}{
-		{
-			name: "should create staff successfully",
-			input: dto.CreateOrderHistoryInput{
-				OrderID: 1,
-				Status:  "OPEN",
-			},
-			setupMocks: func() {
-				s.mockGateway.EXPECT().
-					Create(s.ctx, gomock.Any()).
-					Return(nil)
-			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
-				assert.NoError(t, err)
-				assert.NotNil(t, orderHistory)
-				assert.Equal(t, uint64(1), orderHistory.OrderID)
-				assert.Equal(t, valueobject.OPEN, orderHistory.Status)
-			},
-		},
-		{
-			name: "should return error when gateway fails",
-			input: dto.CreateOrderHistoryInput{
-				OrderID: 1,
-				Status:  "OPEN",
-			},
-			setupMocks: func() {
-				s.mockGateway.EXPECT().
-					Create(s.ctx, gomock.Any()).
-					Return(assert.AnError)
-			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, orderHistory)
-			},
-		},
-	}
-
-	for _, tt := range tests {
-		s.T().Run(tt.name, func(t *testing.T) {
-			// Arrange
-			tt.setupMocks()
-
-			// Act
-			orderHistory, err := s.useCase.Create(s.ctx, tt.input)
-
-			// Assert
-			tt.checkResult(t, orderHistory, err)
-		})
-	}
-}
-
 func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Get() {
 	tests := []struct {
 		name        string
@@ -227,72 +171,87 @@ func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Get() {
 	}
 }
 
-func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Delete() {
+func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Verify() {
 	tests := []struct {
 		name        string
-		input       dto.DeleteOrderHistoryInput
+		input       dto.VerifyOrderHistoriesInput
 		setupMocks  func()
-		checkResult func(*testing.T, *entity.OrderHistory, error)
+		checkResult func(*testing.T, *entity.OrderHistoryVerification, error)
 	}{
 		{
-			name:  "should delete order history successfully",
-			input: dto.DeleteOrderHistoryInput{ID: 1},
+			name:  "should verify a valid chain with legacy histories",
+			input: dto.VerifyOrderHistoriesInput{OrderID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(&entity.OrderHistory{ID: 1}, nil)
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(newOrderHistoryChain(), nil)
+			},
+			checkResult: func(t *testing.T, verification *entity.OrderHistoryVerification, err error) {
+				assert.NoError(t, err)
+				assert.True(t, verification.Valid)
+				assert.Equal(t, 3, verification.Total)
+				assert.Equal(t, 2, verification.Sealed)
+				assert.Equal(t, 1, verification.Unsealed)
+				assert.Nil(t, verification.BrokenAt)
+			},
+		},
+		{
+			name:  "should detect a tampered history",
+			input: dto.VerifyOrderHistoriesInput{OrderID: 1},
+			setupMocks: func() {
+				histories := newOrderHistoryChain()
+				histories[1].Status = valueobject.CANCELLED
 
 				s.mockGateway.EXPECT().
-					Delete(s.ctx, uint64(1)).
-					Return(nil)
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(histories, nil)
 			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
+			checkResult: func(t *testing.T, verification *entity.OrderHistoryVerification, err error) {
 				assert.NoError(t, err)
-				assert.NotNil(t, orderHistory)
-				assert.Equal(t, uint64(1), orderHistory.ID)
+				assert.False(t, verification.Valid)
+				assert.Equal(t, uint64(2), *verification.BrokenAt)
 			},
 		},
 		{
-			name:  "should return not found error when order history doesn't exist",
-			input: dto.DeleteOrderHistoryInput{ID: 1},
+			name:  "should detect a removed history",
+			input: dto.VerifyOrderHistoriesInput{OrderID: 1},
 			setupMocks: func() {
+				histories := newOrderHistoryChain()
+
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(nil, nil)
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return([]*entity.OrderHistory{histories[0], histories[2]}, nil)
 			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, orderHistory)
+			checkResult: func(t *testing.T, verification *entity.OrderHistoryVerification, err error) {
+				assert.NoError(t, err)
+				assert.False(t, verification.Valid)
+				assert.Equal(t, uint64(3), *verification.BrokenAt)
 			},
 		},
 		{
-			name:  "should return error when gateway fails on find",
-			input: dto.DeleteOrderHistoryInput{ID: 1},
+			name:  "should return not found error when order has no histories",
+			input: dto.VerifyOrderHistoriesInput{OrderID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(nil, assert.AnError)
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(nil, nil)
 			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, orderHistory)
+			checkResult: func(t *testing.T, verification *entity.OrderHistoryVerification, err error) {
+				assert.Nil(t, verification)
+				assert.IsType(t, &domain.NotFoundError{}, err)
 			},
 		},
 		{
-			name:  "should return error when gateway fails on delete",
-			input: dto.DeleteOrderHistoryInput{ID: 1},
+			name:  "should return internal error when gateway fails",
+			input: dto.VerifyOrderHistoriesInput{OrderID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(&entity.OrderHistory{}, nil)
-
-				s.mockGateway.EXPECT().
-					Delete(s.ctx, uint64(1)).
-					Return(assert.AnError)
+					FindAllByOrderID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
 			},
-			checkResult: func(t *testing.T, orderHistory *entity.OrderHistory, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, orderHistory)
+			checkResult: func(t *testing.T, verification *entity.OrderHistoryVerification, err error) {
+				assert.Nil(t, verification)
+				assert.IsType(t, &domain.InternalError{}, err)
 			},
 		},
 	}
@@ -303,10 +262,25 @@ func (s *OrderHistoryUsecaseSuiteTest) TestOrderHistoryUseCase_Delete() {
 			tt.setupMocks()
 
 			// Act
-			orderHistory, err := s.useCase.Delete(s.ctx, tt.input)
+			verification, err := s.useCase.Verify(s.ctx, tt.input)
 
 			// Assert
-			tt.checkResult(t, orderHistory, err)
+			tt.checkResult(t, verification, err)
 		})
 	}
 }
+
+// newOrderHistoryChain returns a legacy history followed by two sealed histories
+func newOrderHistoryChain() []*entity.OrderHistory {
+	createdAt := time.Date(2025, 2, 27, 12, 0, 0, 0, time.UTC)
+
+	legacy := &entity.OrderHistory{ID: 1, OrderID: 1, Status: valueobject.OPEN, CreatedAt: createdAt}
+
+	pending := &entity.OrderHistory{ID: 2, OrderID: 1, Status: valueobject.PENDING, CreatedAt: createdAt.Add(time.Minute)}
+	pending.Seal("")
+
+	received := &entity.OrderHistory{ID: 3, OrderID: 1, Status: valueobject.RECEIVED, Source: valueobject.SourceSQS, CreatedAt: createdAt.Add(2 * time.Minute)}
+	received.Seal(*pending.Hash)
+
+	return []*entity.OrderHistory{legacy, pending, received}
+}
@@ -2,20 +2,28 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 )
 
 type orderProductUseCase struct {
-	gateway port.OrderProductGateway
+	gateway          port.OrderProductGateway
+	orderGateway     port.OrderGateway
+	productGateway   port.ProductGateway
+	categoryGateway  port.CategoryGateway
+	promotionUseCase port.PromotionUseCase
 }
 
-// NewOrderProductUseCase creates a new ListOrderProductsUseCase
-func NewOrderProductUseCase(gateway port.OrderProductGateway) port.OrderProductUseCase {
-	return &orderProductUseCase{gateway}
+// NewOrderProductUseCase creates a new ListOrderProductsUseCase, the discounts of the order
+// are recalculated by the promotionUseCase whenever its line items change.
+// Only the line items of OPEN orders can be changed, the payment is created for the total on checkout
+func NewOrderProductUseCase(gateway port.OrderProductGateway, orderGateway port.OrderGateway, productGateway port.ProductGateway, categoryGateway port.CategoryGateway, promotionUseCase port.PromotionUseCase) port.OrderProductUseCase {
+	return &orderProductUseCase{gateway, orderGateway, productGateway, categoryGateway, promotionUseCase}
 }
 
 // List lists all orderProducts
@@ -27,20 +35,69 @@ func (uc *orderProductUseCase) List(ctx context.Context, i dto.ListOrderProducts
 	return orderProducts, total, nil
 }
 
-// Create creates a new orderProduct
+// Create adds a new line item to the order, each call creates a new line even for the same product
 func (uc *orderProductUseCase) Create(ctx context.Context, i dto.CreateOrderProductInput) (*entity.OrderProduct, error) {
+	var orderProduct *entity.OrderProduct
+	err := uc.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		orderProduct, err = uc.create(ctx, i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orderProduct, nil
+}
+
+func (uc *orderProductUseCase) create(ctx context.Context, i dto.CreateOrderProductInput) (*entity.OrderProduct, error) {
+	if err := uc.checkOrderOpen(ctx, i.OrderID); err != nil {
+		return nil, err
+	}
+
+	product, err := uc.findProduct(ctx, i.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkOpenNow(ctx, product); err != nil {
+		return nil, err
+	}
+
+	modifiers, err := product.SelectModifiers(i.ModifierIDs)
+	if err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	components, err := product.SelectBundleComponents(dto.ToBundleSelections(i.BundleSelections))
+	if err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
 	orderProduct := i.ToEntity()
+	if !product.IsAvailableWith(orderProduct.Quantity, components) {
+		return nil, domain.NewInvalidInputError(domain.ErrProductUnavailable)
+	}
+	orderProduct.Modifiers = modifiers
+	orderProduct.Components = components
+	orderProduct.Price = product.Price
+	if price := product.PriceAt(time.Now()); price != nil {
+		orderProduct.ProductPriceID = &price.ID
+	}
 
 	if err := uc.gateway.Create(ctx, orderProduct); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	if err := uc.promotionUseCase.RepriceOrder(ctx, orderProduct.OrderID); err != nil {
+		return nil, err
+	}
+
 	return orderProduct, nil
 }
 
 // Get returns a orderProduct by ID
 func (uc *orderProductUseCase) Get(ctx context.Context, i dto.GetOrderProductInput) (*entity.OrderProduct, error) {
-	orderProduct, err := uc.gateway.FindByID(ctx, i.OrderID, i.ProductID)
+	orderProduct, err := uc.gateway.FindByID(ctx, i.ID)
 	if err != nil {
 		return nil, domain.NewInternalError(err)
 	}
@@ -53,7 +110,20 @@ func (uc *orderProductUseCase) Get(ctx context.Context, i dto.GetOrderProductInp
 }
 
 func (uc *orderProductUseCase) Update(ctx context.Context, i dto.UpdateOrderProductInput) (*entity.OrderProduct, error) {
-	orderProduct, err := uc.gateway.FindByID(ctx, i.OrderID, i.ProductID)
+	var orderProduct *entity.OrderProduct
+	err := uc.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		orderProduct, err = uc.update(ctx, i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orderProduct, nil
+}
+
+func (uc *orderProductUseCase) update(ctx context.Context, i dto.UpdateOrderProductInput) (*entity.OrderProduct, error) {
+	orderProduct, err := uc.gateway.FindByID(ctx, i.ID)
 	if err != nil {
 		return nil, domain.NewInternalError(err)
 	}
@@ -62,14 +132,41 @@ func (uc *orderProductUseCase) Update(ctx context.Context, i dto.UpdateOrderProd
 		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
+	if err := uc.checkOrderOpen(ctx, orderProduct.OrderID); err != nil {
+		return nil, err
+	}
+
+	if i.ModifierIDs != nil || i.BundleSelections != nil {
+		product, err := uc.findProduct(ctx, orderProduct.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		if i.ModifierIDs != nil {
+			if orderProduct.Modifiers, err = product.SelectModifiers(i.ModifierIDs); err != nil {
+				return nil, domain.NewInvalidInputError(err.Error())
+			}
+		}
+
+		if i.BundleSelections != nil {
+			if orderProduct.Components, err = product.SelectBundleComponents(dto.ToBundleSelections(i.BundleSelections)); err != nil {
+				return nil, domain.NewInvalidInputError(err.Error())
+			}
+		}
+	}
+
 	order := orderProduct.Order
 	product := orderProduct.Product
-	orderProduct.Update(i.Quantity)
+	orderProduct.Update(i.Quantity, i.Notes)
 
 	if err := uc.gateway.Update(ctx, orderProduct); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	if err := uc.promotionUseCase.RepriceOrder(ctx, orderProduct.OrderID); err != nil {
+		return nil, err
+	}
+
 	orderProduct.Order = order
 	orderProduct.Product = product
 
@@ -77,7 +174,20 @@ func (uc *orderProductUseCase) Update(ctx context.Context, i dto.UpdateOrderProd
 }
 
 func (uc *orderProductUseCase) Delete(ctx context.Context, i dto.DeleteOrderProductInput) (*entity.OrderProduct, error) {
-	order, err := uc.gateway.FindByID(ctx, i.OrderID, i.ProductID)
+	var orderProduct *entity.OrderProduct
+	err := uc.inTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		orderProduct, err = uc.delete(ctx, i)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orderProduct, nil
+}
+
+func (uc *orderProductUseCase) delete(ctx context.Context, i dto.DeleteOrderProductInput) (*entity.OrderProduct, error) {
+	order, err := uc.gateway.FindByID(ctx, i.ID)
 	if err != nil {
 		return nil, domain.NewInternalError(err)
 	}
@@ -85,9 +195,93 @@ func (uc *orderProductUseCase) Delete(ctx context.Context, i dto.DeleteOrderProd
 		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
-	if err := uc.gateway.Delete(ctx, i.OrderID, i.ProductID); err != nil {
+	if err := uc.checkOrderOpen(ctx, order.OrderID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.gateway.Delete(ctx, i.ID); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	if err := uc.promotionUseCase.RepriceOrder(ctx, order.OrderID); err != nil {
+		return nil, err
+	}
+
 	return order, nil
 }
+
+// SalesReport returns the products sold in the period, bundles are expanded into their components
+func (uc *orderProductUseCase) SalesReport(ctx context.Context, i dto.GetSalesReportInput) (*entity.SalesReport, error) {
+	if !i.From.Before(i.To) {
+		return nil, domain.NewInvalidInputError(domain.ErrInvalidPeriod)
+	}
+
+	orderProducts, err := uc.gateway.FindAllSold(ctx, i.From, i.To)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return entity.NewSalesReport(i.From, i.To, orderProducts), nil
+}
+
+// inTransaction changes the line items in a transaction of the order, so the change is rolled back
+// when the order can't be repriced, ex: it was checked out in the meantime
+func (uc *orderProductUseCase) inTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	var fnErr error
+	err := uc.orderGateway.Transaction(ctx, func(ctx context.Context) error {
+		fnErr = fn(ctx)
+		return fnErr
+	})
+	if fnErr != nil {
+		return fnErr
+	}
+	if err != nil {
+		return domain.NewInternalError(err)
+	}
+	return nil
+}
+
+// checkOrderOpen rejects the changes to the line items of an order that is no longer OPEN,
+// its payment was already created for the total and the kitchen may be preparing it
+func (uc *orderProductUseCase) checkOrderOpen(ctx context.Context, orderID uint64) error {
+	order, err := uc.orderGateway.FindByID(ctx, orderID)
+	if err != nil {
+		return domain.NewInternalError(err)
+	}
+	if order == nil {
+		return domain.NewNotFoundError(domain.ErrNotFound)
+	}
+	if order.Status != valueobject.OPEN {
+		return domain.NewInvalidInputError(domain.ErrOrderIsNotOpen)
+	}
+	return nil
+}
+
+// checkOpenNow rejects the products out of their availability windows or the windows of their category
+func (uc *orderProductUseCase) checkOpenNow(ctx context.Context, product *entity.Product) error {
+	now := time.Now()
+	if !product.IsOpenAt(now) {
+		return domain.NewInvalidInputError(domain.ErrProductNotAvailableNow)
+	}
+
+	category, err := uc.categoryGateway.FindByID(ctx, product.CategoryID)
+	if err != nil {
+		return domain.NewInternalError(err)
+	}
+	if category != nil && !category.IsOpenAt(now) {
+		return domain.NewInvalidInputError(domain.ErrProductNotAvailableNow)
+	}
+	return nil
+}
+
+// findProduct returns the product with its modifier groups and bundle slots
+func (uc *orderProductUseCase) findProduct(ctx context.Context, productID uint64) (*entity.Product, error) {
+	product, err := uc.productGateway.FindByID(ctx, productID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if product == nil {
+		return nil, domain.NewNotFoundError(domain.ErrProductNotFound)
+	}
+	return product, nil
+}
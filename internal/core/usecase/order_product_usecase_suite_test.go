@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
@@ -15,18 +16,38 @@ import (
 
 type OrderProductUsecaseSuiteTest struct {
 	suite.Suite
-	mockOrderProducts []*entity.OrderProduct
-	mockGateway       *mockport.MockOrderProductGateway
-	useCase           port.OrderProductUseCase
-	ctx               context.Context
+	mockOrderProducts   []*entity.OrderProduct
+	mockProduct         *entity.Product
+	mockBundle          *entity.Product
+	mockGateway         *mockport.MockOrderProductGateway
+	mockOrderGateway    *mockport.MockOrderGateway
+	mockProductGateway  *mockport.MockProductGateway
+	mockCategoryGateway *mockport.MockCategoryGateway
+	mockCategory        *entity.Category
+	mockPromotionUC     *mockport.MockPromotionUseCase
+	useCase             port.OrderProductUseCase
+	ctx                 context.Context
+	txErr               error // Error the transaction of the order was rolled back with
 }
 
 func (s *OrderProductUsecaseSuiteTest) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
 	s.mockGateway = mockport.NewMockOrderProductGateway(ctrl)
-	s.useCase = usecase.NewOrderProductUseCase(s.mockGateway)
+	s.mockOrderGateway = mockport.NewMockOrderGateway(ctrl)
+	s.mockProductGateway = mockport.NewMockProductGateway(ctrl)
+	s.mockCategoryGateway = mockport.NewMockCategoryGateway(ctrl)
+	s.mockPromotionUC = mockport.NewMockPromotionUseCase(ctrl)
+	s.useCase = usecase.NewOrderProductUseCase(s.mockGateway, s.mockOrderGateway, s.mockProductGateway, s.mockCategoryGateway, s.mockPromotionUC)
 	s.ctx = context.Background()
+	s.txErr = nil
+	s.mockOrderGateway.EXPECT().
+		Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			s.txErr = fn(ctx)
+			return s.txErr
+		}).
+		AnyTimes()
 	currentTime := time.Now()
 	s.mockOrderProducts = []*entity.OrderProduct{
 		{
@@ -44,6 +65,93 @@ func (s *OrderProductUsecaseSuiteTest) SetupTest() {
 			UpdatedAt: currentTime,
 		},
 	}
+	s.mockCategory = &entity.Category{ID: 1, Name: "Burgers", Active: true}
+	s.mockProduct = &entity.Product{
+		ID:        1,
+		Name:      "X-Burger",
+		Price:     10.0,
+		StockMode: valueobject.StockUnlimited,
+		Available: true,
+		ModifierGroups: []entity.ProductModifierGroup{
+			{
+				ID:         1,
+				ProductID:  1,
+				Name:       "Extras",
+				MinChoices: 0,
+				MaxChoices: 2,
+				Modifiers: []entity.ProductModifier{
+					{ID: 1, ProductModifierGroupID: 1, Name: "Extra cheese", PriceDelta: 2.5},
+					{ID: 2, ProductModifierGroupID: 1, Name: "Bacon", PriceDelta: 1.0},
+					{ID: 3, ProductModifierGroupID: 1, Name: "No tomato", PriceDelta: 0},
+				},
+			},
+		},
+	}
+	s.mockBundle = &entity.Product{
+		ID:        5,
+		Name:      "Combo Big",
+		Price:     42.9,
+		StockMode: valueobject.StockUnlimited,
+		Available: true,
+		BundleSlots: []entity.ProductBundleSlot{
+			{
+				ID:        1,
+				ProductID: 5,
+				Name:      "Burger",
+				Quantity:  1,
+				Options: []entity.ProductBundleOption{
+					{ComponentProductID: 1, ComponentProduct: entity.Product{ID: 1, Name: "X-Burger", Price: 25.9, Available: true}},
+				},
+			},
+			{
+				ID:        2,
+				ProductID: 5,
+				Name:      "Drink",
+				Quantity:  1,
+				Options: []entity.ProductBundleOption{
+					{ComponentProductID: 2, ComponentProduct: entity.Product{ID: 2, Name: "Coca-Cola 350ml", Price: 6.9, Available: true}},
+					{ComponentProductID: 6, ComponentProduct: entity.Product{ID: 6, Name: "Milkshake", Price: 14.9, Available: true}, PriceDelta: 4.0},
+				},
+			},
+		},
+	}
+}
+
+// expectOrder expects the order of the line items to be loaded with the status
+func (s *OrderProductUsecaseSuiteTest) expectOrder(orderID uint64, status valueobject.OrderStatus) {
+	s.mockOrderGateway.EXPECT().
+		FindByID(s.ctx, orderID).
+		Return(&entity.Order{ID: orderID, Status: status}, nil)
+}
+
+// openProductWindows returns a window of the current weekday covering the whole day
+func (s *OrderProductUsecaseSuiteTest) openProductWindows() []entity.ProductAvailabilityWindow {
+	return []entity.ProductAvailabilityWindow{
+		{AvailabilityWindow: entity.AvailabilityWindow{Weekday: time.Now().UTC().Weekday(), StartTime: "00:00", EndTime: "23:59", Timezone: "UTC"}},
+		{AvailabilityWindow: entity.AvailabilityWindow{Weekday: time.Now().UTC().Weekday(), StartTime: "23:59", EndTime: "00:00", Timezone: "UTC"}},
+	}
+}
+
+// closedProductWindows returns a window on every weekday but the current one
+func (s *OrderProductUsecaseSuiteTest) closedProductWindows() []entity.ProductAvailabilityWindow {
+	var windows []entity.ProductAvailabilityWindow
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if weekday != time.Now().UTC().Weekday() {
+			windows = append(windows, entity.ProductAvailabilityWindow{
+				AvailabilityWindow: entity.AvailabilityWindow{Weekday: weekday, StartTime: "00:00", EndTime: "23:59", Timezone: "UTC"},
+			})
+		}
+	}
+	return windows
+}
+
+// closedCategoryWindows returns a window on every weekday but the current one
+func (s *OrderProductUsecaseSuiteTest) closedCategoryWindows() []entity.CategoryAvailabilityWindow {
+	var windows []entity.CategoryAvailabilityWindow
+	for _, window := range s.closedProductWindows() {
+		windows = append(windows, entity.CategoryAvailabilityWindow{AvailabilityWindow: window.AvailabilityWindow})
+	}
+	return windows
 }
 
 func TestOrderProductUsecaseSuiteTest(t *testing.T) {
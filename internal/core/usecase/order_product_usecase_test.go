@@ -3,11 +3,14 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 )
 
@@ -118,15 +121,503 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Create() {
 				ProductID: 1,
 			},
 			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
 				s.mockGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
 					Return(nil)
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
 				assert.NoError(t, err)
 				assert.NotNil(t, orderProduct)
 				assert.Equal(t, uint64(1), orderProduct.OrderID)
 				assert.Equal(t, uint64(1), orderProduct.ProductID)
+				assert.Empty(t, orderProduct.Modifiers)
+				assert.Equal(t, 10.0, orderProduct.Price)
+			},
+		},
+		{
+			name: "should copy the price record effective now to the order-product",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 7,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				now := time.Now()
+				end := now.Add(-time.Hour)
+				product := &entity.Product{
+					ID: 7, Name: "Cheese fries", Price: 14.9, CategoryID: 1, StockMode: valueobject.StockUnlimited, Available: true,
+					Prices: []entity.ProductPrice{
+						{ID: 3, ProductID: 7, Price: 12.9, EffectiveFrom: now.AddDate(0, -1, 0), EffectiveTo: &end},
+						{ID: 4, ProductID: 7, Price: 14.9, EffectiveFrom: end},
+					},
+				}
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(7)).
+					Return(product, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 14.9, orderProduct.Price)
+				assert.Equal(t, uint64(4), *orderProduct.ProductPriceID)
+			},
+		},
+		{
+			name: "should create order-product with notes and modifiers",
+			input: dto.CreateOrderProductInput{
+				OrderID:     1,
+				ProductID:   1,
+				Quantity:    2,
+				Notes:       "No tomato",
+				ModifierIDs: []uint64{1, 2},
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.OrderProduct) error {
+						p.Product = *s.mockProduct
+						return nil
+					})
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "No tomato", orderProduct.Notes)
+				assert.Len(t, orderProduct.Modifiers, 2)
+				assert.Equal(t, "Extras", orderProduct.Modifiers[0].GroupName)
+				assert.Equal(t, "Extra cheese", orderProduct.Modifiers[0].Name)
+				assert.InDelta(t, 13.5, orderProduct.UnitPrice(), 0.001)
+				assert.InDelta(t, 27.0, orderProduct.Total(), 0.001)
+			},
+		},
+		{
+			name: "should return invalid input error when modifier is not available for the product",
+			input: dto.CreateOrderProductInput{
+				OrderID:     1,
+				ProductID:   1,
+				ModifierIDs: []uint64{99},
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+			},
+		},
+		{
+			name: "should return invalid input error when modifier group max choices is exceeded",
+			input: dto.CreateOrderProductInput{
+				OrderID:     1,
+				ProductID:   1,
+				ModifierIDs: []uint64{1, 2, 3},
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+			},
+		},
+		{
+			name: "should return invalid input error when product is unavailable",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Product{ID: 1, Name: "X-Burger", StockMode: valueobject.StockUnlimited, Available: false}, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+				assert.Equal(t, domain.ErrProductUnavailable, invalidInputErr.Error())
+			},
+		},
+		{
+			name: "should return invalid input error when counted stock is not enough",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  3,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Product{ID: 1, Name: "X-Burger", StockMode: valueobject.StockCounted, StockQuantity: 2, Available: true}, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+				assert.Equal(t, domain.ErrProductUnavailable, invalidInputErr.Error())
+			},
+		},
+		{
+			name: "should create bundle order-product with the selected components",
+			input: dto.CreateOrderProductInput{
+				OrderID:          1,
+				ProductID:        5,
+				Quantity:         1,
+				BundleSelections: []dto.BundleSelectionInput{{SlotID: 2, ProductID: 6}},
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(5)).
+					Return(s.mockBundle, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.OrderProduct) error {
+						p.Product = *s.mockBundle
+						return nil
+					})
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, orderProduct.Components, 2)
+				assert.Equal(t, "X-Burger", orderProduct.Components[0].Name)
+				assert.Equal(t, "Drink", orderProduct.Components[1].SlotName)
+				assert.Equal(t, uint64(6), orderProduct.Components[1].ProductID)
+				assert.InDelta(t, 46.9, orderProduct.UnitPrice(), 0.001)
+			},
+		},
+		{
+			name: "should return invalid input error when bundle slot requires a choice",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 5,
+				Quantity:  1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(5)).
+					Return(s.mockBundle, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+			},
+		},
+		{
+			name: "should return invalid input error when selected product is not an option of the slot",
+			input: dto.CreateOrderProductInput{
+				OrderID:          1,
+				ProductID:        5,
+				Quantity:         1,
+				BundleSelections: []dto.BundleSelectionInput{{SlotID: 2, ProductID: 3}},
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(5)).
+					Return(s.mockBundle, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+			},
+		},
+		{
+			name: "should return error when order reprice fails",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, orderProduct)
+			},
+		},
+		{
+			name: "should roll the order-product back when the order is checked out before the reprice",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.ConflictError{}, err)
+				assert.Equal(t, err, s.txErr)
+			},
+		},
+		{
+			name: "should return invalid input error when product is out of its availability windows",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Product{ID: 1, Name: "Beer", StockMode: valueobject.StockUnlimited, Available: true, AvailabilityWindows: s.closedProductWindows()}, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+				assert.Equal(t, domain.ErrProductNotAvailableNow, invalidInputErr.Error())
+			},
+		},
+		{
+			name: "should return invalid input error when category is out of its availability windows",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(&entity.Category{ID: 1, Name: "Breakfast", Active: true, AvailabilityWindows: s.closedCategoryWindows()}, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+				assert.Equal(t, domain.ErrProductNotAvailableNow, invalidInputErr.Error())
+			},
+		},
+		{
+			name: "should create order-product inside the availability windows",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Product{ID: 1, Name: "Beer", StockMode: valueobject.StockUnlimited, Available: true, AvailabilityWindows: s.openProductWindows()}, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, orderProduct)
+			},
+		},
+		{
+			name: "should return internal error when category gateway fails",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when the order is PENDING",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.PENDING)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
+		{
+			name: "should return invalid input error when the order is RECEIVED",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.RECEIVED)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
+		{
+			name: "should return not found error when product doesn't exist",
+			input: dto.CreateOrderProductInput{
+				OrderID:   1,
+				ProductID: 1,
+			},
+			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				var notFoundErr *domain.NotFoundError
+				assert.ErrorAs(t, err, &notFoundErr)
 			},
 		},
 		{
@@ -136,6 +627,16 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Create() {
 				ProductID: 1,
 			},
 			setupMocks: func() {
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockCategoryGateway.EXPECT().
+					FindByID(s.ctx, gomock.Any()).
+					Return(s.mockCategory, nil)
+
 				s.mockGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
 					Return(assert.AnError)
@@ -170,10 +671,10 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Get() {
 	}{
 		{
 			name:  "should get orderProduct successfully",
-			input: dto.GetOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.GetOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(s.mockOrderProducts[0], nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -185,10 +686,10 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Get() {
 		},
 		{
 			name:  "should return not found error when orderProduct doesn't exist",
-			input: dto.GetOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.GetOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -198,10 +699,10 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Get() {
 		},
 		{
 			name:  "should return internal error when gateway fails",
-			input: dto.GetOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.GetOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, assert.AnError)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -235,15 +736,16 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Update() {
 		{
 			name: "should update orderProduct successfully",
 			input: dto.UpdateOrderProductInput{
-				OrderID:   1,
-				ProductID: 1,
-				Quantity:  1,
+				ID:       1,
+				Quantity: 1,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(s.mockOrderProducts[0], nil)
 
+				s.expectOrder(1, valueobject.OPEN)
+
 				s.mockGateway.EXPECT().
 					Update(s.ctx, gomock.Any()).
 					DoAndReturn(func(_ context.Context, p *entity.OrderProduct) error {
@@ -252,6 +754,9 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Update() {
 						assert.Equal(s.T(), uint32(1), p.Quantity)
 						return nil
 					})
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
 				assert.NoError(t, err)
@@ -261,16 +766,86 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Update() {
 				assert.Equal(t, uint32(1), orderProduct.Quantity)
 			},
 		},
+		{
+			name: "should replace modifiers when modifier ids are given",
+			input: dto.UpdateOrderProductInput{
+				ID:          1,
+				Quantity:    1,
+				Notes:       "Well done",
+				ModifierIDs: []uint64{3},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.OrderProduct{ID: 1, OrderID: 1, ProductID: 1, Quantity: 1}, nil)
+
+				s.expectOrder(1, valueobject.OPEN)
+
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Well done", orderProduct.Notes)
+				assert.Len(t, orderProduct.Modifiers, 1)
+				assert.Equal(t, uint64(3), orderProduct.Modifiers[0].ProductModifierID)
+			},
+		},
+		{
+			name: "should return invalid input error when the order is PENDING",
+			input: dto.UpdateOrderProductInput{
+				ID:       1,
+				Quantity: 3,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.OrderProduct{ID: 1, OrderID: 1, ProductID: 1, Quantity: 1}, nil)
+
+				s.expectOrder(1, valueobject.PENDING)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
+		{
+			name: "should return invalid input error when the order is RECEIVED",
+			input: dto.UpdateOrderProductInput{
+				ID:       1,
+				Quantity: 3,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.OrderProduct{ID: 1, OrderID: 1, ProductID: 1, Quantity: 1}, nil)
+
+				s.expectOrder(1, valueobject.RECEIVED)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
 		{
 			name: "should return error when orderProduct not found",
 			input: dto.UpdateOrderProductInput{
-				OrderID:   1,
-				ProductID: 1,
-				Quantity:  1,
+				ID:       1,
+				Quantity: 1,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -281,13 +856,12 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Update() {
 		{
 			name: "should return error when gateway find fails",
 			input: dto.UpdateOrderProductInput{
-				OrderID:   1,
-				ProductID: 1,
-				Quantity:  1,
+				ID:       1,
+				Quantity: 1,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, assert.AnError)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -298,15 +872,16 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Update() {
 		{
 			name: "should return error when gateway update fails",
 			input: dto.UpdateOrderProductInput{
-				OrderID:   1,
-				ProductID: 1,
-				Quantity:  1,
+				ID:       1,
+				Quantity: 1,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(s.mockOrderProducts[0], nil)
 
+				s.expectOrder(1, valueobject.OPEN)
+
 				s.mockGateway.EXPECT().
 					Update(s.ctx, gomock.Any()).
 					Return(assert.AnError)
@@ -341,14 +916,19 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Delete() {
 	}{
 		{
 			name:  "should delete orderProduct successfully",
-			input: dto.DeleteOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.DeleteOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(&entity.OrderProduct{OrderID: 1, ProductID: 1}, nil)
 
+				s.expectOrder(1, valueobject.OPEN)
+
 				s.mockGateway.EXPECT().
-					Delete(s.ctx, uint64(1), uint64(1)).
+					Delete(s.ctx, uint64(1)).
+					Return(nil)
+				s.mockPromotionUC.EXPECT().
+					RepriceOrder(s.ctx, uint64(1)).
 					Return(nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -358,12 +938,44 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Delete() {
 				assert.Equal(t, uint64(1), orderProduct.ProductID)
 			},
 		},
+		{
+			name:  "should return invalid input error when the order is PENDING",
+			input: dto.DeleteOrderProductInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.OrderProduct{ID: 1, OrderID: 1, ProductID: 1}, nil)
+
+				s.expectOrder(1, valueobject.PENDING)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
+		{
+			name:  "should return invalid input error when the order is RECEIVED",
+			input: dto.DeleteOrderProductInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.OrderProduct{ID: 1, OrderID: 1, ProductID: 1}, nil)
+
+				s.expectOrder(1, valueobject.RECEIVED)
+			},
+			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
+				assert.Nil(t, orderProduct)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
 		{
 			name:  "should return not found error when orderProduct doesn't exist",
-			input: dto.DeleteOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.DeleteOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, nil)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -373,10 +985,10 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Delete() {
 		},
 		{
 			name:  "should return error when gateway fails on find",
-			input: dto.DeleteOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.DeleteOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(nil, assert.AnError)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -386,14 +998,16 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Delete() {
 		},
 		{
 			name:  "should return error when gateway fails on delete",
-			input: dto.DeleteOrderProductInput{OrderID: 1, ProductID: 1},
+			input: dto.DeleteOrderProductInput{ID: 1},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1), uint64(1)).
+					FindByID(s.ctx, uint64(1)).
 					Return(&entity.OrderProduct{}, nil)
 
+				s.expectOrder(0, valueobject.OPEN)
+
 				s.mockGateway.EXPECT().
-					Delete(s.ctx, uint64(1), uint64(1)).
+					Delete(s.ctx, uint64(1)).
 					Return(assert.AnError)
 			},
 			checkResult: func(t *testing.T, orderProduct *entity.OrderProduct, err error) {
@@ -416,3 +1030,90 @@ func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_Delete() {
 		})
 	}
 }
+
+func (s *OrderProductUsecaseSuiteTest) TestOrderProductUseCase_SalesReport() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	oldPriceID, newPriceID := uint64(1), uint64(7)
+
+	tests := []struct {
+		name        string
+		input       dto.GetSalesReportInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.SalesReport, error)
+	}{
+		{
+			name:  "should expand bundles into their components",
+			input: dto.GetSalesReportInput{From: from, To: to},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllSold(s.ctx, from, to).
+					Return([]*entity.OrderProduct{
+						{ID: 1, ProductID: 1, Quantity: 2, Price: 25.9, ProductPriceID: &oldPriceID, Product: entity.Product{ID: 1, Name: "X-Burger", Price: 27.9}},
+						{ID: 3, ProductID: 1, Quantity: 1, Price: 27.9, ProductPriceID: &newPriceID, Product: entity.Product{ID: 1, Name: "X-Burger", Price: 27.9}},
+						{
+							ID: 2, ProductID: 5, Quantity: 3, Price: 42.9, Product: *s.mockBundle,
+							Components: []entity.OrderProductComponent{
+								{ProductBundleSlotID: 1, SlotName: "Burger", ProductID: 1, Name: "X-Burger", Quantity: 1},
+								{ProductBundleSlotID: 2, SlotName: "Drink", ProductID: 2, Name: "Coca-Cola 350ml", Quantity: 1},
+							},
+						},
+					}, nil)
+			},
+			checkResult: func(t *testing.T, report *entity.SalesReport, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, report.Lines, 3)
+				assert.Equal(t, uint64(1), report.Lines[0].ProductID)
+				assert.Equal(t, uint32(3), report.Lines[0].Quantity)
+				assert.Equal(t, uint32(3), report.Lines[0].BundleQuantity)
+				assert.Equal(t, uint32(6), report.Lines[0].TotalQuantity())
+				assert.InDelta(t, 79.7, report.Lines[0].Revenue, 0.001)
+				// The revenue follows the price records used on the orders, not the current price
+				assert.Equal(t, []entity.SalesReportPrice{
+					{ProductPriceID: &oldPriceID, Price: 25.9, Quantity: 2},
+					{ProductPriceID: &newPriceID, Price: 27.9, Quantity: 1},
+				}, report.Lines[0].Prices)
+				assert.Equal(t, uint32(3), report.Lines[1].BundleQuantity)
+				assert.Equal(t, uint64(5), report.Lines[2].ProductID)
+				assert.InDelta(t, 128.7, report.Lines[2].Revenue, 0.001)
+			},
+		},
+		{
+			name:       "should return invalid input error when period is invalid",
+			input:      dto.GetSalesReportInput{From: to, To: from},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, report *entity.SalesReport, err error) {
+				assert.Nil(t, report)
+				var invalidInputErr *domain.InvalidInputError
+				assert.ErrorAs(t, err, &invalidInputErr)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.GetSalesReportInput{From: from, To: to},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAllSold(s.ctx, from, to).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, report *entity.SalesReport, err error) {
+				assert.Nil(t, report)
+				var internalErr *domain.InternalError
+				assert.ErrorAs(t, err, &internalErr)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			report, err := s.useCase.SalesReport(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, report, err)
+		})
+	}
+}
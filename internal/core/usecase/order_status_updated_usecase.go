@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+const (
+	maxStatusUpdateAttempts  = 3
+	statusUpdateRetryBackoff = 100 * time.Millisecond
+)
+
+type orderStatusUpdatedUseCase struct {
+	orderUseCase port.OrderUseCase
+}
+
+// NewOrderStatusUpdatedUseCase creates a new OrderStatusUpdatedUseCase, it applies the status updates
+// sent by the other services and partners, whether they arrive from the SQS queue or from the webhook
+func NewOrderStatusUpdatedUseCase(orderUseCase port.OrderUseCase) port.OrderStatusUpdatedUseCase {
+	return &orderStatusUpdatedUseCase{orderUseCase}
+}
+
+// Process validates the event and updates the status of its order
+func (uc *orderStatusUpdatedUseCase) Process(ctx context.Context, i dto.ProcessOrderStatusUpdatedInput) (*entity.Order, error) {
+	event := i.Event
+	if event.OrderID == 0 {
+		return nil, domain.NewValidationError(errors.New(domain.ErrOrderIsMandatory))
+	}
+
+	if event.Status == "" {
+		return nil, domain.NewValidationError(errors.New(domain.ErrStatusIsMandatory))
+	}
+
+	// The senders are partners whatever they claim to be, so they can't move an order to RECEIVED,
+	// the payments are only confirmed by the payment service on its callback
+	input := dto.UpdateOrderInput{
+		ID:         event.OrderID,
+		Status:     event.Status,
+		ActorType:  valueobject.ActorPartner,
+		ActorID:    i.ActorID,
+		ReasonCode: event.ReasonCode,
+		ReasonText: event.ReasonText,
+		Source:     i.Source,
+	}
+	if input.ActorID == "" {
+		input.ActorID = event.ActorID
+	}
+	if event.StaffID != nil {
+		input.StaffID = *event.StaffID
+	}
+
+	return uc.updateWithRetry(ctx, input)
+}
+
+// updateWithRetry updates the order, reloading and retrying it when a concurrent
+// update changed its version in the meantime
+func (uc *orderStatusUpdatedUseCase) updateWithRetry(ctx context.Context, input dto.UpdateOrderInput) (*entity.Order, error) {
+	var conflictErr *domain.ConflictError
+	for attempt := 1; ; attempt++ {
+		// Update reloads the order before applying the new status
+		order, err := uc.orderUseCase.Update(ctx, input)
+		if err == nil || !errors.As(err, &conflictErr) || attempt >= maxStatusUpdateAttempts {
+			return order, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, domain.NewInternalError(ctx.Err())
+		case <-time.After(time.Duration(attempt) * statusUpdateRetryBackoff):
+		}
+	}
+}
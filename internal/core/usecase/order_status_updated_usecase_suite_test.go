@@ -0,0 +1,31 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type OrderStatusUpdatedUsecaseSuiteTest struct {
+	suite.Suite
+	mockOrderUseCase *mockport.MockOrderUseCase
+	useCase          port.OrderStatusUpdatedUseCase
+	ctx              context.Context
+}
+
+func (s *OrderStatusUpdatedUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockOrderUseCase = mockport.NewMockOrderUseCase(ctrl)
+	s.useCase = usecase.NewOrderStatusUpdatedUseCase(s.mockOrderUseCase)
+	s.ctx = context.Background()
+}
+
+func TestOrderStatusUpdatedUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(OrderStatusUpdatedUsecaseSuiteTest))
+}
@@ -0,0 +1,134 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *OrderStatusUpdatedUsecaseSuiteTest) TestOrderStatusUpdatedUseCase_Process() {
+	staffID := uint64(2)
+	receivedOrder := &entity.Order{ID: 1, Status: valueobject.RECEIVED}
+
+	tests := []struct {
+		name        string
+		input       dto.ProcessOrderStatusUpdatedInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name: "should update the order on behalf of the sender partner",
+			input: dto.ProcessOrderStatusUpdatedInput{
+				Event:   entity.OrderStatusUpdated{OrderID: 1, Status: valueobject.CANCELLED, ActorID: "someone-else", ReasonCode: "OUT_OF_STOCK"},
+				Source:  valueobject.SourceWebhook,
+				ActorID: "partner-a",
+			},
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, dto.UpdateOrderInput{
+					ID:         1,
+					Status:     valueobject.CANCELLED,
+					ActorType:  valueobject.ActorPartner,
+					ActorID:    "partner-a",
+					ReasonCode: "OUT_OF_STOCK",
+					Source:     valueobject.SourceWebhook,
+				}).Return(receivedOrder, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, receivedOrder, order)
+			},
+		},
+		{
+			name: "should keep the staff and the actor of the queued event",
+			input: dto.ProcessOrderStatusUpdatedInput{
+				Event:  entity.OrderStatusUpdated{OrderID: 1, Status: valueobject.PREPARING, StaffID: &staffID, ActorID: "partner-b"},
+				Source: valueobject.SourceSQS,
+			},
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, dto.UpdateOrderInput{
+					ID:        1,
+					Status:    valueobject.PREPARING,
+					StaffID:   2,
+					ActorType: valueobject.ActorPartner,
+					ActorID:   "partner-b",
+					Source:    valueobject.SourceSQS,
+				}).Return(receivedOrder, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "should retry when the order was updated concurrently",
+			input: dto.ProcessOrderStatusUpdatedInput{
+				Event:  entity.OrderStatusUpdated{OrderID: 1, Status: valueobject.RECEIVED},
+				Source: valueobject.SourceSQS,
+			},
+			setupMocks: func() {
+				gomock.InOrder(
+					s.mockOrderUseCase.EXPECT().Update(s.ctx, gomock.Any()).
+						Return(nil, domain.NewConflictError(domain.ErrOrderVersionConflict)),
+					s.mockOrderUseCase.EXPECT().Update(s.ctx, gomock.Any()).
+						Return(receivedOrder, nil),
+				)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, receivedOrder, order)
+			},
+		},
+		{
+			name: "should return the error of the update",
+			input: dto.ProcessOrderStatusUpdatedInput{
+				Event:  entity.OrderStatusUpdated{OrderID: 1, Status: valueobject.RECEIVED},
+				Source: valueobject.SourceSQS,
+			},
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, gomock.Any()).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:       "should return validation error when the order is missing",
+			input:      dto.ProcessOrderStatusUpdatedInput{Event: entity.OrderStatusUpdated{Status: valueobject.RECEIVED}},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.ValidationError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsMandatory)
+			},
+		},
+		{
+			name:       "should return validation error when the status is missing",
+			input:      dto.ProcessOrderStatusUpdatedInput{Event: entity.OrderStatusUpdated{OrderID: 1}},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.EqualError(t, err, domain.ErrStatusIsMandatory)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.Process(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
@@ -2,6 +2,9 @@ package usecase
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -10,19 +13,40 @@ import (
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 )
 
+// orderExpiredReasonCode is recorded on orders cancelled for being idle too long
+const orderExpiredReasonCode = "EXPIRED"
+
 type orderUseCase struct {
 	gateway             port.OrderGateway
-	orderHistoryUseCase port.OrderHistoryUseCase
+	orderHistoryGateway port.OrderHistoryGateway
+	stockUseCase        port.StockUseCase
+	kitchenUseCase      port.KitchenRoutingUseCase
+	publisher           port.EventPublisher
+	statusMachine       *valueobject.OrderStatusMachine
+	location            *time.Location
 }
 
-// NewOrderUseCase creates a new OrdersUseCase
-func NewOrderUseCase(gateway port.OrderGateway, orderHistoryUseCase port.OrderHistoryUseCase) port.OrderUseCase {
-	return &orderUseCase{gateway, orderHistoryUseCase}
+// NewOrderUseCase creates a new OrdersUseCase.
+// Order histories are only written here, on order creation and status transitions.
+// The products are taken from the stock when the order is RECEIVED and given back if it is CANCELLED,
+// received orders are also given a pickup code and split into kitchen tickets.
+// The creation and the status transitions are published as order events.
+// The pickup codes restart every day at midnight of the restaurant location
+func NewOrderUseCase(
+	gateway port.OrderGateway,
+	orderHistoryGateway port.OrderHistoryGateway,
+	stockUseCase port.StockUseCase,
+	kitchenUseCase port.KitchenRoutingUseCase,
+	publisher port.EventPublisher,
+	statusMachine *valueobject.OrderStatusMachine,
+	location *time.Location,
+) port.OrderUseCase {
+	return &orderUseCase{gateway, orderHistoryGateway, stockUseCase, kitchenUseCase, publisher, statusMachine, location}
 }
 
 // List returns a list of Orders
 func (uc *orderUseCase) List(ctx context.Context, i dto.ListOrdersInput) ([]*entity.Order, int64, error) {
-	orders, total, err := uc.gateway.FindAll(ctx, i.CustomerID, i.Status, i.StatusExclude, i.Page, i.Limit, i.Sort)
+	orders, total, err := uc.gateway.FindAll(ctx, i.CustomerID, i.Status, i.StatusExclude, i.Channels, i.FulfilmentModes, i.Page, i.Limit, i.Sort)
 	if err != nil {
 		return nil, 0, domain.NewInternalError(err)
 	}
@@ -30,23 +54,64 @@ func (uc *orderUseCase) List(ctx context.Context, i dto.ListOrdersInput) ([]*ent
 	return orders, total, nil
 }
 
+// ListByCustomer returns a page of the orders of the customer with how much was spent in the period
+func (uc *orderUseCase) ListByCustomer(ctx context.Context, i dto.ListCustomerOrdersInput) (*entity.CustomerOrderHistory, int64, error) {
+	if !i.From.IsZero() && !i.To.IsZero() && !i.From.Before(i.To) {
+		return nil, 0, domain.NewInvalidInputError(domain.ErrInvalidPeriod)
+	}
+
+	orders, total, err := uc.gateway.FindByCustomer(ctx, i.CustomerID, i.From, i.To, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	totalSpent, err := uc.gateway.SumCustomerSpending(ctx, i.CustomerID, i.From, i.To)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return &entity.CustomerOrderHistory{
+		CustomerID: i.CustomerID,
+		From:       i.From,
+		To:         i.To,
+		Orders:     orders,
+		TotalSpent: totalSpent,
+	}, total, nil
+}
+
 // Create creates a new Order
 func (uc *orderUseCase) Create(ctx context.Context, i dto.CreateOrderInput) (*entity.Order, error) {
-	order := &entity.Order{CustomerID: i.CustomerID, Status: valueobject.OPEN}
+	order := &entity.Order{
+		CustomerID:      i.CustomerID,
+		Status:          uc.statusMachine.Initial,
+		Channel:         i.Channel,
+		FulfilmentMode:  i.FulfilmentMode,
+		TableNumber:     i.TableNumber,
+		DeliveryAddress: i.DeliveryAddress.ToEntity(),
+	}
+	if order.Channel == "" {
+		order.Channel = valueobject.DefaultOrderChannel
+	}
+	if order.FulfilmentMode == "" {
+		order.FulfilmentMode = valueobject.DefaultFulfilmentMode
+	}
+	if err := order.ValidateFulfilment(); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+	if err := order.StartGuest(i.GuestName); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
 
 	if err := uc.gateway.Create(ctx, order); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
-	_, err := uc.orderHistoryUseCase.Create(ctx, dto.CreateOrderHistoryInput{
-		OrderID: order.ID,
-		Status:  valueobject.OPEN,
-		StaffID: nil,
-	})
-	if err != nil {
+	if err := uc.orderHistoryGateway.Create(ctx, entity.NewOrderHistory(order.ID, order.Status, nil)); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	uc.publish(ctx, entity.NewOrderEvent(valueobject.OrderCreatedEvent, order, "", ""))
+
 	return order, nil
 }
 
@@ -64,8 +129,23 @@ func (uc *orderUseCase) Get(ctx context.Context, i dto.GetOrderInput) (*entity.O
 	return order, nil
 }
 
-// Update updates a Order
-func (uc *orderUseCase) Update(ctx context.Context, i dto.UpdateOrderInput) (*entity.Order, error) {
+// GetByGuestToken returns the guest order of the token, so the guest can track it
+func (uc *orderUseCase) GetByGuestToken(ctx context.Context, i dto.GetGuestOrderInput) (*entity.Order, error) {
+	order, err := uc.gateway.FindByGuestToken(ctx, i.GuestToken)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if order == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	return order, nil
+}
+
+// AttachCustomer gives a guest order to the customer that signed in before paying it,
+// the guest token must be informed to prove the customer placed the order
+func (uc *orderUseCase) AttachCustomer(ctx context.Context, i dto.AttachOrderCustomerInput) (*entity.Order, error) {
 	order, err := uc.gateway.FindByID(ctx, i.ID)
 	if err != nil {
 		return nil, domain.NewInternalError(err)
@@ -75,43 +155,198 @@ func (uc *orderUseCase) Update(ctx context.Context, i dto.UpdateOrderInput) (*en
 		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
+	if !order.HasGuestToken(i.GuestToken) {
+		return nil, domain.NewUnauthorizedError(domain.ErrInvalidGuestToken)
+	}
+
+	if !order.IsGuest() {
+		return nil, domain.NewConflictError(domain.ErrOrderHasCustomer)
+	}
+
+	if order.IsPaid() {
+		return nil, domain.NewInvalidInputError(domain.ErrOrderIsPaid)
+	}
+
+	order.AttachCustomer(i.CustomerID)
+	if err := uc.gateway.Update(ctx, order); err != nil {
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, conflictErr
+		}
+		return nil, domain.NewInternalError(err)
+	}
+
+	return order, nil
+}
+
+// Update updates a Order.
+// The status, the stock, the history and the kitchen tickets are saved in one transaction,
+// so the order is left as it was when one of them fails and the update can be retried
+func (uc *orderUseCase) Update(ctx context.Context, i dto.UpdateOrderInput) (*entity.Order, error) {
+	var order *entity.Order
+	var previousStatus valueobject.OrderStatus
+	var updateErr error
+	err := uc.gateway.Transaction(ctx, func(ctx context.Context) error {
+		order, previousStatus, updateErr = uc.update(ctx, i)
+		return updateErr
+	})
+	if updateErr != nil {
+		return nil, updateErr
+	}
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if i.Status != "" && order.Status != previousStatus {
+		uc.publish(ctx, entity.NewOrderEvent(valueobject.OrderStatusChangedEvent, order, previousStatus, i.ReasonCode))
+	}
+
+	return order, nil
+}
+
+// update applies the changes of the order with the context of the transaction, returning the status it had
+func (uc *orderUseCase) update(ctx context.Context, i dto.UpdateOrderInput) (*entity.Order, valueobject.OrderStatus, error) {
+	order, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, "", domain.NewInternalError(err)
+	}
+
+	if order == nil {
+		return nil, "", domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if i.Version != 0 && order.Version != i.Version {
+		return nil, "", domain.NewPreconditionFailedError(domain.ErrOrderVersionMismatch)
+	}
+
 	if i.CustomerID != 0 && order.CustomerID != i.CustomerID {
-		return nil, domain.NewInvalidInputError(domain.ErrInvalidBody)
+		return nil, "", domain.NewInvalidInputError(domain.ErrInvalidBody)
 	}
 
 	statusHasChanged := order.Status != i.Status
 	if i.Status != "" && statusHasChanged {
-		if !valueobject.StatusCanTransitionTo(order.Status, i.Status) {
-			return nil, domain.NewInvalidInputError(domain.ErrOrderInvalidStatusTransition)
+		transition, ok := uc.statusMachine.Transition(order.Status, i.Status)
+		if !ok || !transition.AllowsMode(order.FulfilmentMode) {
+			return nil, "", domain.NewInvalidInputError(domain.ErrOrderInvalidStatusTransition)
 		}
 
-		if valueobject.StatusTransitionNeedsStaffID(i.Status) && i.StaffID == 0 {
-			return nil, domain.NewInvalidInputError(domain.ErrStaffIdIsMandatory)
+		if err := checkStatusTransition(transition, order, i); err != nil {
+			return nil, "", err
+		}
+	}
+
+	reservesStock := statusHasChanged && i.Status == valueobject.RECEIVED
+	releasesStock := statusHasChanged && i.Status == valueobject.CANCELLED && holdsStock(order.Status)
+	if reservesStock && order.PickupCode == "" {
+		now := time.Now().In(uc.location)
+		number, err := uc.gateway.NextPickupNumber(ctx, now)
+		if err != nil {
+			return nil, "", domain.NewInternalError(err)
+		}
+		order.AssignPickupCode(now, number)
+	}
+
+	if reservesStock {
+		if err := uc.stockUseCase.Reserve(ctx, order); err != nil {
+			return nil, "", err
 		}
 	}
 
 	orderProducts := order.OrderProducts
+	previousStatus := order.Status
 	order.Update(i.CustomerID, i.Status)
 
 	if err := uc.gateway.Update(ctx, order); err != nil {
-		return nil, domain.NewInternalError(err)
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, "", conflictErr
+		}
+		return nil, "", domain.NewInternalError(err)
 	}
 
 	// Restore order products, to calculate total bill in the presenter
 	order.OrderProducts = orderProducts // TODO: Remove relations from entities
 
+	if releasesStock {
+		if err := uc.stockUseCase.Release(ctx, order); err != nil {
+			return nil, "", err
+		}
+	}
+
 	// if status has changed, create a new order history
 	if i.Status != "" && statusHasChanged {
-		if _, err := uc.orderHistoryUseCase.Create(ctx, dto.CreateOrderHistoryInput{
-			OrderID: order.ID,
-			Status:  i.Status,
-			StaffID: &i.StaffID,
-		}); err != nil {
-			return nil, domain.NewInternalError(err)
+		orderHistory := entity.NewOrderHistory(order.ID, i.Status, &i.StaffID)
+		orderHistory.ActorType = resolveActor(i)
+		orderHistory.ActorID = i.ActorID
+		orderHistory.ReasonCode = i.ReasonCode
+		orderHistory.ReasonText = i.ReasonText
+		orderHistory.Source = i.Source
+
+		if err := uc.orderHistoryGateway.Create(ctx, orderHistory); err != nil {
+			return nil, "", domain.NewInternalError(err)
 		}
 	}
 
-	return order, nil
+	// The kitchen only receives the orders once their products were taken from the stock
+	if reservesStock {
+		if _, err := uc.kitchenUseCase.Route(ctx, order); err != nil {
+			return nil, "", err
+		}
+	}
+
+	return order, previousStatus, nil
+}
+
+// GetStatusMachine returns the order status machine in use
+func (uc *orderUseCase) GetStatusMachine(_ context.Context) *valueobject.OrderStatusMachine {
+	return uc.statusMachine
+}
+
+// ExpireIdle cancels the orders that stayed on a status longer than its TTL.
+// Orders changed concurrently are skipped, they will be checked again on the next run
+func (uc *orderUseCase) ExpireIdle(ctx context.Context, i dto.ExpireIdleOrdersInput) ([]*entity.Order, error) {
+	var expired []*entity.Order
+	var errs []error
+
+	for status, ttl := range i.TTLs {
+		if ttl <= 0 {
+			continue
+		}
+
+		orders, err := uc.gateway.FindIdle(ctx, status, time.Now().Add(-ttl), i.Limit)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		for _, idle := range orders {
+			order, err := uc.Update(ctx, dto.UpdateOrderInput{
+				ID:         idle.ID,
+				Status:     valueobject.CANCELLED,
+				ActorType:  valueobject.ActorSystem,
+				ReasonCode: orderExpiredReasonCode,
+				ReasonText: fmt.Sprintf("order idle on %s for more than %s", status, ttl),
+				Source:     valueobject.SourceScheduler,
+				Version:    idle.Version,
+			})
+			if err != nil {
+				var conflictErr *domain.ConflictError
+				var preconditionErr *domain.PreconditionFailedError
+				if errors.As(err, &conflictErr) || errors.As(err, &preconditionErr) {
+					continue
+				}
+				errs = append(errs, fmt.Errorf("error expiring order %d: %w", idle.ID, err))
+				continue
+			}
+			expired = append(expired, order)
+		}
+	}
+
+	if len(errs) > 0 {
+		return expired, domain.NewInternalError(errors.Join(errs...))
+	}
+
+	return expired, nil
 }
 
 // Delete deletes a Order
@@ -130,3 +365,53 @@ func (uc *orderUseCase) Delete(ctx context.Context, i dto.DeleteOrderInput) (*en
 
 	return order, nil
 }
+
+// publish publishes the order event, the order was already saved so a failure is only logged by the publisher
+func (uc *orderUseCase) publish(ctx context.Context, event entity.OrderEvent) {
+	_ = uc.publisher.Publish(ctx, event.Type.String(), event)
+}
+
+// resolveActor returns the actor of the update, the updates without a known actor are done by the customer
+func resolveActor(i dto.UpdateOrderInput) valueobject.ActorType {
+	if actor, ok := valueobject.ToActorType(string(i.ActorType)); ok {
+		return actor
+	}
+	return valueobject.ActorCustomer
+}
+
+// holdsStock returns true when the products of an order on the status were taken from the stock
+func holdsStock(status valueobject.OrderStatus) bool {
+	switch status {
+	case valueobject.RECEIVED, valueobject.PREPARING, valueobject.READY:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkStatusTransition validates the actor, required fields and guards of a transition
+func checkStatusTransition(t valueobject.OrderStatusTransition, order *entity.Order, i dto.UpdateOrderInput) error {
+	if !t.AllowsActor(resolveActor(i)) {
+		return domain.NewInvalidInputError(domain.ErrOrderTransitionNotAllowedForActor)
+	}
+
+	for _, field := range t.RequiredFields {
+		switch field {
+		case valueobject.RequiredFieldStaffID:
+			if i.StaffID == 0 {
+				return domain.NewInvalidInputError(domain.ErrStaffIdIsMandatory)
+			}
+		}
+	}
+
+	for _, guard := range t.Guards {
+		switch guard {
+		case valueobject.GuardHasProducts:
+			if len(order.OrderProducts) == 0 {
+				return domain.NewInvalidInputError(domain.ErrOrderWithoutProducts)
+			}
+		}
+	}
+
+	return nil
+}
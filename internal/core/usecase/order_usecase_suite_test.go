@@ -17,19 +17,35 @@ import (
 type OrderUsecaseSuiteTest struct {
 	suite.Suite
 	mockOrders              []*entity.Order
-	mockOrderHistoryUseCase *mockport.MockOrderHistoryUseCase
+	mockOrderHistoryGateway *mockport.MockOrderHistoryGateway
 	mockGateway             *mockport.MockOrderGateway
+	mockStockUseCase        *mockport.MockStockUseCase
+	mockKitchenUseCase      *mockport.MockKitchenRoutingUseCase
+	mockPublisher           *mockport.MockEventPublisher
 	useCase                 port.OrderUseCase
 	ctx                     context.Context
+	txErr                   error
 }
 
 func (s *OrderUsecaseSuiteTest) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
-	s.mockOrderHistoryUseCase = mockport.NewMockOrderHistoryUseCase(ctrl)
+	s.mockOrderHistoryGateway = mockport.NewMockOrderHistoryGateway(ctrl)
 	s.mockGateway = mockport.NewMockOrderGateway(ctrl)
-	s.useCase = usecase.NewOrderUseCase(s.mockGateway, s.mockOrderHistoryUseCase)
+	s.mockStockUseCase = mockport.NewMockStockUseCase(ctrl)
+	s.mockKitchenUseCase = mockport.NewMockKitchenRoutingUseCase(ctrl)
+	s.mockPublisher = mockport.NewMockEventPublisher(ctrl)
+	s.useCase = usecase.NewOrderUseCase(s.mockGateway, s.mockOrderHistoryGateway, s.mockStockUseCase, s.mockKitchenUseCase, s.mockPublisher, valueobject.DefaultOrderStatusMachine(), time.UTC)
 	s.ctx = context.Background()
+	// The updates run in the transaction with the same context, txErr is the error that rolled it back
+	s.txErr = nil
+	s.mockGateway.EXPECT().
+		Transaction(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+			s.txErr = fn(ctx)
+			return s.txErr
+		}).
+		AnyTimes()
 	currentTime := time.Now()
 	s.mockOrders = []*entity.Order{
 		{
@@ -3,6 +3,7 @@ package usecase_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -28,7 +29,7 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, uint64(0), nil, nil, 1, 10, "").
+					FindAll(s.ctx, uint64(0), nil, nil, nil, nil, 1, 10, "").
 					Return(s.mockOrders, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, orders []*entity.Order, total int64, err error) {
@@ -45,7 +46,7 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, uint64(0), nil, nil, 1, 10, "").
+					FindAll(s.ctx, uint64(0), nil, nil, nil, nil, 1, 10, "").
 					Return(nil, int64(0), assert.AnError)
 			},
 			checkResult: func(t *testing.T, orders []*entity.Order, total int64, err error) {
@@ -63,7 +64,7 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, uint64(0), []valueobject.OrderStatus{"PENDING"}, nil, 1, 10, "").
+					FindAll(s.ctx, uint64(0), []valueobject.OrderStatus{"PENDING"}, nil, nil, nil, 1, 10, "").
 					Return(s.mockOrders, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, orders []*entity.Order, total int64, err error) {
@@ -72,6 +73,24 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 				assert.Equal(t, int64(2), total)
 			},
 		},
+		{
+			name: "should filter by channel and fulfilment mode",
+			input: dto.ListOrdersInput{
+				Channels:        []valueobject.OrderChannel{valueobject.ChannelApp},
+				FulfilmentModes: []valueobject.FulfilmentMode{valueobject.FulfilmentDelivery},
+				Page:            1,
+				Limit:           10,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, uint64(0), nil, nil, []valueobject.OrderChannel{valueobject.ChannelApp}, []valueobject.FulfilmentMode{valueobject.FulfilmentDelivery}, 1, 10, "").
+					Return(s.mockOrders, int64(2), nil)
+			},
+			checkResult: func(t *testing.T, orders []*entity.Order, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), total)
+			},
+		},
 		{
 			name: "should filter by customer",
 			input: dto.ListOrdersInput{
@@ -81,7 +100,7 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, uint64(1), nil, nil, 1, 10, "").
+					FindAll(s.ctx, uint64(1), nil, nil, nil, nil, 1, 10, "").
 					Return(s.mockOrders, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, orders []*entity.Order, total int64, err error) {
@@ -107,6 +126,15 @@ func (s *OrderUsecaseSuiteTest) TestOrdersUseCase_List() {
 }
 
 func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Create() {
+	tableNumber := uint32(12)
+	deliveryAddress := &dto.DeliveryAddressInput{
+		Street:  "Av. Paulista",
+		Number:  "1000",
+		City:    "São Paulo",
+		State:   "SP",
+		ZipCode: "01310-100",
+	}
+
 	tests := []struct {
 		name        string
 		input       dto.CreateOrderInput
@@ -122,14 +150,200 @@ func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Create() {
 				s.mockGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
 					Return(nil)
-				s.mockOrderHistoryUseCase.EXPECT().
+				s.mockOrderHistoryGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
-					Return(&entity.OrderHistory{OrderID: 1, Status: valueobject.OPEN}, nil)
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderCreatedEvent.String(), gomock.Any()).
+					Return(nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
 				assert.NoError(t, err)
 				assert.NotNil(t, order)
 				assert.Equal(t, uint64(1), order.CustomerID)
+				assert.Equal(t, valueobject.DefaultOrderChannel, order.Channel)
+				assert.Equal(t, valueobject.DefaultFulfilmentMode, order.FulfilmentMode)
+			},
+		},
+		{
+			name: "should create guest order with a guest token",
+			input: dto.CreateOrderInput{
+				GuestName: "John",
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderCreatedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.True(t, order.IsGuest())
+				assert.Equal(t, "John", order.GuestName)
+				assert.NotEmpty(t, order.GuestToken)
+			},
+		},
+		{
+			name: "should not give a guest token to customer orders",
+			input: dto.CreateOrderInput{
+				CustomerID: 1,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderCreatedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, order.GuestToken)
+			},
+		},
+		{
+			name: "should return invalid input error when customer order has a guest name",
+			input: dto.CreateOrderInput{
+				CustomerID: 1,
+				GuestName:  "John",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should create dine-in order with the table number",
+			input: dto.CreateOrderInput{
+				CustomerID:     1,
+				Channel:        valueobject.ChannelCounter,
+				FulfilmentMode: valueobject.FulfilmentDineIn,
+				TableNumber:    &tableNumber,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderCreatedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.ChannelCounter, order.Channel)
+				assert.Equal(t, &tableNumber, order.TableNumber)
+				assert.Nil(t, order.DeliveryAddress)
+			},
+		},
+		{
+			name: "should create delivery order with the address snapshot",
+			input: dto.CreateOrderInput{
+				CustomerID:      1,
+				Channel:         valueobject.ChannelApp,
+				FulfilmentMode:  valueobject.FulfilmentDelivery,
+				DeliveryAddress: deliveryAddress,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) error {
+						assert.Equal(s.T(), "Av. Paulista", o.DeliveryAddress.Street)
+						assert.Equal(s.T(), "01310-100", o.DeliveryAddress.ZipCode)
+						return nil
+					})
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderCreatedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.FulfilmentDelivery, order.FulfilmentMode)
+				assert.NotNil(t, order.DeliveryAddress)
+			},
+		},
+		{
+			name: "should return error when dine-in order has no table number",
+			input: dto.CreateOrderInput{
+				CustomerID:     1,
+				FulfilmentMode: valueobject.FulfilmentDineIn,
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError("table number is mandatory for dine-in orders"), err)
+			},
+		},
+		{
+			name: "should return error when delivery order has no address",
+			input: dto.CreateOrderInput{
+				CustomerID:     1,
+				FulfilmentMode: valueobject.FulfilmentDelivery,
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError("delivery address is mandatory for delivery orders"), err)
+			},
+		},
+		{
+			name: "should return error when delivery address is incomplete",
+			input: dto.CreateOrderInput{
+				CustomerID:      1,
+				FulfilmentMode:  valueobject.FulfilmentDelivery,
+				DeliveryAddress: &dto.DeliveryAddressInput{Street: "Av. Paulista"},
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when takeaway order has a table number",
+			input: dto.CreateOrderInput{
+				CustomerID:     1,
+				FulfilmentMode: valueobject.FulfilmentTakeaway,
+				TableNumber:    &tableNumber,
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError("table number is only allowed for dine-in orders"), err)
+			},
+		},
+		{
+			name: "should return error when dine-in order has a delivery address",
+			input: dto.CreateOrderInput{
+				CustomerID:      1,
+				FulfilmentMode:  valueobject.FulfilmentDineIn,
+				TableNumber:     &tableNumber,
+				DeliveryAddress: deliveryAddress,
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError("delivery address is only allowed for delivery orders"), err)
 			},
 		},
 		{
@@ -143,284 +357,1005 @@ func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Create() {
 					Return(assert.AnError)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, order)
-				assert.IsType(t, &domain.InternalError{}, err)
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when order history use case create fails",
+			input: dto.CreateOrderInput{
+				CustomerID: 1,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.Create(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Get() {
+	tests := []struct {
+		name        string
+		input       dto.GetOrderInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should get order successfully",
+			input: dto.GetOrderInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, order)
+				assert.Equal(t, uint64(1), order.ID)
+			},
+		},
+		{
+			name:  "should return not found error when order doesn't exist",
+			input: dto.GetOrderInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.GetOrderInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.Get(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_GetByGuestToken() {
+	tests := []struct {
+		name        string
+		input       dto.GetGuestOrderInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should get guest order successfully",
+			input: dto.GetGuestOrderInput{GuestToken: "TOKEN"},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByGuestToken(s.ctx, "TOKEN").
+					Return(&entity.Order{ID: 3, GuestToken: "TOKEN", Status: valueobject.OPEN}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(3), order.ID)
+			},
+		},
+		{
+			name:  "should return not found error when no order has the token",
+			input: dto.GetGuestOrderInput{GuestToken: "TOKEN"},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByGuestToken(s.ctx, "TOKEN").
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.GetGuestOrderInput{GuestToken: "TOKEN"},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByGuestToken(s.ctx, "TOKEN").
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.GetByGuestToken(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_ListByCustomer() {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       dto.ListCustomerOrdersInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.CustomerOrderHistory, int64, error)
+	}{
+		{
+			name:  "should return the orders of the customer with the total spent",
+			input: dto.ListCustomerOrdersInput{CustomerID: 1, From: from, To: to, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomer(s.ctx, uint64(1), from, to, 1, 10).
+					Return(s.mockOrders, int64(2), nil)
+				s.mockGateway.EXPECT().
+					SumCustomerSpending(s.ctx, uint64(1), from, to).
+					Return(59.98, nil)
+			},
+			checkResult: func(t *testing.T, history *entity.CustomerOrderHistory, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(2), total)
+				assert.Equal(t, uint64(1), history.CustomerID)
+				assert.Equal(t, from, history.From)
+				assert.Equal(t, to, history.To)
+				assert.Equal(t, s.mockOrders, history.Orders)
+				assert.Equal(t, 59.98, history.TotalSpent)
+			},
+		},
+		{
+			name:       "should return invalid input error when the period is inverted",
+			input:      dto.ListCustomerOrdersInput{CustomerID: 1, From: to, To: from, Page: 1, Limit: 10},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, history *entity.CustomerOrderHistory, total int64, err error) {
+				assert.Nil(t, history)
+				assert.Zero(t, total)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when listing the orders fails",
+			input: dto.ListCustomerOrdersInput{CustomerID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomer(s.ctx, uint64(1), time.Time{}, time.Time{}, 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, history *entity.CustomerOrderHistory, total int64, err error) {
+				assert.Nil(t, history)
+				assert.Zero(t, total)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when summing the spending fails",
+			input: dto.ListCustomerOrdersInput{CustomerID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCustomer(s.ctx, uint64(1), time.Time{}, time.Time{}, 1, 10).
+					Return(s.mockOrders, int64(2), nil)
+				s.mockGateway.EXPECT().
+					SumCustomerSpending(s.ctx, uint64(1), time.Time{}, time.Time{}).
+					Return(float64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, history *entity.CustomerOrderHistory, total int64, err error) {
+				assert.Nil(t, history)
+				assert.Zero(t, total)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			history, total, err := s.useCase.ListByCustomer(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, history, total, err)
+		})
+	}
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_AttachCustomer() {
+	guestOrder := func(status valueobject.OrderStatus) *entity.Order {
+		return &entity.Order{ID: 3, GuestName: "John", GuestToken: "TOKEN", Status: status, Version: 1}
+	}
+	input := dto.AttachOrderCustomerInput{ID: 3, CustomerID: 7, GuestToken: "TOKEN"}
+
+	tests := []struct {
+		name        string
+		input       dto.AttachOrderCustomerInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should attach the customer to the guest order",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(guestOrder(valueobject.PENDING), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) error {
+						assert.Equal(s.T(), uint64(7), o.CustomerID)
+						return nil
+					})
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(7), order.CustomerID)
+				assert.False(t, order.IsGuest())
+				assert.Equal(t, "TOKEN", order.GuestToken)
+			},
+		},
+		{
+			name:  "should return not found error when order doesn't exist",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return unauthorized error when the guest token doesn't match",
+			input: dto.AttachOrderCustomerInput{ID: 3, CustomerID: 7, GuestToken: "OTHER"},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(guestOrder(valueobject.OPEN), nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.UnauthorizedError{}, err)
+			},
+		},
+		{
+			name:  "should return unauthorized error when the order has no guest token",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(&entity.Order{ID: 3, CustomerID: 1, Status: valueobject.OPEN}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.UnauthorizedError{}, err)
+			},
+		},
+		{
+			name:  "should return conflict error when the order already has a customer",
+			input: input,
+			setupMocks: func() {
+				order := guestOrder(valueobject.OPEN)
+				order.CustomerID = 1
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(order, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when the order was paid",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(guestOrder(valueobject.RECEIVED), nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return conflict error when the order was updated concurrently",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(guestOrder(valueobject.OPEN), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: input,
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(guestOrder(valueobject.OPEN), nil)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.AttachCustomer(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Update() {
+	pendingOrder := &entity.Order{ID: 3, CustomerID: 1, Status: valueobject.PENDING}
+
+	tests := []struct {
+		name        string
+		input       dto.UpdateOrderInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name: "should update order successfully",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+
+				s.mockGateway.EXPECT().
+					NextPickupNumber(s.ctx, gomock.Any()).
+					Return(uint32(42), nil)
+
+				s.mockStockUseCase.EXPECT().
+					Reserve(s.ctx, s.mockOrders[0]).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, p *entity.Order) error {
+						assert.Equal(s.T(), uint64(1), p.ID)
+						return nil
+					})
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockKitchenUseCase.EXPECT().
+					Route(s.ctx, s.mockOrders[0]).
+					Return([]*entity.KitchenTicket{{ID: 1, OrderID: 1, Station: valueobject.StationGrill}}, nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderStatusChangedEvent.String(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, payload any) error {
+						event := payload.(entity.OrderEvent)
+						assert.Equal(s.T(), valueobject.RECEIVED, event.Status)
+						assert.Equal(s.T(), valueobject.PENDING, event.PreviousStatus)
+						assert.Equal(s.T(), "A42", event.PickupCode)
+						return nil
+					})
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.NotNil(t, order)
+				assert.Equal(t, valueobject.RECEIVED, order.Status)
+				assert.Equal(t, "A42", order.PickupCode)
+				assert.NotNil(t, order.PickupDate)
+			},
+		},
+		{
+			name: "should return error when kitchen routing fails",
+			input: dto.UpdateOrderInput{
+				ID:         3,
+				CustomerID: 1,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(3)).
+					Return(pendingOrder, nil)
+
+				s.mockGateway.EXPECT().
+					NextPickupNumber(s.ctx, gomock.Any()).
+					Return(uint32(42), nil)
+
+				s.mockStockUseCase.EXPECT().
+					Reserve(s.ctx, pendingOrder).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockKitchenUseCase.EXPECT().
+					Route(s.ctx, pendingOrder).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+				// The status, the stock and the history are rolled back with the transaction
+				assert.Equal(t, err, s.txErr)
+			},
+		},
+		{
+			name: "should return error when gateway find fails",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when order not found",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name: "should return error when customer id is different",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 2,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when status is different and can't transition",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.READY,
+				ActorType:  valueobject.ActorStaff,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when status is different and need staff id",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.PREPARING,
+				ActorType:  valueobject.ActorStaff,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway update fails",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.RECEIVED,
+				ActorType:  valueobject.ActorService,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when status is different and order history use case create fails",
+			input: dto.UpdateOrderInput{
+				ID:         1,
+				CustomerID: 1,
+				Status:     valueobject.CANCELLED,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockOrders[0], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				// The order was received on the first test case
+				s.mockStockUseCase.EXPECT().
+					Release(s.ctx, s.mockOrders[0]).
+					Return(nil)
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return precondition failed when version does not match",
+			input: dto.UpdateOrderInput{
+				ID:      1,
+				Status:  valueobject.CANCELLED,
+				Version: 1,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.OPEN, Version: 2}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.PreconditionFailedError{}, err)
+			},
+		},
+		{
+			name: "should update order when version matches",
+			input: dto.UpdateOrderInput{
+				ID:      1,
+				Status:  valueobject.CANCELLED,
+				Version: 2,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.OPEN, Version: 2}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) error {
+						o.Version++
+						return nil
+					})
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderStatusChangedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint32(3), order.Version)
 			},
 		},
 		{
-			name: "should return error when order history use case create fails",
-			input: dto.CreateOrderInput{
-				CustomerID: 1,
+			name: "should return conflict error when order was updated concurrently",
+			input: dto.UpdateOrderInput{
+				ID:     1,
+				Status: valueobject.CANCELLED,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					Create(s.ctx, gomock.Any()).
-					Return(nil)
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.OPEN, Version: 1}, nil)
 
-				s.mockOrderHistoryUseCase.EXPECT().
-					Create(s.ctx, gomock.Any()).
-					Return(nil, assert.AnError)
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(domain.NewConflictError(domain.ErrOrderVersionConflict))
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
 				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.InternalError{}, err)
+				assert.IsType(t, &domain.ConflictError{}, err)
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		s.T().Run(tt.name, func(t *testing.T) {
-			// Arrange
-			tt.setupMocks()
-
-			// Act
-			order, err := s.useCase.Create(s.ctx, tt.input)
-
-			// Assert
-			tt.checkResult(t, order, err)
-		})
-	}
-}
-
-func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Get() {
-	tests := []struct {
-		name        string
-		input       dto.GetOrderInput
-		setupMocks  func()
-		checkResult func(*testing.T, *entity.Order, error)
-	}{
 		{
-			name:  "should get order successfully",
-			input: dto.GetOrderInput{ID: 1},
+			name: "should return error when products are out of stock",
+			input: dto.UpdateOrderInput{
+				ID:        1,
+				Status:    valueobject.RECEIVED,
+				ActorType: valueobject.ActorService,
+			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
 					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					Return(&entity.Order{ID: 1, Status: valueobject.PENDING}, nil)
+
+				s.mockGateway.EXPECT().
+					NextPickupNumber(s.ctx, gomock.Any()).
+					Return(uint32(42), nil)
+
+				s.mockStockUseCase.EXPECT().
+					Reserve(s.ctx, gomock.Any()).
+					Return(domain.NewInvalidInputError(domain.ErrProductOutOfStock))
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.NoError(t, err)
-				assert.NotNil(t, order)
-				assert.Equal(t, uint64(1), order.ID)
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrProductOutOfStock), err)
 			},
 		},
 		{
-			name:  "should return not found error when order doesn't exist",
-			input: dto.GetOrderInput{ID: 1},
+			name: "should return error when the pickup code can't be assigned",
+			input: dto.UpdateOrderInput{
+				ID:        1,
+				Status:    valueobject.RECEIVED,
+				ActorType: valueobject.ActorService,
+			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
 					FindByID(s.ctx, uint64(1)).
-					Return(nil, nil)
+					Return(&entity.Order{ID: 1, Status: valueobject.PENDING}, nil)
+
+				s.mockGateway.EXPECT().
+					NextPickupNumber(s.ctx, gomock.Any()).
+					Return(uint32(0), assert.AnError)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.NotFoundError{}, err)
+				assert.IsType(t, &domain.InternalError{}, err)
 			},
 		},
 		{
-			name:  "should return internal error when gateway fails",
-			input: dto.GetOrderInput{ID: 1},
+			name: "should roll the reserved stock back when gateway update fails",
+			input: dto.UpdateOrderInput{
+				ID:        1,
+				Status:    valueobject.RECEIVED,
+				ActorType: valueobject.ActorService,
+			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
 					FindByID(s.ctx, uint64(1)).
-					Return(nil, assert.AnError)
+					Return(&entity.Order{ID: 1, Status: valueobject.PENDING}, nil)
+
+				gomock.InOrder(
+					s.mockGateway.EXPECT().
+						NextPickupNumber(s.ctx, gomock.Any()).
+						Return(uint32(1), nil),
+					s.mockStockUseCase.EXPECT().
+						Reserve(s.ctx, gomock.Any()).
+						Return(nil),
+					s.mockGateway.EXPECT().
+						Update(s.ctx, gomock.Any()).
+						Return(assert.AnError),
+				)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
 				assert.IsType(t, &domain.InternalError{}, err)
+				assert.Equal(t, err, s.txErr)
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		s.T().Run(tt.name, func(t *testing.T) {
-			// Arrange
-			tt.setupMocks()
-
-			// Act
-			order, err := s.useCase.Get(s.ctx, tt.input)
-
-			// Assert
-			tt.checkResult(t, order, err)
-		})
-	}
-}
-
-func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Update() {
-	tests := []struct {
-		name        string
-		input       dto.UpdateOrderInput
-		setupMocks  func()
-		checkResult func(*testing.T, *entity.Order, error)
-	}{
 		{
-			name: "should update order successfully",
+			name: "should release the stock when a received order is cancelled",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.RECEIVED,
+				ID:     2,
+				Status: valueobject.CANCELLED,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					FindByID(s.ctx, uint64(2)).
+					Return(&entity.Order{ID: 2, Status: valueobject.RECEIVED}, nil)
 
-				s.mockGateway.EXPECT().
-					Update(s.ctx, gomock.Any()).
-					DoAndReturn(func(_ context.Context, p *entity.Order) error {
-						assert.Equal(s.T(), uint64(1), p.ID)
-						return nil
-					})
+				gomock.InOrder(
+					s.mockGateway.EXPECT().
+						Update(s.ctx, gomock.Any()).
+						Return(nil),
+					s.mockStockUseCase.EXPECT().
+						Release(s.ctx, gomock.Any()).
+						Return(nil),
+				)
 
-				s.mockOrderHistoryUseCase.EXPECT().
+				s.mockOrderHistoryGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
-					Return(&entity.OrderHistory{OrderID: 1, Status: valueobject.RECEIVED}, nil)
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderStatusChangedEvent.String(), gomock.Any()).
+					Return(nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
 				assert.NoError(t, err)
-				assert.NotNil(t, order)
-				assert.Equal(t, valueobject.RECEIVED, order.Status)
+				assert.Equal(t, valueobject.CANCELLED, order.Status)
 			},
 		},
 		{
-			name: "should return error when gateway find fails",
+			name: "should roll the cancellation back when the stock can't be released",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.RECEIVED,
+				ID:     2,
+				Status: valueobject.CANCELLED,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(nil, assert.AnError)
+					FindByID(s.ctx, uint64(2)).
+					Return(&entity.Order{ID: 2, Status: valueobject.RECEIVED}, nil)
+
+				gomock.InOrder(
+					s.mockGateway.EXPECT().
+						Update(s.ctx, gomock.Any()).
+						Return(nil),
+					s.mockStockUseCase.EXPECT().
+						Release(s.ctx, gomock.Any()).
+						Return(domain.NewInternalError(assert.AnError)),
+				)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
 				assert.IsType(t, &domain.InternalError{}, err)
+				assert.Equal(t, err, s.txErr)
 			},
 		},
 		{
-			name: "should return error when order not found",
+			name: "should return error when actor is not allowed to perform the transition",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.RECEIVED,
+				ID:         2,
+				CustomerID: 2,
+				Status:     valueobject.PREPARING,
+				StaffID:    1,
+				ActorType:  valueobject.ActorCustomer,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(nil, nil)
+					FindByID(s.ctx, uint64(2)).
+					Return(s.mockOrders[1], nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.NotFoundError{}, err)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderTransitionNotAllowedForActor), err)
 			},
 		},
 		{
-			name: "should return error when customer id is different",
+			name: "should check the transition as the customer when the actor is missing",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 2,
-				Status:     valueobject.RECEIVED,
+				ID:         5,
+				CustomerID: 1,
+				Status:     valueobject.PREPARING,
+				StaffID:    1,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					FindByID(s.ctx, uint64(5)).
+					Return(&entity.Order{ID: 5, CustomerID: 1, Status: valueobject.RECEIVED}, nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderTransitionNotAllowedForActor), err)
 			},
 		},
 		{
-			name: "should return error when status is different and can't transition",
+			name: "should return error when customer marks the order as paid",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.READY,
+				ID:        1,
+				Status:    valueobject.RECEIVED,
+				ActorType: valueobject.ActorCustomer,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
 					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					Return(&entity.Order{ID: 1, CustomerID: 1, Status: valueobject.PENDING}, nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderTransitionNotAllowedForActor), err)
 			},
 		},
 		{
-			name: "should return error when status is different and need staff id",
+			name: "should return error when transition guard fails",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.PREPARING,
+				ID:     1,
+				Status: valueobject.PENDING,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
 					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					Return(&entity.Order{ID: 1, Status: valueobject.OPEN, Version: 1}, nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderWithoutProducts), err)
 			},
 		},
 		{
-			name: "should return error when gateway update fails",
+			name: "should send delivery order out for delivery",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.RECEIVED,
+				ID:        4,
+				Status:    valueobject.OUT_FOR_DELIVERY,
+				StaffID:   1,
+				ActorType: valueobject.ActorStaff,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
+					FindByID(s.ctx, uint64(4)).
+					Return(&entity.Order{ID: 4, Status: valueobject.READY, FulfilmentMode: valueobject.FulfilmentDelivery}, nil)
 
 				s.mockGateway.EXPECT().
 					Update(s.ctx, gomock.Any()).
-					Return(assert.AnError)
+					Return(nil)
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderStatusChangedEvent.String(), gomock.Any()).
+					Return(nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
-				assert.Nil(t, order)
-				assert.IsType(t, &domain.InternalError{}, err)
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.OUT_FOR_DELIVERY, order.Status)
 			},
 		},
 		{
-			name: "should return error when status is different and order history use case create fails",
+			name: "should return error when takeaway order is sent out for delivery",
 			input: dto.UpdateOrderInput{
-				ID:         1,
-				CustomerID: 1,
-				Status:     valueobject.CANCELLED,
+				ID:        4,
+				Status:    valueobject.OUT_FOR_DELIVERY,
+				StaffID:   1,
+				ActorType: valueobject.ActorStaff,
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindByID(s.ctx, uint64(1)).
-					Return(s.mockOrders[0], nil)
-
+					FindByID(s.ctx, uint64(4)).
+					Return(&entity.Order{ID: 4, Status: valueobject.READY, FulfilmentMode: valueobject.FulfilmentTakeaway}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderInvalidStatusTransition), err)
+			},
+		},
+		{
+			name: "should return error when delivery order is completed at the counter",
+			input: dto.UpdateOrderInput{
+				ID:        4,
+				Status:    valueobject.COMPLETED,
+				StaffID:   1,
+				ActorType: valueobject.ActorStaff,
+			},
+			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					Update(s.ctx, gomock.Any()).
-					Return(nil)
-
-				s.mockOrderHistoryUseCase.EXPECT().
-					Create(s.ctx, gomock.Any()).
-					Return(nil, assert.AnError)
+					FindByID(s.ctx, uint64(4)).
+					Return(&entity.Order{ID: 4, Status: valueobject.READY, FulfilmentMode: valueobject.FulfilmentDelivery}, nil)
 			},
 			checkResult: func(t *testing.T, order *entity.Order, err error) {
-				assert.Error(t, err)
 				assert.Nil(t, order)
-				assert.IsType(t, &domain.InternalError{}, err)
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderInvalidStatusTransition), err)
 			},
 		},
 	}
@@ -524,3 +1459,108 @@ func (s *OrderUsecaseSuiteTest) TestOrderUseCase_Delete() {
 		})
 	}
 }
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_GetStatusMachine() {
+	machine := s.useCase.GetStatusMachine(s.ctx)
+
+	assert.NotNil(s.T(), machine)
+	assert.Equal(s.T(), valueobject.OPEN, machine.Initial)
+	assert.True(s.T(), machine.CanTransition(valueobject.READY, valueobject.COMPLETED))
+}
+
+func (s *OrderUsecaseSuiteTest) TestOrderUseCase_ExpireIdle() {
+	input := dto.ExpireIdleOrdersInput{
+		TTLs: map[valueobject.OrderStatus]time.Duration{
+			valueobject.PENDING: 15 * time.Minute,
+			valueobject.OPEN:    0,
+		},
+		Limit: 10,
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.Order, error)
+	}{
+		{
+			name: "should cancel idle orders as system actor",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindIdle(s.ctx, valueobject.PENDING, gomock.Any(), 10).
+					DoAndReturn(func(_ context.Context, _ valueobject.OrderStatus, updatedBefore time.Time, _ int) ([]*entity.Order, error) {
+						assert.WithinDuration(s.T(), time.Now().Add(-15*time.Minute), updatedBefore, time.Second)
+						return []*entity.Order{{ID: 1, Status: valueobject.PENDING, Version: 1}}, nil
+					})
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.PENDING, Version: 1}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockOrderHistoryGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, i *entity.OrderHistory) error {
+						assert.Equal(s.T(), valueobject.CANCELLED, i.Status)
+						assert.Equal(s.T(), valueobject.ActorSystem, i.ActorType)
+						assert.Equal(s.T(), "EXPIRED", i.ReasonCode)
+						assert.Equal(s.T(), valueobject.SourceScheduler, i.Source)
+						return nil
+					})
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, valueobject.OrderStatusChangedEvent.String(), gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, orders []*entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, orders, 1)
+				assert.Equal(t, valueobject.CANCELLED, orders[0].Status)
+			},
+		},
+		{
+			name: "should skip orders changed concurrently",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindIdle(s.ctx, valueobject.PENDING, gomock.Any(), 10).
+					Return([]*entity.Order{{ID: 1, Status: valueobject.PENDING, Version: 1}}, nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.RECEIVED, Version: 2}, nil)
+			},
+			checkResult: func(t *testing.T, orders []*entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, orders)
+			},
+		},
+		{
+			name: "should return error when gateway find idle fails",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindIdle(s.ctx, valueobject.PENDING, gomock.Any(), 10).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, orders []*entity.Order, err error) {
+				assert.Error(t, err)
+				assert.Empty(t, orders)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			orders, err := s.useCase.ExpireIdle(s.ctx, input)
+
+			// Assert
+			tt.checkResult(t, orders, err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+const (
+	// checkoutReasonCode is recorded on orders moved to PENDING by the checkout
+	checkoutReasonCode = "CHECKOUT"
+	// paymentFailedReasonCode is recorded on orders reopened because the payment could not be created
+	paymentFailedReasonCode = "PAYMENT_FAILED"
+	// paymentActorID identifies the payment service on the order history
+	paymentActorID = "payment-service"
+)
+
+type paymentUseCase struct {
+	orderGateway   port.OrderGateway
+	orderUseCase   port.OrderUseCase
+	paymentService port.PaymentService
+}
+
+// NewPaymentUseCase creates a new PaymentUseCase, the order status changes go through the order use case
+// so the payment follows the same status machine as any other update
+func NewPaymentUseCase(orderGateway port.OrderGateway, orderUseCase port.OrderUseCase, paymentService port.PaymentService) port.PaymentUseCase {
+	return &paymentUseCase{orderGateway, orderUseCase, paymentService}
+}
+
+// Checkout moves the OPEN order to PENDING and creates its payment on the payment service.
+// The order is reopened when the payment can't be created, so the customer can try again
+func (uc *paymentUseCase) Checkout(ctx context.Context, i dto.CheckoutInput) (*entity.Order, error) {
+	order, err := uc.orderUseCase.Get(ctx, dto.GetOrderInput{ID: i.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if order.Status != valueobject.OPEN {
+		return nil, domain.NewInvalidInputError(domain.ErrOrderIsNotOpen)
+	}
+
+	// The items of a PENDING order can't be changed, so the payment is created for the final total
+	order, err = uc.orderUseCase.Update(ctx, dto.UpdateOrderInput{
+		ID:         order.ID,
+		Status:     valueobject.PENDING,
+		ActorType:  valueobject.ActorCustomer,
+		ReasonCode: checkoutReasonCode,
+		Source:     valueobject.SourceAPI,
+		Version:    order.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := uc.paymentService.CreatePayment(ctx, order)
+	if err != nil {
+		paymentErr := fmt.Errorf("%s: %w", domain.ErrFailedToCreatePaymentExternal, err)
+		_, reopenErr := uc.orderUseCase.Update(ctx, dto.UpdateOrderInput{
+			ID:         order.ID,
+			Status:     valueobject.OPEN,
+			ActorType:  valueobject.ActorSystem,
+			ReasonCode: paymentFailedReasonCode,
+			ReasonText: err.Error(),
+			Source:     valueobject.SourceAPI,
+			Version:    order.Version,
+		})
+		if reopenErr != nil {
+			return nil, domain.NewInternalError(errors.Join(paymentErr, reopenErr))
+		}
+		return nil, domain.NewInternalError(paymentErr)
+	}
+
+	order.PaymentReference = payment.Reference
+	if err := uc.orderGateway.Update(ctx, order); err != nil {
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			return nil, conflictErr
+		}
+		return nil, domain.NewInternalError(err)
+	}
+
+	return order, nil
+}
+
+// Callback moves the order of the payment to RECEIVED when it was approved, or to CANCELLED otherwise.
+// The payment service may send the same outcome more than once, an order already on the status is returned as is
+func (uc *paymentUseCase) Callback(ctx context.Context, i dto.PaymentCallbackInput) (*entity.Order, error) {
+	status, ok := valueobject.ToPaymentStatus(string(i.Status))
+	if !ok {
+		return nil, domain.NewInvalidInputError(domain.ErrPaymentStatusInvalid)
+	}
+
+	order, err := uc.orderGateway.FindByPaymentReference(ctx, i.Reference)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if order == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if order.Status == status.OrderStatus() {
+		return order, nil
+	}
+
+	return uc.orderUseCase.Update(ctx, dto.UpdateOrderInput{
+		ID:         order.ID,
+		Status:     status.OrderStatus(),
+		ActorType:  valueobject.ActorService,
+		ActorID:    paymentActorID,
+		ReasonCode: "PAYMENT_" + status.String(),
+		Source:     valueobject.SourceAPI,
+		Version:    order.Version,
+	})
+}
@@ -0,0 +1,48 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PaymentUsecaseSuiteTest struct {
+	suite.Suite
+	mockOrder          *entity.Order
+	mockOrderGateway   *mockport.MockOrderGateway
+	mockOrderUseCase   *mockport.MockOrderUseCase
+	mockPaymentService *mockport.MockPaymentService
+	useCase            port.PaymentUseCase
+	ctx                context.Context
+}
+
+func (s *PaymentUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockOrderGateway = mockport.NewMockOrderGateway(ctrl)
+	s.mockOrderUseCase = mockport.NewMockOrderUseCase(ctrl)
+	s.mockPaymentService = mockport.NewMockPaymentService(ctrl)
+	s.useCase = usecase.NewPaymentUseCase(s.mockOrderGateway, s.mockOrderUseCase, s.mockPaymentService)
+	s.ctx = context.Background()
+	s.mockOrder = &entity.Order{
+		ID:         1,
+		CustomerID: 1,
+		Status:     valueobject.OPEN,
+		Total:      59.98,
+		Version:    3,
+		OrderProducts: []entity.OrderProduct{
+			{OrderID: 1, ProductID: 1, Quantity: 2, Price: 29.99},
+		},
+	}
+}
+
+func TestPaymentUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(PaymentUsecaseSuiteTest))
+}
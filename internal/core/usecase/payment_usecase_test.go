@@ -0,0 +1,270 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *PaymentUsecaseSuiteTest) TestPaymentUseCase_Checkout() {
+	pendingOrder := func() *entity.Order {
+		order := *s.mockOrder
+		order.Status = valueobject.PENDING
+		order.Version = 4
+		return &order
+	}
+	checkoutInput := dto.UpdateOrderInput{
+		ID:         1,
+		Status:     valueobject.PENDING,
+		ActorType:  valueobject.ActorCustomer,
+		ReasonCode: "CHECKOUT",
+		Source:     valueobject.SourceAPI,
+		Version:    3,
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name: "should move the order to pending and store the payment reference",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockOrder, nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, checkoutInput).Return(pendingOrder(), nil)
+				s.mockPaymentService.EXPECT().
+					CreatePayment(s.ctx, gomock.Any()).
+					Return(&entity.Payment{OrderID: 1, Reference: "pay_8f14e45f", Amount: 59.98}, nil)
+				s.mockOrderGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, o *entity.Order) error {
+						assert.Equal(s.T(), "pay_8f14e45f", o.PaymentReference)
+						return nil
+					})
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.PENDING, order.Status)
+				assert.Equal(t, "pay_8f14e45f", order.PaymentReference)
+			},
+		},
+		{
+			name: "should return invalid input error when the order is not open",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(pendingOrder(), nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrOrderIsNotOpen)
+			},
+		},
+		{
+			name: "should return not found error when the order doesn't exist",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name: "should return the error of the status change",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockOrder, nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, checkoutInput).
+					Return(nil, domain.NewInvalidInputError(domain.ErrOrderWithoutProducts))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should reopen the order when the payment can't be created",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockOrder, nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, checkoutInput).Return(pendingOrder(), nil)
+				s.mockPaymentService.EXPECT().CreatePayment(s.ctx, gomock.Any()).Return(nil, assert.AnError)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, dto.UpdateOrderInput{
+					ID:         1,
+					Status:     valueobject.OPEN,
+					ActorType:  valueobject.ActorSystem,
+					ReasonCode: "PAYMENT_FAILED",
+					ReasonText: assert.AnError.Error(),
+					Source:     valueobject.SourceAPI,
+					Version:    4,
+				}).Return(s.mockOrder, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+				assert.ErrorContains(t, err, domain.ErrFailedToCreatePaymentExternal)
+			},
+		},
+		{
+			name: "should return both errors when the order can't be reopened",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockOrder, nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, checkoutInput).Return(pendingOrder(), nil)
+				s.mockPaymentService.EXPECT().CreatePayment(s.ctx, gomock.Any()).Return(nil, assert.AnError)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, gomock.Any()).
+					Return(nil, domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+				assert.ErrorContains(t, err, domain.ErrOrderVersionConflict)
+			},
+		},
+		{
+			name: "should return conflict error when the order changed before the reference was stored",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockOrder, nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, checkoutInput).Return(pendingOrder(), nil)
+				s.mockPaymentService.EXPECT().
+					CreatePayment(s.ctx, gomock.Any()).
+					Return(&entity.Payment{OrderID: 1, Reference: "pay_8f14e45f"}, nil)
+				s.mockOrderGateway.EXPECT().Update(s.ctx, gomock.Any()).
+					Return(domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.Checkout(s.ctx, dto.CheckoutInput{ID: 1})
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *PaymentUsecaseSuiteTest) TestPaymentUseCase_Callback() {
+	pendingOrder := func() *entity.Order {
+		return &entity.Order{ID: 1, Status: valueobject.PENDING, PaymentReference: "pay_8f14e45f", Version: 4}
+	}
+
+	tests := []struct {
+		name        string
+		input       dto.PaymentCallbackInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should move the order to received when the payment was approved",
+			input: dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentApproved},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().FindByPaymentReference(s.ctx, "pay_8f14e45f").Return(pendingOrder(), nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, dto.UpdateOrderInput{
+					ID:         1,
+					Status:     valueobject.RECEIVED,
+					ActorType:  valueobject.ActorService,
+					ActorID:    "payment-service",
+					ReasonCode: "PAYMENT_APPROVED",
+					Source:     valueobject.SourceAPI,
+					Version:    4,
+				}).Return(&entity.Order{ID: 1, Status: valueobject.RECEIVED}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.RECEIVED, order.Status)
+			},
+		},
+		{
+			name:  "should cancel the order when the payment was rejected",
+			input: dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentRejected},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().FindByPaymentReference(s.ctx, "pay_8f14e45f").Return(pendingOrder(), nil)
+				s.mockOrderUseCase.EXPECT().Update(s.ctx, dto.UpdateOrderInput{
+					ID:         1,
+					Status:     valueobject.CANCELLED,
+					ActorType:  valueobject.ActorService,
+					ActorID:    "payment-service",
+					ReasonCode: "PAYMENT_REJECTED",
+					Source:     valueobject.SourceAPI,
+					Version:    4,
+				}).Return(&entity.Order{ID: 1, Status: valueobject.CANCELLED}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.CANCELLED, order.Status)
+			},
+		},
+		{
+			name:  "should return the order as is when the outcome was already applied",
+			input: dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentApproved},
+			setupMocks: func() {
+				order := pendingOrder()
+				order.Status = valueobject.RECEIVED
+				s.mockOrderGateway.EXPECT().FindByPaymentReference(s.ctx, "pay_8f14e45f").Return(order, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.RECEIVED, order.Status)
+			},
+		},
+		{
+			name:       "should return invalid input error when the payment status is unknown",
+			input:      dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: "REFUNDED"},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when no order has the payment",
+			input: dto.PaymentCallbackInput{Reference: "unknown", Status: valueobject.PaymentApproved},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().FindByPaymentReference(s.ctx, "unknown").Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when the gateway fails",
+			input: dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentApproved},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().FindByPaymentReference(s.ctx, "pay_8f14e45f").Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.Callback(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// pickupBoardLimit is how many orders fit on the lobby monitor
+const pickupBoardLimit = 100
+
+type pickupBoardUseCase struct {
+	orderGateway port.OrderGateway
+	location     *time.Location
+}
+
+// NewPickupBoardUseCase creates a new PickupBoardUseCase, the board shows the orders of the current day
+// of the restaurant location
+func NewPickupBoardUseCase(orderGateway port.OrderGateway, location *time.Location) port.PickupBoardUseCase {
+	return &pickupBoardUseCase{orderGateway, location}
+}
+
+// Get returns the pickup codes of the orders being prepared and ready to be picked up
+func (uc *pickupBoardUseCase) Get(ctx context.Context) (*entity.PickupBoard, error) {
+	now := time.Now()
+	orders, err := uc.orderGateway.FindOnPickupBoard(ctx, now.In(uc.location), pickupBoardLimit)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return entity.NewPickupBoard(orders, now), nil
+}
@@ -0,0 +1,44 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PickupBoardUsecaseSuiteTest struct {
+	suite.Suite
+	mockOrders       []*entity.Order
+	mockOrderGateway *mockport.MockOrderGateway
+	useCase          port.PickupBoardUseCase
+	location         *time.Location
+	ctx              context.Context
+}
+
+func (s *PickupBoardUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockOrderGateway = mockport.NewMockOrderGateway(ctrl)
+	s.location = time.FixedZone("BRT", -3*60*60)
+	s.useCase = usecase.NewPickupBoardUseCase(s.mockOrderGateway, s.location)
+	s.ctx = context.Background()
+	s.mockOrders = []*entity.Order{
+		{ID: 1, CustomerID: 1, Status: valueobject.READY, PickupCode: "A41"},
+		{ID: 2, CustomerID: 2, Status: valueobject.PREPARING, PickupCode: "A42"},
+		{ID: 3, CustomerID: 1, Status: valueobject.READY, PickupCode: "A43"},
+		// Received before the pickup codes existed
+		{ID: 4, CustomerID: 3, Status: valueobject.PREPARING},
+	}
+}
+
+func TestPickupBoardUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(PickupBoardUsecaseSuiteTest))
+}
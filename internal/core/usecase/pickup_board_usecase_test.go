@@ -0,0 +1,89 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+)
+
+func (s *PickupBoardUsecaseSuiteTest) TestPickupBoardUseCase_Get() {
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.PickupBoard, error)
+	}{
+		{
+			name: "should split the pickup codes by status",
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindOnPickupBoard(s.ctx, gomock.Any(), gomock.Any()).
+					Return(s.mockOrders, nil)
+			},
+			checkResult: func(t *testing.T, board *entity.PickupBoard, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []string{"A42"}, board.Preparing)
+				assert.Equal(t, []string{"A41", "A43"}, board.Ready)
+				assert.False(t, board.GeneratedAt.IsZero())
+			},
+		},
+		{
+			name: "should only show the orders of the day of the restaurant",
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindOnPickupBoard(s.ctx, gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, day time.Time, _ int) ([]*entity.Order, error) {
+						assert.Equal(s.T(), s.location, day.Location())
+						assert.Equal(s.T(), time.Now().In(s.location).Format(time.DateOnly), day.Format(time.DateOnly))
+						return nil, nil
+					})
+			},
+			checkResult: func(t *testing.T, board *entity.PickupBoard, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "should return an empty board when there are no orders",
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindOnPickupBoard(s.ctx, gomock.Any(), gomock.Any()).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, board *entity.PickupBoard, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, board.Preparing)
+				assert.NotNil(t, board.Ready)
+			},
+		},
+		{
+			name: "should return error when gateway fails",
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindOnPickupBoard(s.ctx, gomock.Any(), gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, board *entity.PickupBoard, err error) {
+				assert.Nil(t, board)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			board, err := s.useCase.Get(s.ctx)
+
+			// Assert
+			tt.checkResult(t, board, err)
+		})
+	}
+}
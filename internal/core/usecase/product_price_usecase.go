@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type productPriceUseCase struct {
+	productGateway port.ProductGateway
+	menuCache      port.MenuCache
+}
+
+// NewProductPriceUseCase creates a new ProductPriceUseCase
+func NewProductPriceUseCase(productGateway port.ProductGateway, menuCache port.MenuCache) port.ProductPriceUseCase {
+	return &productPriceUseCase{productGateway, menuCache}
+}
+
+// List returns the price history of a product, the latest prices first
+func (uc *productPriceUseCase) List(ctx context.Context, i dto.ListProductPricesInput) ([]*entity.ProductPrice, int64, error) {
+	if _, err := uc.findProduct(ctx, i.ProductID); err != nil {
+		return nil, 0, err
+	}
+
+	prices, total, err := uc.productGateway.FindPrices(ctx, i.ProductID, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return prices, total, nil
+}
+
+// Schedule sets the price of a product for a range of time, starting now when no start is given.
+// The prices overlapping the range are trimmed, a price without end lasts until the next change
+func (uc *productPriceUseCase) Schedule(ctx context.Context, i dto.ScheduleProductPriceInput) (*entity.ProductPrice, error) {
+	if _, err := uc.findProduct(ctx, i.ProductID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	price := i.ToEntity()
+	if price.EffectiveFrom.IsZero() {
+		price.EffectiveFrom = now
+	}
+	if err := price.Validate(now); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.productGateway.SchedulePrice(ctx, price); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	uc.menuCache.Invalidate(ctx)
+
+	return price, nil
+}
+
+func (uc *productPriceUseCase) findProduct(ctx context.Context, productID uint64) (*entity.Product, error) {
+	product, err := uc.productGateway.FindByID(ctx, productID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if product == nil {
+		return nil, domain.NewNotFoundError(domain.ErrProductNotFound)
+	}
+	return product, nil
+}
@@ -0,0 +1,44 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ProductPriceUsecaseSuiteTest struct {
+	suite.Suite
+	mockProduct        *entity.Product
+	mockPrices         []*entity.ProductPrice
+	mockProductGateway *mockport.MockProductGateway
+	mockMenuCache      *mockport.MockMenuCache
+	useCase            port.ProductPriceUseCase
+	ctx                context.Context
+}
+
+func (s *ProductPriceUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockProductGateway = mockport.NewMockProductGateway(ctrl)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewProductPriceUseCase(s.mockProductGateway, s.mockMenuCache)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	changedAt := currentTime.AddDate(0, 0, -7)
+	s.mockProduct = &entity.Product{ID: 1, Name: "X-Burger", Price: 27.9, CategoryID: 1}
+	s.mockPrices = []*entity.ProductPrice{
+		{ID: 2, ProductID: 1, Price: 27.9, EffectiveFrom: changedAt, CreatedAt: changedAt},
+		{ID: 1, ProductID: 1, Price: 25.9, EffectiveFrom: currentTime.AddDate(0, -1, 0), EffectiveTo: &changedAt, CreatedAt: currentTime.AddDate(0, -1, 0)},
+	}
+}
+
+func TestProductPriceUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(ProductPriceUsecaseSuiteTest))
+}
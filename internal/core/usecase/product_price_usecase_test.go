@@ -0,0 +1,210 @@
+package usecase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *ProductPriceUsecaseSuiteTest) TestProductPriceUseCase_List() {
+	tests := []struct {
+		name        string
+		input       dto.ListProductPricesInput
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.ProductPrice, int64, error)
+	}{
+		{
+			name:  "should list the price history",
+			input: dto.ListProductPricesInput{ProductID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					FindPrices(s.ctx, uint64(1), 1, 10).
+					Return(s.mockPrices, int64(2), nil)
+			},
+			checkResult: func(t *testing.T, prices []*entity.ProductPrice, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockPrices, prices)
+				assert.Equal(t, int64(2), total)
+			},
+		},
+		{
+			name:  "should return not found error when product doesn't exist",
+			input: dto.ListProductPricesInput{ProductID: 9, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(9)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, prices []*entity.ProductPrice, total int64, err error) {
+				assert.Nil(t, prices)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.ListProductPricesInput{ProductID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					FindPrices(s.ctx, uint64(1), 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, prices []*entity.ProductPrice, total int64, err error) {
+				assert.Nil(t, prices)
+				assert.Equal(t, int64(0), total)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			prices, total, err := s.useCase.List(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, prices, total, err)
+		})
+	}
+}
+
+func (s *ProductPriceUsecaseSuiteTest) TestProductPriceUseCase_Schedule() {
+	monday := time.Now().AddDate(0, 0, 3).Truncate(time.Hour)
+	nextMonday := monday.AddDate(0, 0, 7)
+	yesterday := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name        string
+		input       dto.ScheduleProductPriceInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.ProductPrice, error)
+	}{
+		{
+			name:  "should schedule a price for a week",
+			input: dto.ScheduleProductPriceInput{ProductID: 1, Price: 22.9, EffectiveFrom: monday, EffectiveTo: &nextMonday},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					SchedulePrice(s.ctx, &entity.ProductPrice{ProductID: 1, Price: 22.9, EffectiveFrom: monday, EffectiveTo: &nextMonday}).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 22.9, price.Price)
+				assert.Equal(t, monday, price.EffectiveFrom)
+			},
+		},
+		{
+			name:  "should start the price now when no start is given",
+			input: dto.ScheduleProductPriceInput{ProductID: 1, Price: 28.9},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					SchedulePrice(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.NoError(t, err)
+				assert.WithinDuration(t, time.Now(), price.EffectiveFrom, time.Second)
+				assert.Nil(t, price.EffectiveTo)
+			},
+		},
+		{
+			name:  "should return invalid input error when the price starts in the past",
+			input: dto.ScheduleProductPriceInput{ProductID: 1, Price: 22.9, EffectiveFrom: yesterday},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.Nil(t, price)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when the price ends before it starts",
+			input: dto.ScheduleProductPriceInput{ProductID: 1, Price: 22.9, EffectiveFrom: nextMonday, EffectiveTo: &monday},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.Nil(t, price)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when product doesn't exist",
+			input: dto.ScheduleProductPriceInput{ProductID: 9, Price: 22.9},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(9)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.Nil(t, price)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails",
+			input: dto.ScheduleProductPriceInput{ProductID: 1, Price: 22.9, EffectiveFrom: monday},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					SchedulePrice(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, price *entity.ProductPrice, err error) {
+				assert.Nil(t, price)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			price, err := s.useCase.Schedule(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, price, err)
+		})
+	}
+}
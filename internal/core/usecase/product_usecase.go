@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -10,17 +11,23 @@ import (
 )
 
 type productUseCase struct {
-	gateway port.ProductGateway
+	gateway   port.ProductGateway
+	menuCache port.MenuCache
 }
 
 // NewProductUseCase creates a new StaffUseCase
-func NewProductUseCase(gateway port.ProductGateway) port.ProductUseCase {
-	return &productUseCase{gateway: gateway}
+func NewProductUseCase(gateway port.ProductGateway, menuCache port.MenuCache) port.ProductUseCase {
+	return &productUseCase{gateway: gateway, menuCache: menuCache}
 }
 
-// List returns a list of products
+// List returns a list of the products available at the moment of the input
 func (uc *productUseCase) List(ctx context.Context, i dto.ListProductsInput) ([]*entity.Product, int64, error) {
-	products, total, err := uc.gateway.FindAll(ctx, i.Name, i.CategoryID, i.Page, i.Limit)
+	availableAt := i.AvailableAt
+	if availableAt.IsZero() {
+		availableAt = time.Now()
+	}
+
+	products, total, err := uc.gateway.FindAll(ctx, i.Name, i.CategoryID, availableAt, i.Page, i.Limit)
 	if err != nil {
 		return nil, 0, domain.NewInternalError(err)
 	}
@@ -32,9 +39,23 @@ func (uc *productUseCase) List(ctx context.Context, i dto.ListProductsInput) ([]
 func (uc *productUseCase) Create(ctx context.Context, i dto.CreateProductInput) (*entity.Product, error) {
 	product := i.ToEntity()
 
+	if err := validateModifierGroups(product.ModifierGroups); err != nil {
+		return nil, err
+	}
+
+	if err := validateProductAvailabilityWindows(product.AvailabilityWindows); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.findBundleComponents(ctx, 0, product.BundleSlots); err != nil {
+		return nil, err
+	}
+	product.Prices = []entity.ProductPrice{{Price: product.Price, EffectiveFrom: time.Now()}}
+
 	if err := uc.gateway.Create(ctx, product); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
+	uc.menuCache.Invalidate(ctx)
 
 	return product, nil
 }
@@ -63,12 +84,66 @@ func (uc *productUseCase) Update(ctx context.Context, i dto.UpdateProductInput)
 		return nil, domain.NewNotFoundError(domain.ErrNotFound)
 	}
 
-	product.Update(i.Name, i.Description, i.Price, i.CategoryID)
+	var price *entity.ProductPrice
+	if product.Price != i.Price {
+		price = product.NewCurrentPrice(i.Price, time.Now())
+	}
+	product.Update(i.Name, i.Description, i.Price, i.CategoryID, i.KitchenStation)
+
+	groups := dto.ToProductModifierGroupEntities(i.ModifierGroups)
+	if err := validateModifierGroups(groups); err != nil {
+		return nil, err
+	}
+
+	windows := dto.ToProductAvailabilityWindowEntities(i.AvailabilityWindows)
+	if err := validateProductAvailabilityWindows(windows); err != nil {
+		return nil, err
+	}
+
+	slots := dto.ToProductBundleSlotEntities(i.BundleSlots)
+	components, err := uc.findBundleComponents(ctx, product.ID, slots)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := uc.gateway.Update(ctx, product); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
 
+	// The price history is kept, the new price lasts until the next scheduled change
+	if price != nil {
+		if err := uc.gateway.SchedulePrice(ctx, price); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+	}
+
+	if groups != nil {
+		if err := uc.gateway.ReplaceModifierGroups(ctx, product.ID, groups); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		product.ModifierGroups = groups
+	}
+
+	if slots != nil {
+		if err := uc.gateway.ReplaceBundleSlots(ctx, product.ID, slots); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		for j := range slots {
+			for k := range slots[j].Options {
+				slots[j].Options[k].ComponentProduct = *components[slots[j].Options[k].ComponentProductID]
+			}
+		}
+		product.BundleSlots = slots
+	}
+
+	if windows != nil {
+		if err := uc.gateway.ReplaceAvailabilityWindows(ctx, product.ID, windows); err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		product.AvailabilityWindows = windows
+	}
+	uc.menuCache.Invalidate(ctx)
+
 	return product, nil
 }
 
@@ -85,6 +160,60 @@ func (uc *productUseCase) Delete(ctx context.Context, i dto.DeleteProductInput)
 	if err := uc.gateway.Delete(ctx, i.ID); err != nil {
 		return nil, domain.NewInternalError(err)
 	}
+	uc.menuCache.Invalidate(ctx)
 
 	return product, nil
 }
+
+// validateModifierGroups checks the choice limits of each modifier group
+func validateModifierGroups(groups []entity.ProductModifierGroup) error {
+	for i := range groups {
+		if err := groups[i].Validate(); err != nil {
+			return domain.NewInvalidInputError(err.Error())
+		}
+	}
+	return nil
+}
+
+// validateProductAvailabilityWindows checks the weekday, times and timezone of each window
+func validateProductAvailabilityWindows(windows []entity.ProductAvailabilityWindow) error {
+	for i := range windows {
+		if err := windows[i].Validate(); err != nil {
+			return domain.NewInvalidInputError(err.Error())
+		}
+	}
+	return nil
+}
+
+// findBundleComponents validates the bundle slots and returns their component products by ID,
+// a bundle can not contain itself or other bundles
+func (uc *productUseCase) findBundleComponents(ctx context.Context, bundleID uint64, slots []entity.ProductBundleSlot) (map[uint64]*entity.Product, error) {
+	components := make(map[uint64]*entity.Product)
+	for i := range slots {
+		if err := slots[i].Validate(); err != nil {
+			return nil, domain.NewInvalidInputError(err.Error())
+		}
+
+		for _, option := range slots[i].Options {
+			if _, ok := components[option.ComponentProductID]; ok {
+				continue
+			}
+			if option.ComponentProductID == bundleID {
+				return nil, domain.NewInvalidInputError(domain.ErrBundleContainsBundle)
+			}
+
+			component, err := uc.gateway.FindByID(ctx, option.ComponentProductID)
+			if err != nil {
+				return nil, domain.NewInternalError(err)
+			}
+			if component == nil {
+				return nil, domain.NewInvalidInputError(domain.ErrBundleComponentNotFound)
+			}
+			if component.IsBundle() {
+				return nil, domain.NewInvalidInputError(domain.ErrBundleContainsBundle)
+			}
+			components[option.ComponentProductID] = component
+		}
+	}
+	return components, nil
+}
@@ -15,17 +15,19 @@ import (
 
 type ProductUsecaseSuiteTest struct {
 	suite.Suite
-	mockProducts []*entity.Product
-	mockGateway  *mockport.MockProductGateway
-	useCase      port.ProductUseCase
-	ctx          context.Context
+	mockProducts  []*entity.Product
+	mockGateway   *mockport.MockProductGateway
+	mockMenuCache *mockport.MockMenuCache
+	useCase       port.ProductUseCase
+	ctx           context.Context
 }
 
 func (s *ProductUsecaseSuiteTest) SetupTest() {
 	ctrl := gomock.NewController(s.T())
 	defer ctrl.Finish()
 	s.mockGateway = mockport.NewMockProductGateway(ctrl)
-	s.useCase = usecase.NewProductUseCase(s.mockGateway)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewProductUseCase(s.mockGateway, s.mockMenuCache)
 	s.ctx = context.Background()
 	currentTime := time.Now()
 	s.mockProducts = []*entity.Product{
@@ -2,6 +2,7 @@ package usecase_test
 
 import (
 	"testing"
+	"time"
 
 	"go.uber.org/mock/gomock"
 
@@ -27,7 +28,7 @@ func (s *ProductUsecaseSuiteTest) TestProductsUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, "", uint64(0), 1, 10).
+					FindAll(s.ctx, "", uint64(0), gomock.Any(), 1, 10).
 					Return(s.mockProducts, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, products []*entity.Product, total int64, err error) {
@@ -44,7 +45,7 @@ func (s *ProductUsecaseSuiteTest) TestProductsUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, "", uint64(0), 1, 10).
+					FindAll(s.ctx, "", uint64(0), gomock.Any(), 1, 10).
 					Return(nil, int64(0), assert.AnError)
 			},
 			checkResult: func(t *testing.T, products []*entity.Product, total int64, err error) {
@@ -62,7 +63,7 @@ func (s *ProductUsecaseSuiteTest) TestProductsUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, "Test", uint64(0), 1, 10).
+					FindAll(s.ctx, "Test", uint64(0), gomock.Any(), 1, 10).
 					Return(s.mockProducts, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, products []*entity.Product, total int64, err error) {
@@ -71,6 +72,23 @@ func (s *ProductUsecaseSuiteTest) TestProductsUseCase_List() {
 				assert.Equal(t, int64(2), total)
 			},
 		},
+		{
+			name: "should filter by the requested time",
+			input: dto.ListProductsInput{
+				AvailableAt: time.Date(2024, 2, 5, 11, 0, 0, 0, time.UTC),
+				Page:        1,
+				Limit:       10,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, "", uint64(0), time.Date(2024, 2, 5, 11, 0, 0, 0, time.UTC), 1, 10).
+					Return(s.mockProducts, int64(2), nil)
+			},
+			checkResult: func(t *testing.T, products []*entity.Product, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockProducts, products)
+			},
+		},
 		{
 			name: "should filter by category",
 			input: dto.ListProductsInput{
@@ -80,7 +98,7 @@ func (s *ProductUsecaseSuiteTest) TestProductsUseCase_List() {
 			},
 			setupMocks: func() {
 				s.mockGateway.EXPECT().
-					FindAll(s.ctx, "", uint64(1), 1, 10).
+					FindAll(s.ctx, "", uint64(1), gomock.Any(), 1, 10).
 					Return(s.mockProducts, int64(2), nil)
 			},
 			checkResult: func(t *testing.T, products []*entity.Product, total int64, err error) {
@@ -124,6 +142,9 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Create() {
 				s.mockGateway.EXPECT().
 					Create(s.ctx, gomock.Any()).
 					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, product *entity.Product, err error) {
 				assert.NoError(t, err)
@@ -132,6 +153,110 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Create() {
 				assert.Equal(t, "Test Description", product.Description)
 				assert.Equal(t, 99.99, product.Price)
 				assert.Equal(t, uint64(1), product.CategoryID)
+				// The first price record starts the price history
+				assert.Len(t, product.Prices, 1)
+				assert.Equal(t, 99.99, product.Prices[0].Price)
+			},
+		},
+		{
+			name: "should create bundle product with its slots",
+			input: dto.CreateProductInput{
+				Name:       "Combo Big",
+				Price:      42.9,
+				CategoryID: 5,
+				BundleSlots: []dto.ProductBundleSlotInput{
+					{Name: "Burger", Quantity: 1, Options: []dto.ProductBundleOptionInput{{ProductID: 1}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Product{ID: 1, Name: "X-Burger", Price: 25.9}, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.True(t, product.IsBundle())
+				assert.Equal(t, uint64(1), product.BundleSlots[0].Options[0].ComponentProductID)
+			},
+		},
+		{
+			name: "should return invalid input error when bundle contains another bundle",
+			input: dto.CreateProductInput{
+				Name:       "Combo Mega",
+				Price:      50.0,
+				CategoryID: 5,
+				BundleSlots: []dto.ProductBundleSlotInput{
+					{Name: "Combo", Quantity: 1, Options: []dto.ProductBundleOptionInput{{ProductID: 5}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(5)).
+					Return(&entity.Product{ID: 5, BundleSlots: []entity.ProductBundleSlot{{ID: 1}}}, nil)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when bundle component doesn't exist",
+			input: dto.CreateProductInput{
+				Name:       "Combo Big",
+				Price:      42.9,
+				CategoryID: 5,
+				BundleSlots: []dto.ProductBundleSlotInput{
+					{Name: "Burger", Quantity: 1, Options: []dto.ProductBundleOptionInput{{ProductID: 99}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(99)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when modifier group is invalid",
+			input: dto.CreateProductInput{
+				Name:       "X-Burger",
+				Price:      10.0,
+				CategoryID: 1,
+				ModifierGroups: []dto.ProductModifierGroupInput{
+					{Name: "Extras", MinChoices: 2, MaxChoices: 1, Modifiers: []dto.ProductModifierInput{{Name: "Bacon", PriceDelta: 1.0}}},
+				},
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when availability window is invalid",
+			input: dto.CreateProductInput{
+				Name:       "Pancakes",
+				Price:      10.0,
+				CategoryID: 1,
+				AvailabilityWindows: []dto.AvailabilityWindowInput{
+					{Weekday: time.Monday, StartTime: "06:00", EndTime: "10:30", Timezone: "Mars/Olympus_Mons"},
+				},
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
 			},
 		},
 		{
@@ -235,6 +360,8 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Get() {
 }
 
 func (s *ProductUsecaseSuiteTest) TestProductUseCase_Update() {
+	scheduledFrom := time.Now().Add(72 * time.Hour)
+
 	tests := []struct {
 		name        string
 		input       dto.UpdateProductInput
@@ -258,6 +385,18 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Update() {
 				s.mockGateway.EXPECT().
 					Update(s.ctx, gomock.Any()).
 					Return(nil)
+
+				s.mockGateway.EXPECT().
+					SchedulePrice(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ any, price *entity.ProductPrice) error {
+						assert.Equal(s.T(), uint64(1), price.ProductID)
+						assert.Equal(s.T(), 20.0, price.Price)
+						assert.Nil(s.T(), price.EffectiveTo)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, product *entity.Product, err error) {
 				assert.NoError(t, err)
@@ -268,6 +407,199 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Update() {
 				assert.Equal(t, uint64(2), product.CategoryID)
 			},
 		},
+		{
+			name: "should keep the scheduled price when the price changes",
+			input: dto.UpdateProductInput{
+				ID:          2,
+				Name:        "Test Product 2",
+				Description: "Description 2",
+				Price:       149.99,
+				CategoryID:  1,
+			},
+			setupMocks: func() {
+				s.mockProducts[1].Prices = []entity.ProductPrice{
+					{ID: 1, ProductID: 2, Price: 199.99, EffectiveFrom: scheduledFrom.AddDate(0, -1, 0), EffectiveTo: &scheduledFrom},
+					{ID: 2, ProductID: 2, Price: 179.99, EffectiveFrom: scheduledFrom},
+				}
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(2)).
+					Return(s.mockProducts[1], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					SchedulePrice(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ any, price *entity.ProductPrice) error {
+						assert.Equal(s.T(), 149.99, price.Price)
+						assert.Equal(s.T(), &scheduledFrom, price.EffectiveTo)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, 149.99, product.Price)
+			},
+		},
+		{
+			name: "should return error when gateway fails to schedule the price",
+			input: dto.UpdateProductInput{
+				ID:          2,
+				Name:        "Test Product 2",
+				Description: "Description 2",
+				Price:       139.99,
+				CategoryID:  1,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(2)).
+					Return(s.mockProducts[1], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					SchedulePrice(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should replace modifier groups when given",
+			input: dto.UpdateProductInput{
+				ID:          1,
+				Name:        "New Name",
+				Description: "New Description",
+				Price:       20.0,
+				CategoryID:  2,
+				ModifierGroups: []dto.ProductModifierGroupInput{
+					{Name: "Extras", MinChoices: 0, MaxChoices: 2, Modifiers: []dto.ProductModifierInput{{Name: "Bacon", PriceDelta: 1.0}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProducts[0], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					ReplaceModifierGroups(s.ctx, uint64(1), gomock.Len(1)).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, product.ModifierGroups, 1)
+				assert.Equal(t, "Bacon", product.ModifierGroups[0].Modifiers[0].Name)
+			},
+		},
+		{
+			name: "should replace availability windows when given",
+			input: dto.UpdateProductInput{
+				ID:          1,
+				Name:        "New Name",
+				Description: "New Description",
+				Price:       20.0,
+				CategoryID:  2,
+				AvailabilityWindows: []dto.AvailabilityWindowInput{
+					{Weekday: time.Friday, StartTime: "18:00", EndTime: "02:00", Timezone: "America/Sao_Paulo"},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProducts[0], nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					ReplaceAvailabilityWindows(s.ctx, uint64(1), gomock.Len(1)).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, product.AvailabilityWindows, 1)
+				assert.Equal(t, "02:00", product.AvailabilityWindows[0].EndTime)
+			},
+		},
+		{
+			name: "should replace bundle slots when given",
+			input: dto.UpdateProductInput{
+				ID:         1,
+				Name:       "Combo",
+				Price:      20.0,
+				CategoryID: 5,
+				BundleSlots: []dto.ProductBundleSlotInput{
+					{Name: "Drink", Quantity: 2, Options: []dto.ProductBundleOptionInput{{ProductID: 2}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProducts[0], nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(2)).
+					Return(&entity.Product{ID: 2, Name: "Coca-Cola 350ml", Price: 6.9}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockGateway.EXPECT().
+					ReplaceBundleSlots(s.ctx, uint64(1), gomock.Len(1)).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "Coca-Cola 350ml", product.BundleSlots[0].Options[0].ComponentProduct.Name)
+				assert.InDelta(t, 13.8, product.ComponentsPrice(), 0.001)
+				assert.Equal(t, 0.0, product.Savings())
+			},
+		},
+		{
+			name: "should return invalid input error when bundle contains itself",
+			input: dto.UpdateProductInput{
+				ID:         1,
+				Name:       "Combo",
+				Price:      20.0,
+				CategoryID: 5,
+				BundleSlots: []dto.ProductBundleSlotInput{
+					{Name: "Combo", Quantity: 1, Options: []dto.ProductBundleOptionInput{{ProductID: 1}}},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProducts[0], nil)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
 		{
 			name: "should return error when product not found",
 			input: dto.UpdateProductInput{
@@ -366,6 +698,9 @@ func (s *ProductUsecaseSuiteTest) TestProductUseCase_Delete() {
 				s.mockGateway.EXPECT().
 					Delete(s.ctx, uint64(1)).
 					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
 			},
 			checkResult: func(t *testing.T, product *entity.Product, err error) {
 				assert.NoError(t, err)
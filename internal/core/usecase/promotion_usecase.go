@@ -0,0 +1,282 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionUseCase struct {
+	gateway      port.PromotionGateway
+	orderGateway port.OrderGateway
+}
+
+// NewPromotionUseCase creates a new PromotionUseCase
+func NewPromotionUseCase(gateway port.PromotionGateway, orderGateway port.OrderGateway) port.PromotionUseCase {
+	return &promotionUseCase{gateway, orderGateway}
+}
+
+// List returns a list of Promotions
+func (uc *promotionUseCase) List(ctx context.Context, i dto.ListPromotionsInput) ([]*entity.Promotion, int64, error) {
+	promotions, total, err := uc.gateway.FindAll(ctx, i.Name, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return promotions, total, nil
+}
+
+// Create creates a new Promotion
+func (uc *promotionUseCase) Create(ctx context.Context, i dto.CreatePromotionInput) (*entity.Promotion, error) {
+	promotion := i.ToEntity()
+
+	if err := uc.validate(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	if err := uc.gateway.Create(ctx, promotion); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return promotion, nil
+}
+
+// Get returns a Promotion by ID
+func (uc *promotionUseCase) Get(ctx context.Context, i dto.GetPromotionInput) (*entity.Promotion, error) {
+	promotion, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if promotion == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	return promotion, nil
+}
+
+// Update updates a Promotion, the discounts already applied to orders are kept until they are repriced
+func (uc *promotionUseCase) Update(ctx context.Context, i dto.UpdatePromotionInput) (*entity.Promotion, error) {
+	promotion, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if promotion == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	promotion.Update(i.ToEntity())
+
+	if err := uc.validate(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	if err := uc.gateway.Update(ctx, promotion); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return promotion, nil
+}
+
+// Delete deletes a Promotion
+func (uc *promotionUseCase) Delete(ctx context.Context, i dto.DeletePromotionInput) (*entity.Promotion, error) {
+	promotion, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if promotion == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if err := uc.gateway.Delete(ctx, i.ID); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return promotion, nil
+}
+
+// ApplyToOrder adds the coupon to the OPEN order and recalculates its discounts
+func (uc *promotionUseCase) ApplyToOrder(ctx context.Context, i dto.ApplyOrderPromotionsInput) (*entity.Order, error) {
+	order, err := uc.findOpenOrder(ctx, i.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if code := entity.NormalizeCouponCode(i.CouponCode); code != "" && !hasCoupon(order, code) {
+		promotion, err := uc.gateway.FindByCode(ctx, code)
+		if err != nil {
+			return nil, domain.NewInternalError(err)
+		}
+		if promotion == nil {
+			return nil, domain.NewNotFoundError(domain.ErrCouponNotFound)
+		}
+		if !promotion.IsAvailableAt(time.Now()) {
+			return nil, domain.NewInvalidInputError(domain.ErrCouponNotAvailable)
+		}
+
+		reached, err := uc.usageLimitReached(ctx, promotion, order)
+		if err != nil {
+			return nil, err
+		}
+		if reached {
+			return nil, domain.NewInvalidInputError(domain.ErrCouponUsageLimitReached)
+		}
+
+		order.Coupons = append(order.Coupons, entity.OrderCoupon{
+			OrderID:     order.ID,
+			PromotionID: promotion.ID,
+			Code:        code,
+		})
+	}
+
+	if err := uc.reprice(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// RemoveFromOrder removes the coupon from the OPEN order and recalculates its discounts
+func (uc *promotionUseCase) RemoveFromOrder(ctx context.Context, i dto.RemoveOrderCouponInput) (*entity.Order, error) {
+	order, err := uc.findOpenOrder(ctx, i.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	code := entity.NormalizeCouponCode(i.CouponCode)
+	if !hasCoupon(order, code) {
+		return nil, domain.NewNotFoundError(domain.ErrCouponNotApplied)
+	}
+
+	coupons := make([]entity.OrderCoupon, 0, len(order.Coupons))
+	for _, coupon := range order.Coupons {
+		if coupon.Code != code {
+			coupons = append(coupons, coupon)
+		}
+	}
+	order.Coupons = coupons
+
+	if err := uc.reprice(ctx, order); err != nil {
+		return nil, err
+	}
+
+	return order, nil
+}
+
+// RepriceOrder recalculates the discounts of the order after its line items changed,
+// orders that are not OPEN are rejected, they keep the totals they were placed with
+func (uc *promotionUseCase) RepriceOrder(ctx context.Context, orderID uint64) error {
+	order, err := uc.findOpenOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	return uc.reprice(ctx, order)
+}
+
+func (uc *promotionUseCase) findOpenOrder(ctx context.Context, orderID uint64) (*entity.Order, error) {
+	order, err := uc.orderGateway.FindByID(ctx, orderID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if order == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+	if order.Status != valueobject.OPEN {
+		return nil, domain.NewInvalidInputError(domain.ErrOrderIsNotOpen)
+	}
+	return order, nil
+}
+
+// reprice applies the automatic promotions and the coupons of the order, promotions over the
+// usage limit of the customer are ignored
+func (uc *promotionUseCase) reprice(ctx context.Context, order *entity.Order) error {
+	promotions, err := uc.gateway.FindAllAutomatic(ctx)
+	if err != nil {
+		return domain.NewInternalError(err)
+	}
+
+	for _, coupon := range order.Coupons {
+		promotion, err := uc.gateway.FindByID(ctx, coupon.PromotionID)
+		if err != nil {
+			return domain.NewInternalError(err)
+		}
+		if promotion != nil {
+			promotions = append(promotions, promotion)
+		}
+	}
+
+	eligible := make([]*entity.Promotion, 0, len(promotions))
+	for _, promotion := range promotions {
+		reached, err := uc.usageLimitReached(ctx, promotion, order)
+		if err != nil {
+			return err
+		}
+		if !reached {
+			eligible = append(eligible, promotion)
+		}
+	}
+
+	order.ApplyPromotions(eligible, time.Now())
+
+	// The order checked out or repriced since it was read is a conflict, the totals are not replaced
+	if err := uc.orderGateway.UpdateTotals(ctx, order); err != nil {
+		var conflictErr *domain.ConflictError
+		if errors.As(err, &conflictErr) {
+			return err
+		}
+		return domain.NewInternalError(err)
+	}
+	return nil
+}
+
+// usageLimitReached returns true when the customer already used the promotion on other orders,
+// anonymous orders can't use promotions with a limit per customer
+func (uc *promotionUseCase) usageLimitReached(ctx context.Context, promotion *entity.Promotion, order *entity.Order) (bool, error) {
+	if promotion.MaxUsesPerCustomer == 0 {
+		return false, nil
+	}
+	if order.CustomerID == 0 {
+		return true, nil
+	}
+
+	uses, err := uc.gateway.CountCustomerUsage(ctx, promotion.ID, order.CustomerID, order.ID)
+	if err != nil {
+		return false, domain.NewInternalError(err)
+	}
+	return uses >= int64(promotion.MaxUsesPerCustomer), nil
+}
+
+// validate checks the promotion rules and that its coupon code is not used by another promotion
+func (uc *promotionUseCase) validate(ctx context.Context, promotion *entity.Promotion) error {
+	if err := promotion.Validate(); err != nil {
+		return domain.NewInvalidInputError(err.Error())
+	}
+	if !promotion.IsCoupon() {
+		return nil
+	}
+
+	existing, err := uc.gateway.FindByCode(ctx, *promotion.Code)
+	if err != nil {
+		return domain.NewInternalError(err)
+	}
+	if existing != nil && existing.ID != promotion.ID {
+		return domain.NewConflictError(domain.ErrPromotionCodeInUse)
+	}
+	return nil
+}
+
+func hasCoupon(order *entity.Order, code string) bool {
+	for _, coupon := range order.Coupons {
+		if coupon.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PromotionUsecaseSuiteTest struct {
+	suite.Suite
+	mockPromotions     []*entity.Promotion
+	mockCoupon         *entity.Promotion
+	mockGateway        *mockport.MockPromotionGateway
+	mockOrderGateway   *mockport.MockOrderGateway
+	useCase            port.PromotionUseCase
+	ctx                context.Context
+	newOpenOrder       func() *entity.Order
+	automaticPromotion *entity.Promotion
+}
+
+func (s *PromotionUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockPromotionGateway(ctrl)
+	s.mockOrderGateway = mockport.NewMockOrderGateway(ctrl)
+	s.useCase = usecase.NewPromotionUseCase(s.mockGateway, s.mockOrderGateway)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	code := "WELCOME10"
+	foodsID := uint64(1)
+	s.mockCoupon = &entity.Promotion{
+		ID:                 1,
+		Name:               "Welcome coupon",
+		Code:               &code,
+		DiscountType:       valueobject.DiscountPercentage,
+		Value:              10,
+		MaxUsesPerCustomer: 1,
+		Active:             true,
+		CreatedAt:          currentTime,
+		UpdatedAt:          currentTime,
+	}
+	s.automaticPromotion = &entity.Promotion{
+		ID:           2,
+		Name:         "Foods discount",
+		DiscountType: valueobject.DiscountFixed,
+		Value:        2,
+		CategoryID:   &foodsID,
+		Active:       true,
+		CreatedAt:    currentTime,
+		UpdatedAt:    currentTime,
+	}
+	s.mockPromotions = []*entity.Promotion{s.mockCoupon, s.automaticPromotion}
+	s.newOpenOrder = func() *entity.Order {
+		return &entity.Order{
+			ID:         1,
+			CustomerID: 1,
+			Status:     valueobject.OPEN,
+			OrderProducts: []entity.OrderProduct{
+				{ID: 1, OrderID: 1, ProductID: 1, Quantity: 2, Price: 25, Product: entity.Product{ID: 1, Price: 25, CategoryID: 1}},
+				{ID: 2, OrderID: 1, ProductID: 2, Quantity: 1, Price: 10, Product: entity.Product{ID: 2, Price: 10, CategoryID: 2}},
+			},
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+		}
+	}
+}
+
+func TestPromotionUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(PromotionUsecaseSuiteTest))
+}
@@ -0,0 +1,715 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_List() {
+	tests := []struct {
+		name        string
+		input       dto.ListPromotionsInput
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.Promotion, int64, error)
+	}{
+		{
+			name:  "should list promotions successfully",
+			input: dto.ListPromotionsInput{Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, "", 1, 10).
+					Return(s.mockPromotions, int64(2), nil)
+			},
+			checkResult: func(t *testing.T, promotions []*entity.Promotion, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, promotions, 2)
+				assert.Equal(t, int64(2), total)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			input: dto.ListPromotionsInput{Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, "", 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, promotions []*entity.Promotion, total int64, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, promotions)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			promotions, total, err := s.useCase.List(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, promotions, total, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_Create() {
+	tests := []struct {
+		name        string
+		input       dto.CreatePromotionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Promotion, error)
+	}{
+		{
+			name: "should create coupon with normalized code",
+			input: dto.CreatePromotionInput{
+				Name:         "Welcome coupon",
+				Code:         " welcome10 ",
+				DiscountType: valueobject.DiscountPercentage,
+				Value:        10,
+				Active:       true,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(nil, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "WELCOME10", *promotion.Code)
+				assert.True(t, promotion.IsCoupon())
+			},
+		},
+		{
+			name: "should create automatic promotion without checking the code",
+			input: dto.CreatePromotionInput{
+				Name:           "Happy hour",
+				DiscountType:   valueobject.DiscountFixed,
+				Value:          5,
+				HappyHourStart: "17:00",
+				HappyHourEnd:   "19:00",
+				Timezone:       "America/Sao_Paulo",
+				Active:         true,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.NoError(t, err)
+				assert.Nil(t, promotion.Code)
+				assert.False(t, promotion.IsCoupon())
+			},
+		},
+		{
+			name: "should return invalid input error when percentage is greater than 100",
+			input: dto.CreatePromotionInput{
+				Name:         "Invalid",
+				DiscountType: valueobject.DiscountPercentage,
+				Value:        150,
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when happy hour has no end",
+			input: dto.CreatePromotionInput{
+				Name:           "Invalid",
+				DiscountType:   valueobject.DiscountFixed,
+				Value:          5,
+				HappyHourStart: "17:00",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when happy hour has no timezone",
+			input: dto.CreatePromotionInput{
+				Name:           "Invalid",
+				DiscountType:   valueobject.DiscountFixed,
+				Value:          5,
+				HappyHourStart: "17:00",
+				HappyHourEnd:   "19:00",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return conflict error when code is already in use",
+			input: dto.CreatePromotionInput{
+				Name:         "Welcome coupon",
+				Code:         "WELCOME10",
+				DiscountType: valueobject.DiscountPercentage,
+				Value:        10,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(s.mockCoupon, nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+		{
+			name: "should return internal error when gateway fails",
+			input: dto.CreatePromotionInput{
+				Name:         "Happy hour",
+				DiscountType: valueobject.DiscountFixed,
+				Value:        5,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			promotion, err := s.useCase.Create(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, promotion, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_Get() {
+	tests := []struct {
+		name        string
+		input       dto.GetPromotionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Promotion, error)
+	}{
+		{
+			name:  "should get promotion successfully",
+			input: dto.GetPromotionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCoupon, nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockCoupon, promotion)
+			},
+		},
+		{
+			name:  "should return not found error when promotion doesn't exist",
+			input: dto.GetPromotionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			promotion, err := s.useCase.Get(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, promotion, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_Update() {
+	tests := []struct {
+		name        string
+		input       dto.UpdatePromotionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Promotion, error)
+	}{
+		{
+			name: "should update promotion successfully",
+			input: dto.UpdatePromotionInput{
+				ID: 1,
+				CreatePromotionInput: dto.CreatePromotionInput{
+					Name:               "Welcome coupon",
+					Code:               "WELCOME10",
+					DiscountType:       valueobject.DiscountPercentage,
+					Value:              15,
+					MaxUsesPerCustomer: 2,
+					Active:             true,
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Promotion{ID: 1, Name: "Welcome coupon", DiscountType: valueobject.DiscountPercentage, Value: 10}, nil)
+
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(&entity.Promotion{ID: 1}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, float64(15), promotion.Value)
+				assert.Equal(t, uint32(2), promotion.MaxUsesPerCustomer)
+			},
+		},
+		{
+			name:  "should return not found error when promotion doesn't exist",
+			input: dto.UpdatePromotionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			promotion, err := s.useCase.Update(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, promotion, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_Delete() {
+	tests := []struct {
+		name        string
+		input       dto.DeletePromotionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Promotion, error)
+	}{
+		{
+			name:  "should delete promotion successfully",
+			input: dto.DeletePromotionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCoupon, nil)
+
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, s.mockCoupon, promotion)
+			},
+		},
+		{
+			name:  "should return internal error when gateway fails on delete",
+			input: dto.DeletePromotionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCoupon, nil)
+
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, promotion *entity.Promotion, err error) {
+				assert.Nil(t, promotion)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			promotion, err := s.useCase.Delete(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, promotion, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_ApplyToOrder() {
+	tests := []struct {
+		name        string
+		input       dto.ApplyOrderPromotionsInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should apply the best non stackable promotion",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1, CouponCode: "welcome10"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(s.mockCoupon, nil)
+
+				s.mockGateway.EXPECT().
+					CountCustomerUsage(s.ctx, uint64(1), uint64(1), uint64(1)).
+					Return(int64(0), nil).
+					Times(2)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return([]*entity.Promotion{s.automaticPromotion}, nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCoupon, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, order.Coupons, 1)
+				assert.Equal(t, "WELCOME10", order.Coupons[0].Code)
+				assert.Len(t, order.Discounts, 1)
+				assert.Equal(t, uint64(1), order.Discounts[0].PromotionID)
+				assert.InDelta(t, 60.0, order.Subtotal, 0.001)
+				assert.InDelta(t, 6.0, order.DiscountTotal, 0.001)
+				assert.InDelta(t, 54.0, order.Total, 0.001)
+			},
+		},
+		{
+			name:  "should combine stackable promotions",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return([]*entity.Promotion{
+						{ID: 3, Name: "Drinks", DiscountType: valueobject.DiscountPercentage, Value: 50, ProductID: &[]uint64{2}[0], Stackable: true, Active: true},
+						{ID: 4, Name: "Foods", DiscountType: valueobject.DiscountFixed, Value: 4, CategoryID: &[]uint64{1}[0], Stackable: true, Active: true},
+						{ID: 5, Name: "Inactive", DiscountType: valueobject.DiscountFixed, Value: 30, Active: false},
+					}, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, order.Discounts, 2)
+				assert.InDelta(t, 9.0, order.DiscountTotal, 0.001)
+				assert.InDelta(t, 51.0, order.Total, 0.001)
+			},
+		},
+		{
+			name:  "should return invalid input error when order is not open",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1, CouponCode: "WELCOME10"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.PENDING}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when coupon doesn't exist",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1, CouponCode: "UNKNOWN"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "UNKNOWN").
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when coupon is not active",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1, CouponCode: "WELCOME10"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(&entity.Promotion{ID: 1, Code: s.mockCoupon.Code, Active: false}, nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrCouponNotAvailable)
+			},
+		},
+		{
+			name:  "should return invalid input error when customer reached the usage limit",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1, CouponCode: "WELCOME10"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByCode(s.ctx, "WELCOME10").
+					Return(s.mockCoupon, nil)
+
+				s.mockGateway.EXPECT().
+					CountCustomerUsage(s.ctx, uint64(1), uint64(1), uint64(1)).
+					Return(int64(1), nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrCouponUsageLimitReached)
+			},
+		},
+		{
+			name:  "should return internal error when totals update fails",
+			input: dto.ApplyOrderPromotionsInput{OrderID: 1},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return(nil, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.ApplyToOrder(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_RemoveFromOrder() {
+	tests := []struct {
+		name        string
+		input       dto.RemoveOrderCouponInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Order, error)
+	}{
+		{
+			name:  "should remove coupon and its discount",
+			input: dto.RemoveOrderCouponInput{OrderID: 1, CouponCode: "welcome10"},
+			setupMocks: func() {
+				order := s.newOpenOrder()
+				order.Coupons = []entity.OrderCoupon{{OrderID: 1, PromotionID: 1, Code: "WELCOME10"}}
+				order.Discounts = []entity.OrderDiscount{{OrderID: 1, PromotionID: 1, Amount: 6}}
+
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(order, nil)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return(nil, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, order.Coupons)
+				assert.Empty(t, order.Discounts)
+				assert.InDelta(t, 60.0, order.Total, 0.001)
+			},
+		},
+		{
+			name:  "should return not found error when coupon is not applied",
+			input: dto.RemoveOrderCouponInput{OrderID: 1, CouponCode: "WELCOME10"},
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+			},
+			checkResult: func(t *testing.T, order *entity.Order, err error) {
+				assert.Nil(t, order)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			order, err := s.useCase.RemoveFromOrder(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, order, err)
+		})
+	}
+}
+
+func (s *PromotionUsecaseSuiteTest) TestPromotionUseCase_RepriceOrder() {
+	tests := []struct {
+		name        string
+		orderID     uint64
+		setupMocks  func()
+		checkResult func(*testing.T, error)
+	}{
+		{
+			name:    "should return invalid input error when the order is not open",
+			orderID: 1,
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(&entity.Order{ID: 1, Status: valueobject.RECEIVED}, nil)
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrOrderIsNotOpen), err)
+			},
+		},
+		{
+			name:    "should return conflict error when the order changed since it was read",
+			orderID: 1,
+			setupMocks: func() {
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newOpenOrder(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return(nil, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					Return(domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.IsType(t, &domain.ConflictError{}, err)
+			},
+		},
+		{
+			name:    "should skip promotions with a usage limit on anonymous orders",
+			orderID: 1,
+			setupMocks: func() {
+				order := s.newOpenOrder()
+				order.CustomerID = 0
+				order.Coupons = []entity.OrderCoupon{{OrderID: 1, PromotionID: 1, Code: "WELCOME10"}}
+
+				s.mockOrderGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(order, nil)
+
+				s.mockGateway.EXPECT().
+					FindAllAutomatic(s.ctx).
+					Return(nil, nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockCoupon, nil)
+
+				s.mockOrderGateway.EXPECT().
+					UpdateTotals(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ any, o *entity.Order) error {
+						assert.Empty(s.T(), o.Discounts)
+						assert.InDelta(s.T(), 60.0, o.Total, 0.001)
+						return nil
+					})
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			err := s.useCase.RepriceOrder(s.ctx, tt.orderID)
+
+			// Assert
+			tt.checkResult(t, err)
+		})
+	}
+}
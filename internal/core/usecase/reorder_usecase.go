@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type reorderUseCase struct {
+	orderUseCase        port.OrderUseCase
+	orderProductUseCase port.OrderProductUseCase
+}
+
+// NewReorderUseCase creates a new ReorderUseCase, the orders and their items are created by the
+// order use cases so the new order is priced and checked like any other
+func NewReorderUseCase(orderUseCase port.OrderUseCase, orderProductUseCase port.OrderProductUseCase) port.ReorderUseCase {
+	return &reorderUseCase{orderUseCase, orderProductUseCase}
+}
+
+// Reorder creates a new OPEN order for the customer with the items of a previous order at the current prices.
+// Only the customer's own orders are repeated, a guest order has to be attached with its guest token first.
+// The items that can't be ordered anymore are skipped with a warning
+func (uc *reorderUseCase) Reorder(ctx context.Context, i dto.ReorderInput) (*entity.Reorder, error) {
+	source, err := uc.orderUseCase.Get(ctx, dto.GetOrderInput{ID: i.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if source.IsGuest() {
+		return nil, domain.NewForbiddenError(domain.ErrGuestOrderReorder)
+	}
+	if source.CustomerID != i.CustomerID {
+		return nil, domain.NewForbiddenError(domain.ErrCustomerMismatch)
+	}
+
+	order, err := uc.orderUseCase.Create(ctx, dto.CreateOrderInput{
+		CustomerID:      i.CustomerID,
+		Channel:         source.Channel,
+		FulfilmentMode:  source.FulfilmentMode,
+		TableNumber:     source.TableNumber,
+		DeliveryAddress: toDeliveryAddressInput(source.DeliveryAddress),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reorder := &entity.Reorder{SourceOrderID: source.ID}
+	for _, item := range source.OrderProducts {
+		_, err := uc.orderProductUseCase.Create(ctx, toReorderItemInput(order.ID, item))
+		if err == nil {
+			continue
+		}
+
+		var invalidInputErr *domain.InvalidInputError
+		var notFoundErr *domain.NotFoundError
+		if !errors.As(err, &invalidInputErr) && !errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+		reorder.Skip(item, err.Error())
+	}
+
+	// Reload the order to return it with the items and the totals
+	if reorder.Order, err = uc.orderUseCase.Get(ctx, dto.GetOrderInput{ID: order.ID}); err != nil {
+		return nil, err
+	}
+
+	return reorder, nil
+}
+
+// toReorderItemInput repeats the line item with the same customizations, the price is taken from the catalog again
+func toReorderItemInput(orderID uint64, item entity.OrderProduct) dto.CreateOrderProductInput {
+	input := dto.CreateOrderProductInput{
+		OrderID:   orderID,
+		ProductID: item.ProductID,
+		Quantity:  item.Quantity,
+		Notes:     item.Notes,
+	}
+	for _, modifier := range item.Modifiers {
+		input.ModifierIDs = append(input.ModifierIDs, modifier.ProductModifierID)
+	}
+	for _, component := range item.Components {
+		input.BundleSelections = append(input.BundleSelections, dto.BundleSelectionInput{
+			SlotID:    component.ProductBundleSlotID,
+			ProductID: component.ProductID,
+		})
+	}
+	return input
+}
+
+func toDeliveryAddressInput(address *entity.OrderDeliveryAddress) *dto.DeliveryAddressInput {
+	if address == nil {
+		return nil
+	}
+	return &dto.DeliveryAddressInput{
+		Street:       address.Street,
+		Number:       address.Number,
+		Complement:   address.Complement,
+		Neighborhood: address.Neighborhood,
+		City:         address.City,
+		State:        address.State,
+		ZipCode:      address.ZipCode,
+	}
+}
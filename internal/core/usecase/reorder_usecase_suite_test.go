@@ -0,0 +1,64 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ReorderUsecaseSuiteTest struct {
+	suite.Suite
+	mockSourceOrder         *entity.Order
+	mockOrderUseCase        *mockport.MockOrderUseCase
+	mockOrderProductUseCase *mockport.MockOrderProductUseCase
+	useCase                 port.ReorderUseCase
+	ctx                     context.Context
+}
+
+func (s *ReorderUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockOrderUseCase = mockport.NewMockOrderUseCase(ctrl)
+	s.mockOrderProductUseCase = mockport.NewMockOrderProductUseCase(ctrl)
+	s.useCase = usecase.NewReorderUseCase(s.mockOrderUseCase, s.mockOrderProductUseCase)
+	s.ctx = context.Background()
+	s.mockSourceOrder = &entity.Order{
+		ID:             1,
+		CustomerID:     1,
+		Status:         valueobject.COMPLETED,
+		Channel:        valueobject.ChannelApp,
+		FulfilmentMode: valueobject.FulfilmentTakeaway,
+		OrderProducts: []entity.OrderProduct{
+			{
+				ID:        1,
+				OrderID:   1,
+				ProductID: 1,
+				Quantity:  2,
+				Notes:     "No tomato",
+				Price:     19.99,
+				Product:   entity.Product{ID: 1, Name: "X-Burger"},
+				Modifiers: []entity.OrderProductModifier{{ProductModifierID: 3, Name: "Extra cheese"}},
+			},
+			{
+				ID:         2,
+				OrderID:    1,
+				ProductID:  2,
+				Quantity:   1,
+				Price:      29.99,
+				Product:    entity.Product{ID: 2, Name: "Combo"},
+				Components: []entity.OrderProductComponent{{ProductBundleSlotID: 4, ProductID: 5, Name: "Coca-Cola 350ml"}},
+			},
+		},
+	}
+}
+
+func TestReorderUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(ReorderUsecaseSuiteTest))
+}
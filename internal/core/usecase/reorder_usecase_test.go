@@ -0,0 +1,176 @@
+package usecase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *ReorderUsecaseSuiteTest) TestReorderUseCase_Reorder() {
+	newOrder := &entity.Order{ID: 9, CustomerID: 1, Status: valueobject.OPEN}
+	createOrderInput := dto.CreateOrderInput{
+		CustomerID:     1,
+		Channel:        valueobject.ChannelApp,
+		FulfilmentMode: valueobject.FulfilmentTakeaway,
+	}
+	burgerInput := dto.CreateOrderProductInput{
+		OrderID:     9,
+		ProductID:   1,
+		Quantity:    2,
+		Notes:       "No tomato",
+		ModifierIDs: []uint64{3},
+	}
+	comboInput := dto.CreateOrderProductInput{
+		OrderID:          9,
+		ProductID:        2,
+		Quantity:         1,
+		BundleSelections: []dto.BundleSelectionInput{{SlotID: 4, ProductID: 5}},
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Reorder, error)
+	}{
+		{
+			name: "should repeat all the items of the previous order",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockSourceOrder, nil)
+				s.mockOrderUseCase.EXPECT().Create(s.ctx, createOrderInput).Return(newOrder, nil)
+				s.mockOrderProductUseCase.EXPECT().Create(s.ctx, burgerInput).Return(&entity.OrderProduct{}, nil)
+				s.mockOrderProductUseCase.EXPECT().Create(s.ctx, comboInput).Return(&entity.OrderProduct{}, nil)
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 9}).Return(newOrder, nil)
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), reorder.SourceOrderID)
+				assert.Equal(t, newOrder, reorder.Order)
+				assert.Empty(t, reorder.Warnings)
+			},
+		},
+		{
+			name: "should skip the items that can't be ordered anymore with warnings",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockSourceOrder, nil)
+				s.mockOrderUseCase.EXPECT().Create(s.ctx, createOrderInput).Return(newOrder, nil)
+				s.mockOrderProductUseCase.EXPECT().Create(s.ctx, burgerInput).
+					Return(nil, domain.NewInvalidInputError(domain.ErrProductUnavailable))
+				s.mockOrderProductUseCase.EXPECT().Create(s.ctx, comboInput).
+					Return(nil, domain.NewNotFoundError(domain.ErrProductNotFound))
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 9}).Return(newOrder, nil)
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, []entity.ReorderWarning{
+					{ProductID: 1, ProductName: "X-Burger", Reason: domain.ErrProductUnavailable},
+					{ProductID: 2, ProductName: "Combo", Reason: domain.ErrProductNotFound},
+				}, reorder.Warnings)
+			},
+		},
+		{
+			name: "should copy the table number and the delivery address of the previous order",
+			setupMocks: func() {
+				tableNumber := uint32(4)
+				source := &entity.Order{
+					ID:             1,
+					CustomerID:     1,
+					FulfilmentMode: valueobject.FulfilmentDelivery,
+					TableNumber:    &tableNumber,
+					DeliveryAddress: &entity.OrderDeliveryAddress{
+						ID: 7, OrderID: 1, Street: "Av. Paulista", Number: "1000", City: "São Paulo", State: "SP", ZipCode: "01310-100",
+					},
+				}
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(source, nil)
+				s.mockOrderUseCase.EXPECT().Create(s.ctx, dto.CreateOrderInput{
+					CustomerID:     1,
+					FulfilmentMode: valueobject.FulfilmentDelivery,
+					TableNumber:    &tableNumber,
+					DeliveryAddress: &dto.DeliveryAddressInput{
+						Street: "Av. Paulista", Number: "1000", City: "São Paulo", State: "SP", ZipCode: "01310-100",
+					},
+				}).Return(newOrder, nil)
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 9}).Return(newOrder, nil)
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, newOrder, reorder.Order)
+			},
+		},
+		{
+			name: "should return not found error when the previous order doesn't exist",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.Nil(t, reorder)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name: "should return forbidden error when the previous order belongs to another customer",
+			setupMocks: func() {
+				source := &entity.Order{ID: 1, CustomerID: 2, Status: valueobject.COMPLETED}
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(source, nil)
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.Nil(t, reorder)
+				assert.Equal(t, domain.NewForbiddenError(domain.ErrCustomerMismatch), err)
+			},
+		},
+		{
+			name: "should return forbidden error when the previous order is a guest order",
+			setupMocks: func() {
+				source := &entity.Order{ID: 1, GuestName: "Ana", GuestToken: "guest-token", Status: valueobject.COMPLETED}
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(source, nil)
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.Nil(t, reorder)
+				assert.Equal(t, domain.NewForbiddenError(domain.ErrGuestOrderReorder), err)
+			},
+		},
+		{
+			name: "should return error when the new order can't be created",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockSourceOrder, nil)
+				s.mockOrderUseCase.EXPECT().Create(s.ctx, gomock.Any()).Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.Nil(t, reorder)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return internal error when an item fails to be created",
+			setupMocks: func() {
+				s.mockOrderUseCase.EXPECT().Get(s.ctx, dto.GetOrderInput{ID: 1}).Return(s.mockSourceOrder, nil)
+				s.mockOrderUseCase.EXPECT().Create(s.ctx, createOrderInput).Return(newOrder, nil)
+				s.mockOrderProductUseCase.EXPECT().Create(s.ctx, burgerInput).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, reorder *entity.Reorder, err error) {
+				assert.Nil(t, reorder)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			reorder, err := s.useCase.Reorder(s.ctx, dto.ReorderInput{ID: 1, CustomerID: 1})
+
+			// Assert
+			tt.checkResult(t, reorder, err)
+		})
+	}
+}
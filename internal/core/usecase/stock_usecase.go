@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type stockUseCase struct {
+	productGateway port.ProductGateway
+	publisher      port.EventPublisher
+	menuCache      port.MenuCache
+}
+
+// NewStockUseCase creates a new StockUseCase, every stock change is published as a StockChanged event
+func NewStockUseCase(productGateway port.ProductGateway, publisher port.EventPublisher, menuCache port.MenuCache) port.StockUseCase {
+	return &stockUseCase{productGateway, publisher, menuCache}
+}
+
+// Update changes the stock settings of a product, used by the staff to switch it off when it runs out
+func (uc *stockUseCase) Update(ctx context.Context, i dto.UpdateProductStockInput) (*entity.Product, error) {
+	product, err := uc.productGateway.FindByID(ctx, i.ProductID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if product == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	previousQuantity := product.StockQuantity
+	if err := product.UpdateStock(i.StockMode, i.StockQuantity, i.Available); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.productGateway.Update(ctx, product); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	uc.menuCache.Invalidate(ctx)
+
+	uc.publish(ctx, entity.NewStockChanged(product, product.StockQuantity-previousQuantity, 0, entity.StockReasonManual))
+
+	return product, nil
+}
+
+// Reserve takes the products of the order from the stock, failing when one of them is out of stock
+func (uc *stockUseCase) Reserve(ctx context.Context, order *entity.Order) error {
+	return uc.change(ctx, order, -1, entity.StockReasonOrderReceived)
+}
+
+// Release gives the products of the order back to the stock
+func (uc *stockUseCase) Release(ctx context.Context, order *entity.Order) error {
+	return uc.change(ctx, order, 1, entity.StockReasonOrderCancelled)
+}
+
+func (uc *stockUseCase) change(ctx context.Context, order *entity.Order, sign int64, reason string) error {
+	quantities := order.StockQuantities()
+	if len(quantities) == 0 {
+		return nil
+	}
+
+	changes := make(map[uint64]int64, len(quantities))
+	for productID, quantity := range quantities {
+		changes[productID] = sign * quantity
+	}
+
+	products, err := uc.productGateway.UpdateStock(ctx, changes)
+	if err != nil {
+		var invalidInputErr *domain.InvalidInputError
+		if errors.As(err, &invalidInputErr) {
+			return invalidInputErr
+		}
+		return domain.NewInternalError(err)
+	}
+	if len(products) > 0 {
+		uc.menuCache.Invalidate(ctx)
+	}
+
+	for _, product := range products {
+		uc.publish(ctx, entity.NewStockChanged(product, changes[product.ID], order.ID, reason))
+	}
+	return nil
+}
+
+// publish sends the event, failures are logged by the publisher and don't undo the stock change
+func (uc *stockUseCase) publish(ctx context.Context, event entity.StockChanged) {
+	_ = uc.publisher.Publish(ctx, entity.StockChangedEvent, event)
+}
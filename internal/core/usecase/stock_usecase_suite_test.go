@@ -0,0 +1,70 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type StockUsecaseSuiteTest struct {
+	suite.Suite
+	mockProduct        *entity.Product
+	mockOrder          *entity.Order
+	mockProductGateway *mockport.MockProductGateway
+	mockPublisher      *mockport.MockEventPublisher
+	mockMenuCache      *mockport.MockMenuCache
+	useCase            port.StockUseCase
+	ctx                context.Context
+}
+
+func (s *StockUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockProductGateway = mockport.NewMockProductGateway(ctrl)
+	s.mockPublisher = mockport.NewMockEventPublisher(ctrl)
+	s.mockMenuCache = mockport.NewMockMenuCache(ctrl)
+	s.useCase = usecase.NewStockUseCase(s.mockProductGateway, s.mockPublisher, s.mockMenuCache)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.mockProduct = &entity.Product{
+		ID:            1,
+		Name:          "X-Burger",
+		Price:         25.9,
+		StockMode:     valueobject.StockCounted,
+		StockQuantity: 10,
+		Available:     true,
+		CreatedAt:     currentTime,
+		UpdatedAt:     currentTime,
+	}
+	s.mockOrder = &entity.Order{
+		ID:         1,
+		CustomerID: 1,
+		Status:     valueobject.PENDING,
+		OrderProducts: []entity.OrderProduct{
+			{OrderID: 1, ProductID: 1, Quantity: 2},
+			{
+				OrderID:   1,
+				ProductID: 5,
+				Quantity:  1,
+				Components: []entity.OrderProductComponent{
+					{ProductBundleSlotID: 1, ProductID: 1, Quantity: 1},
+					{ProductBundleSlotID: 2, ProductID: 2, Quantity: 1},
+				},
+			},
+		},
+		CreatedAt: currentTime,
+		UpdatedAt: currentTime,
+	}
+}
+
+func TestStockUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(StockUsecaseSuiteTest))
+}
@@ -0,0 +1,305 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *StockUsecaseSuiteTest) TestStockUseCase_Update() {
+	quantity := int64(20)
+	negativeQuantity := int64(-1)
+	unavailable := false
+
+	tests := []struct {
+		name        string
+		input       dto.UpdateProductStockInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.Product, error)
+	}{
+		{
+			name: "should update product stock and publish the change",
+			input: dto.UpdateProductStockInput{
+				ProductID:     1,
+				StockQuantity: &quantity,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, entity.StockChangedEvent, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, payload any) error {
+						event := payload.(entity.StockChanged)
+						assert.Equal(s.T(), int64(10), event.Change)
+						assert.Equal(s.T(), int64(20), event.StockQuantity)
+						assert.Equal(s.T(), entity.StockReasonManual, event.Reason)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(20), product.StockQuantity)
+				assert.Equal(t, valueobject.StockCounted, product.StockMode)
+			},
+		},
+		{
+			name: "should switch product off",
+			input: dto.UpdateProductStockInput{
+				ProductID: 1,
+				Available: &unavailable,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, entity.StockChangedEvent, gomock.Any()).
+					Return(nil)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.False(t, product.Available)
+			},
+		},
+		{
+			name: "should not fail when the event publish fails",
+			input: dto.UpdateProductStockInput{
+				ProductID: 1,
+				StockMode: valueobject.StockUnlimited,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, entity.StockChangedEvent, gomock.Any()).
+					Return(assert.AnError)
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.StockUnlimited, product.StockMode)
+			},
+		},
+		{
+			name: "should return not found error when product does not exist",
+			input: dto.UpdateProductStockInput{
+				ProductID: 1,
+				Available: &unavailable,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when quantity is negative",
+			input: dto.UpdateProductStockInput{
+				ProductID:     1,
+				StockQuantity: &negativeQuantity,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return internal error when gateway update fails",
+			input: dto.UpdateProductStockInput{
+				ProductID: 1,
+				Available: &unavailable,
+			},
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.mockProduct, nil)
+
+				s.mockProductGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, product *entity.Product, err error) {
+				assert.Nil(t, product)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			product, err := s.useCase.Update(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, product, err)
+		})
+	}
+}
+
+func (s *StockUsecaseSuiteTest) TestStockUseCase_Reserve() {
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, error)
+	}{
+		{
+			name: "should take the order products and bundle components from the stock",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					UpdateStock(s.ctx, map[uint64]int64{1: -3, 2: -1, 5: -1}).
+					Return([]*entity.Product{s.mockProduct}, nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, entity.StockChangedEvent, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, payload any) error {
+						event := payload.(entity.StockChanged)
+						assert.Equal(s.T(), uint64(1), event.ProductID)
+						assert.Equal(s.T(), int64(-3), event.Change)
+						assert.Equal(s.T(), uint64(1), event.OrderID)
+						assert.Equal(s.T(), entity.StockReasonOrderReceived, event.Reason)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "should return invalid input error when products are out of stock",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					UpdateStock(s.ctx, gomock.Any()).
+					Return(nil, domain.NewInvalidInputError(domain.ErrProductOutOfStock))
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.Equal(t, domain.NewInvalidInputError(domain.ErrProductOutOfStock), err)
+			},
+		},
+		{
+			name: "should return internal error when gateway fails",
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					UpdateStock(s.ctx, gomock.Any()).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			err := s.useCase.Reserve(s.ctx, s.mockOrder)
+
+			// Assert
+			tt.checkResult(t, err)
+		})
+	}
+}
+
+func (s *StockUsecaseSuiteTest) TestStockUseCase_Release() {
+	tests := []struct {
+		name        string
+		order       *entity.Order
+		setupMocks  func()
+		checkResult func(*testing.T, error)
+	}{
+		{
+			name:  "should give the order products back to the stock",
+			order: s.mockOrder,
+			setupMocks: func() {
+				s.mockProductGateway.EXPECT().
+					UpdateStock(s.ctx, map[uint64]int64{1: 3, 2: 1, 5: 1}).
+					Return([]*entity.Product{s.mockProduct}, nil)
+
+				s.mockPublisher.EXPECT().
+					Publish(s.ctx, entity.StockChangedEvent, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, payload any) error {
+						event := payload.(entity.StockChanged)
+						assert.Equal(s.T(), int64(3), event.Change)
+						assert.Equal(s.T(), entity.StockReasonOrderCancelled, event.Reason)
+						return nil
+					})
+
+				s.mockMenuCache.EXPECT().
+					Invalidate(s.ctx)
+			},
+			checkResult: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:       "should do nothing when the order has no products",
+			order:      &entity.Order{ID: 2},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			err := s.useCase.Release(s.ctx, tt.order)
+
+			// Assert
+			tt.checkResult(t, err)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookDeliveryUseCase struct {
+	gateway             port.WebhookDeliveryGateway
+	subscriptionGateway port.WebhookSubscriptionGateway
+	sender              port.WebhookSender
+}
+
+// NewWebhookDeliveryUseCase creates a new WebhookDeliveryUseCase.
+// The order events are enqueued as deliveries when they happen and sent later by the dispatcher,
+// so a slow or unavailable subscriber never delays the orders
+func NewWebhookDeliveryUseCase(
+	gateway port.WebhookDeliveryGateway,
+	subscriptionGateway port.WebhookSubscriptionGateway,
+	sender port.WebhookSender,
+) port.WebhookDeliveryUseCase {
+	return &webhookDeliveryUseCase{gateway, subscriptionGateway, sender}
+}
+
+// List returns the deliveries of a subscription, the latest first
+func (uc *webhookDeliveryUseCase) List(ctx context.Context, i dto.ListWebhookDeliveriesInput) ([]*entity.WebhookDelivery, int64, error) {
+	if _, err := uc.findSubscription(ctx, i.SubscriptionID); err != nil {
+		return nil, 0, err
+	}
+
+	deliveries, total, err := uc.gateway.FindAll(ctx, i.SubscriptionID, i.Status, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return deliveries, total, nil
+}
+
+// Redeliver sends the event of a delivery again on the next dispatch, the subscription must be active
+func (uc *webhookDeliveryUseCase) Redeliver(ctx context.Context, i dto.RedeliverWebhookInput) (*entity.WebhookDelivery, error) {
+	subscription, err := uc.findSubscription(ctx, i.SubscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := uc.gateway.FindByID(ctx, i.DeliveryID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if delivery == nil || delivery.SubscriptionID != subscription.ID {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if !subscription.Active {
+		return nil, domain.NewInvalidInputError(domain.ErrWebhookSubscriptionDisabled)
+	}
+
+	redelivery := delivery.Redeliver()
+	if err := uc.gateway.Create(ctx, redelivery); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return redelivery, nil
+}
+
+// Enqueue creates a delivery of the event for each active subscription that subscribes to it
+func (uc *webhookDeliveryUseCase) Enqueue(ctx context.Context, event entity.OrderEvent) ([]*entity.WebhookDelivery, error) {
+	subscriptions, err := uc.subscriptionGateway.FindAllActive(ctx)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	var payload []byte
+	var deliveries []*entity.WebhookDelivery
+	var errs []error
+	for _, subscription := range subscriptions {
+		if !subscription.Subscribes(event) {
+			continue
+		}
+
+		if payload == nil {
+			if payload, err = json.Marshal(event); err != nil {
+				return nil, domain.NewInternalError(fmt.Errorf("error serializing order event: %w", err))
+			}
+		}
+
+		delivery := entity.NewWebhookDelivery(subscription.ID, event.Type, string(payload))
+		if err := uc.gateway.Create(ctx, delivery); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	if len(errs) > 0 {
+		return deliveries, domain.NewInternalError(errors.Join(errs...))
+	}
+
+	return deliveries, nil
+}
+
+// Dispatch sends the due deliveries. The failed attempts are retried with exponential backoff until the delivery
+// runs out of attempts, and the subscriptions with too many failed deliveries in a row are disabled
+func (uc *webhookDeliveryUseCase) Dispatch(ctx context.Context, i dto.DispatchWebhooksInput) ([]*entity.WebhookDelivery, error) {
+	deliveries, err := uc.gateway.ClaimDue(ctx, time.Now(), i.Lease, i.Limit)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	// The deliveries of the same subscription share it, so its failures are counted together
+	subscriptions := make(map[uint64]*entity.WebhookSubscription)
+	var errs []error
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription = &delivery.Subscription
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		if err := uc.send(ctx, i, subscription, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("error dispatching webhook delivery %d: %w", delivery.ID, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return deliveries, domain.NewInternalError(errors.Join(errs...))
+	}
+
+	return deliveries, nil
+}
+
+// send delivers the event and records the outcome on the delivery and on its subscription
+func (uc *webhookDeliveryUseCase) send(ctx context.Context, i dto.DispatchWebhooksInput, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) error {
+	if !subscription.Active {
+		delivery.Cancel(domain.ErrWebhookSubscriptionDisabled)
+		return uc.gateway.Update(ctx, delivery)
+	}
+
+	subscriptionChanged := false
+	responseStatus, err := uc.sender.Send(ctx, subscription, delivery)
+	if err == nil {
+		delivery.MarkDelivered(responseStatus)
+		subscriptionChanged = subscription.RecordDelivery()
+	} else if delivery.MarkAttemptFailed(responseStatus, err.Error(), i.MaxAttempts, i.Backoff) {
+		subscription.RecordFailure(i.MaxFailures)
+		subscriptionChanged = true
+	}
+
+	if err := uc.gateway.Update(ctx, delivery); err != nil {
+		return err
+	}
+	if subscriptionChanged {
+		return uc.subscriptionGateway.Update(ctx, subscription)
+	}
+	return nil
+}
+
+func (uc *webhookDeliveryUseCase) findSubscription(ctx context.Context, id uint64) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.subscriptionGateway.FindByID(ctx, id)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if subscription == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+	return subscription, nil
+}
@@ -0,0 +1,75 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type WebhookDeliveryUsecaseSuiteTest struct {
+	suite.Suite
+	newSubscription         func() *entity.WebhookSubscription
+	newDelivery             func() *entity.WebhookDelivery
+	dispatchInput           dto.DispatchWebhooksInput
+	mockGateway             *mockport.MockWebhookDeliveryGateway
+	mockSubscriptionGateway *mockport.MockWebhookSubscriptionGateway
+	mockSender              *mockport.MockWebhookSender
+	useCase                 port.WebhookDeliveryUseCase
+	ctx                     context.Context
+}
+
+func (s *WebhookDeliveryUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockWebhookDeliveryGateway(ctrl)
+	s.mockSubscriptionGateway = mockport.NewMockWebhookSubscriptionGateway(ctrl)
+	s.mockSender = mockport.NewMockWebhookSender(ctrl)
+	s.useCase = usecase.NewWebhookDeliveryUseCase(s.mockGateway, s.mockSubscriptionGateway, s.mockSender)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.newSubscription = func() *entity.WebhookSubscription {
+		return &entity.WebhookSubscription{
+			ID:         1,
+			URL:        "https://partner.example.com/webhooks",
+			EventTypes: entity.StringList{valueobject.OrderStatusChangedEvent.String()},
+			Statuses:   entity.StringList{valueobject.READY.String()},
+			Secret:     "partner-secret-0001",
+			Active:     true,
+			CreatedAt:  currentTime,
+			UpdatedAt:  currentTime,
+		}
+	}
+	s.newDelivery = func() *entity.WebhookDelivery {
+		return &entity.WebhookDelivery{
+			ID:             1,
+			SubscriptionID: 1,
+			EventType:      valueobject.OrderStatusChangedEvent,
+			Payload:        `{"type":"order.status_changed","order_id":1,"status":"READY"}`,
+			Status:         valueobject.DeliveryPending,
+			NextAttemptAt:  currentTime,
+			CreatedAt:      currentTime,
+			UpdatedAt:      currentTime,
+			Subscription:   *s.newSubscription(),
+		}
+	}
+	s.dispatchInput = dto.DispatchWebhooksInput{
+		Limit:       10,
+		Lease:       time.Minute,
+		MaxAttempts: 3,
+		Backoff:     30 * time.Second,
+		MaxFailures: 2,
+	}
+}
+
+func TestWebhookDeliveryUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(WebhookDeliveryUsecaseSuiteTest))
+}
@@ -0,0 +1,475 @@
+package usecase_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *WebhookDeliveryUsecaseSuiteTest) TestWebhookDeliveryUseCase_List() {
+	tests := []struct {
+		name        string
+		input       dto.ListWebhookDeliveriesInput
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.WebhookDelivery, int64, error)
+	}{
+		{
+			name:  "should list deliveries of the subscription",
+			input: dto.ListWebhookDeliveriesInput{SubscriptionID: 1, Status: valueobject.DeliveryFailed, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, uint64(1), valueobject.DeliveryFailed, 1, 10).
+					Return([]*entity.WebhookDelivery{s.newDelivery()}, int64(1), nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 1)
+				assert.Equal(t, int64(1), total)
+			},
+		},
+		{
+			name:  "should return not found error when subscription does not exist",
+			input: dto.ListWebhookDeliveriesInput{SubscriptionID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, total int64, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, deliveries)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			input: dto.ListWebhookDeliveriesInput{SubscriptionID: 1, Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, uint64(1), valueobject.WebhookDeliveryStatus(""), 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, total int64, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, deliveries)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			deliveries, total, err := s.useCase.List(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, deliveries, total, err)
+		})
+	}
+}
+
+func (s *WebhookDeliveryUsecaseSuiteTest) TestWebhookDeliveryUseCase_Redeliver() {
+	tests := []struct {
+		name        string
+		input       dto.RedeliverWebhookInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.WebhookDelivery, error)
+	}{
+		{
+			name:  "should create a new pending delivery with the same payload",
+			input: dto.RedeliverWebhookInput{SubscriptionID: 1, DeliveryID: 1},
+			setupMocks: func() {
+				delivery := s.newDelivery()
+				delivery.MarkAttemptFailed(http.StatusBadGateway, "bad gateway", 1, time.Second)
+
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(delivery, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, delivery *entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.DeliveryPending, delivery.Status)
+				assert.Zero(t, delivery.Attempts)
+				assert.Equal(t, s.newDelivery().Payload, delivery.Payload)
+			},
+		},
+		{
+			name:  "should return not found error when subscription does not exist",
+			input: dto.RedeliverWebhookInput{SubscriptionID: 1, DeliveryID: 1},
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, delivery *entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, delivery)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when delivery belongs to another subscription",
+			input: dto.RedeliverWebhookInput{SubscriptionID: 1, DeliveryID: 1},
+			setupMocks: func() {
+				delivery := s.newDelivery()
+				delivery.SubscriptionID = 2
+
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(delivery, nil)
+			},
+			checkResult: func(t *testing.T, delivery *entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, delivery)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return invalid input error when subscription is disabled",
+			input: dto.RedeliverWebhookInput{SubscriptionID: 1, DeliveryID: 1},
+			setupMocks: func() {
+				subscription := s.newSubscription()
+				subscription.Active = false
+
+				s.mockSubscriptionGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(subscription, nil)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newDelivery(), nil)
+			},
+			checkResult: func(t *testing.T, delivery *entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, delivery)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+				assert.EqualError(t, err, domain.ErrWebhookSubscriptionDisabled)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			delivery, err := s.useCase.Redeliver(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, delivery, err)
+		})
+	}
+}
+
+func (s *WebhookDeliveryUsecaseSuiteTest) TestWebhookDeliveryUseCase_Enqueue() {
+	readyEvent := entity.OrderEvent{
+		Type:           valueobject.OrderStatusChangedEvent,
+		OrderID:        1,
+		Status:         valueobject.READY,
+		PreviousStatus: valueobject.PREPARING,
+		Channel:        valueobject.ChannelApp,
+	}
+
+	tests := []struct {
+		name        string
+		input       entity.OrderEvent
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.WebhookDelivery, error)
+	}{
+		{
+			name:  "should enqueue the event to the matching subscriptions only",
+			input: readyEvent,
+			setupMocks: func() {
+				otherStatus := s.newSubscription()
+				otherStatus.ID = 2
+				otherStatus.Statuses = entity.StringList{valueobject.COMPLETED.String()}
+				otherType := s.newSubscription()
+				otherType.ID = 3
+				otherType.EventTypes = entity.StringList{valueobject.OrderCreatedEvent.String()}
+
+				s.mockSubscriptionGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return([]*entity.WebhookSubscription{s.newSubscription(), otherStatus, otherType}, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 1)
+				assert.Equal(t, uint64(1), deliveries[0].SubscriptionID)
+				assert.Equal(t, valueobject.DeliveryPending, deliveries[0].Status)
+				assert.Contains(t, deliveries[0].Payload, `"status":"READY"`)
+			},
+		},
+		{
+			name:  "should not enqueue when no subscription matches",
+			input: entity.OrderEvent{Type: valueobject.OrderCreatedEvent, OrderID: 1, Status: valueobject.OPEN},
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return([]*entity.WebhookSubscription{s.newSubscription()}, nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Empty(t, deliveries)
+			},
+		},
+		{
+			name:  "should return error when gateway create fails",
+			input: readyEvent,
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return([]*entity.WebhookSubscription{s.newSubscription()}, nil)
+
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Empty(t, deliveries)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name:  "should return error when subscription gateway fails",
+			input: readyEvent,
+			setupMocks: func() {
+				s.mockSubscriptionGateway.EXPECT().
+					FindAllActive(s.ctx).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, deliveries)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			deliveries, err := s.useCase.Enqueue(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, deliveries, err)
+		})
+	}
+}
+
+func (s *WebhookDeliveryUsecaseSuiteTest) TestWebhookDeliveryUseCase_Dispatch() {
+	tests := []struct {
+		name        string
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.WebhookDelivery, error)
+	}{
+		{
+			name: "should deliver and clear the subscription failures",
+			setupMocks: func() {
+				delivery := s.newDelivery()
+				delivery.Subscription.ConsecutiveFailures = 1
+
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return([]*entity.WebhookDelivery{delivery}, nil)
+
+				s.mockSender.EXPECT().
+					Send(s.ctx, &delivery.Subscription, delivery).
+					Return(http.StatusNoContent, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, delivery).
+					Return(nil)
+
+				s.mockSubscriptionGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, subscription *entity.WebhookSubscription) error {
+						assert.Zero(s.T(), subscription.ConsecutiveFailures)
+						return nil
+					})
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, deliveries, 1)
+				assert.Equal(t, valueobject.DeliveryDelivered, deliveries[0].Status)
+				assert.Equal(t, http.StatusNoContent, deliveries[0].ResponseStatus)
+				assert.Equal(t, uint32(1), deliveries[0].Attempts)
+				assert.NotNil(t, deliveries[0].DeliveredAt)
+			},
+		},
+		{
+			name: "should schedule the retry with exponential backoff",
+			setupMocks: func() {
+				delivery := s.newDelivery()
+				delivery.Attempts = 1
+
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return([]*entity.WebhookDelivery{delivery}, nil)
+
+				s.mockSender.EXPECT().
+					Send(s.ctx, gomock.Any(), delivery).
+					Return(http.StatusServiceUnavailable, assert.AnError)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, delivery).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.DeliveryPending, deliveries[0].Status)
+				assert.Equal(t, uint32(2), deliveries[0].Attempts)
+				assert.Equal(t, http.StatusServiceUnavailable, deliveries[0].ResponseStatus)
+				assert.Equal(t, assert.AnError.Error(), deliveries[0].LastError)
+				assert.WithinDuration(t, time.Now().Add(time.Minute), deliveries[0].NextAttemptAt, time.Second)
+			},
+		},
+		{
+			name: "should disable the subscription when its deliveries run out of attempts in a row",
+			setupMocks: func() {
+				first := s.newDelivery()
+				first.Attempts = 2
+				second := s.newDelivery()
+				second.ID = 2
+				second.Attempts = 2
+
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return([]*entity.WebhookDelivery{first, second}, nil)
+
+				s.mockSender.EXPECT().
+					Send(s.ctx, gomock.Any(), gomock.Any()).
+					Return(0, assert.AnError).
+					Times(2)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil).
+					Times(2)
+
+				s.mockSubscriptionGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil).
+					Times(2)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.DeliveryFailed, deliveries[0].Status)
+				assert.Equal(t, valueobject.DeliveryFailed, deliveries[1].Status)
+				assert.Equal(t, uint32(2), deliveries[0].Subscription.ConsecutiveFailures)
+				assert.False(t, deliveries[0].Subscription.Active)
+				assert.NotNil(t, deliveries[0].Subscription.DisabledAt)
+			},
+		},
+		{
+			name: "should cancel deliveries of disabled subscriptions without sending them",
+			setupMocks: func() {
+				delivery := s.newDelivery()
+				delivery.Subscription.Active = false
+
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return([]*entity.WebhookDelivery{delivery}, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, delivery).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, valueobject.DeliveryFailed, deliveries[0].Status)
+				assert.Zero(t, deliveries[0].Attempts)
+				assert.Equal(t, domain.ErrWebhookSubscriptionDisabled, deliveries[0].LastError)
+			},
+		},
+		{
+			name: "should return error when delivery update fails",
+			setupMocks: func() {
+				delivery := s.newDelivery()
+
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return([]*entity.WebhookDelivery{delivery}, nil)
+
+				s.mockSender.EXPECT().
+					Send(s.ctx, gomock.Any(), delivery).
+					Return(http.StatusOK, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, delivery).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Len(t, deliveries, 1)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+		{
+			name: "should return error when claim fails",
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					ClaimDue(s.ctx, gomock.Any(), time.Minute, 10).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, deliveries []*entity.WebhookDelivery, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, deliveries)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			deliveries, err := s.useCase.Dispatch(s.ctx, s.dispatchInput)
+
+			// Assert
+			tt.checkResult(t, deliveries, err)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookSubscriptionUseCase struct {
+	gateway port.WebhookSubscriptionGateway
+}
+
+// NewWebhookSubscriptionUseCase creates a new WebhookSubscriptionUseCase
+func NewWebhookSubscriptionUseCase(gateway port.WebhookSubscriptionGateway) port.WebhookSubscriptionUseCase {
+	return &webhookSubscriptionUseCase{gateway}
+}
+
+// List returns a list of WebhookSubscriptions
+func (uc *webhookSubscriptionUseCase) List(ctx context.Context, i dto.ListWebhookSubscriptionsInput) ([]*entity.WebhookSubscription, int64, error) {
+	subscriptions, total, err := uc.gateway.FindAll(ctx, i.Page, i.Limit)
+	if err != nil {
+		return nil, 0, domain.NewInternalError(err)
+	}
+
+	return subscriptions, total, nil
+}
+
+// Create creates a new WebhookSubscription
+func (uc *webhookSubscriptionUseCase) Create(ctx context.Context, i dto.CreateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	subscription := i.ToEntity()
+
+	if err := subscription.Validate(); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.gateway.Create(ctx, subscription); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+// Get returns a WebhookSubscription by ID
+func (uc *webhookSubscriptionUseCase) Get(ctx context.Context, i dto.GetWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	if subscription == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	return subscription, nil
+}
+
+// Update updates a WebhookSubscription, a disabled subscription is enabled again by updating it as active
+func (uc *webhookSubscriptionUseCase) Update(ctx context.Context, i dto.UpdateWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if subscription == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	subscription.Update(i.ToEntity())
+
+	if err := subscription.Validate(); err != nil {
+		return nil, domain.NewInvalidInputError(err.Error())
+	}
+
+	if err := uc.gateway.Update(ctx, subscription); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return subscription, nil
+}
+
+// Delete deletes a WebhookSubscription with its deliveries
+func (uc *webhookSubscriptionUseCase) Delete(ctx context.Context, i dto.DeleteWebhookSubscriptionInput) (*entity.WebhookSubscription, error) {
+	subscription, err := uc.gateway.FindByID(ctx, i.ID)
+	if err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+	if subscription == nil {
+		return nil, domain.NewNotFoundError(domain.ErrNotFound)
+	}
+
+	if err := uc.gateway.Delete(ctx, i.ID); err != nil {
+		return nil, domain.NewInternalError(err)
+	}
+
+	return subscription, nil
+}
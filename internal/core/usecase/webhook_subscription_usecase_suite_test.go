@@ -0,0 +1,48 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/usecase"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type WebhookSubscriptionUsecaseSuiteTest struct {
+	suite.Suite
+	newSubscription func() *entity.WebhookSubscription
+	mockGateway     *mockport.MockWebhookSubscriptionGateway
+	useCase         port.WebhookSubscriptionUseCase
+	ctx             context.Context
+}
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) SetupTest() {
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockGateway = mockport.NewMockWebhookSubscriptionGateway(ctrl)
+	s.useCase = usecase.NewWebhookSubscriptionUseCase(s.mockGateway)
+	s.ctx = context.Background()
+	currentTime := time.Now()
+	s.newSubscription = func() *entity.WebhookSubscription {
+		return &entity.WebhookSubscription{
+			ID:         1,
+			URL:        "https://partner.example.com/webhooks",
+			EventTypes: entity.StringList{valueobject.OrderStatusChangedEvent.String()},
+			Statuses:   entity.StringList{valueobject.READY.String()},
+			Secret:     "partner-secret-0001",
+			Active:     true,
+			CreatedAt:  currentTime,
+			UpdatedAt:  currentTime,
+		}
+	}
+}
+
+func TestWebhookSubscriptionUsecaseSuiteTest(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriptionUsecaseSuiteTest))
+}
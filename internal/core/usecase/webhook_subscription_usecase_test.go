@@ -0,0 +1,484 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+)
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) TestWebhookSubscriptionUseCase_List() {
+	tests := []struct {
+		name        string
+		input       dto.ListWebhookSubscriptionsInput
+		setupMocks  func()
+		checkResult func(*testing.T, []*entity.WebhookSubscription, int64, error)
+	}{
+		{
+			name:  "should list subscriptions successfully",
+			input: dto.ListWebhookSubscriptionsInput{Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, 1, 10).
+					Return([]*entity.WebhookSubscription{s.newSubscription()}, int64(1), nil)
+			},
+			checkResult: func(t *testing.T, subscriptions []*entity.WebhookSubscription, total int64, err error) {
+				assert.NoError(t, err)
+				assert.Len(t, subscriptions, 1)
+				assert.Equal(t, int64(1), total)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			input: dto.ListWebhookSubscriptionsInput{Page: 1, Limit: 10},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindAll(s.ctx, 1, 10).
+					Return(nil, int64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, subscriptions []*entity.WebhookSubscription, total int64, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscriptions)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			subscriptions, total, err := s.useCase.List(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, subscriptions, total, err)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) TestWebhookSubscriptionUseCase_Create() {
+	tests := []struct {
+		name        string
+		input       dto.CreateWebhookSubscriptionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.WebhookSubscription, error)
+	}{
+		{
+			name: "should create subscription with unique filters",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://partner.example.com/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String(), valueobject.OrderCreatedEvent.String()},
+				Channels:   []string{" APP ", ""},
+				Secret:     "partner-secret-0001",
+				Active:     true,
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, entity.StringList{valueobject.OrderCreatedEvent.String()}, subscription.EventTypes)
+				assert.Equal(t, entity.StringList{"APP"}, subscription.Channels)
+				assert.Empty(t, subscription.Statuses)
+				assert.True(t, subscription.Active)
+			},
+		},
+		{
+			name: "should return invalid input error when url is not http",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "ftp://partner.example.com/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when url is not https",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "http://partner.example.com/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when url is the cloud metadata address",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://169.254.169.254/latest/meta-data",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when url is a private address",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://10.0.0.12/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when url is the local host",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://localhost:8080/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return invalid input error when event type is unknown",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://partner.example.com/webhooks",
+				EventTypes: []string{"order.deleted"},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway fails",
+			input: dto.CreateWebhookSubscriptionInput{
+				URL:        "https://partner.example.com/webhooks",
+				EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				Secret:     "partner-secret-0001",
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					Create(s.ctx, gomock.Any()).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			subscription, err := s.useCase.Create(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, subscription, err)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) TestWebhookSubscriptionUseCase_Get() {
+	tests := []struct {
+		name        string
+		input       dto.GetWebhookSubscriptionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.WebhookSubscription, error)
+	}{
+		{
+			name:  "should get subscription successfully",
+			input: dto.GetWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), subscription.ID)
+			},
+		},
+		{
+			name:  "should return not found error when subscription does not exist",
+			input: dto.GetWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return error when gateway fails",
+			input: dto.GetWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, assert.AnError)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			subscription, err := s.useCase.Get(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, subscription, err)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) TestWebhookSubscriptionUseCase_Update() {
+	tests := []struct {
+		name        string
+		input       dto.UpdateWebhookSubscriptionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.WebhookSubscription, error)
+	}{
+		{
+			name: "should update subscription keeping the secret",
+			input: dto.UpdateWebhookSubscriptionInput{
+				ID: 1,
+				CreateWebhookSubscriptionInput: dto.CreateWebhookSubscriptionInput{
+					URL:        "https://partner.example.com/orders",
+					EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+					Active:     true,
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "https://partner.example.com/orders", subscription.URL)
+				assert.Equal(t, "partner-secret-0001", subscription.Secret)
+				assert.Empty(t, subscription.Statuses)
+			},
+		},
+		{
+			name: "should enable disabled subscription clearing its failures",
+			input: dto.UpdateWebhookSubscriptionInput{
+				ID: 1,
+				CreateWebhookSubscriptionInput: dto.CreateWebhookSubscriptionInput{
+					URL:        "https://partner.example.com/webhooks",
+					EventTypes: []string{valueobject.OrderStatusChangedEvent.String()},
+					Secret:     "partner-secret-0002",
+					Active:     true,
+				},
+			},
+			setupMocks: func() {
+				subscription := s.newSubscription()
+				subscription.RecordFailure(1)
+
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(subscription, nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.NoError(t, err)
+				assert.True(t, subscription.Active)
+				assert.Zero(t, subscription.ConsecutiveFailures)
+				assert.Nil(t, subscription.DisabledAt)
+				assert.Equal(t, "partner-secret-0002", subscription.Secret)
+			},
+		},
+		{
+			name: "should return invalid input error when subscription has no event types",
+			input: dto.UpdateWebhookSubscriptionInput{
+				ID: 1,
+				CreateWebhookSubscriptionInput: dto.CreateWebhookSubscriptionInput{
+					URL: "https://partner.example.com/webhooks",
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InvalidInputError{}, err)
+			},
+		},
+		{
+			name:  "should return not found error when subscription does not exist",
+			input: dto.UpdateWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name: "should return error when gateway update fails",
+			input: dto.UpdateWebhookSubscriptionInput{
+				ID: 1,
+				CreateWebhookSubscriptionInput: dto.CreateWebhookSubscriptionInput{
+					URL:        "https://partner.example.com/webhooks",
+					EventTypes: []string{valueobject.OrderCreatedEvent.String()},
+				},
+			},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					Update(s.ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, subscription *entity.WebhookSubscription) error {
+						assert.False(s.T(), subscription.Active)
+						return assert.AnError
+					})
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			subscription, err := s.useCase.Update(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, subscription, err)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionUsecaseSuiteTest) TestWebhookSubscriptionUseCase_Delete() {
+	tests := []struct {
+		name        string
+		input       dto.DeleteWebhookSubscriptionInput
+		setupMocks  func()
+		checkResult func(*testing.T, *entity.WebhookSubscription, error)
+	}{
+		{
+			name:  "should delete subscription successfully",
+			input: dto.DeleteWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, uint64(1), subscription.ID)
+			},
+		},
+		{
+			name:  "should return not found error when subscription does not exist",
+			input: dto.DeleteWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(nil, nil)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.NotFoundError{}, err)
+			},
+		},
+		{
+			name:  "should return error when gateway delete fails",
+			input: dto.DeleteWebhookSubscriptionInput{ID: 1},
+			setupMocks: func() {
+				s.mockGateway.EXPECT().
+					FindByID(s.ctx, uint64(1)).
+					Return(s.newSubscription(), nil)
+
+				s.mockGateway.EXPECT().
+					Delete(s.ctx, uint64(1)).
+					Return(assert.AnError)
+			},
+			checkResult: func(t *testing.T, subscription *entity.WebhookSubscription, err error) {
+				assert.Error(t, err)
+				assert.Nil(t, subscription)
+				assert.IsType(t, &domain.InternalError{}, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+
+			// Act
+			subscription, err := s.useCase.Delete(s.ctx, tt.input)
+
+			// Assert
+			tt.checkResult(t, subscription, err)
+		})
+	}
+}
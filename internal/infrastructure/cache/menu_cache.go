@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type menuCache struct {
+	mu        sync.RWMutex
+	menu      *entity.Menu
+	expiresAt time.Time
+	ttl       time.Duration
+}
+
+// NewMenuCache creates an in memory menu cache, the TTL bounds how long another instance of the service
+// can serve a menu changed by a write it didn't see. A zero TTL disables the cache
+func NewMenuCache(ttl time.Duration) port.MenuCache {
+	return &menuCache{ttl: ttl}
+}
+
+func (c *menuCache) Get(_ context.Context) (*entity.Menu, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.menu == nil || time.Now().After(c.expiresAt) {
+		return nil, false
+	}
+	return c.menu, true
+}
+
+func (c *menuCache) Set(_ context.Context, menu *entity.Menu) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.menu = menu
+	c.expiresAt = time.Now().Add(c.ttl)
+}
+
+func (c *menuCache) Invalidate(_ context.Context) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.menu = nil
+}
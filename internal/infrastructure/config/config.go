@@ -4,6 +4,7 @@ import (
 	"log"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -14,6 +15,8 @@ type Config struct {
 	AWS_SQS_OrderStatusUpdatedURL             string
 	AWS_SQS_OrderStatusUpdatedMaxMessages     int
 	AWS_SQS_OrderStatusUpdatedWaitTimeSeconds int
+	// Queue of the domain events published by the service, ex: stock changes
+	AWS_SQS_EventsURL string
 
 	// Database settings
 	DBDSN          string
@@ -30,10 +33,73 @@ type Config struct {
 
 	// Environment
 	Environment string
+	// RestaurantTimezone is the IANA timezone of the restaurant, the pickup days follow it
+	RestaurantTimezone string
 
 	// JWT Settings
 	JWTSecret     string
 	JWTExpiration time.Duration
+
+	// APIKeys are the keys of the API clients by client name, they access the administrative routes
+	APIKeys map[string]string
+
+	// Order settings
+	OrderStatusMachineFile string
+
+	// Menu settings
+	MenuCacheTTL time.Duration
+
+	// Scheduler settings
+	SchedulerInterval        time.Duration
+	SchedulerBatchSize       int
+	SchedulerOpenOrderTTL    time.Duration
+	SchedulerPendingOrderTTL time.Duration
+
+	// HTTP client settings, the retries are only made on network errors and 5xx responses
+	HTTPClientTimeout       time.Duration
+	HTTPClientRetryCount    int
+	HTTPClientRetryWaitTime time.Duration
+
+	// Payment service settings, the circuit breaker opens after the max failures in a row
+	// and rejects the payments until the open timeout passes
+	PaymentServiceURL         string
+	PaymentServiceToken       string
+	PaymentCallbackSecret     string
+	PaymentBreakerMaxFailures int
+	PaymentBreakerOpenTimeout time.Duration
+
+	// Webhook settings, the partners sign the webhook requests with their secrets and
+	// the requests older or newer than the timestamp tolerance are rejected. The bodies are
+	// limited to the max bytes and the received requests are kept on the replay store
+	// (memory or postgres) while their timestamp is accepted, so they aren't accepted twice
+	WebhookPartnerSecrets     map[string]string
+	WebhookTimestampTolerance time.Duration
+	WebhookMaxBodyBytes       int64
+	WebhookReplayStore        string
+
+	// Webhook dispatcher settings, a delivery is retried with an exponential backoff up to the max attempts and
+	// the subscription is disabled after the max failures, deliveries exhausted in a row
+	WebhookDispatchInterval  time.Duration
+	WebhookDispatchBatchSize int
+	WebhookDispatchLease     time.Duration
+	WebhookMaxAttempts       int
+	WebhookRetryBackoff      time.Duration
+	WebhookMaxFailures       int
+	// WebhookAllowPrivateNetworks lets the deliveries reach the private networks, only for local development
+	WebhookAllowPrivateNetworks bool
+
+	// Notification settings, the notifications are appended to the sink file as JSON lines or logged when it is empty
+	NotificationSinkFile      string
+	NotificationTemplatesFile string
+
+	// Rate limit settings, the limits of the route groups are token buckets of requests/period[/burst] loaded
+	// by LoadRateLimits, the default one applies to the groups without their own and the store is memory or postgres
+	RateLimitStore string
+	RateLimits     string
+
+	// TrustedProxies are the addresses or CIDRs of the proxies trusted to inform the client IP on X-Forwarded-For,
+	// the client IP is the address of the connection when empty
+	TrustedProxies []string
 }
 
 func LoadConfig() *Config {
@@ -53,6 +119,31 @@ func LoadConfig() *Config {
 	serverIdleTimeout, _ := time.ParseDuration(getEnv("SERVER_IDLE_TIMEOUT", "60s"))
 	serverGracefulShutdownTimeout, _ := time.ParseDuration(getEnv("SERVER_GRACEFUL_SHUTDOWN_SEC_TIMEOUT", "5s"))
 
+	menuCacheTTL, _ := time.ParseDuration(getEnv("MENU_CACHE_TTL", "5m"))
+
+	schedulerInterval, _ := time.ParseDuration(getEnv("SCHEDULER_INTERVAL", "1m"))
+	schedulerBatchSize, _ := strconv.Atoi(getEnv("SCHEDULER_BATCH_SIZE", "100"))
+	schedulerOpenOrderTTL, _ := time.ParseDuration(getEnv("SCHEDULER_OPEN_ORDER_TTL", "30m"))
+	schedulerPendingOrderTTL, _ := time.ParseDuration(getEnv("SCHEDULER_PENDING_ORDER_TTL", "15m"))
+
+	httpClientTimeout, _ := time.ParseDuration(getEnv("HTTP_CLIENT_TIMEOUT", "10s"))
+	httpClientRetryCount, _ := strconv.Atoi(getEnv("HTTP_CLIENT_RETRY_COUNT", "2"))
+	httpClientRetryWaitTime, _ := time.ParseDuration(getEnv("HTTP_CLIENT_RETRY_WAIT_TIME", "200ms"))
+
+	paymentBreakerMaxFailures, _ := strconv.Atoi(getEnv("PAYMENT_BREAKER_MAX_FAILURES", "5"))
+	paymentBreakerOpenTimeout, _ := time.ParseDuration(getEnv("PAYMENT_BREAKER_OPEN_TIMEOUT", "30s"))
+
+	webhookTimestampTolerance, _ := time.ParseDuration(getEnv("WEBHOOK_TIMESTAMP_TOLERANCE", "5m"))
+	webhookMaxBodyBytes, _ := strconv.ParseInt(getEnv("WEBHOOK_MAX_BODY_BYTES", "1048576"), 10, 64)
+
+	webhookDispatchInterval, _ := time.ParseDuration(getEnv("WEBHOOK_DISPATCH_INTERVAL", "10s"))
+	webhookDispatchBatchSize, _ := strconv.Atoi(getEnv("WEBHOOK_DISPATCH_BATCH_SIZE", "100"))
+	webhookDispatchLease, _ := time.ParseDuration(getEnv("WEBHOOK_DISPATCH_LEASE", "1m"))
+	webhookMaxAttempts, _ := strconv.Atoi(getEnv("WEBHOOK_MAX_ATTEMPTS", "5"))
+	webhookRetryBackoff, _ := time.ParseDuration(getEnv("WEBHOOK_RETRY_BACKOFF", "30s"))
+	webhookMaxFailures, _ := strconv.Atoi(getEnv("WEBHOOK_MAX_FAILURES", "5"))
+	webhookAllowPrivateNetworks, _ := strconv.ParseBool(getEnv("WEBHOOK_ALLOW_PRIVATE_NETWORKS", "false"))
+
 	jwtExpirationStr := getEnv("JWT_EXPIRATION", "24h")
 	jwtExpiration, err := time.ParseDuration(jwtExpirationStr)
 	if err != nil {
@@ -65,6 +156,7 @@ func LoadConfig() *Config {
 		AWS_SQS_OrderStatusUpdatedURL:             getEnv("AWS_SQS_ORDER_STATUS_UPDATED_URL", ""),
 		AWS_SQS_OrderStatusUpdatedMaxMessages:     AWS_SQS_OrderStatusUpdatedMaxMessages,
 		AWS_SQS_OrderStatusUpdatedWaitTimeSeconds: AWS_SQS_OrderStatusUpdatedWaitTimeSeconds,
+		AWS_SQS_EventsURL:                         getEnv("AWS_SQS_EVENTS_URL", ""),
 
 		// Database settings
 		DBDSN:          getEnv("DB_DSN", "host=localhost port=5432 user=postgres password=postgres dbname=fastfood_10soat_g19_tc4_order sslmode=disable"),
@@ -80,12 +172,91 @@ func LoadConfig() *Config {
 		ServerGracefulShutdownTimeout: serverGracefulShutdownTimeout,
 
 		// Environment
-		Environment: getEnv("ENVIRONMENT", "development"),
+		Environment:        getEnv("ENVIRONMENT", "development"),
+		RestaurantTimezone: getEnv("RESTAURANT_TIMEZONE", "America/Sao_Paulo"),
 
 		// JWT Settings
 		JWTSecret:     getEnv("JWT_SECRET", "SUPER_SECRET_KEY_DONT_TELL_ANYONE"),
 		JWTExpiration: jwtExpiration,
+
+		// API key settings
+		APIKeys: parseKeyValues(getEnv("API_KEYS", "")),
+
+		// Order settings
+		OrderStatusMachineFile: getEnv("ORDER_STATUS_MACHINE_FILE", ""),
+
+		// Menu settings
+		MenuCacheTTL: menuCacheTTL,
+
+		// Scheduler settings
+		SchedulerInterval:        schedulerInterval,
+		SchedulerBatchSize:       schedulerBatchSize,
+		SchedulerOpenOrderTTL:    schedulerOpenOrderTTL,
+		SchedulerPendingOrderTTL: schedulerPendingOrderTTL,
+
+		// HTTP client settings
+		HTTPClientTimeout:       httpClientTimeout,
+		HTTPClientRetryCount:    httpClientRetryCount,
+		HTTPClientRetryWaitTime: httpClientRetryWaitTime,
+
+		// Payment service settings
+		PaymentServiceURL:         getEnv("PAYMENT_SERVICE_URL", "http://localhost:8081/api/v1"),
+		PaymentServiceToken:       getEnv("PAYMENT_SERVICE_TOKEN", ""),
+		PaymentCallbackSecret:     getEnv("PAYMENT_CALLBACK_SECRET", ""),
+		PaymentBreakerMaxFailures: paymentBreakerMaxFailures,
+		PaymentBreakerOpenTimeout: paymentBreakerOpenTimeout,
+
+		// Webhook settings
+		WebhookPartnerSecrets:     parseKeyValues(getEnv("WEBHOOK_PARTNER_SECRETS", "")),
+		WebhookTimestampTolerance: webhookTimestampTolerance,
+		WebhookMaxBodyBytes:       webhookMaxBodyBytes,
+		WebhookReplayStore:        getEnv("WEBHOOK_REPLAY_STORE", "memory"),
+
+		// Webhook dispatcher settings
+		WebhookDispatchInterval:  webhookDispatchInterval,
+		WebhookDispatchBatchSize: webhookDispatchBatchSize,
+		WebhookDispatchLease:     webhookDispatchLease,
+		WebhookMaxAttempts:       webhookMaxAttempts,
+		WebhookRetryBackoff:      webhookRetryBackoff,
+		WebhookMaxFailures:       webhookMaxFailures,
+
+		WebhookAllowPrivateNetworks: webhookAllowPrivateNetworks,
+
+		// Notification settings
+		NotificationSinkFile:      getEnv("NOTIFICATION_SINK_FILE", ""),
+		NotificationTemplatesFile: getEnv("NOTIFICATION_TEMPLATES_FILE", ""),
+
+		// Rate limit settings
+		RateLimitStore: getEnv("RATE_LIMIT_STORE", "memory"),
+		RateLimits:     getEnv("RATE_LIMITS", "default:300/1m/60,orders:120/1m/30,pickup-board:60/1m/20"),
+
+		// Proxy settings
+		TrustedProxies: parseList(getEnv("TRUSTED_PROXIES", "")),
+	}
+}
+
+// parseList parses a comma separated list, the empty values are skipped
+func parseList(value string) []string {
+	var list []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// parseKeyValues parses a comma separated list of key:value pairs, ex: partner-a:secret-a,partner-b:secret-b
+func parseKeyValues(value string) map[string]string {
+	pairs := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		key, val, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok || key == "" || val == "" {
+			continue
+		}
+		pairs[key] = val
 	}
+	return pairs
 }
 
 func getEnv(key, defaultValue string) string {
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// LoadNotificationTemplates loads the notification templates from a JSON file,
+// falling back to the embedded default when no file is configured
+func LoadNotificationTemplates(path string) (valueobject.NotificationTemplates, error) {
+	if path == "" {
+		return valueobject.DefaultNotificationTemplates(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading notification templates file: %w", err)
+	}
+
+	return valueobject.NewNotificationTemplates(data)
+}
@@ -0,0 +1,23 @@
+package config
+
+import (
+	"fmt"
+	"os"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// LoadOrderStatusMachine loads the order status machine from a JSON file,
+// falling back to the embedded default when no file is configured
+func LoadOrderStatusMachine(path string) (*valueobject.OrderStatusMachine, error) {
+	if path == "" {
+		return valueobject.DefaultOrderStatusMachine(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading order status machine file: %w", err)
+	}
+
+	return valueobject.NewOrderStatusMachine(data)
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
+
+// LoadRateLimits parses the limits of the route groups, a comma separated list of group:limit pairs,
+// ex: default:300/1m/60,orders:120/1m/30. An empty value disables the limits
+func LoadRateLimits(value string) (map[string]valueobject.RateLimit, error) {
+	limits := make(map[string]valueobject.RateLimit)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		group, spec, ok := strings.Cut(pair, ":")
+		if !ok || group == "" {
+			return nil, fmt.Errorf("invalid rate limit %q, expected group:requests/period[/burst]", pair)
+		}
+		if _, exists := limits[group]; exists {
+			return nil, fmt.Errorf("duplicated rate limit of the group %q", group)
+		}
+
+		limit, err := valueobject.ParseRateLimit(spec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rate limit of the group %q: %w", group, err)
+		}
+		limits[group] = limit
+	}
+	return limits, nil
+}
@@ -0,0 +1,19 @@
+package config
+
+import (
+	"fmt"
+	"time"
+)
+
+// LoadRestaurantLocation loads the timezone of the restaurant, the receipts are printed on its time and the days of the pickup codes
+// and of the pickup board start at its midnight
+func LoadRestaurantLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return nil, fmt.Errorf("restaurant timezone is required")
+	}
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restaurant timezone %q: %w", timezone, err)
+	}
+	return location, nil
+}
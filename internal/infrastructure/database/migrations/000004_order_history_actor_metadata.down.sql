@@ -0,0 +1,6 @@
+ALTER TABLE order_histories
+    DROP COLUMN IF EXISTS actor_type,
+    DROP COLUMN IF EXISTS actor_id,
+    DROP COLUMN IF EXISTS reason_code,
+    DROP COLUMN IF EXISTS reason_text,
+    DROP COLUMN IF EXISTS source;
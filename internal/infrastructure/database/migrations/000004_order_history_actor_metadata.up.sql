@@ -0,0 +1,6 @@
+ALTER TABLE order_histories
+    ADD COLUMN IF NOT EXISTS actor_type  VARCHAR(20)  NULL,
+    ADD COLUMN IF NOT EXISTS actor_id    VARCHAR(64)  NULL,
+    ADD COLUMN IF NOT EXISTS reason_code VARCHAR(50)  NULL,
+    ADD COLUMN IF NOT EXISTS reason_text VARCHAR(255) NULL,
+    ADD COLUMN IF NOT EXISTS source      VARCHAR(20)  NULL;
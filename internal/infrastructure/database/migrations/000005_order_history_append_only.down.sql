@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS trg_order_histories_no_truncate ON order_histories;
+DROP TRIGGER IF EXISTS trg_order_histories_append_only ON order_histories;
+DROP FUNCTION IF EXISTS order_histories_append_only();
+
+DROP INDEX IF EXISTS idx_order_histories_order_id_previous_hash;
+
+ALTER TABLE order_histories
+    DROP COLUMN IF EXISTS previous_hash,
+    DROP COLUMN IF EXISTS hash;
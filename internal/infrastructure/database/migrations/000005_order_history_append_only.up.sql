@@ -0,0 +1,31 @@
+ALTER TABLE order_histories
+    ADD COLUMN IF NOT EXISTS previous_hash VARCHAR(64) NULL,
+    ADD COLUMN IF NOT EXISTS hash          VARCHAR(64) NULL;
+
+-- Only one history can follow each sealed history of an order
+CREATE UNIQUE INDEX IF NOT EXISTS idx_order_histories_order_id_previous_hash
+    ON order_histories (order_id, previous_hash)
+    WHERE previous_hash IS NOT NULL;
+
+-- Order histories are an audit trail, rows can only be inserted
+CREATE OR REPLACE FUNCTION order_histories_append_only()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    RAISE EXCEPTION 'order_histories is append-only, % is not allowed', TG_OP;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_order_histories_append_only ON order_histories;
+CREATE TRIGGER trg_order_histories_append_only
+    BEFORE UPDATE OR DELETE
+    ON order_histories
+    FOR EACH ROW
+EXECUTE FUNCTION order_histories_append_only();
+
+DROP TRIGGER IF EXISTS trg_order_histories_no_truncate ON order_histories;
+CREATE TRIGGER trg_order_histories_no_truncate
+    BEFORE TRUNCATE
+    ON order_histories
+    FOR EACH STATEMENT
+EXECUTE FUNCTION order_histories_append_only();
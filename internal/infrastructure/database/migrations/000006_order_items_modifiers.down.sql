@@ -0,0 +1,26 @@
+DROP TABLE IF EXISTS order_product_modifiers;
+DROP TABLE IF EXISTS product_modifiers;
+DROP TABLE IF EXISTS product_modifier_groups;
+
+DROP INDEX IF EXISTS idx_order_products_order_id;
+
+-- merge the line items of the same product back into a single row
+UPDATE order_products op
+SET quantity = merged.quantity
+FROM (SELECT MIN(id) AS id, SUM(quantity) AS quantity
+      FROM order_products
+      GROUP BY order_id, product_id) merged
+WHERE op.id = merged.id;
+DELETE
+FROM order_products op
+    USING order_products other
+WHERE op.order_id = other.order_id
+  AND op.product_id = other.product_id
+  AND op.id > other.id;
+
+ALTER TABLE order_products
+    DROP CONSTRAINT IF EXISTS order_products_pkey;
+ALTER TABLE order_products
+    DROP COLUMN IF EXISTS id,
+    DROP COLUMN IF EXISTS notes,
+    ADD PRIMARY KEY (order_id, product_id);
@@ -0,0 +1,43 @@
+ALTER TABLE order_products
+    DROP CONSTRAINT IF EXISTS order_products_pkey;
+ALTER TABLE order_products
+    ADD COLUMN IF NOT EXISTS id    SERIAL PRIMARY KEY,
+    ADD COLUMN IF NOT EXISTS notes VARCHAR(255) NULL;
+
+CREATE INDEX IF NOT EXISTS idx_order_products_order_id ON order_products (order_id);
+
+CREATE TABLE IF NOT EXISTS product_modifier_groups
+(
+    id          SERIAL PRIMARY KEY,
+    product_id  INT          NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    name        VARCHAR(100) NOT NULL,
+    min_choices INT          NOT NULL DEFAULT 0,
+    max_choices INT          NOT NULL DEFAULT 1,
+    created_at  TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS product_modifiers
+(
+    id                        SERIAL PRIMARY KEY,
+    product_modifier_group_id INT            NOT NULL REFERENCES product_modifier_groups (id) ON DELETE CASCADE,
+    name                      VARCHAR(100)   NOT NULL,
+    price_delta               DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    created_at                TIMESTAMP      NOT NULL DEFAULT now(),
+    updated_at                TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+-- name and price are copied from the catalog so the order keeps the values of the moment it was placed
+CREATE TABLE IF NOT EXISTS order_product_modifiers
+(
+    id                  SERIAL PRIMARY KEY,
+    order_product_id    INT            NOT NULL REFERENCES order_products (id) ON DELETE CASCADE,
+    product_modifier_id INT            NOT NULL,
+    group_name          VARCHAR(100)   NOT NULL,
+    name                VARCHAR(100)   NOT NULL,
+    price_delta         DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    created_at          TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_modifier_groups_product_id ON product_modifier_groups (product_id);
+CREATE INDEX IF NOT EXISTS idx_order_product_modifiers_order_product_id ON order_product_modifiers (order_product_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS order_product_components;
+DROP TABLE IF EXISTS product_bundle_options;
+DROP TABLE IF EXISTS product_bundle_slots;
@@ -0,0 +1,63 @@
+CREATE TABLE IF NOT EXISTS product_bundle_slots
+(
+    id         SERIAL PRIMARY KEY,
+    product_id INT          NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    name       VARCHAR(100) NOT NULL,
+    quantity   INT          NOT NULL DEFAULT 1,
+    created_at TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP    NOT NULL DEFAULT now()
+);
+
+-- the first option of a slot is its default component
+CREATE TABLE IF NOT EXISTS product_bundle_options
+(
+    id                     SERIAL PRIMARY KEY,
+    product_bundle_slot_id INT            NOT NULL REFERENCES product_bundle_slots (id) ON DELETE CASCADE,
+    component_product_id   INT            NOT NULL REFERENCES products (id),
+    price_delta            DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    created_at             TIMESTAMP      NOT NULL DEFAULT now(),
+    updated_at             TIMESTAMP      NOT NULL DEFAULT now(),
+    UNIQUE (product_bundle_slot_id, component_product_id)
+);
+
+-- slot and product names are copied from the catalog so the order keeps the values of the moment it was placed
+CREATE TABLE IF NOT EXISTS order_product_components
+(
+    id                     SERIAL PRIMARY KEY,
+    order_product_id       INT            NOT NULL REFERENCES order_products (id) ON DELETE CASCADE,
+    product_bundle_slot_id INT            NOT NULL,
+    slot_name              VARCHAR(100)   NOT NULL,
+    product_id             INT            NOT NULL REFERENCES products (id),
+    name                   VARCHAR        NOT NULL,
+    quantity               INT            NOT NULL DEFAULT 1,
+    price_delta            DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    created_at             TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_bundle_slots_product_id ON product_bundle_slots (product_id);
+CREATE INDEX IF NOT EXISTS idx_order_product_components_order_product_id ON order_product_components (order_product_id);
+
+-- link the seeded "Combo Big" to the products it contains
+INSERT INTO product_bundle_slots (product_id, name, quantity)
+SELECT combo.id, slot.name, 1
+FROM products combo,
+     (VALUES (1, 'Lanche'), (2, 'Acompanhamento'), (3, 'Bebida')) AS slot(position, name)
+WHERE combo.name = 'Combo Big'
+ORDER BY slot.position;
+
+INSERT INTO product_bundle_options (product_bundle_slot_id, component_product_id)
+SELECT slot.id, component.id
+FROM product_bundle_slots slot
+         JOIN products combo ON combo.id = slot.product_id AND combo.name = 'Combo Big'
+         JOIN products component ON component.name = CASE slot.name
+                                                         WHEN 'Lanche' THEN 'X-Burger'
+                                                         WHEN 'Acompanhamento' THEN 'Batata Frita'
+                                                         WHEN 'Bebida' THEN 'Coca-Cola 350ml'
+    END;
+
+INSERT INTO order_product_components (order_product_id, product_bundle_slot_id, slot_name, product_id, name, quantity)
+SELECT op.id, slot.id, slot.name, component.id, component.name, slot.quantity
+FROM order_products op
+         JOIN product_bundle_slots slot ON slot.product_id = op.product_id
+         JOIN product_bundle_options opt ON opt.product_bundle_slot_id = slot.id
+         JOIN products component ON component.id = opt.component_product_id;
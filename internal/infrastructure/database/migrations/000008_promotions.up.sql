@@ -0,0 +1,51 @@
+-- promotions without a code are applied automatically to the OPEN orders
+CREATE TABLE IF NOT EXISTS promotions
+(
+    id                    SERIAL PRIMARY KEY,
+    name                  VARCHAR(100)   NOT NULL,
+    code                  VARCHAR(50)    NULL UNIQUE,
+    discount_type         VARCHAR(20)    NOT NULL,
+    value                 DECIMAL(19, 2) NOT NULL,
+    category_id           INT            NULL REFERENCES categories (id) ON DELETE CASCADE,
+    product_id            INT            NULL REFERENCES products (id) ON DELETE CASCADE,
+    starts_at             TIMESTAMP      NULL,
+    ends_at               TIMESTAMP      NULL,
+    happy_hour_start      VARCHAR(5)     NOT NULL DEFAULT '',
+    happy_hour_end        VARCHAR(5)     NOT NULL DEFAULT '',
+    max_uses_per_customer INT            NOT NULL DEFAULT 0,
+    stackable             BOOLEAN        NOT NULL DEFAULT FALSE,
+    active                BOOLEAN        NOT NULL DEFAULT TRUE,
+    created_at            TIMESTAMP      NOT NULL DEFAULT now(),
+    updated_at            TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS order_coupons
+(
+    id           SERIAL PRIMARY KEY,
+    order_id     INT         NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    promotion_id INT         NOT NULL REFERENCES promotions (id) ON DELETE CASCADE,
+    code         VARCHAR(50) NOT NULL,
+    created_at   TIMESTAMP   NOT NULL DEFAULT now(),
+    UNIQUE (order_id, promotion_id)
+);
+
+-- name and code are copied from the promotion so the order keeps the values of the moment it was placed
+CREATE TABLE IF NOT EXISTS order_discounts
+(
+    id           SERIAL PRIMARY KEY,
+    order_id     INT            NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    promotion_id INT            NOT NULL,
+    name         VARCHAR(100)   NOT NULL,
+    code         VARCHAR(50)    NOT NULL DEFAULT '',
+    amount       DECIMAL(19, 2) NOT NULL,
+    created_at   TIMESTAMP      NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_order_coupons_order_id ON order_coupons (order_id);
+CREATE INDEX IF NOT EXISTS idx_order_discounts_order_id ON order_discounts (order_id);
+CREATE INDEX IF NOT EXISTS idx_order_discounts_promotion_id ON order_discounts (promotion_id);
+
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS subtotal       DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS discount_total DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS total          DECIMAL(19, 2) NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+ALTER TABLE products
+    DROP COLUMN IF EXISTS available,
+    DROP COLUMN IF EXISTS stock_quantity,
+    DROP COLUMN IF EXISTS stock_mode;
@@ -0,0 +1,4 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS stock_mode VARCHAR(20) NOT NULL DEFAULT 'UNLIMITED',
+    ADD COLUMN IF NOT EXISTS stock_quantity INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS available BOOLEAN NOT NULL DEFAULT TRUE;
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_categories_parent_id;
+
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS image_url,
+    DROP COLUMN IF EXISTS active,
+    DROP COLUMN IF EXISTS display_order,
+    DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,9 @@
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS parent_id     INT          NULL REFERENCES categories (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS display_order INT          NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS active        BOOLEAN      NOT NULL DEFAULT TRUE,
+    ADD COLUMN IF NOT EXISTS image_url     VARCHAR(255) NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS idx_categories_parent_id ON categories (parent_id);
+
+UPDATE categories SET display_order = id;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS product_availability_windows;
+DROP TABLE IF EXISTS category_availability_windows;
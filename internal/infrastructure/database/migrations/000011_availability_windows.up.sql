@@ -0,0 +1,28 @@
+-- weekday goes from 0 (sunday) to 6 (saturday), the times are HH:MM on the timezone of the window
+-- and a window that ends before it starts crosses midnight
+CREATE TABLE IF NOT EXISTS category_availability_windows
+(
+    id          SERIAL PRIMARY KEY,
+    category_id INT         NOT NULL REFERENCES categories (id) ON DELETE CASCADE,
+    weekday     SMALLINT    NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time  VARCHAR(5)  NOT NULL,
+    end_time    VARCHAR(5)  NOT NULL,
+    timezone    VARCHAR(64) NOT NULL,
+    created_at  TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP   NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS product_availability_windows
+(
+    id         SERIAL PRIMARY KEY,
+    product_id INT         NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    weekday    SMALLINT    NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    start_time VARCHAR(5)  NOT NULL,
+    end_time   VARCHAR(5)  NOT NULL,
+    timezone   VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP   NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_category_availability_windows_category_id ON category_availability_windows (category_id);
+CREATE INDEX IF NOT EXISTS idx_product_availability_windows_product_id ON product_availability_windows (product_id);
@@ -0,0 +1,5 @@
+ALTER TABLE order_products
+    DROP COLUMN IF EXISTS product_price_id,
+    DROP COLUMN IF EXISTS price;
+
+DROP TABLE IF EXISTS product_prices;
@@ -0,0 +1,28 @@
+-- the price of a product is resolved by time, a NULL effective_to keeps the price until the next change
+CREATE TABLE IF NOT EXISTS product_prices
+(
+    id             SERIAL PRIMARY KEY,
+    product_id     INT            NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    price          DECIMAL(19, 2) NOT NULL CHECK (price > 0),
+    effective_from TIMESTAMP      NOT NULL,
+    effective_to   TIMESTAMP      NULL,
+    created_at     TIMESTAMP      NOT NULL DEFAULT now(),
+    CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_prices_product_id_effective_from ON product_prices (product_id, effective_from);
+
+INSERT INTO product_prices (product_id, price, effective_from)
+SELECT id, price, created_at
+FROM products;
+
+-- the price is copied to the line item so the order keeps the price of the moment it was placed
+ALTER TABLE order_products
+    ADD COLUMN IF NOT EXISTS price            DECIMAL(19, 2) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS product_price_id INT            NULL REFERENCES product_prices (id) ON DELETE SET NULL;
+
+UPDATE order_products op
+SET price            = pp.price,
+    product_price_id = pp.id
+FROM product_prices pp
+WHERE pp.product_id = op.product_id;
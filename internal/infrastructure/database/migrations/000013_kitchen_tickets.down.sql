@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS kitchen_ticket_items;
+DROP TABLE IF EXISTS kitchen_tickets;
+
+ALTER TABLE products
+    DROP COLUMN IF EXISTS kitchen_station;
+ALTER TABLE categories
+    DROP COLUMN IF EXISTS kitchen_station;
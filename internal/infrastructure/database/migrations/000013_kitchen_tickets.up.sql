@@ -0,0 +1,38 @@
+-- the station of a product overrides the station of its category, NULL inherits it
+ALTER TABLE categories
+    ADD COLUMN IF NOT EXISTS kitchen_station VARCHAR(20) NULL;
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS kitchen_station VARCHAR(20) NULL;
+
+-- an order is split into one ticket per station when it is RECEIVED
+CREATE TABLE IF NOT EXISTS kitchen_tickets
+(
+    id         SERIAL PRIMARY KEY,
+    order_id   INT         NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    station    VARCHAR(20) NOT NULL,
+    status     VARCHAR(20) NOT NULL DEFAULT 'QUEUED',
+    staff_id   INT         NULL,
+    started_at TIMESTAMP   NULL,
+    done_at    TIMESTAMP   NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP   NOT NULL DEFAULT now(),
+    UNIQUE (order_id, station)
+);
+
+CREATE INDEX IF NOT EXISTS idx_kitchen_tickets_station_status ON kitchen_tickets (station, status);
+
+-- names are copied from the order so the ticket keeps what was ordered
+CREATE TABLE IF NOT EXISTS kitchen_ticket_items
+(
+    id                SERIAL PRIMARY KEY,
+    kitchen_ticket_id INT          NOT NULL REFERENCES kitchen_tickets (id) ON DELETE CASCADE,
+    order_product_id  INT          NOT NULL,
+    product_id        INT          NOT NULL,
+    name              VARCHAR(100) NOT NULL,
+    quantity          INT          NOT NULL CHECK (quantity > 0),
+    modifiers         VARCHAR(500) NOT NULL DEFAULT '',
+    notes             VARCHAR(255) NOT NULL DEFAULT '',
+    created_at        TIMESTAMP    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_kitchen_ticket_items_kitchen_ticket_id ON kitchen_ticket_items (kitchen_ticket_id);
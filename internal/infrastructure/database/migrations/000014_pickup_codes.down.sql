@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS pickup_code_counters;
+
+DROP INDEX IF EXISTS idx_orders_pickup_date_pickup_code;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS pickup_date,
+    DROP COLUMN IF EXISTS pickup_code;
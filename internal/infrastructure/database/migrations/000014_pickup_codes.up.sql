@@ -0,0 +1,14 @@
+-- the pickup code is the short number the customer is called by, it is assigned when the order is RECEIVED
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS pickup_code VARCHAR(10) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS pickup_date DATE        NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_pickup_date_pickup_code ON orders (pickup_date, pickup_code)
+    WHERE pickup_date IS NOT NULL;
+
+-- one counter per day, so the codes start over every day
+CREATE TABLE IF NOT EXISTS pickup_code_counters
+(
+    day         DATE PRIMARY KEY,
+    last_number INT NOT NULL
+);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS order_delivery_addresses;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS table_number,
+    DROP COLUMN IF EXISTS fulfilment_mode,
+    DROP COLUMN IF EXISTS channel;
@@ -0,0 +1,20 @@
+-- the orders placed before the channels and modes existed came from the totem and were picked up at the counter
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS channel         VARCHAR(20) NOT NULL DEFAULT 'TOTEM',
+    ADD COLUMN IF NOT EXISTS fulfilment_mode VARCHAR(20) NOT NULL DEFAULT 'TAKEAWAY',
+    ADD COLUMN IF NOT EXISTS table_number    INT         NULL CHECK (table_number > 0);
+
+-- the address is copied to the order so it is kept even if the customer changes it
+CREATE TABLE IF NOT EXISTS order_delivery_addresses
+(
+    id           SERIAL PRIMARY KEY,
+    order_id     INT          NOT NULL UNIQUE REFERENCES orders (id) ON DELETE CASCADE,
+    street       VARCHAR(150) NOT NULL,
+    number       VARCHAR(20)  NOT NULL,
+    complement   VARCHAR(100) NOT NULL DEFAULT '',
+    neighborhood VARCHAR(100) NOT NULL DEFAULT '',
+    city         VARCHAR(100) NOT NULL,
+    state        VARCHAR(50)  NOT NULL,
+    zip_code     VARCHAR(20)  NOT NULL,
+    created_at   TIMESTAMP    NOT NULL DEFAULT now()
+);
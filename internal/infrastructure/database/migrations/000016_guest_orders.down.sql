@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_orders_guest_token;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS guest_token,
+    DROP COLUMN IF EXISTS guest_name;
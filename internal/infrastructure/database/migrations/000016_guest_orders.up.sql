@@ -0,0 +1,7 @@
+-- guest orders have no customer_id, the guest token gives the customer access to the order tracking
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS guest_name  VARCHAR(100) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS guest_token VARCHAR(64)  NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_guest_token ON orders (guest_token)
+    WHERE guest_token <> '';
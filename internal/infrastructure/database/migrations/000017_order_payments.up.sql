@@ -0,0 +1,6 @@
+-- reference of the payment created on the payment service at checkout, the callbacks find the order by it
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS payment_reference VARCHAR(100) NOT NULL DEFAULT '';
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_orders_payment_reference ON orders (payment_reference)
+    WHERE payment_reference <> '';
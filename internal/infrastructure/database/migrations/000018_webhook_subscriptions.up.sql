@@ -0,0 +1,37 @@
+-- partners subscribed to the order events, the event types and filters are comma separated lists
+CREATE TABLE IF NOT EXISTS webhook_subscriptions
+(
+    id                   SERIAL PRIMARY KEY,
+    url                  VARCHAR(500) NOT NULL,
+    event_types          VARCHAR(255) NOT NULL,
+    statuses             VARCHAR(255) NOT NULL DEFAULT '',
+    channels             VARCHAR(255) NOT NULL DEFAULT '',
+    fulfilment_modes     VARCHAR(255) NOT NULL DEFAULT '',
+    secret               VARCHAR(255) NOT NULL,
+    active               BOOLEAN      NOT NULL DEFAULT TRUE,
+    consecutive_failures INT          NOT NULL DEFAULT 0,
+    disabled_at          TIMESTAMP    NULL,
+    created_at           TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at           TIMESTAMP    NOT NULL DEFAULT now()
+);
+
+-- log of the events sent to the subscribers, the dispatcher sends the pending ones when their next attempt is due
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              SERIAL PRIMARY KEY,
+    subscription_id INT          NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_type      VARCHAR(50)  NOT NULL,
+    payload         TEXT         NOT NULL,
+    status          VARCHAR(20)  NOT NULL DEFAULT 'PENDING',
+    attempts        INT          NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP    NOT NULL DEFAULT now(),
+    response_status INT          NOT NULL DEFAULT 0,
+    last_error      VARCHAR(500) NOT NULL DEFAULT '',
+    delivered_at    TIMESTAMP    NULL,
+    created_at      TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at      TIMESTAMP    NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription_id ON webhook_deliveries (subscription_id);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'PENDING';
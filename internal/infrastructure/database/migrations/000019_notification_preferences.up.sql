@@ -0,0 +1,13 @@
+-- opt-in of the customers to the order notifications, the channels are a comma separated list and empty opts out
+CREATE TABLE IF NOT EXISTS notification_preferences
+(
+    id          SERIAL PRIMARY KEY,
+    customer_id INT          NOT NULL UNIQUE,
+    locale      VARCHAR(10)  NOT NULL DEFAULT 'pt-BR',
+    channels    VARCHAR(50)  NOT NULL DEFAULT '',
+    email       VARCHAR(255) NOT NULL DEFAULT '',
+    phone       VARCHAR(20)  NOT NULL DEFAULT '',
+    push_token  VARCHAR(255) NOT NULL DEFAULT '',
+    created_at  TIMESTAMP    NOT NULL DEFAULT now(),
+    updated_at  TIMESTAMP    NOT NULL DEFAULT now()
+);
@@ -0,0 +1,9 @@
+-- token buckets of the rate limited clients, a missing bucket is full so the idle ones can be deleted anytime
+CREATE TABLE IF NOT EXISTS rate_limit_buckets
+(
+    bucket_key VARCHAR(255)     PRIMARY KEY,
+    tokens     DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMP        NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets (updated_at);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_rate_limit_buckets_full_at;
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_updated_at ON rate_limit_buckets (updated_at);
+
+ALTER TABLE rate_limit_buckets
+    DROP COLUMN IF EXISTS full_at;
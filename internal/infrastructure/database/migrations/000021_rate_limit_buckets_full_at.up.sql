@@ -0,0 +1,7 @@
+-- time the bucket is refilled, the full buckets are deleted since a missing bucket is full
+ALTER TABLE rate_limit_buckets
+    ADD COLUMN IF NOT EXISTS full_at TIMESTAMP NOT NULL DEFAULT now();
+
+DROP INDEX IF EXISTS idx_rate_limit_buckets_updated_at;
+
+CREATE INDEX IF NOT EXISTS idx_rate_limit_buckets_full_at ON rate_limit_buckets (full_at);
@@ -0,0 +1,2 @@
+ALTER TABLE promotions
+    DROP COLUMN IF EXISTS timezone;
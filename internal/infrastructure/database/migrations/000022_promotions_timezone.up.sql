@@ -0,0 +1,3 @@
+-- the happy hours are read on the timezone of the promotion, the promotions without one are read on UTC
+ALTER TABLE promotions
+    ADD COLUMN IF NOT EXISTS timezone VARCHAR(64) NOT NULL DEFAULT '';
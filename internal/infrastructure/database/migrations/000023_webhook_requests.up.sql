@@ -0,0 +1,8 @@
+-- signed webhook requests already received, kept while their timestamp is accepted so they can't be replayed
+CREATE TABLE IF NOT EXISTS webhook_requests
+(
+    request_key VARCHAR(255) PRIMARY KEY,
+    expires_at  TIMESTAMP    NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_requests_expires_at ON webhook_requests (expires_at);
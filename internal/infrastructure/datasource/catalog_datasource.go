@@ -0,0 +1,101 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type catalogDataSource struct {
+	db *gorm.DB
+}
+
+func NewCatalogDataSource(db *gorm.DB) port.CatalogDataSource {
+	return &catalogDataSource{db}
+}
+
+func (ds *catalogDataSource) FindAllCategories(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+	if err := dbFrom(ctx, ds.db).Order("display_order, id").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("error finding catalog categories: %w", err)
+	}
+	return categories, nil
+}
+
+func (ds *catalogDataSource) FindAllProducts(ctx context.Context) ([]*entity.Product, error) {
+	var products []*entity.Product
+	if err := dbFrom(ctx, ds.db).Preload("Prices", currentProductPrices).Order("id").Find(&products).Error; err != nil {
+		return nil, fmt.Errorf("error finding catalog products: %w", err)
+	}
+	resolveProductPrices(products...)
+	return products, nil
+}
+
+// Apply creates or updates the changed categories and products in a single transaction, the categories
+// come ordered with the parents first so the IDs of the new parents are known when the children are written.
+// Only the columns of the file are written, the associations of the products, as modifier groups and bundle slots,
+// are left untouched, the stock is only written when the row changes it and the new prices are added to their
+// price history
+func (ds *catalogDataSource) Apply(ctx context.Context, catalogImport *entity.CatalogImport) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		for _, item := range catalogImport.Categories {
+			category := item.Category
+			if item.Parent != nil {
+				category.ParentID = &item.Parent.ID
+			}
+			switch {
+			case item.New:
+				if err := tx.Omit(clause.Associations).Create(category).Error; err != nil {
+					return fmt.Errorf("error creating category %q: %w", category.Name, err)
+				}
+			case item.Changed:
+				if err := tx.Model(category).Select("name", "parent_id", "updated_at").Updates(category).Error; err != nil {
+					return fmt.Errorf("error updating category %q: %w", category.Name, err)
+				}
+			}
+		}
+
+		for _, item := range catalogImport.Products {
+			product := item.Product
+			product.CategoryID = item.Category.ID
+			switch {
+			case item.New:
+				if err := tx.Omit(clause.Associations).Create(product).Error; err != nil {
+					return fmt.Errorf("error creating product %q: %w", product.Name, err)
+				}
+			case item.Changed:
+				if err := tx.Model(product).Select(catalogProductColumns(item)).Updates(product).Error; err != nil {
+					return fmt.Errorf("error updating product %q: %w", product.Name, err)
+				}
+			}
+			if item.New || item.PriceChanged {
+				if err := schedulePrice(tx, product.NewCurrentPrice(product.Price, time.Now())); err != nil {
+					return fmt.Errorf("error scheduling price of product %q: %w", product.Name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// catalogProductColumns returns the columns of the product written by the import, the stock
+// columns only when the row changes them
+func catalogProductColumns(item *entity.CatalogProduct) []string {
+	columns := []string{"name", "description", "price", "category_id", "updated_at"}
+	if item.StockModeChanged {
+		columns = append(columns, "stock_mode")
+	}
+	if item.StockQuantityChanged {
+		columns = append(columns, "stock_quantity")
+	}
+	if item.AvailableChanged {
+		columns = append(columns, "available")
+	}
+	return columns
+}
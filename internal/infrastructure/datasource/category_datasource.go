@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
@@ -20,7 +21,7 @@ func NewCategoryDataSource(db *gorm.DB) port.CategoryDataSource {
 
 func (ds *categoryDataSource) FindByID(ctx context.Context, id uint64) (*entity.Category, error) {
 	var category entity.Category
-	result := ds.db.WithContext(ctx).First(&category, id)
+	result := preloadAvailabilityWindows(dbFrom(ctx, ds.db)).First(&category, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -34,7 +35,7 @@ func (ds *categoryDataSource) FindAll(ctx context.Context, filters map[string]in
 	var categorys []*entity.Category
 	var total int64
 
-	query := ds.db.WithContext(ctx)
+	query := dbFrom(ctx, ds.db)
 
 	// Apply filters
 	for key, value := range filters {
@@ -53,22 +54,31 @@ func (ds *categoryDataSource) FindAll(ctx context.Context, filters map[string]in
 
 	// Get paginated results
 	offset := (page - 1) * limit
-	if err := query.Offset(offset).Limit(limit).Find(&categorys).Error; err != nil {
+	if err := preloadAvailabilityWindows(query).Order("display_order, id").Offset(offset).Limit(limit).Find(&categorys).Error; err != nil {
 		return nil, 0, fmt.Errorf("error finding categorys: %w", err)
 	}
 
 	return categorys, total, nil
 }
 
+// FindAllActive returns all the active categories, ordered as they are displayed on the menu
+func (ds *categoryDataSource) FindAllActive(ctx context.Context) ([]*entity.Category, error) {
+	var categories []*entity.Category
+	if err := preloadAvailabilityWindows(dbFrom(ctx, ds.db)).Where("active = ?", true).Order("display_order, id").Find(&categories).Error; err != nil {
+		return nil, fmt.Errorf("error finding active categories: %w", err)
+	}
+	return categories, nil
+}
+
 func (ds *categoryDataSource) Create(ctx context.Context, category *entity.Category) error {
-	if err := ds.db.WithContext(ctx).Create(category).Error; err != nil {
+	if err := dbFrom(ctx, ds.db).Create(category).Error; err != nil {
 		return fmt.Errorf("error creating category: %w", err)
 	}
 	return nil
 }
 
 func (ds *categoryDataSource) Update(ctx context.Context, category *entity.Category) error {
-	result := ds.db.WithContext(ctx).Save(category)
+	result := dbFrom(ctx, ds.db).Omit(clause.Associations).Save(category)
 	if result.Error != nil {
 		return fmt.Errorf("error updating category: %w", result.Error)
 	}
@@ -78,8 +88,27 @@ func (ds *categoryDataSource) Update(ctx context.Context, category *entity.Categ
 	return nil
 }
 
+// ReplaceAvailabilityWindows deletes the availability windows of the category and creates the given ones
+func (ds *categoryDataSource) ReplaceAvailabilityWindows(ctx context.Context, categoryID uint64, windows []entity.CategoryAvailabilityWindow) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("category_id = ?", categoryID).Delete(&entity.CategoryAvailabilityWindow{}).Error; err != nil {
+			return fmt.Errorf("error deleting category availability windows: %w", err)
+		}
+		if len(windows) == 0 {
+			return nil
+		}
+		for i := range windows {
+			windows[i].CategoryID = categoryID
+		}
+		if err := tx.Create(&windows).Error; err != nil {
+			return fmt.Errorf("error creating category availability windows: %w", err)
+		}
+		return nil
+	})
+}
+
 func (ds *categoryDataSource) Delete(ctx context.Context, id uint64) error {
-	result := ds.db.WithContext(ctx).Delete(&entity.Category{}, id)
+	result := dbFrom(ctx, ds.db).Delete(&entity.Category{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("error deleting category: %w", result.Error)
 	}
@@ -88,3 +117,10 @@ func (ds *categoryDataSource) Delete(ctx context.Context, id uint64) error {
 	}
 	return nil
 }
+
+// preloadAvailabilityWindows loads the availability windows ordered by the week
+func preloadAvailabilityWindows(query *gorm.DB) *gorm.DB {
+	return query.Preload("AvailabilityWindows", func(db *gorm.DB) *gorm.DB {
+		return db.Order("weekday, start_time, id")
+	})
+}
@@ -0,0 +1,113 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type kitchenTicketDataSource struct {
+	db *gorm.DB
+}
+
+func NewKitchenTicketDataSource(db *gorm.DB) port.KitchenTicketDataSource {
+	return &kitchenTicketDataSource{db}
+}
+
+func (ds *kitchenTicketDataSource) FindByID(ctx context.Context, id uint64) (*entity.KitchenTicket, error) {
+	var ticket entity.KitchenTicket
+	result := dbFrom(ctx, ds.db).Preload("Items", orderKitchenTicketItems).First(&ticket, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding kitchen ticket: %w", result.Error)
+	}
+	return &ticket, nil
+}
+
+// FindAll returns the tickets in the order they reached the kitchen
+func (ds *kitchenTicketDataSource) FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.KitchenTicket, int64, error) {
+	var tickets []*entity.KitchenTicket
+	var total int64
+
+	query := dbFrom(ctx, ds.db).Model(&entity.KitchenTicket{})
+
+	// Apply filters
+	for key, value := range filters {
+		switch key {
+		case "station":
+			if station, ok := value.(valueobject.KitchenStation); ok && station != "" {
+				query = query.Where("kitchen_tickets.station = ?", station)
+			}
+		case "status":
+			if status, ok := value.(valueobject.KitchenTicketStatus); ok && status != "" {
+				query = query.Where("kitchen_tickets.status = ?", status)
+			}
+		case "order_statuses":
+			if statuses, ok := value.([]valueobject.OrderStatus); ok && len(statuses) > 0 {
+				query = query.Joins("JOIN orders ON orders.id = kitchen_tickets.order_id").Where("orders.status IN ?", statuses)
+			}
+		}
+	}
+
+	// Count total before pagination
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting kitchen tickets: %w", err)
+	}
+
+	// Get paginated results
+	offset := (page - 1) * limit
+	if err := query.Preload("Items", orderKitchenTicketItems).
+		Order("kitchen_tickets.created_at, kitchen_tickets.id").
+		Offset(offset).Limit(limit).
+		Find(&tickets).Error; err != nil {
+		return nil, 0, fmt.Errorf("error finding kitchen tickets: %w", err)
+	}
+
+	return tickets, total, nil
+}
+
+func (ds *kitchenTicketDataSource) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.KitchenTicket, error) {
+	var tickets []*entity.KitchenTicket
+	if err := dbFrom(ctx, ds.db).
+		Preload("Items", orderKitchenTicketItems).
+		Where("order_id = ?", orderID).
+		Order("id").
+		Find(&tickets).Error; err != nil {
+		return nil, fmt.Errorf("error finding kitchen tickets of order: %w", err)
+	}
+	return tickets, nil
+}
+
+// CreateAll saves the tickets with their items in a single transaction
+func (ds *kitchenTicketDataSource) CreateAll(ctx context.Context, tickets []*entity.KitchenTicket) error {
+	if len(tickets) == 0 {
+		return nil
+	}
+	if err := dbFrom(ctx, ds.db).Create(tickets).Error; err != nil {
+		return fmt.Errorf("error creating kitchen tickets: %w", err)
+	}
+	return nil
+}
+
+// Update saves the status of the ticket, the items never change
+func (ds *kitchenTicketDataSource) Update(ctx context.Context, ticket *entity.KitchenTicket) error {
+	result := dbFrom(ctx, ds.db).
+		Model(ticket).
+		Select("status", "staff_id", "started_at", "done_at", "updated_at").
+		Updates(ticket)
+	if result.Error != nil {
+		return fmt.Errorf("error updating kitchen ticket: %w", result.Error)
+	}
+	return nil
+}
+
+func orderKitchenTicketItems(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
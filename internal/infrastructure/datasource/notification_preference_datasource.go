@@ -0,0 +1,54 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type notificationPreferenceDataSource struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceDataSource(db *gorm.DB) port.NotificationPreferenceDataSource {
+	return &notificationPreferenceDataSource{db}
+}
+
+func (ds *notificationPreferenceDataSource) FindByCustomerID(ctx context.Context, customerID uint64) (*entity.NotificationPreference, error) {
+	var preference entity.NotificationPreference
+	result := dbFrom(ctx, ds.db).Where("customer_id = ?", customerID).First(&preference)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding notification preference: %w", result.Error)
+	}
+	return &preference, nil
+}
+
+func (ds *notificationPreferenceDataSource) Create(ctx context.Context, preference *entity.NotificationPreference) error {
+	if err := dbFrom(ctx, ds.db).Create(preference).Error; err != nil {
+		return fmt.Errorf("error creating notification preference: %w", err)
+	}
+	return nil
+}
+
+func (ds *notificationPreferenceDataSource) Update(ctx context.Context, preference *entity.NotificationPreference) error {
+	result := dbFrom(ctx, ds.db).Save(preference)
+	if result.Error != nil {
+		return fmt.Errorf("error updating notification preference: %w", result.Error)
+	}
+	return nil
+}
+
+func (ds *notificationPreferenceDataSource) Delete(ctx context.Context, id uint64) error {
+	result := dbFrom(ctx, ds.db).Delete(&entity.NotificationPreference{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error deleting notification preference: %w", result.Error)
+	}
+	return nil
+}
@@ -3,10 +3,11 @@ package datasource
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/google/uuid"
 	"gorm.io/gorm"
 
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
@@ -16,15 +17,17 @@ type orderDataSource struct {
 	db *gorm.DB
 }
 
-type orderKey string
-
 func NewOrderDataSource(db *gorm.DB) port.OrderDataSource {
 	return &orderDataSource{db}
 }
 
 func (ds *orderDataSource) FindByID(ctx context.Context, id uint64) (*entity.Order, error) {
 	var order entity.Order
-	result := ds.db.WithContext(ctx).Preload("OrderProducts.Product").First(&order, id)
+	result := dbFrom(ctx, ds.db).
+		Preload("OrderProducts.Product").Preload("OrderProducts.Modifiers").Preload("OrderProducts.Components").
+		Preload("Coupons").Preload("Discounts").Preload("DeliveryAddress").
+		Preload("Histories", func(db *gorm.DB) *gorm.DB { return db.Order("created_at, id") }).
+		First(&order, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -38,25 +41,10 @@ func (ds *orderDataSource) FindAll(ctx context.Context, filters map[string]any,
 	var orders []*entity.Order
 	var total int64
 
-	query := ds.db.WithContext(ctx).Preload("OrderProducts.Product")
+	query := dbFrom(ctx, ds.db).Preload("OrderProducts.Product").Preload("OrderProducts.Modifiers").Preload("OrderProducts.Components").Preload("Coupons").Preload("Discounts").Preload("DeliveryAddress")
 
 	// Apply filters
-	for key, value := range filters {
-		switch key {
-		case "statuses":
-			if statuses, ok := value.([]valueobject.OrderStatus); ok && len(statuses) > 0 {
-				query = query.Where("status IN ?", statuses)
-			}
-		case "statuses_exclude":
-			if statuses, ok := value.([]valueobject.OrderStatus); ok && len(statuses) > 0 {
-				query = query.Where("status NOT IN ?", statuses)
-			}
-		case "customer_id":
-			if customerID, ok := value.(uint64); ok && customerID != 0 {
-				query = query.Where("customer_id = ?", customerID)
-			}
-		}
-	}
+	query = applyOrderFilters(query, filters)
 
 	// Apply order
 	if sort != "" {
@@ -77,32 +65,120 @@ func (ds *orderDataSource) FindAll(ctx context.Context, filters map[string]any,
 	return orders, total, nil
 }
 
+// SumTotals sums the totals of the orders matching the filters
+func (ds *orderDataSource) SumTotals(ctx context.Context, filters map[string]any) (float64, error) {
+	var sum float64
+	query := applyOrderFilters(dbFrom(ctx, ds.db).Model(&entity.Order{}), filters)
+	if err := query.Select("COALESCE(SUM(total), 0)").Scan(&sum).Error; err != nil {
+		return 0, fmt.Errorf("error summing order totals: %w", err)
+	}
+	return sum, nil
+}
+
 func (ds *orderDataSource) Create(ctx context.Context, order *entity.Order) error {
-	if err := ds.db.WithContext(ctx).Create(order).Error; err != nil {
+	query := dbFrom(ctx, ds.db)
+	if order.IsGuest() {
+		// Guest orders keep the customer_id NULL
+		query = query.Omit("customer_id")
+	}
+	if err := query.Create(order).Error; err != nil {
 		return fmt.Errorf("error creating order: %w", err)
 	}
 	return nil
 }
 
+// Update saves the order only if its version was not changed since it was read,
+// incrementing the version on success
 func (ds *orderDataSource) Update(ctx context.Context, order *entity.Order) error {
-	result := ds.db.WithContext(ctx).Preload("OrderProducts").Save(order)
+	currentVersion := order.Version
+	order.Version = currentVersion + 1
+
+	columns := []string{"status", "pickup_code", "pickup_date", "payment_reference", "version", "updated_at"}
+	if !order.IsGuest() {
+		columns = append(columns, "customer_id")
+	}
+
+	result := dbFrom(ctx, ds.db).
+		Model(order).
+		Where("version = ?", currentVersion).
+		Select(columns).
+		Updates(order)
 	if result.Error != nil {
+		order.Version = currentVersion
 		return fmt.Errorf("error updating order: %w", result.Error)
 	}
 
 	if result.RowsAffected == 0 {
+		order.Version = currentVersion
+		return domain.NewConflictError(domain.ErrOrderVersionConflict)
+	}
+	return nil
+}
+
+// UpdateTotals saves the coupons, discounts and totals of the order only if it is still OPEN and its
+// version was not changed since it was read, incrementing the version on success. A checkout between
+// the read and the save changes the version, so the totals of a placed order are never replaced
+func (ds *orderDataSource) UpdateTotals(ctx context.Context, order *entity.Order) error {
+	currentVersion := order.Version
+	order.Version = currentVersion + 1
+
+	err := dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(order).
+			Where("version = ? AND status = ?", currentVersion, valueobject.OPEN).
+			Select("subtotal", "discount_total", "total", "version", "updated_at").
+			Updates(order)
+		if result.Error != nil {
+			return fmt.Errorf("error updating order totals: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.NewConflictError(domain.ErrOrderVersionConflict)
+		}
+		if err := replaceAssociation(tx.Model(order).Association("Coupons"), order.Coupons); err != nil {
+			return fmt.Errorf("error replacing order coupons: %w", err)
+		}
+		if err := replaceAssociation(tx.Model(order).Association("Discounts"), order.Discounts); err != nil {
+			return fmt.Errorf("error replacing order discounts: %w", err)
+		}
 		return nil
+	})
+	if err != nil {
+		order.Version = currentVersion
+		return err
 	}
 	return nil
 }
 
+// NextPickupNumber increments the pickup code counter of the day, the upsert keeps concurrent orders from
+// receiving the same number
+func (ds *orderDataSource) NextPickupNumber(ctx context.Context, day time.Time) (uint32, error) {
+	var number uint32
+	err := dbFrom(ctx, ds.db).Raw(`
+		INSERT INTO pickup_code_counters (day, last_number) VALUES (?, 1)
+		ON CONFLICT (day) DO UPDATE SET last_number = pickup_code_counters.last_number + 1
+		RETURNING last_number`,
+		day.Format(time.DateOnly),
+	).Scan(&number).Error
+	if err != nil {
+		return 0, fmt.Errorf("error incrementing pickup code counter: %w", err)
+	}
+	return number, nil
+}
+
 func (ds *orderDataSource) Delete(ctx context.Context, id uint64) error {
+	// Delete the coupons and discounts of the order
+	if err := dbFrom(ctx, ds.db).Where("order_id = ?", id).Delete(&entity.OrderCoupon{}).Error; err != nil {
+		return fmt.Errorf("error deleting order coupons: %w", err)
+	}
+	if err := dbFrom(ctx, ds.db).Where("order_id = ?", id).Delete(&entity.OrderDiscount{}).Error; err != nil {
+		return fmt.Errorf("error deleting order discounts: %w", err)
+	}
+
 	// Delete all order products first
-	if err := ds.db.WithContext(ctx).Where("order_id = ?", id).Delete(&entity.OrderProduct{}).Error; err != nil {
+	if err := dbFrom(ctx, ds.db).Where("order_id = ?", id).Delete(&entity.OrderProduct{}).Error; err != nil {
 		return fmt.Errorf("error deleting order products: %w", err)
 	}
 
-	result := ds.db.WithContext(ctx).Delete(&entity.Order{}, id)
+	result := dbFrom(ctx, ds.db).Delete(&entity.Order{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("error deleting order: %w", result.Error)
 	}
@@ -113,10 +189,62 @@ func (ds *orderDataSource) Delete(ctx context.Context, id uint64) error {
 }
 
 func (ds *orderDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return ds.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Create a new context with the transaction
-		keyPrincipalID := orderKey(uuid.NewString())
-		txCtx := context.WithValue(ctx, keyPrincipalID, tx)
-		return fn(txCtx)
-	})
+	return withTransaction(ctx, ds.db, fn)
+}
+
+// applyOrderFilters adds the conditions of the filters to the query, unknown filters are ignored
+func applyOrderFilters(query *gorm.DB, filters map[string]any) *gorm.DB {
+	for key, value := range filters {
+		switch key {
+		case "statuses":
+			if statuses, ok := value.([]valueobject.OrderStatus); ok && len(statuses) > 0 {
+				query = query.Where("status IN ?", statuses)
+			}
+		case "statuses_exclude":
+			if statuses, ok := value.([]valueobject.OrderStatus); ok && len(statuses) > 0 {
+				query = query.Where("status NOT IN ?", statuses)
+			}
+		case "channels":
+			if channels, ok := value.([]valueobject.OrderChannel); ok && len(channels) > 0 {
+				query = query.Where("channel IN ?", channels)
+			}
+		case "fulfilment_modes":
+			if modes, ok := value.([]valueobject.FulfilmentMode); ok && len(modes) > 0 {
+				query = query.Where("fulfilment_mode IN ?", modes)
+			}
+		case "updated_before":
+			if updatedBefore, ok := value.(time.Time); ok && !updatedBefore.IsZero() {
+				query = query.Where("updated_at < ?", updatedBefore)
+			}
+		case "with_pickup_code":
+			if withPickupCode, ok := value.(bool); ok && withPickupCode {
+				query = query.Where("pickup_code <> ''")
+			}
+		case "pickup_date":
+			if pickupDate, ok := value.(time.Time); ok && !pickupDate.IsZero() {
+				query = query.Where("pickup_date = ?", pickupDate.Format(time.DateOnly))
+			}
+		case "guest_token":
+			if guestToken, ok := value.(string); ok && guestToken != "" {
+				query = query.Where("guest_token = ?", guestToken)
+			}
+		case "payment_reference":
+			if paymentReference, ok := value.(string); ok && paymentReference != "" {
+				query = query.Where("payment_reference = ?", paymentReference)
+			}
+		case "created_from":
+			if createdFrom, ok := value.(time.Time); ok && !createdFrom.IsZero() {
+				query = query.Where("created_at >= ?", createdFrom)
+			}
+		case "created_to":
+			if createdTo, ok := value.(time.Time); ok && !createdTo.IsZero() {
+				query = query.Where("created_at < ?", createdTo)
+			}
+		case "customer_id":
+			if customerID, ok := value.(uint64); ok && customerID != 0 {
+				query = query.Where("customer_id = ?", customerID)
+			}
+		}
+	}
+	return query
 }
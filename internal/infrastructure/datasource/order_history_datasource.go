@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/google/uuid"
 	"gorm.io/gorm"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
@@ -16,8 +15,6 @@ type orderHistoryDataSource struct {
 	db *gorm.DB
 }
 
-type orderHistoryKey string
-
 func NewOrderHistoryDataSource(db *gorm.DB) port.OrderHistoryDataSource {
 	return &orderHistoryDataSource{
 		db: db,
@@ -26,7 +23,7 @@ func NewOrderHistoryDataSource(db *gorm.DB) port.OrderHistoryDataSource {
 
 func (ds *orderHistoryDataSource) FindByID(ctx context.Context, id uint64) (*entity.OrderHistory, error) {
 	var orderHistory entity.OrderHistory
-	result := ds.db.WithContext(ctx).First(&orderHistory, id)
+	result := dbFrom(ctx, ds.db).First(&orderHistory, id)
 	if result.Error != nil {
 		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
 			return nil, nil
@@ -40,7 +37,7 @@ func (ds *orderHistoryDataSource) FindAll(ctx context.Context, filters map[strin
 	var orderHistories []*entity.OrderHistory
 	var total int64
 
-	query := ds.db.WithContext(ctx)
+	query := dbFrom(ctx, ds.db)
 
 	// Apply filters
 	for key, value := range filters {
@@ -71,40 +68,36 @@ func (ds *orderHistoryDataSource) FindAll(ctx context.Context, filters map[strin
 	return orderHistories, total, nil
 }
 
-func (ds *orderHistoryDataSource) Create(ctx context.Context, orderHistory *entity.OrderHistory) error {
-	if err := ds.db.WithContext(ctx).Create(orderHistory).Error; err != nil {
-		return fmt.Errorf("error creating orderHistory: %w", err)
+func (ds *orderHistoryDataSource) FindAllByOrderID(ctx context.Context, orderID uint64) ([]*entity.OrderHistory, error) {
+	var orderHistories []*entity.OrderHistory
+	if err := dbFrom(ctx, ds.db).Where("order_id = ?", orderID).Order("id").Find(&orderHistories).Error; err != nil {
+		return nil, fmt.Errorf("error finding orderHistories: %w", err)
 	}
-	return nil
+	return orderHistories, nil
 }
 
-func (ds *orderHistoryDataSource) Update(ctx context.Context, orderHistory *entity.OrderHistory) error {
-	result := ds.db.WithContext(ctx).Save(orderHistory)
+func (ds *orderHistoryDataSource) FindLastSealedByOrderID(ctx context.Context, orderID uint64) (*entity.OrderHistory, error) {
+	var orderHistory entity.OrderHistory
+	result := dbFrom(ctx, ds.db).
+		Where("order_id = ? AND hash IS NOT NULL", orderID).
+		Order("id DESC").
+		First(&orderHistory)
 	if result.Error != nil {
-		return fmt.Errorf("error updating orderHistory: %w", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return nil
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding last orderHistory: %w", result.Error)
 	}
-	return nil
+	return &orderHistory, nil
 }
 
-func (ds *orderHistoryDataSource) Delete(ctx context.Context, id uint64) error {
-	result := ds.db.WithContext(ctx).Delete(&entity.OrderHistory{}, id)
-	if result.Error != nil {
-		return fmt.Errorf("error deleting orderHistory: %w", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return nil
+func (ds *orderHistoryDataSource) Create(ctx context.Context, orderHistory *entity.OrderHistory) error {
+	if err := dbFrom(ctx, ds.db).Create(orderHistory).Error; err != nil {
+		return fmt.Errorf("error creating orderHistory: %w", err)
 	}
 	return nil
 }
 
 func (ds *orderHistoryDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return ds.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Create a new context with the transaction
-		keyPrincipalID := orderHistoryKey(uuid.NewString())
-		txCtx := context.WithValue(ctx, keyPrincipalID, tx)
-		return fn(txCtx)
-	})
+	return withTransaction(ctx, ds.db, fn)
 }
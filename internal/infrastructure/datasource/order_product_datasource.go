@@ -3,11 +3,13 @@ package datasource
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 )
 
@@ -15,15 +17,13 @@ type orderProductDataSource struct {
 	db *gorm.DB
 }
 
-type orderProductKey string
-
 func NewOrderProductDataSource(db *gorm.DB) port.OrderProductDataSource {
 	return &orderProductDataSource{db}
 }
 
-func (ds *orderProductDataSource) FindByID(ctx context.Context, orderId, productId uint64) (*entity.OrderProduct, error) {
+func (ds *orderProductDataSource) FindByID(ctx context.Context, id uint64) (*entity.OrderProduct, error) {
 	var orderProduct entity.OrderProduct
-	result := ds.db.WithContext(ctx).Preload("Order").Preload("Product").First(&orderProduct, "order_id = ? AND product_id = ?", orderId, productId)
+	result := dbFrom(ctx, ds.db).Preload("Order").Preload("Product").Preload("Modifiers").Preload("Components").First(&orderProduct, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
@@ -37,7 +37,7 @@ func (ds *orderProductDataSource) FindAll(ctx context.Context, filters map[strin
 	var orderProducts []*entity.OrderProduct
 	var total int64
 
-	query := ds.db.WithContext(ctx).Preload("Order").Preload("Product")
+	query := dbFrom(ctx, ds.db).Preload("Order").Preload("Product").Preload("Modifiers").Preload("Components")
 
 	// Apply filters
 	for key, value := range filters {
@@ -46,6 +46,10 @@ func (ds *orderProductDataSource) FindAll(ctx context.Context, filters map[strin
 			if orderID, ok := value.(uint64); ok && orderID != 0 {
 				query = query.Where("order_id = ?", orderID)
 			}
+		case "product_id":
+			if productID, ok := value.(uint64); ok && productID != 0 {
+				query = query.Where("product_id = ?", productID)
+			}
 		}
 	}
 
@@ -56,39 +60,59 @@ func (ds *orderProductDataSource) FindAll(ctx context.Context, filters map[strin
 
 	// Get paginated results
 	offset := (page - 1) * limit
-	if err := query.Offset(offset).Limit(limit).Find(&orderProducts).Error; err != nil {
+	if err := query.Order("id").Offset(offset).Limit(limit).Find(&orderProducts).Error; err != nil {
 		return nil, 0, fmt.Errorf("error finding orderProducts: %w", err)
 	}
 
 	return orderProducts, total, nil
 }
 
+// Create saves the line item and its modifiers
 func (ds *orderProductDataSource) Create(ctx context.Context, orderProduct *entity.OrderProduct) error {
-	if err := ds.db.WithContext(ctx).Create(orderProduct).Error; err != nil {
+	if err := dbFrom(ctx, ds.db).Omit("Order", "Product").Create(orderProduct).Error; err != nil {
 		return fmt.Errorf("error creating orderProduct: %w", err)
 	}
 
 	// Preload related entities
-	if err := ds.db.WithContext(ctx).Preload("Order").Preload("Product").First(orderProduct, "order_id = ? AND product_id = ?", orderProduct.OrderID, orderProduct.ProductID).Error; err != nil {
+	if err := dbFrom(ctx, ds.db).Preload("Order").Preload("Product").Preload("Modifiers").Preload("Components").First(orderProduct, orderProduct.ID).Error; err != nil {
 		return fmt.Errorf("error preloading orderProduct: %w", err)
 	}
 
 	return nil
 }
 
+// Update saves the quantity and notes of the line item, replacing its modifiers
 func (ds *orderProductDataSource) Update(ctx context.Context, orderProduct *entity.OrderProduct) error {
-	result := ds.db.WithContext(ctx).Model(orderProduct).Where("order_id = ? AND product_id = ?", orderProduct.OrderID, orderProduct.ProductID).Updates(orderProduct)
-	if result.Error != nil {
-		return fmt.Errorf("error updating orderProduct: %w", result.Error)
-	}
-	if result.RowsAffected == 0 {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(orderProduct).
+			Omit(clause.Associations).
+			Select("quantity", "notes", "updated_at").
+			Updates(orderProduct)
+		if result.Error != nil {
+			return fmt.Errorf("error updating orderProduct: %w", result.Error)
+		}
+
+		if err := replaceAssociation(tx.Model(orderProduct).Association("Modifiers"), orderProduct.Modifiers); err != nil {
+			return fmt.Errorf("error replacing orderProduct modifiers: %w", err)
+		}
+		if err := replaceAssociation(tx.Model(orderProduct).Association("Components"), orderProduct.Components); err != nil {
+			return fmt.Errorf("error replacing orderProduct components: %w", err)
+		}
 		return nil
+	})
+}
+
+// replaceAssociation deletes the current associated records and saves the given ones
+func replaceAssociation[T any](association *gorm.Association, values []T) error {
+	association = association.Unscoped()
+	if len(values) == 0 {
+		return association.Clear()
 	}
-	return nil
+	return association.Replace(values)
 }
 
-func (ds *orderProductDataSource) Delete(ctx context.Context, orderId, productId uint64) error {
-	result := ds.db.WithContext(ctx).Delete(&entity.OrderProduct{}, "order_id = ? AND product_id = ?", orderId, productId)
+func (ds *orderProductDataSource) Delete(ctx context.Context, id uint64) error {
+	result := dbFrom(ctx, ds.db).Delete(&entity.OrderProduct{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("error deleting orderProduct: %w", result.Error)
 	}
@@ -98,11 +122,26 @@ func (ds *orderProductDataSource) Delete(ctx context.Context, orderId, productId
 	return nil
 }
 
+// FindAllSold returns the line items of the orders placed in the period that were not cancelled or left open
+func (ds *orderProductDataSource) FindAllSold(ctx context.Context, from, to time.Time) ([]*entity.OrderProduct, error) {
+	var orderProducts []*entity.OrderProduct
+
+	err := dbFrom(ctx, ds.db).
+		Preload("Product").
+		Preload("Modifiers").
+		Preload("Components").
+		Joins("JOIN orders ON orders.id = order_products.order_id").
+		Where("orders.status NOT IN ?", []valueobject.OrderStatus{valueobject.OPEN, valueobject.CANCELLED}).
+		Where("orders.created_at >= ? AND orders.created_at < ?", from, to).
+		Order("order_products.id").
+		Find(&orderProducts).Error
+	if err != nil {
+		return nil, fmt.Errorf("error finding sold orderProducts: %w", err)
+	}
+
+	return orderProducts, nil
+}
+
 func (ds *orderProductDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return ds.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Create a new context with the transaction
-		keyPrincipalID := orderProductKey(uuid.NewString())
-		txCtx := context.WithValue(ctx, keyPrincipalID, tx)
-		return fn(txCtx)
-	})
+	return withTransaction(ctx, ds.db, fn)
 }
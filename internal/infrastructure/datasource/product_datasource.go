@@ -2,12 +2,16 @@ package datasource
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"time"
 
-	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 )
 
@@ -15,21 +19,20 @@ type productDataSource struct {
 	db *gorm.DB
 }
 
-type productKey string
-
 func NewProductDataSource(db *gorm.DB) port.ProductDataSource {
 	return &productDataSource{db}
 }
 
 func (ds *productDataSource) FindByID(ctx context.Context, id uint64) (*entity.Product, error) {
 	var product entity.Product
-	result := ds.db.WithContext(ctx).First(&product, id)
+	result := preloadProductAssociations(dbFrom(ctx, ds.db)).First(&product, id)
 	if result.Error != nil {
 		if result.Error == gorm.ErrRecordNotFound {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("error finding product: %w", result.Error)
 	}
+	resolveProductPrices(&product)
 	return &product, nil
 }
 
@@ -37,7 +40,7 @@ func (ds *productDataSource) FindAll(ctx context.Context, filters map[string]int
 	var products []*entity.Product
 	var total int64
 
-	query := ds.db.WithContext(ctx)
+	query := dbFrom(ctx, ds.db)
 
 	// Apply filters
 	for key, value := range filters {
@@ -50,6 +53,10 @@ func (ds *productDataSource) FindAll(ctx context.Context, filters map[string]int
 			if categoryID, ok := value.(uint64); ok && categoryID != 0 {
 				query = query.Where("category_id = ?", categoryID)
 			}
+		case "available_at":
+			if availableAt, ok := value.(time.Time); ok && !availableAt.IsZero() {
+				query = query.Where(availableAtCondition, sql.Named("at", availableAt.UTC()))
+			}
 		}
 	}
 
@@ -60,22 +67,45 @@ func (ds *productDataSource) FindAll(ctx context.Context, filters map[string]int
 
 	// Get paginated results
 	offset := (page - 1) * limit
-	if err := query.Offset(offset).Limit(limit).Find(&products).Error; err != nil {
+	if err := preloadProductAssociations(query).Offset(offset).Limit(limit).Find(&products).Error; err != nil {
 		return nil, 0, fmt.Errorf("error finding products: %w", err)
 	}
+	resolveProductPrices(products...)
 
 	return products, total, nil
 }
 
+func (ds *productDataSource) FindAllByCategoryIDs(ctx context.Context, categoryIDs []uint64) ([]*entity.Product, error) {
+	var products []*entity.Product
+	if len(categoryIDs) == 0 {
+		return products, nil
+	}
+	query := dbFrom(ctx, ds.db).Where("category_id IN ?", categoryIDs).Order("name, id")
+	if err := preloadProductAssociations(query).Find(&products).Error; err != nil {
+		return nil, fmt.Errorf("error finding products by categories: %w", err)
+	}
+	resolveProductPrices(products...)
+	return products, nil
+}
+
 func (ds *productDataSource) Create(ctx context.Context, product *entity.Product) error {
-	if err := ds.db.WithContext(ctx).Create(product).Error; err != nil {
+	if err := dbFrom(ctx, ds.db).Create(product).Error; err != nil {
 		return fmt.Errorf("error creating product: %w", err)
 	}
+
+	// Preload the component products of the bundle slots
+	if product.IsBundle() {
+		if err := preloadProductAssociations(dbFrom(ctx, ds.db)).First(product, product.ID).Error; err != nil {
+			return fmt.Errorf("error preloading product: %w", err)
+		}
+		resolveProductPrices(product)
+	}
+
 	return nil
 }
 
 func (ds *productDataSource) Update(ctx context.Context, product *entity.Product) error {
-	result := ds.db.WithContext(ctx).Save(product)
+	result := dbFrom(ctx, ds.db).Omit(clause.Associations).Save(product)
 	if result.Error != nil {
 		return fmt.Errorf("error updating product: %w", result.Error)
 	}
@@ -85,8 +115,130 @@ func (ds *productDataSource) Update(ctx context.Context, product *entity.Product
 	return nil
 }
 
+// ReplaceModifierGroups deletes the modifier groups of the product and creates the given ones
+func (ds *productDataSource) ReplaceModifierGroups(ctx context.Context, productID uint64, groups []entity.ProductModifierGroup) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.ProductModifierGroup{}).Error; err != nil {
+			return fmt.Errorf("error deleting product modifier groups: %w", err)
+		}
+		if len(groups) == 0 {
+			return nil
+		}
+		for i := range groups {
+			groups[i].ProductID = productID
+		}
+		if err := tx.Create(&groups).Error; err != nil {
+			return fmt.Errorf("error creating product modifier groups: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceBundleSlots deletes the bundle slots of the product and creates the given ones
+func (ds *productDataSource) ReplaceBundleSlots(ctx context.Context, productID uint64, slots []entity.ProductBundleSlot) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.ProductBundleSlot{}).Error; err != nil {
+			return fmt.Errorf("error deleting product bundle slots: %w", err)
+		}
+		if len(slots) == 0 {
+			return nil
+		}
+		for i := range slots {
+			slots[i].ProductID = productID
+		}
+		if err := tx.Create(&slots).Error; err != nil {
+			return fmt.Errorf("error creating product bundle slots: %w", err)
+		}
+		return nil
+	})
+}
+
+// ReplaceAvailabilityWindows deletes the availability windows of the product and creates the given ones
+func (ds *productDataSource) ReplaceAvailabilityWindows(ctx context.Context, productID uint64, windows []entity.ProductAvailabilityWindow) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).Delete(&entity.ProductAvailabilityWindow{}).Error; err != nil {
+			return fmt.Errorf("error deleting product availability windows: %w", err)
+		}
+		if len(windows) == 0 {
+			return nil
+		}
+		for i := range windows {
+			windows[i].ProductID = productID
+		}
+		if err := tx.Create(&windows).Error; err != nil {
+			return fmt.Errorf("error creating product availability windows: %w", err)
+		}
+		return nil
+	})
+}
+
+// UpdateStock adds the changes to the stock of the counted products in a single transaction, the products
+// are locked so concurrent orders can't take the same units. Nothing is changed when a product would end up
+// with a negative stock
+func (ds *productDataSource) UpdateStock(ctx context.Context, changes map[uint64]int64) ([]*entity.Product, error) {
+	ids := make([]uint64, 0, len(changes))
+	for id := range changes {
+		ids = append(ids, id)
+	}
+
+	var updated []*entity.Product
+	err := dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		var products []*entity.Product
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ? AND stock_mode = ?", ids, valueobject.StockCounted).
+			Order("id").
+			Find(&products).Error; err != nil {
+			return fmt.Errorf("error locking products stock: %w", err)
+		}
+
+		for _, product := range products {
+			quantity := product.StockQuantity + changes[product.ID]
+			if quantity < 0 {
+				return domain.NewInvalidInputError(domain.ErrProductOutOfStock)
+			}
+			if err := tx.Model(product).Update("stock_quantity", quantity).Error; err != nil {
+				return fmt.Errorf("error updating product stock: %w", err)
+			}
+			product.StockQuantity = quantity
+		}
+
+		updated = products
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// FindPrices returns the price history of the product, the latest prices first
+func (ds *productDataSource) FindPrices(ctx context.Context, productID uint64, page, limit int) ([]*entity.ProductPrice, int64, error) {
+	var prices []*entity.ProductPrice
+	var total int64
+
+	query := dbFrom(ctx, ds.db).Model(&entity.ProductPrice{}).Where("product_id = ?", productID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting product prices: %w", err)
+	}
+
+	offset := (page - 1) * limit
+	if err := query.Order("effective_from DESC, id DESC").Offset(offset).Limit(limit).Find(&prices).Error; err != nil {
+		return nil, 0, fmt.Errorf("error finding product prices: %w", err)
+	}
+
+	return prices, total, nil
+}
+
+// SchedulePrice saves the price, trimming the records of the product that overlap its range
+func (ds *productDataSource) SchedulePrice(ctx context.Context, price *entity.ProductPrice) error {
+	return dbFrom(ctx, ds.db).Transaction(func(tx *gorm.DB) error {
+		return schedulePrice(tx, price)
+	})
+}
+
 func (ds *productDataSource) Delete(ctx context.Context, id uint64) error {
-	result := ds.db.WithContext(ctx).Delete(&entity.Product{}, id)
+	result := dbFrom(ctx, ds.db).Delete(&entity.Product{}, id)
 	if result.Error != nil {
 		return fmt.Errorf("error deleting product: %w", result.Error)
 	}
@@ -97,10 +249,89 @@ func (ds *productDataSource) Delete(ctx context.Context, id uint64) error {
 }
 
 func (ds *productDataSource) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
-	return ds.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Create a new context with the transaction
-		keyPrincipalID := productKey(uuid.NewString())
-		txCtx := context.WithValue(ctx, keyPrincipalID, tx)
-		return fn(txCtx)
-	})
+	return withTransaction(ctx, ds.db, fn)
+}
+
+// preloadProductAssociations loads the modifier groups, bundle slots and availability windows of the products, the first option of a slot is its default
+func preloadProductAssociations(query *gorm.DB) *gorm.DB {
+	byID := func(db *gorm.DB) *gorm.DB { return db.Order("id") }
+	return query.
+		Preload("ModifierGroups", byID).
+		Preload("ModifierGroups.Modifiers", byID).
+		Preload("BundleSlots", byID).
+		Preload("BundleSlots.Options", byID).
+		Preload("BundleSlots.Options.ComponentProduct").
+		Preload("BundleSlots.Options.ComponentProduct.Prices", currentProductPrices).
+		Preload("AvailabilityWindows", func(db *gorm.DB) *gorm.DB { return db.Order("weekday, start_time, id") }).
+		Preload("Prices", currentProductPrices)
+}
+
+// currentProductPrices keeps the current and the scheduled prices, the history is read apart
+func currentProductPrices(db *gorm.DB) *gorm.DB {
+	return db.Where("effective_to IS NULL OR effective_to > ?", time.Now()).Order("effective_from")
 }
+
+// resolveProductPrices sets the price of the products and their bundle components to the record effective now
+func resolveProductPrices(products ...*entity.Product) {
+	now := time.Now()
+	for _, product := range products {
+		product.ResolvePrice(now)
+		for i := range product.BundleSlots {
+			for j := range product.BundleSlots[i].Options {
+				product.BundleSlots[i].Options[j].ComponentProduct.ResolvePrice(now)
+			}
+		}
+	}
+}
+
+// schedulePrice saves the price on the transaction, the product is locked so concurrent changes
+// can't create overlapping records. The stored price of the product follows the price effective now
+func schedulePrice(tx *gorm.DB, price *entity.ProductPrice) error {
+	var product entity.Product
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&product, price.ProductID).Error; err != nil {
+		return fmt.Errorf("error locking product prices: %w", err)
+	}
+
+	var current []*entity.ProductPrice
+	if err := tx.Where("product_id = ? AND (effective_to IS NULL OR effective_to > ?)", price.ProductID, price.EffectiveFrom).
+		Order("effective_from").
+		Find(&current).Error; err != nil {
+		return fmt.Errorf("error finding product prices: %w", err)
+	}
+
+	changes := entity.ScheduleProductPrice(current, price)
+	if len(changes.Delete) > 0 {
+		if err := tx.Delete(&entity.ProductPrice{}, changes.Delete).Error; err != nil {
+			return fmt.Errorf("error deleting product prices: %w", err)
+		}
+	}
+	for _, record := range changes.Save {
+		if err := tx.Save(record).Error; err != nil {
+			return fmt.Errorf("error saving product price: %w", err)
+		}
+	}
+
+	if price.IsEffectiveAt(time.Now()) {
+		if err := tx.Model(&product).Update("price", price.Price).Error; err != nil {
+			return fmt.Errorf("error updating product price: %w", err)
+		}
+	}
+	return nil
+}
+
+// availabilityWindowMatch is the SQL version of entity.AvailabilityWindow.Contains for a window aliased as w,
+// the moment is converted to the timezone of each window
+const availabilityWindowMatch = `(
+	(EXTRACT(DOW FROM @at::timestamptz AT TIME ZONE w.timezone) = w.weekday
+		AND to_char(@at::timestamptz AT TIME ZONE w.timezone, 'HH24:MI') >= w.start_time
+		AND (w.start_time > w.end_time OR to_char(@at::timestamptz AT TIME ZONE w.timezone, 'HH24:MI') < w.end_time))
+	OR (w.start_time > w.end_time
+		AND EXTRACT(DOW FROM (@at::timestamptz AT TIME ZONE w.timezone) - INTERVAL '1 day') = w.weekday
+		AND to_char(@at::timestamptz AT TIME ZONE w.timezone, 'HH24:MI') < w.end_time))`
+
+// availableAtCondition keeps the products whose own windows and the windows of their category are open at the moment,
+// products and categories without windows are always available
+const availableAtCondition = `(NOT EXISTS (SELECT 1 FROM product_availability_windows w WHERE w.product_id = products.id)
+	OR EXISTS (SELECT 1 FROM product_availability_windows w WHERE w.product_id = products.id AND ` + availabilityWindowMatch + `))
+AND (NOT EXISTS (SELECT 1 FROM category_availability_windows w WHERE w.category_id = products.category_id)
+	OR EXISTS (SELECT 1 FROM category_availability_windows w WHERE w.category_id = products.category_id AND ` + availabilityWindowMatch + `))`
@@ -0,0 +1,137 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type promotionDataSource struct {
+	db *gorm.DB
+}
+
+func NewPromotionDataSource(db *gorm.DB) port.PromotionDataSource {
+	return &promotionDataSource{db}
+}
+
+func (ds *promotionDataSource) FindByID(ctx context.Context, id uint64) (*entity.Promotion, error) {
+	var promotion entity.Promotion
+	result := dbFrom(ctx, ds.db).First(&promotion, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding promotion: %w", result.Error)
+	}
+	return &promotion, nil
+}
+
+func (ds *promotionDataSource) FindByCode(ctx context.Context, code string) (*entity.Promotion, error) {
+	var promotion entity.Promotion
+	result := dbFrom(ctx, ds.db).Where("code = ?", code).First(&promotion)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding promotion: %w", result.Error)
+	}
+	return &promotion, nil
+}
+
+func (ds *promotionDataSource) FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.Promotion, int64, error) {
+	var promotions []*entity.Promotion
+	var total int64
+
+	query := dbFrom(ctx, ds.db)
+
+	// Apply filters
+	for key, value := range filters {
+		switch key {
+		case "name":
+			if name, ok := value.(string); ok && name != "" {
+				query = query.Where("name LIKE ?", "%"+name+"%")
+			}
+		}
+	}
+
+	// Count total before pagination
+	if err := query.Model(&entity.Promotion{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting promotions: %w", err)
+	}
+
+	// Get paginated results
+	offset := (page - 1) * limit
+	if err := query.Order("id").Offset(offset).Limit(limit).Find(&promotions).Error; err != nil {
+		return nil, 0, fmt.Errorf("error finding promotions: %w", err)
+	}
+
+	return promotions, total, nil
+}
+
+// FindAllAutomatic returns the active promotions without a coupon code
+func (ds *promotionDataSource) FindAllAutomatic(ctx context.Context) ([]*entity.Promotion, error) {
+	var promotions []*entity.Promotion
+	if err := dbFrom(ctx, ds.db).
+		Where("active = ? AND (code IS NULL OR code = '')", true).
+		Order("id").
+		Find(&promotions).Error; err != nil {
+		return nil, fmt.Errorf("error finding automatic promotions: %w", err)
+	}
+	return promotions, nil
+}
+
+// usedPromotionStatuses are the statuses of the orders that used their promotions, the orders not paid yet
+// can still be abandoned and the cancelled ones gave them back
+var usedPromotionStatuses = []valueobject.OrderStatus{
+	valueobject.RECEIVED,
+	valueobject.PREPARING,
+	valueobject.READY,
+	valueobject.OUT_FOR_DELIVERY,
+	valueobject.COMPLETED,
+	valueobject.DELIVERED,
+}
+
+// CountCustomerUsage counts the paid orders of the customer discounted by the promotion, the order
+// being priced is not counted
+func (ds *promotionDataSource) CountCustomerUsage(ctx context.Context, promotionID, customerID, excludeOrderID uint64) (int64, error) {
+	var total int64
+	if err := dbFrom(ctx, ds.db).
+		Model(&entity.OrderDiscount{}).
+		Joins("JOIN orders ON orders.id = order_discounts.order_id").
+		Where("order_discounts.promotion_id = ?", promotionID).
+		Where("orders.customer_id = ?", customerID).
+		Where("orders.id <> ?", excludeOrderID).
+		Where("orders.status IN ?", usedPromotionStatuses).
+		Count(&total).Error; err != nil {
+		return 0, fmt.Errorf("error counting promotion usage: %w", err)
+	}
+	return total, nil
+}
+
+func (ds *promotionDataSource) Create(ctx context.Context, promotion *entity.Promotion) error {
+	if err := dbFrom(ctx, ds.db).Create(promotion).Error; err != nil {
+		return fmt.Errorf("error creating promotion: %w", err)
+	}
+	return nil
+}
+
+func (ds *promotionDataSource) Update(ctx context.Context, promotion *entity.Promotion) error {
+	result := dbFrom(ctx, ds.db).Save(promotion)
+	if result.Error != nil {
+		return fmt.Errorf("error updating promotion: %w", result.Error)
+	}
+	return nil
+}
+
+func (ds *promotionDataSource) Delete(ctx context.Context, id uint64) error {
+	result := dbFrom(ctx, ds.db).Delete(&entity.Promotion{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error deleting promotion: %w", result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package datasource
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txKey is the context key of the transaction opened by withTransaction
+type txKey struct{}
+
+// dbFrom returns the transaction of the context when there's one, so the datasources
+// called inside a Transaction take part in it, otherwise the database
+func dbFrom(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
+
+// withTransaction runs fn in a transaction carried by its context, it joins the transaction
+// of the context when there's one already
+func withTransaction(ctx context.Context, db *gorm.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
@@ -0,0 +1,112 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookDeliveryDataSource struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryDataSource(db *gorm.DB) port.WebhookDeliveryDataSource {
+	return &webhookDeliveryDataSource{db}
+}
+
+func (ds *webhookDeliveryDataSource) FindByID(ctx context.Context, id uint64) (*entity.WebhookDelivery, error) {
+	var delivery entity.WebhookDelivery
+	result := dbFrom(ctx, ds.db).Preload("Subscription").First(&delivery, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding webhook delivery: %w", result.Error)
+	}
+	return &delivery, nil
+}
+
+func (ds *webhookDeliveryDataSource) FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.WebhookDelivery, int64, error) {
+	var deliveries []*entity.WebhookDelivery
+	var total int64
+
+	query := dbFrom(ctx, ds.db)
+
+	// Apply filters
+	for key, value := range filters {
+		switch key {
+		case "subscription_id":
+			query = query.Where("subscription_id = ?", value)
+		case "status":
+			query = query.Where("status = ?", value)
+		}
+	}
+
+	// Count total before pagination
+	if err := query.Model(&entity.WebhookDelivery{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting webhook deliveries: %w", err)
+	}
+
+	// Get paginated results, the latest deliveries first
+	offset := (page - 1) * limit
+	if err := query.Order("id DESC").Offset(offset).Limit(limit).Find(&deliveries).Error; err != nil {
+		return nil, 0, fmt.Errorf("error finding webhook deliveries: %w", err)
+	}
+
+	return deliveries, total, nil
+}
+
+// ClaimDue moves the next attempt of the due deliveries to the end of the lease in a single statement,
+// the locked rows are skipped so concurrent dispatchers never claim the same delivery
+func (ds *webhookDeliveryDataSource) ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.WebhookDelivery, error) {
+	var ids []uint64
+	err := dbFrom(ctx, ds.db).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at, id
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id`,
+		now.Add(lease), valueobject.DeliveryPending, now, limit,
+	).Scan(&ids).Error
+	if err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var deliveries []*entity.WebhookDelivery
+	if err := dbFrom(ctx, ds.db).Preload("Subscription").Where("id IN ?", ids).Order("id").Find(&deliveries).Error; err != nil {
+		return nil, fmt.Errorf("error finding claimed webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+func (ds *webhookDeliveryDataSource) Create(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	if err := dbFrom(ctx, ds.db).Omit("Subscription").Create(delivery).Error; err != nil {
+		return fmt.Errorf("error creating webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// Update saves the outcome of the delivery, the subscription is saved by its own datasource
+func (ds *webhookDeliveryDataSource) Update(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	result := dbFrom(ctx, ds.db).
+		Model(delivery).
+		Select("status", "attempts", "next_attempt_at", "response_status", "last_error", "delivered_at", "updated_at").
+		Updates(delivery)
+	if result.Error != nil {
+		return fmt.Errorf("error updating webhook delivery: %w", result.Error)
+	}
+	return nil
+}
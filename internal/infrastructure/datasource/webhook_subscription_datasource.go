@@ -0,0 +1,94 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type webhookSubscriptionDataSource struct {
+	db *gorm.DB
+}
+
+func NewWebhookSubscriptionDataSource(db *gorm.DB) port.WebhookSubscriptionDataSource {
+	return &webhookSubscriptionDataSource{db}
+}
+
+func (ds *webhookSubscriptionDataSource) FindByID(ctx context.Context, id uint64) (*entity.WebhookSubscription, error) {
+	var subscription entity.WebhookSubscription
+	result := dbFrom(ctx, ds.db).First(&subscription, id)
+	if result.Error != nil {
+		if result.Error == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error finding webhook subscription: %w", result.Error)
+	}
+	return &subscription, nil
+}
+
+func (ds *webhookSubscriptionDataSource) FindAll(ctx context.Context, filters map[string]interface{}, page, limit int) ([]*entity.WebhookSubscription, int64, error) {
+	var subscriptions []*entity.WebhookSubscription
+	var total int64
+
+	query := dbFrom(ctx, ds.db)
+
+	// Apply filters
+	for key, value := range filters {
+		switch key {
+		case "active":
+			if active, ok := value.(bool); ok {
+				query = query.Where("active = ?", active)
+			}
+		}
+	}
+
+	// Count total before pagination
+	if err := query.Model(&entity.WebhookSubscription{}).Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("error counting webhook subscriptions: %w", err)
+	}
+
+	// Get paginated results
+	offset := (page - 1) * limit
+	if err := query.Order("id").Offset(offset).Limit(limit).Find(&subscriptions).Error; err != nil {
+		return nil, 0, fmt.Errorf("error finding webhook subscriptions: %w", err)
+	}
+
+	return subscriptions, total, nil
+}
+
+// FindAllActive returns the subscriptions that receive the events, the event types and filters are matched by the caller
+func (ds *webhookSubscriptionDataSource) FindAllActive(ctx context.Context) ([]*entity.WebhookSubscription, error) {
+	var subscriptions []*entity.WebhookSubscription
+	if err := dbFrom(ctx, ds.db).Where("active = ?", true).Order("id").Find(&subscriptions).Error; err != nil {
+		return nil, fmt.Errorf("error finding active webhook subscriptions: %w", err)
+	}
+	return subscriptions, nil
+}
+
+func (ds *webhookSubscriptionDataSource) Create(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	if err := dbFrom(ctx, ds.db).Create(subscription).Error; err != nil {
+		return fmt.Errorf("error creating webhook subscription: %w", err)
+	}
+	return nil
+}
+
+func (ds *webhookSubscriptionDataSource) Update(ctx context.Context, subscription *entity.WebhookSubscription) error {
+	result := dbFrom(ctx, ds.db).Save(subscription)
+	if result.Error != nil {
+		return fmt.Errorf("error updating webhook subscription: %w", result.Error)
+	}
+	return nil
+}
+
+// Delete deletes the subscription, its deliveries are deleted by the database
+func (ds *webhookSubscriptionDataSource) Delete(ctx context.Context, id uint64) error {
+	result := dbFrom(ctx, ds.db).Delete(&entity.WebhookSubscription{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("error deleting webhook subscription: %w", result.Error)
+	}
+	return nil
+}
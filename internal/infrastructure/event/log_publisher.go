@@ -0,0 +1,22 @@
+package event
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+type logPublisher struct {
+	logger *logger.Logger
+}
+
+// NewLogPublisher creates a publisher that only logs the events, used when no queue is configured
+func NewLogPublisher(logger *logger.Logger) port.EventPublisher {
+	return &logPublisher{logger}
+}
+
+func (p *logPublisher) Publish(_ context.Context, eventType string, payload any) error {
+	p.logger.Info("Event published", "type", eventType, "payload", payload)
+	return nil
+}
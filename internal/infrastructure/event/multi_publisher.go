@@ -0,0 +1,28 @@
+package event
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+type multiPublisher struct {
+	publishers []port.EventPublisher
+}
+
+// NewMultiPublisher creates a publisher that sends the events to all the publishers,
+// a failure of one of them doesn't keep the event from the others
+func NewMultiPublisher(publishers ...port.EventPublisher) port.EventPublisher {
+	return &multiPublisher{publishers}
+}
+
+func (p *multiPublisher) Publish(ctx context.Context, eventType string, payload any) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, eventType, payload); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,33 @@
+package event
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+type notificationPublisher struct {
+	useCase port.NotificationUseCase
+	logger  *logger.Logger
+}
+
+// NewNotificationPublisher creates a publisher that notifies the customers of the order events,
+// the other events are ignored
+func NewNotificationPublisher(useCase port.NotificationUseCase, logger *logger.Logger) port.EventPublisher {
+	return &notificationPublisher{useCase, logger}
+}
+
+func (p *notificationPublisher) Publish(ctx context.Context, eventType string, payload any) error {
+	event, ok := payload.(entity.OrderEvent)
+	if !ok {
+		return nil
+	}
+
+	if _, err := p.useCase.Notify(ctx, event); err != nil {
+		p.logger.Error("Failed to notify customer", "type", eventType, "orderID", event.OrderID, "customerID", event.CustomerID, "error", err.Error())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/aws/sqs"
+)
+
+// Message is the envelope of the events sent to the queue
+type Message struct {
+	Type       string    `json:"type"`
+	Payload    any       `json:"payload"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type sqsPublisher struct {
+	client   *sqs.SqsClient
+	queueURL string
+	logger   *logger.Logger
+}
+
+// NewSqsPublisher creates a publisher that sends the events to a SQS queue
+func NewSqsPublisher(client *sqs.SqsClient, queueURL string, logger *logger.Logger) port.EventPublisher {
+	return &sqsPublisher{client, queueURL, logger}
+}
+
+func (p *sqsPublisher) Publish(ctx context.Context, eventType string, payload any) error {
+	body, err := json.Marshal(Message{Type: eventType, Payload: payload, OccurredAt: time.Now().UTC()})
+	if err != nil {
+		p.logger.Error("Failed to serialize event", "type", eventType, "error", err.Error())
+		return fmt.Errorf("failed to serialize event %s: %w", eventType, err)
+	}
+
+	if _, err := p.client.SendMessage(ctx, p.queueURL, string(body)); err != nil {
+		p.logger.Error("Failed to publish event", "type", eventType, "error", err.Error())
+		return err
+	}
+	return nil
+}
+
+// NewPublisher creates the SQS publisher when the events queue is configured, otherwise the events are only logged
+func NewPublisher(ctx context.Context, queueURL string, logger *logger.Logger) port.EventPublisher {
+	if queueURL == "" {
+		return NewLogPublisher(logger)
+	}
+
+	client, err := sqs.NewSqsClient(ctx)
+	if err != nil {
+		logger.Error("Failed to create SQS client, events will only be logged", "error", err.Error())
+		return NewLogPublisher(logger)
+	}
+	return NewSqsPublisher(client, queueURL, logger)
+}
@@ -0,0 +1,33 @@
+package event
+
+import (
+	"context"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+type webhookPublisher struct {
+	useCase port.WebhookDeliveryUseCase
+	logger  *logger.Logger
+}
+
+// NewWebhookPublisher creates a publisher that enqueues the order events to the webhook subscribers,
+// the other events are ignored
+func NewWebhookPublisher(useCase port.WebhookDeliveryUseCase, logger *logger.Logger) port.EventPublisher {
+	return &webhookPublisher{useCase, logger}
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, eventType string, payload any) error {
+	event, ok := payload.(entity.OrderEvent)
+	if !ok {
+		return nil
+	}
+
+	if _, err := p.useCase.Enqueue(ctx, event); err != nil {
+		p.logger.Error("Failed to enqueue webhook deliveries", "type", eventType, "orderID", event.OrderID, "error", err.Error())
+		return err
+	}
+	return nil
+}
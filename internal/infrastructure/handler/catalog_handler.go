@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type CatalogHandler struct {
+	controller port.CatalogController
+}
+
+func NewCatalogHandler(controller port.CatalogController) *CatalogHandler {
+	return &CatalogHandler{controller}
+}
+
+func (h *CatalogHandler) Register(router *gin.RouterGroup) {
+	router.POST("/import", h.Import)
+	router.GET("/export", h.Export)
+}
+
+// Import godoc
+//
+//	@Summary		Import catalog
+//	@Description	Upserts the categories and products of the file in a single transaction, matching them by name
+//	@Description	The file can be JSON, XML or CSV (Content-Type header: application/json, text/xml or text/csv), as returned by the export
+//	@Description	Nothing is written when a row is invalid or on a dry run, the validation errors of the rows are listed on the report
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			catalog
+//	@Accept			json,xml,text/csv
+//	@Produce		json,xml
+//	@Param			dry_run	query		bool							false	"Validate and report the changes without writing them"
+//	@Param			catalog	body		request.CatalogBodyRequest		true	"Catalog file"
+//	@Success		200		{object}	presenter.CatalogImportJsonResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/catalog/import [post]
+func (h *CatalogHandler) Import(c *gin.Context) {
+	var query request.ImportCatalogQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	rows, err := DecodeCatalog(c.ContentType(), c.Request.Body)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	input := dto.ImportCatalogInput{Rows: rows, DryRun: query.DryRun}
+
+	p, contentType := selectCatalogOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Import(c.Request.Context(), p, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Export godoc
+//
+//	@Summary		Export catalog
+//	@Description	Returns all the categories and products as rows of the catalog file, ready to be imported back
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			catalog
+//	@Produce		json,xml,text/csv
+//	@Success		200	{object}	presenter.CatalogJsonResponse	"OK"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/catalog/export [get]
+func (h *CatalogHandler) Export(c *gin.Context) {
+	p, contentType := selectCatalogExportOutputConfigs(c.GetHeader("Accept"))
+
+	output, err := h.controller.Export(c.Request.Context(), p)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+func selectCatalogOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewCatalogJsonPresenter(),
+		xml:  presenter.NewCatalogXmlPresenter(),
+	})
+}
+
+func selectCatalogExportOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewCatalogJsonPresenter(),
+		xml:  presenter.NewCatalogXmlPresenter(),
+		csv:  presenter.NewCatalogCsvPresenter(),
+	})
+}
+
+// DecodeCatalog reads the rows of a catalog file by its content type, JSON is the default.
+// It's shared with the catalog CLI, so the files are read the same way on both
+func DecodeCatalog(contentType string, r io.Reader) ([]dto.CatalogRowInput, error) {
+	body := &request.CatalogBodyRequest{}
+	switch contentType {
+	case "text/csv":
+		parsed, err := request.ParseCatalogCsv(r)
+		if err != nil {
+			return nil, domain.NewInvalidInputError(err.Error())
+		}
+		body = parsed
+	case "text/xml", "application/xml":
+		if err := xml.NewDecoder(r).Decode(body); err != nil {
+			return nil, domain.NewInvalidInputError(domain.ErrInvalidBody)
+		}
+	default:
+		if err := json.NewDecoder(r).Decode(body); err != nil {
+			return nil, domain.NewInvalidInputError(domain.ErrInvalidBody)
+		}
+	}
+	return toCatalogRowsInput(body.Products), nil
+}
+
+// toCatalogRowsInput converts the catalog rows request to the dto input, numbering the rows from 1
+func toCatalogRowsInput(products []request.CatalogProductRequest) []dto.CatalogRowInput {
+	output := make([]dto.CatalogRowInput, len(products))
+	for i, product := range products {
+		output[i] = dto.CatalogRowInput{
+			Row:            i + 1,
+			Category:       product.Category,
+			ParentCategory: product.ParentCategory,
+			Name:           product.Name,
+			Description:    product.Description,
+			Price:          product.Price,
+			StockMode:      product.StockMode,
+			StockQuantity:  product.StockQuantity,
+			Available:      product.Available,
+		}
+	}
+	return output
+}
@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type CatalogHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.CatalogHandler
+	router         *gin.Engine
+	mockController *mockport.MockCatalogController
+	ctx            context.Context
+	responses      map[string]string // Golden files
+}
+
+func (s *CatalogHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockCatalogController(ctrl)
+	s.handler = handler.NewCatalogHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.POST("/catalog/import", s.handler.Import)
+	s.router.GET("/catalog/export", s.handler.Export)
+
+	// Mock responses
+	var err error
+	s.responses, err = util.ReadGoldenFiles("catalog",
+		"import_report", "import_report_xml",
+		"export_success", "export_success_xml", "export_success_csv",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestCatalogHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(CatalogHandlerSuiteTest))
+}
@@ -0,0 +1,241 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *CatalogHandlerSuiteTest) TestCatalogHandler_Import() {
+	quantity := int64(10)
+	available := false
+	expectedRows := []dto.CatalogRowInput{
+		{Row: 1, Category: "Burgers", ParentCategory: "Foods", Name: "X-Burger", Description: "Hamburger with cheese", Price: 25.9},
+		{Row: 2, Category: "Beverages", Name: "Coca-Cola 350ml", Price: 6.9, StockMode: "COUNTED", StockQuantity: &quantity, Available: &available},
+		{Row: 3, Category: "Desserts"},
+	}
+
+	tests := []struct {
+		name        string
+		query       string
+		contentType string
+		accept      string
+		body        string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:        "success - json",
+			contentType: "application/json",
+			accept:      "application/json",
+			body: `{"products": [
+				{"category": "Burgers", "parent_category": "Foods", "name": "X-Burger", "description": "Hamburger with cheese", "price": 25.9},
+				{"category": "Beverages", "name": "Coca-Cola 350ml", "price": 6.9, "stock_mode": "COUNTED", "stock_quantity": 10, "available": false},
+				{"category": "Desserts"}
+			]}`,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Import(gomock.Any(), gomock.Any(), dto.ImportCatalogInput{Rows: expectedRows}).
+					Return([]byte(s.responses["import_report"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["import_report"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:        "success - csv dry run",
+			query:       "?dry_run=true",
+			contentType: "text/csv",
+			accept:      "application/json",
+			body: "category,parent_category,name,description,price,stock_mode,stock_quantity,available\n" +
+				"Burgers,Foods,X-Burger,Hamburger with cheese,25.90,,,\n" +
+				"Beverages,,Coca-Cola 350ml,,6.90,COUNTED,10,false\n" +
+				"Desserts,,,,,,,\n",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Import(gomock.Any(), gomock.Any(), dto.ImportCatalogInput{Rows: expectedRows, DryRun: true}).
+					Return([]byte(s.responses["import_report"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["import_report"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:        "success - xml",
+			contentType: "text/xml",
+			accept:      "text/xml",
+			body: `<catalog><products>
+				<product><category>Burgers</category><parent_category>Foods</parent_category><name>X-Burger</name><description>Hamburger with cheese</description><price>25.9</price></product>
+				<product><category>Beverages</category><name>Coca-Cola 350ml</name><price>6.9</price><stock_mode>COUNTED</stock_mode><stock_quantity>10</stock_quantity><available>false</available></product>
+				<product><category>Desserts</category></product>
+			</products></catalog>`,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Import(gomock.Any(), gomock.Any(), dto.ImportCatalogInput{Rows: expectedRows}).
+					Return([]byte(s.responses["import_report_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["import_report_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:        "invalid csv row",
+			contentType: "text/csv",
+			accept:      "application/json",
+			body:        "category,name,price\nBurgers,X-Burger,cheap\n",
+			setupMocks:  func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), "catalog csv row 1: price must be a number")
+			},
+		},
+		{
+			name:        "invalid body",
+			contentType: "application/json",
+			accept:      "application/json",
+			body:        `{"products": "all"}`,
+			setupMocks:  func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:        "invalid dry run",
+			query:       "?dry_run=maybe",
+			contentType: "application/json",
+			accept:      "application/json",
+			body:        `{"products": []}`,
+			setupMocks:  func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrInvalidQueryParams)
+			},
+		},
+		{
+			name:        "internal error",
+			contentType: "application/json",
+			accept:      "application/json",
+			body:        `{"products": [{"category": "Desserts"}]}`,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Import(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/catalog/import"+tt.query, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			req.Header.Set("Accept", tt.accept)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *CatalogHandlerSuiteTest) TestCatalogHandler_Export() {
+	tests := []struct {
+		name        string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - json",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Export(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["export_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["export_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - xml",
+			accept: "text/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Export(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["export_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["export_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - csv",
+			accept: "text/csv",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Export(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["export_success_csv"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["export_success_csv"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "internal error",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Export(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/catalog/export", nil)
+			req.Header.Set("Accept", tt.accept)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
@@ -32,9 +32,10 @@ func (h *CategoryHandler) Register(router *gin.RouterGroup) {
 //
 //	@Summary		List categories
 //	@Description	List all categories
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
 //	@Tags			category
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml,text/csv
 //	@Param			page	query		int										false	"Page number"		default(1)
 //	@Param			limit	query		int										false	"Items per page"	default(10)
 //	@Success		200		{object}	presenter.CategoryJsonPaginatedResponse	"OK"
@@ -54,9 +55,10 @@ func (h *CategoryHandler) List(c *gin.Context) {
 		Limit: query.Limit,
 	}
 
+	p, contentType := selectCategoryListOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.List(
 		c.Request.Context(),
-		presenter.NewCategoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -64,16 +66,17 @@ func (h *CategoryHandler) List(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Create godoc
 //
 //	@Summary		Create category
-//	@Description	Creates a new category
+//	@Description	Creates a new category, categories are active unless stated otherwise
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			category
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			category	body		request.CreateCategoryBodyRequest	true	"Category data"
 //	@Success		201			{object}	presenter.CategoryJsonResponse		"Created"
 //	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
@@ -87,12 +90,19 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 	}
 
 	input := dto.CreateCategoryInput{
-		Name: body.Name,
+		Name:                body.Name,
+		ParentID:            body.ParentID,
+		DisplayOrder:        body.DisplayOrder,
+		Active:              body.Active == nil || *body.Active,
+		ImageURL:            body.ImageURL,
+		KitchenStation:      toKitchenStation(body.KitchenStation),
+		AvailabilityWindows: toAvailabilityWindowsInput(body.Availability),
 	}
 
+	p, contentType := selectCategoryOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Create(
 		c.Request.Context(),
-		presenter.NewCategoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -100,16 +110,17 @@ func (h *CategoryHandler) Create(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusCreated, "application/json", output)
+	c.Data(http.StatusCreated, contentType, output)
 }
 
 // Get godoc
 //
 //	@Summary		Get category
 //	@Description	Search for a category by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			category
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id	path		int								true	"Category ID"
 //	@Success		200	{object}	presenter.CategoryJsonResponse	"OK"
 //	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
@@ -127,9 +138,10 @@ func (h *CategoryHandler) Get(c *gin.Context) {
 		ID: uri.ID,
 	}
 
+	p, contentType := selectCategoryOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Get(
 		c.Request.Context(),
-		presenter.NewCategoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -137,16 +149,17 @@ func (h *CategoryHandler) Get(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Update godoc
 //
 //	@Summary		Update category
-//	@Description	Update an existing category
+//	@Description	Update an existing category, a category without parent is shown on the top level of the menu
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			category
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id			path		int									true	"Category ID"
 //	@Param			category	body		request.UpdateCategoryBodyRequest	true	"Category data"
 //	@Success		200			{object}	presenter.CategoryJsonResponse		"OK"
@@ -168,13 +181,20 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 	}
 
 	input := dto.UpdateCategoryInput{
-		ID:   uri.ID,
-		Name: body.Name,
+		ID:                  uri.ID,
+		Name:                body.Name,
+		ParentID:            body.ParentID,
+		DisplayOrder:        body.DisplayOrder,
+		Active:              body.Active == nil || *body.Active,
+		ImageURL:            body.ImageURL,
+		KitchenStation:      toKitchenStation(body.KitchenStation),
+		AvailabilityWindows: toAvailabilityWindowsInput(body.Availability),
 	}
 
+	p, contentType := selectCategoryOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Update(
 		c.Request.Context(),
-		presenter.NewCategoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -182,15 +202,16 @@ func (h *CategoryHandler) Update(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Delete godoc
 //
 //	@Summary		Delete category
 //	@Description	Deletes a category by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			category
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id	path		int								true	"Category ID"
 //	@Success		200	{object}	presenter.CategoryJsonResponse	"OK"
 //	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
@@ -208,9 +229,10 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 		ID: uri.ID,
 	}
 
+	p, contentType := selectCategoryOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Delete(
 		c.Request.Context(),
-		presenter.NewCategoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -218,5 +240,20 @@ func (h *CategoryHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+func selectCategoryOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewCategoryJsonPresenter(),
+		xml:  presenter.NewCategoryXmlPresenter(),
+	})
+}
+
+func selectCategoryListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewCategoryJsonPresenter(),
+		xml:  presenter.NewCategoryXmlPresenter(),
+		csv:  presenter.NewCategoryCsvPresenter(),
+	})
 }
@@ -51,7 +51,7 @@ func (s *CategoryHandlerSuiteTest) SetupTest() {
 
 	// Mock responses
 	s.responses, err = util.ReadGoldenFiles("category",
-		"list_success", "list_success_with_query",
+		"list_success", "list_success_with_query", "list_success_xml", "list_success_csv",
 		"create_success",
 		"update_success",
 		"get_success",
@@ -17,6 +17,7 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_List() {
 	tests := []struct {
 		name        string
 		url         string
+		accept      string
 		setupMocks  func()
 		checkResult func(*testing.T, *httptest.ResponseRecorder)
 	}{
@@ -49,6 +50,32 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_List() {
 				assert.Contains(t, res.Body.String(), s.responses["list_success_with_query"])
 			},
 		},
+		{
+			name:   "success - xml",
+			url:    "/categories",
+			accept: "application/json;q=0.5, application/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListCategoriesInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - csv",
+			url:    "/categories",
+			accept: "text/csv",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListCategoriesInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_csv"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_csv"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
 		{
 			name:       "invalid query - page",
 			url:        "/categories?page=invalid",
@@ -80,6 +107,9 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_List() {
 			tt.setupMocks()
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
 
 			// Act
 			s.router.ServeHTTP(w, req)
@@ -238,8 +268,9 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_Update() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Update(gomock.Any(), gomock.Any(), dto.UpdateCategoryInput{
-						ID:   5,
-						Name: "Foods UPDATED",
+						ID:     5,
+						Name:   "Foods UPDATED",
+						Active: true,
 					}).
 					Return([]byte(s.responses["update_success"]), nil)
 			},
@@ -285,8 +316,9 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_Update() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Update(gomock.Any(), gomock.Any(), dto.UpdateCategoryInput{
-						ID:   5,
-						Name: "Foods UPDATED",
+						ID:     5,
+						Name:   "Foods UPDATED",
+						Active: true,
 					}).
 					Return(nil, domain.NewInternalError(nil))
 			},
@@ -328,7 +360,8 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateCategoryInput{
-						Name: "Foods",
+						Name:   "Foods",
+						Active: true,
 					}).
 					Return([]byte(s.responses["create_success"]), nil)
 			},
@@ -362,7 +395,8 @@ func (s *CategoryHandlerSuiteTest) TestCategoryHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateCategoryInput{
-						Name: "Foods",
+						Name:   "Foods",
+						Active: true,
 					}).
 					Return(nil, domain.NewInternalError(nil))
 			},
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type KitchenTicketHandler struct {
+	controller port.KitchenTicketController
+}
+
+func NewKitchenTicketHandler(controller port.KitchenTicketController) *KitchenTicketHandler {
+	return &KitchenTicketHandler{controller}
+}
+
+func (h *KitchenTicketHandler) Register(router *gin.RouterGroup) {
+	router.GET("", h.List)
+	router.PATCH("/:id", h.Update)
+}
+
+// RegisterStationRoutes registers the routes of the screen of a station, ex: /kitchen/stations/{station}/tickets
+func (h *KitchenTicketHandler) RegisterStationRoutes(router *gin.RouterGroup) {
+	router.GET("", h.ListStation)
+}
+
+// List godoc
+//
+//	@Summary		List kitchen tickets
+//	@Description	List the kitchen tickets of the orders being prepared, oldest first
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			kitchen
+//	@Accept			json
+//	@Produce		json,xml,text/csv
+//	@Param			station	query		string										false	"Filter by station"	Enums(GRILL, FRYER, DRINKS, DESSERTS)
+//	@Param			status	query		string										false	"Filter by status"	Enums(QUEUED, IN_PROGRESS, DONE)
+//	@Param			page	query		int											false	"Page number"		default(1)
+//	@Param			limit	query		int											false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.KitchenTicketJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse				"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/kitchen/tickets [get]
+func (h *KitchenTicketHandler) List(c *gin.Context) {
+	var query request.ListKitchenTicketsQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	station, _ := valueobject.ToKitchenStation(query.Station)
+	status, _ := valueobject.ToKitchenTicketStatus(query.Status)
+	h.list(c, dto.ListKitchenTicketsInput{
+		Station: station,
+		Status:  status,
+		Page:    query.Page,
+		Limit:   query.Limit,
+	})
+}
+
+// ListStation godoc
+//
+//	@Summary		List the kitchen tickets of a station
+//	@Description	List the kitchen tickets of a station for its screen, oldest first
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			kitchen
+//	@Accept			json
+//	@Produce		json,xml,text/csv
+//	@Param			station	path		string										true	"Station"			Enums(GRILL, FRYER, DRINKS, DESSERTS)
+//	@Param			status	query		string										false	"Filter by status"	Enums(QUEUED, IN_PROGRESS, DONE)
+//	@Param			page	query		int											false	"Page number"		default(1)
+//	@Param			limit	query		int											false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.KitchenTicketJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse				"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/kitchen/stations/{station}/tickets [get]
+func (h *KitchenTicketHandler) ListStation(c *gin.Context) {
+	var uri request.ListStationKitchenTicketsUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var query request.ListStationKitchenTicketsQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	station, _ := valueobject.ToKitchenStation(uri.Station)
+	status, _ := valueobject.ToKitchenTicketStatus(query.Status)
+	h.list(c, dto.ListKitchenTicketsInput{
+		Station: station,
+		Status:  status,
+		Page:    query.Page,
+		Limit:   query.Limit,
+	})
+}
+
+func (h *KitchenTicketHandler) list(c *gin.Context, input dto.ListKitchenTicketsInput) {
+	p, contentType := selectKitchenTicketListOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.List(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update kitchen ticket
+//	@Description	Moves a kitchen ticket from QUEUED to IN_PROGRESS and from IN_PROGRESS to DONE.
+//	@Description	The order is PREPARING once one of its tickets is started and READY once all of them are done
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			kitchen
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id		path		int										true	"Kitchen ticket ID"
+//	@Param			ticket	body		request.UpdateKitchenTicketBodyRequest	true	"Ticket status"
+//	@Success		200		{object}	presenter.KitchenTicketJsonResponse		"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/kitchen/tickets/{id} [patch]
+func (h *KitchenTicketHandler) Update(c *gin.Context) {
+	var uri request.UpdateKitchenTicketUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.UpdateKitchenTicketBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	status, _ := valueobject.ToKitchenTicketStatus(body.Status)
+	input := dto.UpdateKitchenTicketInput{
+		ID:      uri.ID,
+		Status:  status,
+		StaffID: body.StaffID,
+	}
+
+	p, contentType := selectKitchenTicketOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Update(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// toKitchenStation converts the station of a body, an empty station is nil so it is inherited
+func toKitchenStation(station string) *valueobject.KitchenStation {
+	if station == "" {
+		return nil
+	}
+	kitchenStation, _ := valueobject.ToKitchenStation(station)
+	return &kitchenStation
+}
+
+func selectKitchenTicketOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewKitchenTicketJsonPresenter(),
+		xml:  presenter.NewKitchenTicketXmlPresenter(),
+	})
+}
+
+func selectKitchenTicketListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewKitchenTicketJsonPresenter(),
+		xml:  presenter.NewKitchenTicketXmlPresenter(),
+		csv:  presenter.NewKitchenTicketCsvPresenter(),
+	})
+}
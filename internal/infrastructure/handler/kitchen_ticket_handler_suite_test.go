@@ -0,0 +1,59 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type KitchenTicketHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.KitchenTicketHandler
+	router         *gin.Engine
+	mockController *mockport.MockKitchenTicketController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *KitchenTicketHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockKitchenTicketController(ctrl)
+	s.handler = handler.NewKitchenTicketHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/kitchen/tickets", s.handler.List)
+	s.router.PATCH("/kitchen/tickets/:id", s.handler.Update)
+	s.router.GET("/kitchen/stations/:station/tickets", s.handler.ListStation)
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("kitchen_ticket",
+		"update_success", "update_invalid_status",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("kitchen_ticket",
+		"list_success", "update_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestKitchenTicketHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(KitchenTicketHandlerSuiteTest))
+}
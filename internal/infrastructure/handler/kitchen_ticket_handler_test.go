@@ -0,0 +1,214 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *KitchenTicketHandlerSuiteTest) TestKitchenTicketHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/kitchen/tickets?station=grill&status=QUEUED",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListKitchenTicketsInput{
+						Station: valueobject.StationGrill,
+						Status:  valueobject.TicketQueued,
+						Page:    1,
+						Limit:   10,
+					}).
+					Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["list_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "success - station screen",
+			url:  "/kitchen/stations/DRINKS/tickets?page=2&limit=5",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListKitchenTicketsInput{
+						Station: valueobject.StationDrinks,
+						Page:    2,
+						Limit:   5,
+					}).
+					Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["list_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "success - csv",
+			url:  "/kitchen/tickets",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListKitchenTicketsInput{Page: 1, Limit: 10}).
+					Return([]byte("ticket_id,order_id\n"), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+			},
+		},
+		{
+			name:       "invalid station",
+			url:        "/kitchen/tickets?station=OVEN",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid station screen",
+			url:        "/kitchen/stations/OVEN/tickets",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Equal(t, s.responses["error_invalid_parameter"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "internal error",
+			url:  "/kitchen/tickets",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.name == "success - csv" {
+				req.Header.Set("Accept", "text/csv")
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *KitchenTicketHandlerSuiteTest) TestKitchenTicketHandler_Update() {
+	tests := []struct {
+		name        string
+		url         string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/kitchen/tickets/2",
+			body: strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateKitchenTicketInput{
+						ID:      2,
+						Status:  valueobject.TicketInProgress,
+						StaffID: 7,
+					}).
+					Return([]byte(s.responses["update_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["update_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:       "invalid status",
+			url:        "/kitchen/tickets/2",
+			body:       strings.NewReader(s.requests["update_invalid_status"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid ticket id",
+			url:        "/kitchen/tickets/abc",
+			body:       strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Equal(t, s.responses["error_invalid_parameter"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "ticket not found",
+			url:  "/kitchen/tickets/9",
+			body: strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Equal(t, s.responses["error_not_found"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "order left the kitchen",
+			url:  "/kitchen/tickets/2",
+			body: strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInvalidInputError(domain.ErrOrderIsNotInKitchen))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrOrderIsNotInKitchen)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPatch, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
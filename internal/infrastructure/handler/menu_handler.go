@@ -0,0 +1,66 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type MenuHandler struct {
+	controller port.MenuController
+}
+
+func NewMenuHandler(controller port.MenuController) *MenuHandler {
+	return &MenuHandler{controller}
+}
+
+func (h *MenuHandler) Register(router *gin.RouterGroup) {
+	router.GET("", h.Get)
+}
+
+// Get godoc
+//
+//	@Summary		Get menu
+//	@Description	Returns the tree of the active categories with their products, ordered by the display order
+//	@Description	Only the categories and products inside their availability windows at the requested time are listed
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			menu
+//	@Produce		json,xml
+//	@Param			at	query		string							false	"Moment the menu is shown for (RFC3339), default now"
+//	@Success		200	{object}	presenter.MenuJsonResponse		"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/menu [get]
+func (h *MenuHandler) Get(c *gin.Context) {
+	var query request.GetMenuQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.GetMenuInput{
+		At: query.At,
+	}
+
+	p, contentType := selectMenuOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Get(c.Request.Context(), p, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+func selectMenuOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewMenuJsonPresenter(),
+		xml:  presenter.NewMenuXmlPresenter(),
+	})
+}
@@ -0,0 +1,50 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type MenuHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.MenuHandler
+	router         *gin.Engine
+	mockController *mockport.MockMenuController
+	ctx            context.Context
+	responses      map[string]string // Golden files
+}
+
+func (s *MenuHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockMenuController(ctrl)
+	s.handler = handler.NewMenuHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/menu", s.handler.Get)
+
+	// Mock responses
+	var err error
+	s.responses, err = util.ReadGoldenFiles("menu",
+		"get_success", "get_success_xml",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestMenuHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(MenuHandlerSuiteTest))
+}
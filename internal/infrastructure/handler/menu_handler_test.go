@@ -0,0 +1,108 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *MenuHandlerSuiteTest) TestMenuHandler_Get() {
+	tests := []struct {
+		name        string
+		query       string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - json",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["get_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - xml",
+			accept: "text/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["get_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - requested time",
+			query:  "?at=2024-02-05T08:00:00-03:00",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Cond(func(input dto.GetMenuInput) bool {
+						return input.At.Equal(time.Date(2024, 2, 5, 11, 0, 0, 0, time.UTC))
+					})).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["get_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:       "invalid requested time",
+			query:      "?at=monday",
+			accept:     "application/json",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:   "internal error",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/menu"+tt.query, nil)
+			req.Header.Set("Accept", tt.accept)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+type NotificationPreferenceHandler struct {
+	controller port.NotificationPreferenceController
+	jwtService port.JWTService
+}
+
+func NewNotificationPreferenceHandler(controller port.NotificationPreferenceController, jwtService port.JWTService) *NotificationPreferenceHandler {
+	return &NotificationPreferenceHandler{controller: controller, jwtService: jwtService}
+}
+
+// RegisterCustomerRoutes registers the notification preferences of a customer, they are only changed by the customer's own access token
+func (h *NotificationPreferenceHandler) RegisterCustomerRoutes(router *gin.RouterGroup) {
+	router.Use(middleware.JWTAuthMiddleware(h.jwtService))
+	router.GET("", h.Get)
+	router.PUT("", h.Update)
+	router.DELETE("", h.Delete)
+}
+
+// Get godoc
+//
+//	@Summary		Get notification preferences
+//	@Description	Returns the channels the customer is notified on about the orders
+//	@Description	Requires the access token of the same customer
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			customers
+//	@Produce		json,xml
+//	@Security		BearerAuth
+//	@Param			id	path		int												true	"Customer ID"
+//	@Success		200	{object}	presenter.NotificationPreferenceJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401	{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		403	{object}	middleware.ErrorJsonResponse					"Forbidden"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse					"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/customers/{id}/notification-preferences [get]
+func (h *NotificationPreferenceHandler) Get(c *gin.Context) {
+	var uri request.GetNotificationPreferenceUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	if middleware.TokenCustomerID(c) != uri.CustomerID {
+		_ = c.Error(domain.NewForbiddenError(domain.ErrCustomerMismatch))
+		return
+	}
+
+	input := dto.GetNotificationPreferenceInput{
+		CustomerID: uri.CustomerID,
+	}
+
+	p, contentType := selectNotificationPreferenceOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Get(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update notification preferences
+//	@Description	Opts the customer in to be notified when the orders are received, ready, out for delivery or cancelled
+//	@Description	The channels are **SMS**, **EMAIL** and **PUSH**, each chosen channel requires its phone (E.164), email or push token, and an empty list opts out of all of them
+//	@Description	The locale is **pt-BR** (default) or **en**
+//	@Description	Requires the access token of the same customer
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			customers
+//	@Accept			json
+//	@Produce		json,xml
+//	@Security		BearerAuth
+//	@Param			id			path		int												true	"Customer ID"
+//	@Param			preference	body		request.UpdateNotificationPreferenceBodyRequest	true	"Notification preference data"
+//	@Success		200			{object}	presenter.NotificationPreferenceJsonResponse	"OK"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401			{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		403			{object}	middleware.ErrorJsonResponse					"Forbidden"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/customers/{id}/notification-preferences [put]
+func (h *NotificationPreferenceHandler) Update(c *gin.Context) {
+	var uri request.UpdateNotificationPreferenceUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	if middleware.TokenCustomerID(c) != uri.CustomerID {
+		_ = c.Error(domain.NewForbiddenError(domain.ErrCustomerMismatch))
+		return
+	}
+
+	var body request.UpdateNotificationPreferenceBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	p, contentType := selectNotificationPreferenceOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Update(
+		c.Request.Context(),
+		p,
+		toUpdateNotificationPreferenceInput(uri.CustomerID, body),
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete notification preferences
+//	@Description	Opts the customer out of all the order notifications
+//	@Description	Requires the access token of the same customer
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			customers
+//	@Produce		json,xml
+//	@Security		BearerAuth
+//	@Param			id	path		int												true	"Customer ID"
+//	@Success		200	{object}	presenter.NotificationPreferenceJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401	{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		403	{object}	middleware.ErrorJsonResponse					"Forbidden"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse					"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/customers/{id}/notification-preferences [delete]
+func (h *NotificationPreferenceHandler) Delete(c *gin.Context) {
+	var uri request.DeleteNotificationPreferenceUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	if middleware.TokenCustomerID(c) != uri.CustomerID {
+		_ = c.Error(domain.NewForbiddenError(domain.ErrCustomerMismatch))
+		return
+	}
+
+	input := dto.DeleteNotificationPreferenceInput{
+		CustomerID: uri.CustomerID,
+	}
+
+	p, contentType := selectNotificationPreferenceOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Delete(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// toUpdateNotificationPreferenceInput normalizes the locale and channels, the locale defaults to pt-BR
+func toUpdateNotificationPreferenceInput(customerID uint64, body request.UpdateNotificationPreferenceBodyRequest) dto.UpdateNotificationPreferenceInput {
+	input := dto.UpdateNotificationPreferenceInput{
+		CustomerID: customerID,
+		Locale:     valueobject.DefaultLocale,
+		Email:      body.Email,
+		Phone:      body.Phone,
+		PushToken:  body.PushToken,
+	}
+	if locale, ok := valueobject.ToLocale(body.Locale); ok {
+		input.Locale = locale
+	}
+	for _, channel := range body.Channels {
+		ch, _ := valueobject.ToNotificationChannel(channel)
+		input.Channels = append(input.Channels, ch.String())
+	}
+	return input
+}
+
+func selectNotificationPreferenceOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewNotificationPreferenceJsonPresenter(),
+		xml:  presenter.NewNotificationPreferenceXmlPresenter(),
+	})
+}
@@ -0,0 +1,59 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type NotificationPreferenceHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.NotificationPreferenceHandler
+	router         *gin.Engine
+	mockController *mockport.MockNotificationPreferenceController
+	mockJWTService *mockport.MockJWTService
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *NotificationPreferenceHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockNotificationPreferenceController(ctrl)
+	s.mockJWTService = mockport.NewMockJWTService(ctrl)
+	s.handler = handler.NewNotificationPreferenceHandler(s.mockController, s.mockJWTService)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.handler.RegisterCustomerRoutes(s.router.Group("/customers/:id/notification-preferences"))
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("notification_preference",
+		"update_success", "update_opt_out", "update_invalid_channel", "update_invalid_phone",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("notification_preference",
+		"get_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestNotificationPreferenceHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(NotificationPreferenceHandlerSuiteTest))
+}
@@ -0,0 +1,288 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *NotificationPreferenceHandlerSuiteTest) TestNotificationPreferenceHandler_Get() {
+	tests := []struct {
+		name        string
+		url         string
+		token       string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetNotificationPreferenceInput{CustomerID: 1}).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["get_success"])
+			},
+		},
+		{
+			name:  "token of another customer",
+			url:   "/customers/2/notification-preferences",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name:       "missing authorization header",
+			url:        "/customers/1/notification-preferences",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:  "invalid parameter",
+			url:   "/customers/abc/notification-preferences",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name:  "not found",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetNotificationPreferenceInput{CustomerID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *NotificationPreferenceHandlerSuiteTest) TestNotificationPreferenceHandler_Update() {
+	tests := []struct {
+		name        string
+		url         string
+		token       string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success - normalizes the locale and channels",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateNotificationPreferenceInput{
+						CustomerID: 1,
+						Locale:     valueobject.LocaleEn,
+						Channels:   []string{valueobject.NotificationSMS.String(), valueobject.NotificationEmail.String()},
+						Email:      "john.doe@email.com",
+						Phone:      "+5511999999999",
+					}).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["get_success"])
+			},
+		},
+		{
+			name:  "success - opts out with the default locale",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_opt_out"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateNotificationPreferenceInput{
+						CustomerID: 1,
+						Locale:     valueobject.DefaultLocale,
+					}).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:  "token of another customer",
+			url:   "/customers/2/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name:  "invalid request - unknown channel",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_invalid_channel"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:  "invalid request - phone is not E.164",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_invalid_phone"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:  "invalid request - channel without recipient",
+			url:   "/customers/1/notification-preferences",
+			token: "Bearer valid-token",
+			body:  strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInvalidInputError("phone is mandatory to be notified by SMS"))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, tt.url, tt.body)
+			req.Header.Set("Authorization", tt.token)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *NotificationPreferenceHandlerSuiteTest) TestNotificationPreferenceHandler_Delete() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/customers/1/notification-preferences",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), dto.DeleteNotificationPreferenceInput{CustomerID: 1}).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "token of another customer",
+			url:  "/customers/2/notification-preferences",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name: "internal error",
+			url:  "/customers/1/notification-preferences",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), dto.DeleteNotificationPreferenceInput{CustomerID: 1}).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, tt.url, nil)
+			req.Header.Set("Authorization", "Bearer valid-token")
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
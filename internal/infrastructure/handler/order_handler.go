@@ -3,7 +3,9 @@ package handler
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -13,27 +15,43 @@ import (
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/negotiation"
 )
 
 type OrderHandler struct {
 	controller port.OrderController
 	jwtService port.JWTService
+	apiKeys    map[string]string
+	location   *time.Location
 }
 
-func NewOrderHandler(controller port.OrderController, jwtService port.JWTService) *OrderHandler {
-	return &OrderHandler{controller: controller, jwtService: jwtService}
+// NewOrderHandler creates the order handler, the API clients of apiKeys update the orders as STAFF
+// and the receipts are printed on the time of the restaurant location
+func NewOrderHandler(controller port.OrderController, jwtService port.JWTService, apiKeys map[string]string, location *time.Location) *OrderHandler {
+	return &OrderHandler{controller: controller, jwtService: jwtService, apiKeys: apiKeys, location: location}
 }
 
 func (h *OrderHandler) Register(router *gin.RouterGroup) {
 	//router.Use(middleware.JWTAuthMiddleware(h.jwtService))
 	router.GET("", h.List)
 	router.POST("", h.Create)
+	router.GET("/status-machine", h.GetStatusMachine)
+	router.GET("/guest/:guest_token", h.GetByGuestToken)
 	router.GET("/:id", h.Get)
+	router.GET("/:id/receipt", h.Receipt)
+	router.PUT("/:id/customer", h.AttachCustomer)
 	router.PUT("/:id", h.Update)
 	router.PATCH("/:id", h.UpdatePartial)
 	router.DELETE("/:id", h.Delete)
 }
 
+// RegisterCustomerRoutes registers the orders of a customer, they are only shown to the customer's own access token
+func (h *OrderHandler) RegisterCustomerRoutes(router *gin.RouterGroup) {
+	router.Use(middleware.JWTAuthMiddleware(h.jwtService))
+	router.GET("", h.ListByCustomer)
+}
+
 // List godoc
 //
 //	@Summary		List orders (Reference TC-1 2.b.vi; TC-2 1.a.iv)
@@ -41,13 +59,16 @@ func (h *OrderHandler) Register(router *gin.RouterGroup) {
 //	@Description	## Order list is sorted by:
 //	@Description	- **Status** in **descending** order (`READY` > `PREPARING` > `RECEIVED` > `PENDING` > `OPEN`)
 //	@Description	- **Created date** (CreatedAt) in **ascending** order (oldest first)
-//	@Description	Obs: Status CANCELLED and COMPLETED are not included in the list by default
+//	@Description	Obs: Status CANCELLED, COMPLETED and DELIVERED are not included in the list by default
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml,text/csv
 //	@Param			customer_id		query		int										false	"Filter by customer ID"
-//	@Param			status			query		string									false	"Filter by status (Accept many), options: <sub>OPEN, PENDING, RECEIVED, PREPARING, READY</sub>, ex: <sub>PENDING</sub> or <sub>OPEN,PENDING</sub>"
-//	@Param			status_exclude	query		string									false	"Exclude by status (Accept many), options: <sub>NONE, OPEN, PENDING, RECEIVED, PREPARING, READY, CANCELLED, COMPLETED</sub>, ex: <sub>CANCELLED</sub> or <sub>CANCELLED,COMPLETED</sub> (default)"	default(CANCELLED,COMPLETED)
+//	@Param			status			query		string									false	"Filter by status (Accept many), options: <sub>OPEN, PENDING, RECEIVED, PREPARING, READY, OUT_FOR_DELIVERY</sub>, ex: <sub>PENDING</sub> or <sub>OPEN,PENDING</sub>"
+//	@Param			channel			query		string									false	"Filter by channel (Accept many), options: <sub>TOTEM, COUNTER, APP</sub>, ex: <sub>TOTEM,APP</sub>"
+//	@Param			fulfilment_mode	query		string									false	"Filter by fulfilment mode (Accept many), options: <sub>DINE_IN, TAKEAWAY, DELIVERY</sub>, ex: <sub>DELIVERY</sub>"
+//	@Param			status_exclude	query		string									false	"Exclude by status (Accept many), options: <sub>NONE, OPEN, PENDING, RECEIVED, PREPARING, READY, OUT_FOR_DELIVERY, CANCELLED, COMPLETED, DELIVERED</sub>, ex: <sub>CANCELLED</sub> or <sub>CANCELLED,COMPLETED,DELIVERED</sub> (default)"	default(CANCELLED,COMPLETED,DELIVERED)
 //	@Param			sort			query		string									false	"Sort by field (Accept many). Use `<field_name>:d` for descending, and the default order is ascending"																								default(status:d,created_at)
 //	@Param			page			query		int										false	"Page number"																																														default(1)
 //	@Param			limit			query		int										false	"Items per page"																																													default(10)
@@ -70,7 +91,7 @@ func (h *OrderHandler) List(c *gin.Context) {
 	// Default status_exclude
 	var statusExclude []valueobject.OrderStatus
 	if query.StatusExclude == "" {
-		query.StatusExclude = "CANCELLED,COMPLETED"
+		query.StatusExclude = "CANCELLED,COMPLETED,DELIVERED"
 	}
 
 	// Convert status_exclude
@@ -93,18 +114,90 @@ func (h *OrderHandler) List(c *gin.Context) {
 		}
 	}
 
+	channels, ok := splitQueryValues(query.Channel, valueobject.ToOrderChannel)
+	if !ok {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	fulfilmentModes, ok := splitQueryValues(query.FulfilmentMode, valueobject.ToFulfilmentMode)
+	if !ok {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
 	input := dto.ListOrdersInput{
-		CustomerID:    query.CustomerID,
-		Status:        status,
-		StatusExclude: statusExclude,
-		Page:          query.Page,
-		Limit:         query.Limit,
-		Sort:          query.Sort,
+		CustomerID:      query.CustomerID,
+		Status:          status,
+		StatusExclude:   statusExclude,
+		Channels:        channels,
+		FulfilmentModes: fulfilmentModes,
+		Page:            query.Page,
+		Limit:           query.Limit,
+		Sort:            query.Sort,
 	}
 
+	p, contentType := selectOrderListOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.List(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// ListByCustomer godoc
+//
+//	@Summary		List customer orders
+//	@Description	Lists the past orders of the customer, the newest first, with how much was spent on the paid orders of the period
+//	@Description	Requires the access token of the same customer
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			customers
+//	@Produce		json,xml
+//	@Security		BearerAuth
+//	@Param			id		path		int										true	"Customer ID"
+//	@Param			query	query		request.ListCustomerOrdersQueryRequest	false	"Query params"
+//	@Success		200		{object}	presenter.CustomerOrderHistoryJsonResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		401		{object}	middleware.ErrorJsonResponse			"Unauthorized"
+//	@Failure		403		{object}	middleware.ErrorJsonResponse			"Forbidden"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/customers/{id}/orders [get]
+func (h *OrderHandler) ListByCustomer(c *gin.Context) {
+	var uri request.ListCustomerOrdersUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	if middleware.TokenCustomerID(c) != uri.CustomerID {
+		_ = c.Error(domain.NewForbiddenError(domain.ErrCustomerMismatch))
+		return
+	}
+
+	var query request.ListCustomerOrdersQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.ListCustomerOrdersInput{
+		CustomerID: uri.CustomerID,
+		From:       query.From,
+		To:         query.To,
+		Page:       query.Page,
+		Limit:      query.Limit,
+	}
+
+	p, contentType := selectCustomerOrderHistoryOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.ListByCustomer(
+		c.Request.Context(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -112,20 +205,24 @@ func (h *OrderHandler) List(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Create godoc
 //
 //	@Summary		Create order
 //	@Description	Creates a new order
+//	@Description	The channel is **TOTEM** (default), **COUNTER** or **APP** and the fulfilment mode is **TAKEAWAY** (default), **DINE_IN** or **DELIVERY**
+//	@Description	Dine-in orders require the table number and delivery orders the delivery address, delivery orders go **READY** > **OUT_FOR_DELIVERY** > **DELIVERED**
+//	@Description	Orders without the customer are guest orders, the response has the **guest_token** to track the order on **GET /orders/guest/{guest_token}**
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			order	body		request.CreateOrderBodyRequest	true	"Order data"
-//	@Success		201		{object}	presenter.OrderJsonResponse		"Created"
-//	@Failure		400		{object}	middleware.ErrorJsonResponse	"Bad Request"
-//	@Failure		500		{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Success		201		{object}	presenter.OrderCreatedJsonResponse	"Created"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse		"Internal Server Error"
 //	@Router			/orders [post]
 func (h *OrderHandler) Create(c *gin.Context) {
 	var body request.CreateOrderBodyRequest
@@ -135,12 +232,28 @@ func (h *OrderHandler) Create(c *gin.Context) {
 	}
 
 	input := dto.CreateOrderInput{
-		CustomerID: body.CustomerID,
+		CustomerID:     body.CustomerID,
+		GuestName:      body.GuestName,
+		Channel:        valueobject.OrderChannel(strings.ToUpper(body.Channel)),
+		FulfilmentMode: valueobject.FulfilmentMode(strings.ToUpper(body.FulfilmentMode)),
+		TableNumber:    body.TableNumber,
+	}
+	if body.DeliveryAddress != nil {
+		input.DeliveryAddress = &dto.DeliveryAddressInput{
+			Street:       body.DeliveryAddress.Street,
+			Number:       body.DeliveryAddress.Number,
+			Complement:   body.DeliveryAddress.Complement,
+			Neighborhood: body.DeliveryAddress.Neighborhood,
+			City:         body.DeliveryAddress.City,
+			State:        body.DeliveryAddress.State,
+			ZipCode:      body.DeliveryAddress.ZipCode,
+		}
 	}
 
-	output, err := h.controller.Create(
+	p, contentType := selectOrderCreatedOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.Create(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -148,18 +261,45 @@ func (h *OrderHandler) Create(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusCreated, "application/json", output)
+	setOrderETag(c, version)
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+// GetStatusMachine godoc
+//
+//	@Summary		Get order status machine
+//	@Description	Returns the states and allowed transitions of an order
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders
+//	@Produce		json,xml
+//	@Success		200	{object}	presenter.OrderStatusMachineJsonResponse	"OK"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/orders/status-machine [get]
+func (h *OrderHandler) GetStatusMachine(c *gin.Context) {
+	p, contentType := selectOrderStatusMachineOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.GetStatusMachine(
+		c.Request.Context(),
+		p,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Get godoc
 //
 //	@Summary		Get order
 //	@Description	Search for a order by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id	path		int								true	"Order ID"
 //	@Success		200	{object}	presenter.OrderJsonResponse		"OK"
+//	@Header			200	{string}	ETag							"Order version, send it back in If-Match to update the order"
 //	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
 //	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
 //	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
@@ -175,9 +315,50 @@ func (h *OrderHandler) Get(c *gin.Context) {
 		ID: uri.ID,
 	}
 
-	output, err := h.controller.Get(
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.Get(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	setOrderETag(c, version)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// GetByGuestToken godoc
+//
+//	@Summary		Get guest order
+//	@Description	Search for a guest order by the guest token returned when it was created, so the guest can track it
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			guest_token	path		string							true	"Guest token"
+//	@Success		200			{object}	presenter.OrderJsonResponse		"OK"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/orders/guest/{guest_token} [get]
+func (h *OrderHandler) GetByGuestToken(c *gin.Context) {
+	var uri request.GetGuestOrderUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.GetGuestOrderInput{
+		GuestToken: uri.GuestToken,
+	}
+
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.GetByGuestToken(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -185,31 +366,128 @@ func (h *OrderHandler) Get(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	setOrderETag(c, version)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// AttachCustomer godoc
+//
+//	@Summary		Attach customer to guest order
+//	@Description	Gives a guest order to the customer that signed in, the order must not be paid yet (**OPEN** or **PENDING**)
+//	@Description	The guest token returned when the order was created must be informed
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id			path		int										true	"Order ID"
+//	@Param			customer	body		request.AttachOrderCustomerBodyRequest	true	"Customer and guest token"
+//	@Success		200			{object}	presenter.OrderJsonResponse				"OK"
+//	@Header			200			{string}	ETag									"New order version"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		401			{object}	middleware.ErrorJsonResponse			"Unauthorized"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		409			{object}	middleware.ErrorJsonResponse			"Conflict"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/orders/{id}/customer [put]
+func (h *OrderHandler) AttachCustomer(c *gin.Context) {
+	var uri request.AttachOrderCustomerUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.AttachOrderCustomerBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	input := dto.AttachOrderCustomerInput{
+		ID:         uri.ID,
+		CustomerID: body.CustomerID,
+		GuestToken: body.GuestToken,
+	}
+
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.AttachCustomer(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	setOrderETag(c, version)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Receipt godoc
+//
+//	@Summary		Get order receipt
+//	@Description	Renders the printable receipt of an order with the line items, discounts, the charged totals and the status timeline
+//	@Description	The times are printed in the timezone of the restaurant
+//	@Description	Response can return plain text for 40 column thermal printers, HTML or PDF (Accept header: text/plain, text/html or application/pdf)
+//	@Tags			orders
+//	@Produce		plain,html,application/pdf
+//	@Param			id	path		int								true	"Order ID"
+//	@Success		200	{string}	string							"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/orders/{id}/receipt [get]
+func (h *OrderHandler) Receipt(c *gin.Context) {
+	var uri request.GetOrderUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.GetOrderInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectOrderReceiptOutputConfigs(c.GetHeader("Accept"), h.location)
+	output, _, err := h.controller.Get(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	if contentType == negotiation.MIMEPDF {
+		c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="order-%d-receipt.pdf"`, uri.ID))
+	}
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Update godoc
 //
 //	@Summary		Update order
 //	@Description	Update an existing order
-//	@Description	The status are: **OPEN**, **CANCELLED**, **PENDING**, **RECEIVED**, **PREPARING**, **READY**, **COMPLETED**
-//	@Description	## Transition of status:
-//	@Description	- OPEN      -> CANCELLED || PENDING
-//	@Description	- CANCELLED -> {},
-//	@Description	- PENDING   -> OPEN || RECEIVED
-//	@Description	- RECEIVED  -> PREPARING
-//	@Description	- PREPARING -> READY
-//	@Description	- READY     -> COMPLETED
-//	@Description	- COMPLETED -> {}
+//	@Description	The status are: **OPEN**, **CANCELLED**, **PENDING**, **RECEIVED**, **PREPARING**, **READY**, **COMPLETED**, **OUT_FOR_DELIVERY**, **DELIVERED**
+//	@Description	The allowed transitions are configurable, see **GET /orders/status-machine**
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
-//	@Param			id		path		int								true	"Order ID"
-//	@Param			order	body		request.UpdateOrderBodyRequest	true	"Order data"
-//	@Success		200		{object}	presenter.OrderJsonResponse		"OK"
-//	@Failure		400		{object}	middleware.ErrorJsonResponse	"Bad Request"
-//	@Failure		404		{object}	middleware.ErrorJsonResponse	"Not Found"
-//	@Failure		500		{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Produce		json,xml
+//	@Param			id			path		int								true	"Order ID"
+//	@Param			If-Match	header		string							false	"Order ETag returned by a previous request"
+//	@Param			X-API-Key	header		string							false	"Key of an API client, required to update as STAFF"
+//	@Param			order		body		request.UpdateOrderBodyRequest	true	"Order data"
+//	@Success		200			{object}	presenter.OrderJsonResponse		"OK"
+//	@Header			200			{string}	ETag							"New order version"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		401			{object}	middleware.ErrorJsonResponse	"Unauthorized"
+//	@Failure		403			{object}	middleware.ErrorJsonResponse	"Forbidden"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		409			{object}	middleware.ErrorJsonResponse	"Conflict"
+//	@Failure		412			{object}	middleware.ErrorJsonResponse	"Precondition Failed"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse	"Internal Server Error"
 //	@Router			/orders/{id} [put]
 func (h *OrderHandler) Update(c *gin.Context) {
 	var uri request.UpdateOrderUriRequest
@@ -224,16 +502,35 @@ func (h *OrderHandler) Update(c *gin.Context) {
 		return
 	}
 
+	version, err := parseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	actorType, actorID, err := h.resolveActor(c, body.ActorType, body.ActorID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
 	input := dto.UpdateOrderInput{
 		ID:         uri.ID,
 		CustomerID: body.CustomerID,
 		Status:     body.Status,
 		StaffID:    body.StaffID,
+		ActorType:  actorType,
+		ActorID:    actorID,
+		ReasonCode: body.ReasonCode,
+		ReasonText: body.ReasonText,
+		Source:     valueobject.SourceAPI,
+		Version:    version,
 	}
 
-	output, err := h.controller.Update(
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.Update(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -241,31 +538,33 @@ func (h *OrderHandler) Update(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	setOrderETag(c, version)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // UpdatePartial godoc
 //
 //	@Summary		Partial update order (Reference TC-2 1.a.v)
 //	@Description	Partially updates an existing order
-//	@Description	The status are: **OPEN**, **CANCELLED**, **PENDING**, **RECEIVED**, **PREPARING**, **READY**, **COMPLETED**
-//	@Description	## Transition of status:
-//	@Description	- OPEN      -> CANCELLED || PENDING
-//	@Description	- CANCELLED -> {},
-//	@Description	- PENDING   -> OPEN || RECEIVED
-//	@Description	- RECEIVED  -> PREPARING
-//	@Description	- PREPARING -> READY
-//	@Description	- READY     -> COMPLETED
-//	@Description	- COMPLETED -> {}
+//	@Description	The status are: **OPEN**, **CANCELLED**, **PENDING**, **RECEIVED**, **PREPARING**, **READY**, **COMPLETED**, **OUT_FOR_DELIVERY**, **DELIVERED**
+//	@Description	The allowed transitions are configurable, see **GET /orders/status-machine**
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
-//	@Param			id		path		int									true	"Order ID"
-//	@Param			order	body		request.UpdateOrderPartilRequest	true	"Order data"
-//	@Success		200		{object}	presenter.OrderJsonResponse			"OK"
-//	@Failure		400		{object}	middleware.ErrorJsonResponse		"Bad Request"
-//	@Failure		404		{object}	middleware.ErrorJsonResponse		"Not Found"
-//	@Failure		500		{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Produce		json,xml
+//	@Param			id			path		int									true	"Order ID"
+//	@Param			If-Match	header		string								false	"Order ETag returned by a previous request"
+//	@Param			X-API-Key	header		string								false	"Key of an API client, required to update as STAFF"
+//	@Param			order		body		request.UpdateOrderPartilRequest	true	"Order data"
+//	@Success		200			{object}	presenter.OrderJsonResponse			"OK"
+//	@Header			200			{string}	ETag								"New order version"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		401			{object}	middleware.ErrorJsonResponse		"Unauthorized"
+//	@Failure		403			{object}	middleware.ErrorJsonResponse		"Forbidden"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse		"Not Found"
+//	@Failure		409			{object}	middleware.ErrorJsonResponse		"Conflict"
+//	@Failure		412			{object}	middleware.ErrorJsonResponse		"Precondition Failed"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse		"Internal Server Error"
 //	@Router			/orders/{id} [patch]
 func (h *OrderHandler) UpdatePartial(c *gin.Context) {
 	var uri request.UpdateOrderUriRequest
@@ -285,16 +584,35 @@ func (h *OrderHandler) UpdatePartial(c *gin.Context) {
 
 	fmt.Println("Body:", body)
 
+	version, err := parseIfMatch(c.GetHeader("If-Match"))
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	actorType, actorID, err := h.resolveActor(c, body.ActorType, body.ActorID)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
 	input := dto.UpdateOrderInput{
 		ID:         uri.ID,
 		CustomerID: body.CustomerID,
 		Status:     body.Status,
 		StaffID:    body.StaffID,
+		ActorType:  actorType,
+		ActorID:    actorID,
+		ReasonCode: body.ReasonCode,
+		ReasonText: body.ReasonText,
+		Source:     valueobject.SourceAPI,
+		Version:    version,
 	}
 
-	output, err := h.controller.Update(
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, version, err := h.controller.Update(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -302,15 +620,17 @@ func (h *OrderHandler) UpdatePartial(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	setOrderETag(c, version)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Delete godoc
 //
 //	@Summary		Delete order
 //	@Description	Deletes a order by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id	path		int								true	"Order ID"
 //	@Success		200	{object}	presenter.OrderJsonResponse		"OK"
 //	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
@@ -328,9 +648,10 @@ func (h *OrderHandler) Delete(c *gin.Context) {
 		ID: uri.ID,
 	}
 
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Delete(
 		c.Request.Context(),
-		presenter.NewOrderJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -338,5 +659,120 @@ func (h *OrderHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// setOrderETag sets the ETag header with the version of the presented order
+func setOrderETag(c *gin.Context, version uint32) {
+	if version == 0 {
+		return
+	}
+	c.Header("ETag", fmt.Sprintf(`"%d"`, version))
+}
+
+// resolveActor returns the actor of an order update from the caller, the API clients update as STAFF by default
+// and may act for the customer, the other callers are always the CUSTOMER. The actor ID of the API clients is
+// their name when not informed
+func (h *OrderHandler) resolveActor(c *gin.Context, actorType valueobject.ActorType, actorID string) (valueobject.ActorType, string, error) {
+	actor, _ := valueobject.ToActorType(string(actorType))
+
+	key := c.GetHeader(middleware.APIKeyHeader)
+	if key == "" {
+		if actor == valueobject.ActorStaff {
+			return "", "", domain.NewForbiddenError(domain.ErrStaffRequiresAPIKey)
+		}
+		return actor, actorID, nil
+	}
+
+	client, ok := middleware.FindAPIClient(h.apiKeys, key)
+	if !ok {
+		return "", "", domain.NewUnauthorizedError(domain.ErrInvalidAPIKey)
+	}
+	if actor == "" {
+		actor = valueobject.ActorStaff
+	}
+	if actorID == "" {
+		actorID = client
+	}
+	return actor, actorID, nil
+}
+
+// parseIfMatch returns the order version sent in the If-Match header, 0 when absent or "*"
+func parseIfMatch(header string) (uint32, error) {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return 0, nil
+	}
+
+	etag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	version, err := strconv.ParseUint(etag, 10, 32)
+	if err != nil || version == 0 {
+		return 0, domain.NewPreconditionFailedError(domain.ErrOrderVersionMismatch)
+	}
+	return uint32(version), nil
+}
+
+func selectOrderOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderJsonPresenter(),
+		xml:  presenter.NewOrderXmlPresenter(),
+	})
+}
+
+// selectOrderCreatedOutputConfigs selects the presenters of the created order, the only response with the guest token
+func selectOrderCreatedOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderCreatedJsonPresenter(),
+		xml:  presenter.NewOrderCreatedXmlPresenter(),
+	})
+}
+
+func selectCustomerOrderHistoryOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewCustomerOrderHistoryJsonPresenter(),
+		xml:  presenter.NewCustomerOrderHistoryXmlPresenter(),
+	})
+}
+
+func selectOrderListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderJsonPresenter(),
+		xml:  presenter.NewOrderXmlPresenter(),
+		csv:  presenter.NewOrderCsvPresenter(),
+	})
+}
+
+func selectOrderStatusMachineOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderStatusMachineJsonPresenter(),
+		xml:  presenter.NewOrderStatusMachineXmlPresenter(),
+	})
+}
+
+// splitQueryValues converts a comma separated query value, ok is false when any of the values is unknown
+func splitQueryValues[T any](value string, convert func(string) (T, bool)) ([]T, bool) {
+	if value == "" {
+		return nil, true
+	}
+	var values []T
+	for _, s := range strings.Split(value, ",") {
+		v, ok := convert(strings.TrimSpace(s))
+		if !ok {
+			return nil, false
+		}
+		values = append(values, v)
+	}
+	return values, true
+}
+
+// selectOrderReceiptOutputConfigs negotiates the format of the receipt, plain text is the default for the thermal printers
+func selectOrderReceiptOutputConfigs(acceptHeader string, location *time.Location) (port.Presenter, string) {
+	switch negotiation.Negotiate(acceptHeader, negotiation.MIMEText, negotiation.MIMEHTML, negotiation.MIMEPDF) {
+	case negotiation.MIMEHTML:
+		return presenter.NewOrderReceiptHtmlPresenter(location), negotiation.MIMEHTML + "; charset=utf-8"
+	case negotiation.MIMEPDF:
+		return presenter.NewOrderReceiptPdfPresenter(location), negotiation.MIMEPDF
+	default:
+		return presenter.NewOrderReceiptTextPresenter(location), negotiation.MIMEText + "; charset=utf-8"
+	}
 }
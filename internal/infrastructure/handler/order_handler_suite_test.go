@@ -3,6 +3,7 @@ package handler_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
@@ -13,6 +14,9 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// orderAPIKey is the key of the API client updating the orders as staff
+const orderAPIKey = "back-office-key"
+
 type OrderHandlerSuiteTest struct {
 	suite.Suite
 	handler        *handler.OrderHandler
@@ -33,31 +37,40 @@ func (s *OrderHandlerSuiteTest) SetupTest() {
 	defer ctrl.Finish()
 	s.mockController = mockport.NewMockOrderController(ctrl)
 	s.mockJWTService = mockport.NewMockJWTService(ctrl)
-	s.handler = handler.NewOrderHandler(s.mockController, s.mockJWTService)
+	s.handler = handler.NewOrderHandler(s.mockController, s.mockJWTService, map[string]string{"back-office": orderAPIKey}, time.UTC)
 	s.ctx = context.Background()
 
 	// Register routes
 	s.router.GET("/orders", s.handler.List)
 	s.router.POST("/orders", s.handler.Create)
+	s.router.GET("/orders/status-machine", s.handler.GetStatusMachine)
 	s.router.PUT("/orders/:id", s.handler.Update)
 	s.router.PATCH("/orders/:id", s.handler.UpdatePartial)
+	s.router.GET("/orders/guest/:guest_token", s.handler.GetByGuestToken)
 	s.router.GET("/orders/:id", s.handler.Get)
+	s.router.PUT("/orders/:id/customer", s.handler.AttachCustomer)
+	s.router.GET("/orders/:id/receipt", s.handler.Receipt)
 	s.router.DELETE("/orders/:id", s.handler.Delete)
+	s.handler.RegisterCustomerRoutes(s.router.Group("/customers/:id/orders"))
 
 	// Mock requests
 	var err error
 	s.requests, err = util.ReadFixtureFiles("order",
 		"create_success", "create_invalid_body",
+		"create_delivery", "create_dine_in", "create_invalid_channel", "create_guest",
+		"attach_customer_success", "attach_customer_invalid_body",
 		"update_success", "update_invalid_body",
+		"update_with_reason", "update_invalid_actor_type", "update_as_staff", "update_system_actor",
 	)
 	assert.NoError(s.T(), err)
 
 	// Mock responses
 	s.responses, err = util.ReadGoldenFiles("order",
-		"list_success", "list_success_with_query",
+		"list_success", "list_success_with_query", "list_by_customer_success",
 		"create_success",
 		"update_success",
 		"get_success",
+		"get_status_machine_success", "get_status_machine_success_xml",
 		"delete_success",
 	)
 	assert.NoError(s.T(), err)
@@ -1,14 +1,18 @@
 package handler_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
 	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -18,6 +22,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 	tests := []struct {
 		name        string
 		url         string
+		accept      string
 		setupMocks  func()
 		checkResult func(*testing.T, *httptest.ResponseRecorder)
 	}{
@@ -26,7 +31,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 			url:  "/orders",
 			setupMocks: func() {
 				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrdersInput{
-					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED},
+					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED, valueobject.DELIVERED},
 					Page:          1,
 					Limit:         10,
 					Sort:          "status:d,created_at",
@@ -44,7 +49,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrdersInput{
 					CustomerID:    1,
 					Status:        []valueobject.OrderStatus{valueobject.OPEN, valueobject.PENDING},
-					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED},
+					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED, valueobject.DELIVERED},
 					Page:          1,
 					Limit:         10,
 					Sort:          "status:d,created_at",
@@ -64,6 +69,41 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
 			},
 		},
+		{
+			name: "success - with channel and fulfilment mode",
+			url:  "/orders?channel=app&fulfilment_mode=DELIVERY,TAKEAWAY",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrdersInput{
+					StatusExclude:   []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED, valueobject.DELIVERED},
+					Channels:        []valueobject.OrderChannel{valueobject.ChannelApp},
+					FulfilmentModes: []valueobject.FulfilmentMode{valueobject.FulfilmentDelivery, valueobject.FulfilmentTakeaway},
+					Page:            1,
+					Limit:           10,
+					Sort:            "status:d,created_at",
+				}).Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:       "invalid query - channel",
+			url:        "/orders?channel=phone",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name:       "invalid query - fulfilment_mode",
+			url:        "/orders?fulfilment_mode=drive_thru",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
 		{
 			name:       "invalid query - status",
 			url:        "/orders?status=invalid",
@@ -78,7 +118,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 			url:  "/orders",
 			setupMocks: func() {
 				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrdersInput{
-					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED},
+					StatusExclude: []valueobject.OrderStatus{valueobject.CANCELLED, valueobject.COMPLETED, valueobject.DELIVERED},
 					Page:          1,
 					Limit:         10,
 					Sort:          "status:d,created_at",
@@ -89,6 +129,35 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
 			},
 		},
+		{
+			name:   "success - csv",
+			url:    "/orders",
+			accept: "application/json;q=0.5, text/csv",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, p port.Presenter, _ dto.ListOrdersInput) ([]byte, error) {
+						return p.Present(dto.PresenterInput{Result: []*entity.Order{{ID: 1, CustomerID: 1, Status: valueobject.PENDING, Version: 1}}})
+					})
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.True(t, strings.HasPrefix(res.Body.String(), "id,customer_id,status,"))
+			},
+		},
+		{
+			name:   "success - not accepted format falls back to json",
+			url:    "/orders",
+			accept: "text/html",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -97,6 +166,9 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_List() {
 			tt.setupMocks()
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
 
 			// Act
 			s.router.ServeHTTP(w, req)
@@ -122,13 +194,85 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateOrderInput{CustomerID: 1}).
-					Return([]byte(s.responses["create_success"]), nil)
+					Return([]byte(s.responses["create_success"]), uint32(1), nil)
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusCreated, res.Code)
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["create_success"])
 			},
 		},
+		{
+			name: "success - guest",
+			url:  "/orders",
+			body: strings.NewReader(s.requests["create_guest"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreateOrderInput{GuestName: "John"}).
+					DoAndReturn(func(_ context.Context, p port.Presenter, _ dto.CreateOrderInput) ([]byte, uint32, error) {
+						order := &entity.Order{ID: 1, GuestName: "John", GuestToken: "JBSWY3DPEHPK3PXPJBSWY3DPEH", Status: valueobject.OPEN, Version: 1}
+						output, err := p.Present(dto.PresenterInput{Result: order})
+						return output, order.Version, err
+					})
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, res.Body.String(), `"guest_token":"JBSWY3DPEHPK3PXPJBSWY3DPEH"`)
+			},
+		},
+		{
+			name: "success - dine-in",
+			url:  "/orders",
+			body: strings.NewReader(s.requests["create_dine_in"]),
+			setupMocks: func() {
+				tableNumber := uint32(7)
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreateOrderInput{
+						CustomerID:     1,
+						Channel:        valueobject.ChannelCounter,
+						FulfilmentMode: valueobject.FulfilmentDineIn,
+						TableNumber:    &tableNumber,
+					}).
+					Return([]byte(s.responses["create_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+			},
+		},
+		{
+			name: "success - delivery",
+			url:  "/orders",
+			body: strings.NewReader(s.requests["create_delivery"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreateOrderInput{
+						CustomerID:     1,
+						Channel:        valueobject.ChannelApp,
+						FulfilmentMode: valueobject.FulfilmentDelivery,
+						DeliveryAddress: &dto.DeliveryAddressInput{
+							Street:       "Avenida Paulista",
+							Number:       "1000",
+							Complement:   "Apto 12",
+							Neighborhood: "Bela Vista",
+							City:         "Sao Paulo",
+							State:        "SP",
+							ZipCode:      "01310-100",
+						},
+					}).
+					Return([]byte(s.responses["create_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - unknown channel",
+			url:        "/orders",
+			body:       strings.NewReader(s.requests["create_invalid_channel"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
 		{
 			name:       "invalid request - body is not a valid json",
 			url:        "/orders",
@@ -154,7 +298,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateOrderInput{CustomerID: 1}).
-					Return(nil, domain.NewInternalError(nil))
+					Return(nil, uint32(0), domain.NewInternalError(nil))
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, res.Code)
@@ -183,6 +327,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Get() {
 	tests := []struct {
 		name        string
 		url         string
+		accept      string
 		setupMocks  func()
 		checkResult func(*testing.T, *httptest.ResponseRecorder)
 	}{
@@ -192,11 +337,29 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Get() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
-					Return([]byte(s.responses["get_success"]), nil)
+					Return([]byte(s.responses["get_success"]), uint32(1), nil)
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, res.Code)
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["get_success"])
+				assert.Equal(t, `"1"`, res.Header().Get("ETag"))
+			},
+		},
+		{
+			name: "success - guest token is not returned",
+			url:  "/orders/5",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					DoAndReturn(func(_ context.Context, p port.Presenter, _ dto.GetOrderInput) ([]byte, uint32, error) {
+						order := &entity.Order{ID: 5, GuestName: "John", GuestToken: "JBSWY3DPEHPK3PXPJBSWY3DPEH", Status: valueobject.OPEN, Version: 1}
+						output, err := p.Present(dto.PresenterInput{Result: order})
+						return output, order.Version, err
+					})
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.NotContains(t, res.Body.String(), "JBSWY3DPEHPK3PXPJBSWY3DPEH")
 			},
 		},
 		{
@@ -205,7 +368,7 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Get() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
-					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+					Return(nil, uint32(0), domain.NewNotFoundError(domain.ErrNotFound))
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusNotFound, res.Code)
@@ -221,6 +384,143 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Get() {
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
 			},
 		},
+		{
+			name:   "success - xml",
+			url:    "/orders/5",
+			accept: "application/json;q=0.5, application/xml;q=0.9",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					DoAndReturn(func(_ context.Context, p port.Presenter, _ dto.GetOrderInput) ([]byte, uint32, error) {
+						order := &entity.Order{ID: 5, CustomerID: 1, Status: valueobject.PENDING, Version: 2}
+						output, err := p.Present(dto.PresenterInput{Result: order})
+						return output, order.Version, err
+					})
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml", res.Header().Get("Content-Type"))
+				assert.Contains(t, res.Body.String(), "<order><id>5</id>")
+				assert.Equal(t, `"2"`, res.Header().Get("ETag"))
+			},
+		},
+		{
+			name:   "not found - xml",
+			url:    "/orders/5",
+			accept: "text/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					Return(nil, uint32(0), domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Equal(t, "text/xml; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Contains(t, res.Body.String(), "<code>404</code>")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *OrderHandlerSuiteTest) TestOrderHandler_Receipt() {
+	presentOrder := func(_ context.Context, p port.Presenter, _ dto.GetOrderInput) ([]byte, uint32, error) {
+		order := &entity.Order{ID: 5, CustomerID: 1, Status: valueobject.RECEIVED}
+		output, err := p.Present(dto.PresenterInput{Result: order})
+		return output, order.Version, err
+	}
+
+	tests := []struct {
+		name        string
+		url         string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - text is the default",
+			url:  "/orders/5/receipt",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					DoAndReturn(presentOrder)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/plain; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Contains(t, res.Body.String(), "ORDER #5")
+			},
+		},
+		{
+			name:   "success - html",
+			url:    "/orders/5/receipt",
+			accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					DoAndReturn(presentOrder)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/html; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Contains(t, res.Body.String(), "<h1>Order #5</h1>")
+			},
+		},
+		{
+			name:   "success - pdf",
+			url:    "/orders/5/receipt",
+			accept: "application/pdf",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					DoAndReturn(presentOrder)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/pdf", res.Header().Get("Content-Type"))
+				assert.Equal(t, `inline; filename="order-5-receipt.pdf"`, res.Header().Get("Content-Disposition"))
+				assert.True(t, strings.HasPrefix(res.Body.String(), "%PDF-"))
+			},
+		},
+		{
+			name: "not found",
+			url:  "/orders/5/receipt",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetOrderInput{ID: 5}).
+					Return(nil, uint32(0), domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+		{
+			name:       "invalid request - id is not a number",
+			url:        "/orders/invalid/receipt",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -229,6 +529,74 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Get() {
 			tt.setupMocks()
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *OrderHandlerSuiteTest) TestOrderHandler_GetStatusMachine() {
+	tests := []struct {
+		name        string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					GetStatusMachine(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_status_machine_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["get_status_machine_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - xml",
+			accept: "text/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					GetStatusMachine(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_status_machine_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["get_status_machine_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "internal error",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					GetStatusMachine(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/orders/status-machine", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
 
 			// Act
 			s.router.ServeHTTP(w, req)
@@ -244,6 +612,8 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Update() {
 		name        string
 		url         string
 		body        *strings.Reader
+		ifMatch     string
+		apiKey      string
 		setupMocks  func()
 		checkResult func(*testing.T, *httptest.ResponseRecorder)
 	}{
@@ -257,12 +627,166 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Update() {
 						ID:         15,
 						CustomerID: 5,
 						Status:     valueobject.PENDING,
+						Source:     valueobject.SourceAPI,
 					}).
-					Return([]byte(s.responses["update_success"]), nil)
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, res.Code)
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["update_success"])
+				assert.Equal(t, `"1"`, res.Header().Get("ETag"))
+			},
+		},
+		{
+			name:    "success - with If-Match",
+			url:     "/orders/15",
+			body:    strings.NewReader(s.requests["update_success"]),
+			ifMatch: `"3"`,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderInput{
+						ID:         15,
+						CustomerID: 5,
+						Status:     valueobject.PENDING,
+						Version:    3,
+						Source:     valueobject.SourceAPI,
+					}).
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "success - with actor and reason",
+			url:  "/orders/15",
+			body: strings.NewReader(s.requests["update_with_reason"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderInput{
+						ID:         15,
+						CustomerID: 5,
+						Status:     valueobject.CANCELLED,
+						ActorType:  valueobject.ActorCustomer,
+						ActorID:    "5",
+						ReasonCode: "CUSTOMER_REQUEST",
+						ReasonText: "Customer gave up the order",
+						Source:     valueobject.SourceAPI,
+					}).
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:   "success - api client updates as staff",
+			url:    "/orders/15",
+			body:   strings.NewReader(s.requests["update_as_staff"]),
+			apiKey: orderAPIKey,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderInput{
+						ID:         15,
+						CustomerID: 5,
+						Status:     valueobject.PREPARING,
+						StaffID:    3,
+						ActorType:  valueobject.ActorStaff,
+						ActorID:    "back-office",
+						Source:     valueobject.SourceAPI,
+					}).
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:       "forbidden - staff actor without api key",
+			url:        "/orders/15",
+			body:       strings.NewReader(s.requests["update_as_staff"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name:       "unauthorized - unknown api key",
+			url:        "/orders/15",
+			body:       strings.NewReader(s.requests["update_as_staff"]),
+			apiKey:     "random-key",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - internal actor type",
+			url:        "/orders/15",
+			body:       strings.NewReader(s.requests["update_system_actor"]),
+			apiKey:     orderAPIKey,
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_body"])
+			},
+		},
+		{
+			name:       "invalid request - unknown actor type",
+			url:        "/orders/15",
+			body:       strings.NewReader(s.requests["update_invalid_actor_type"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_body"])
+			},
+		},
+		{
+			name:       "invalid If-Match",
+			url:        "/orders/15",
+			body:       strings.NewReader(s.requests["update_success"]),
+			ifMatch:    `"abc"`,
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusPreconditionFailed, res.Code)
+			},
+		},
+		{
+			name:    "version mismatch",
+			url:     "/orders/15",
+			body:    strings.NewReader(s.requests["update_success"]),
+			ifMatch: `W/"2"`,
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderInput{
+						ID:         15,
+						CustomerID: 5,
+						Status:     valueobject.PENDING,
+						Version:    2,
+						Source:     valueobject.SourceAPI,
+					}).
+					Return(nil, uint32(0), domain.NewPreconditionFailedError(domain.ErrOrderVersionMismatch))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusPreconditionFailed, res.Code)
+			},
+		},
+		{
+			name: "concurrent update",
+			url:  "/orders/15",
+			body: strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderInput{
+						ID:         15,
+						CustomerID: 5,
+						Status:     valueobject.PENDING,
+						Source:     valueobject.SourceAPI,
+					}).
+					Return(nil, uint32(0), domain.NewConflictError(domain.ErrOrderVersionConflict))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusConflict, res.Code)
 			},
 		},
 		{
@@ -305,8 +829,9 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Update() {
 						ID:         15,
 						CustomerID: 5,
 						Status:     valueobject.PENDING,
+						Source:     valueobject.SourceAPI,
 					}).
-					Return(nil, domain.NewInternalError(nil))
+					Return(nil, uint32(0), domain.NewInternalError(nil))
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, res.Code)
@@ -321,6 +846,12 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Update() {
 			tt.setupMocks()
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodPut, tt.url, tt.body)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
 
 			// Act
 			s.router.ServeHTTP(w, req)
@@ -349,8 +880,9 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_UpdatePartial() {
 						ID:         15,
 						CustomerID: 5,
 						Status:     valueobject.PENDING,
+						Source:     valueobject.SourceAPI,
 					}).
-					Return([]byte(s.responses["update_success"]), nil)
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusOK, res.Code)
@@ -397,8 +929,9 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_UpdatePartial() {
 						ID:         15,
 						CustomerID: 5,
 						Status:     valueobject.PENDING,
+						Source:     valueobject.SourceAPI,
 					}).
-					Return(nil, domain.NewInternalError(nil))
+					Return(nil, uint32(0), domain.NewInternalError(nil))
 			},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusInternalServerError, res.Code)
@@ -482,3 +1015,255 @@ func (s *OrderHandlerSuiteTest) TestOrderHandler_Delete() {
 		})
 	}
 }
+
+func (s *OrderHandlerSuiteTest) TestOrderHandler_GetByGuestToken() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/guest/JBSWY3DPEHPK3PXPJBSWY3DPEH",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					GetByGuestToken(gomock.Any(), gomock.Any(), dto.GetGuestOrderInput{GuestToken: "JBSWY3DPEHPK3PXPJBSWY3DPEH"}).
+					Return([]byte(s.responses["get_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["get_success"])
+			},
+		},
+		{
+			name: "not found",
+			url:  "/orders/guest/UNKNOWN",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					GetByGuestToken(gomock.Any(), gomock.Any(), dto.GetGuestOrderInput{GuestToken: "UNKNOWN"}).
+					Return(nil, uint32(0), domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+		{
+			name:       "invalid request - token is too long",
+			url:        "/orders/guest/" + strings.Repeat("A", 65),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *OrderHandlerSuiteTest) TestOrderHandler_AttachCustomer() {
+	input := dto.AttachOrderCustomerInput{ID: 1, CustomerID: 7, GuestToken: "JBSWY3DPEHPK3PXPJBSWY3DPEH"}
+
+	tests := []struct {
+		name        string
+		url         string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/1/customer",
+			body: strings.NewReader(s.requests["attach_customer_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					AttachCustomer(gomock.Any(), gomock.Any(), input).
+					Return([]byte(s.responses["update_success"]), uint32(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["update_success"])
+			},
+		},
+		{
+			name:       "invalid request - guest token is missing",
+			url:        "/orders/1/customer",
+			body:       strings.NewReader(s.requests["attach_customer_invalid_body"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - id is not a number",
+			url:        "/orders/invalid/customer",
+			body:       strings.NewReader(s.requests["attach_customer_success"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name: "invalid guest token",
+			url:  "/orders/1/customer",
+			body: strings.NewReader(s.requests["attach_customer_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					AttachCustomer(gomock.Any(), gomock.Any(), input).
+					Return(nil, uint32(0), domain.NewUnauthorizedError(domain.ErrInvalidGuestToken))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name: "order already has a customer",
+			url:  "/orders/1/customer",
+			body: strings.NewReader(s.requests["attach_customer_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					AttachCustomer(gomock.Any(), gomock.Any(), input).
+					Return(nil, uint32(0), domain.NewConflictError(domain.ErrOrderHasCustomer))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusConflict, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, tt.url, tt.body)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *OrderHandlerSuiteTest) TestOrderHandler_ListByCustomer() {
+	tests := []struct {
+		name        string
+		url         string
+		token       string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success",
+			url:   "/customers/1/orders?from=2025-01-01T00:00:00Z&to=2025-02-01T00:00:00Z&page=1&limit=10",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					ListByCustomer(gomock.Any(), gomock.Any(), dto.ListCustomerOrdersInput{
+						CustomerID: 1,
+						From:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						To:         time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+						Page:       1,
+						Limit:      10,
+					}).
+					Return([]byte(s.responses["list_by_customer_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["list_by_customer_success"])
+			},
+		},
+		{
+			name:  "token of another customer",
+			url:   "/customers/2/orders",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name:       "missing authorization header",
+			url:        "/customers/1/orders",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:  "invalid token",
+			url:   "/customers/1/orders",
+			token: "Bearer invalid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("invalid-token").Return(uint64(0), assert.AnError)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:  "invalid request - from is not a date",
+			url:   "/customers/1/orders?from=yesterday",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:  "invalid period",
+			url:   "/customers/1/orders?from=2025-02-01T00:00:00Z&to=2025-01-01T00:00:00Z",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					ListByCustomer(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInvalidInputError(domain.ErrInvalidPeriod))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
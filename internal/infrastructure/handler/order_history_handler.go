@@ -26,16 +26,21 @@ func (h *OrderHistoryHandler) Register(router *gin.RouterGroup) {
 	//router.Use(middleware.JWTAuthMiddleware(h.jwtService))
 	router.GET("", h.List)
 	router.GET("/:id", h.Get)
-	router.DELETE("/:id", h.Delete)
+}
+
+// RegisterOrderRoutes registers the routes nested on a single order, ex: /orders/{id}/histories
+func (h *OrderHistoryHandler) RegisterOrderRoutes(router *gin.RouterGroup) {
+	router.GET("/verify", h.Verify)
 }
 
 // List godoc
 
 // @Summary		List order histories
 // @Description	List all order histories
+// @Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
 // @Tags			orders
 // @Accept			json
-// @Produce		json
+// @Produce		json,xml,text/csv
 // @Param			order_id	query		string										false	"Filter by order_id"
 // @Param			status		query		string										false	"Filter by status. Available options: OPEN, CANCELLED, PENDING, RECEIVED, PREPARING, READY, COMPLETED"
 // @Param			page		query		int											false	"Page number"		default(1)
@@ -70,9 +75,10 @@ func (h *OrderHistoryHandler) List(c *gin.Context) {
 		Limit:   query.Limit,
 	}
 
+	p, contentType := selectOrderHistoryListOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.List(
 		c.Request.Context(),
-		presenter.NewOrderHistoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -80,16 +86,17 @@ func (h *OrderHistoryHandler) List(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Get godoc
 //
 //	@Summary		Get order history
 //	@Description	Search for a order history by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			id	path		int									true	"OrderHistory ID"
 //	@Success		200	{object}	presenter.OrderHistoryJsonResponse	"OK"
 //	@Failure		400	{object}	middleware.ErrorJsonResponse		"Bad Request"
@@ -107,9 +114,10 @@ func (h *OrderHistoryHandler) Get(c *gin.Context) {
 		ID: uri.ID,
 	}
 
+	p, contentType := selectOrderHistoryOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Get(
 		c.Request.Context(),
-		presenter.NewOrderHistoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -117,34 +125,37 @@ func (h *OrderHistoryHandler) Get(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
-// Delete godoc
+// Verify godoc
 //
-//	@Summary		Delete order history
-//	@Description	Deletes a order history by ID
+//	@Summary		Verify order histories
+//	@Description	Verifies the hash chain of the histories of an order.
+//	@Description	Histories created before the chain was introduced are reported as unsealed
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
-//	@Produce		json
-//	@Param			id	path		int									true	"OrderHistory ID"
-//	@Success		200	{object}	presenter.OrderHistoryJsonResponse	"OK"
-//	@Failure		400	{object}	middleware.ErrorJsonResponse		"Bad Request"
-//	@Failure		404	{object}	middleware.ErrorJsonResponse		"Not Found"
-//	@Failure		500	{object}	middleware.ErrorJsonResponse		"Internal Server Error"
-//	@Router			/orders/histories/{id} [delete]
-func (h *OrderHistoryHandler) Delete(c *gin.Context) {
-	var uri request.DeleteOrderHistoryUriRequest
+//	@Produce		json,xml
+//	@Param			id	path		int												true	"Order ID"
+//	@Success		200	{object}	presenter.OrderHistoryVerificationJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse					"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/orders/{id}/histories/verify [get]
+func (h *OrderHistoryHandler) Verify(c *gin.Context) {
+	var uri request.VerifyOrderHistoriesUriRequest
 	if err := c.ShouldBindUri(&uri); err != nil {
 		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
 		return
 	}
 
-	input := dto.DeleteOrderHistoryInput{
-		ID: uri.ID,
+	input := dto.VerifyOrderHistoriesInput{
+		OrderID: uri.OrderID,
 	}
-	output, err := h.controller.Delete(
+	p, contentType := selectOrderHistoryOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Verify(
 		c.Request.Context(),
-		presenter.NewOrderHistoryJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -152,5 +163,20 @@ func (h *OrderHistoryHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+func selectOrderHistoryOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderHistoryJsonPresenter(),
+		xml:  presenter.NewOrderHistoryXmlPresenter(),
+	})
+}
+
+func selectOrderHistoryListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderHistoryJsonPresenter(),
+		xml:  presenter.NewOrderHistoryXmlPresenter(),
+		csv:  presenter.NewOrderHistoryCsvPresenter(),
+	})
 }
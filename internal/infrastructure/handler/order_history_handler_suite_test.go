@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type OrderHistoryHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.OrderHistoryHandler
+	router         *gin.Engine
+	mockController *mockport.MockOrderHistoryController
+	mockJWTService *mockport.MockJWTService
+	ctx            context.Context
+	responses      map[string]string // Golden files
+}
+
+func (s *OrderHistoryHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockOrderHistoryController(ctrl)
+	s.mockJWTService = mockport.NewMockJWTService(ctrl)
+	s.handler = handler.NewOrderHistoryHandler(s.mockController, s.mockJWTService)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/orders/histories", s.handler.List)
+
+	// Mock responses
+	var err error
+	s.responses, err = util.ReadGoldenFiles("order_history",
+		"list_success_xml", "list_success_csv",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestOrderHistoryHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(OrderHistoryHandlerSuiteTest))
+}
@@ -0,0 +1,95 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *OrderHistoryHandlerSuiteTest) TestOrderHistoryHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "success - xml",
+			url:    "/orders/histories?order_id=1",
+			accept: "application/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListOrderHistoriesInput{OrderID: 1, Page: 1, Limit: 10}).
+					Return([]byte(s.responses["list_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - csv",
+			url:    "/orders/histories?order_id=1",
+			accept: "text/csv, */*;q=0.1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListOrderHistoriesInput{OrderID: 1, Page: 1, Limit: 10}).
+					Return([]byte(s.responses["list_success_csv"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_csv"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:       "invalid query - missing order_id",
+			url:        "/orders/histories",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Equal(t, s.responses["error_invalid_parameter"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "controller error",
+			url:  "/orders/histories?order_id=1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
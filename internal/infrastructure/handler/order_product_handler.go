@@ -22,19 +22,21 @@ func NewOrderProductHandler(controller port.OrderProductController) *OrderProduc
 
 func (h *OrderProductHandler) Register(router *gin.RouterGroup) {
 	router.GET("", h.List)
+	router.GET("/sales-report", h.SalesReport)
 	router.POST("/:order_id/:product_id", h.Create)
-	router.GET("/:order_id/:product_id", h.Get)
-	router.PUT("/:order_id/:product_id", h.Update)
-	router.DELETE("/:order_id/:product_id", h.Delete)
+	router.GET("/items/:id", h.Get)
+	router.PUT("/items/:id", h.Update)
+	router.DELETE("/items/:id", h.Delete)
 }
 
 // List godoc
 //
 //	@Summary		List order products
 //	@Description	List all order products
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml,text/csv
 //	@Param			order_id	query		string										false	"Filter by order ID"
 //	@Param			page		query		int											false	"Page number"		default(1)
 //	@Param			limit		query		int											false	"Items per page"	default(10)
@@ -56,9 +58,10 @@ func (h *OrderProductHandler) List(c *gin.Context) {
 		Limit:     query.Limit,
 	}
 
+	p, contentType := selectOrderProductListOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.List(
 		c.Request.Context(),
-		presenter.NewOrderProductJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -66,21 +69,23 @@ func (h *OrderProductHandler) List(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Create godoc
 //
 //	@Summary		Create an order product
-//	@Description	Create an order product
+//	@Description	Adds a line item to the order, the same product can be added many times with different notes and modifiers
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,xml
 //	@Param			order_id	path		int										true	"Order ID"
 //	@Param			product_id	path		int										true	"Product ID"
 //	@Param			order		body		request.CreateOrderProductBodyRequest	true	"OrderProduct data"
 //	@Success		201			{object}	presenter.OrderProductJsonResponse		"Created"
 //	@Failure		400			{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse			"Not Found"
 //	@Router			/orders/products/{order_id}/{product_id} [post]
 func (h *OrderProductHandler) Create(c *gin.Context) {
 	var uri request.CreateOrderProductUriRequest
@@ -96,14 +101,18 @@ func (h *OrderProductHandler) Create(c *gin.Context) {
 	}
 
 	input := dto.CreateOrderProductInput{
-		OrderID:   uri.OrderID,
-		ProductID: uri.ProductID,
-		Quantity:  body.Quantity,
+		OrderID:          uri.OrderID,
+		ProductID:        uri.ProductID,
+		Quantity:         body.Quantity,
+		Notes:            body.Notes,
+		ModifierIDs:      body.ModifierIDs,
+		BundleSelections: toBundleSelectionsInput(body.BundleSelections),
 	}
 
+	p, contentType := selectOrderProductOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Create(
 		c.Request.Context(),
-		presenter.NewOrderProductJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -111,23 +120,23 @@ func (h *OrderProductHandler) Create(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusCreated, "application/json", output)
+	c.Data(http.StatusCreated, contentType, output)
 }
 
 // Get godoc
 //
 //	@Summary		Get an order product
 //	@Description	Get an order product
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
-//	@Param			order_id	path		int									true	"Order ID"
-//	@Param			product_id	path		int									true	"Product ID"
-//	@Success		200			{object}	presenter.OrderProductJsonResponse	"OK"
-//	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
-//	@Failure		404			{object}	middleware.ErrorJsonResponse		"Not Found"
-//	@Failure		500			{object}	middleware.ErrorJsonResponse		"Internal Server Error"
-//	@Router			/orders/products/{order_id}/{product_id} [get]
+//	@Produce		json,xml
+//	@Param			id	path		int									true	"Order product ID"
+//	@Success		200	{object}	presenter.OrderProductJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse		"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/orders/products/items/{id} [get]
 func (h *OrderProductHandler) Get(c *gin.Context) {
 	var uri request.GetOrderProductUriRequest
 	if err := c.ShouldBindUri(&uri); err != nil {
@@ -136,13 +145,13 @@ func (h *OrderProductHandler) Get(c *gin.Context) {
 	}
 
 	input := dto.GetOrderProductInput{
-		OrderID:   uri.OrderID,
-		ProductID: uri.ProductID,
+		ID: uri.ID,
 	}
 
+	p, contentType := selectOrderProductOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Get(
 		c.Request.Context(),
-		presenter.NewOrderProductJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -150,24 +159,24 @@ func (h *OrderProductHandler) Get(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Update godoc
 //
 //	@Summary		Update order product
 //	@Description	Update an existing order product
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
 //	@Accept			json
-//	@Produce		json
-//	@Param			order_id	path		int										true	"Order ID"
-//	@Param			product_id	path		int										true	"Product ID"
-//	@Param			order		body		request.UpdateOrderProductBodyRequest	true	"OrderProduct data"
-//	@Success		200			{object}	presenter.OrderProductJsonResponse		"OK"
-//	@Failure		400			{object}	middleware.ErrorJsonResponse			"Bad Request"
-//	@Failure		404			{object}	middleware.ErrorJsonResponse			"Not Found"
-//	@Failure		500			{object}	middleware.ErrorJsonResponse			"Internal Server Error"
-//	@Router			/orders/products/{order_id}/{product_id} [put]
+//	@Produce		json,xml
+//	@Param			id		path		int										true	"Order product ID"
+//	@Param			order	body		request.UpdateOrderProductBodyRequest	true	"OrderProduct data"
+//	@Success		200		{object}	presenter.OrderProductJsonResponse		"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/orders/products/items/{id} [put]
 func (h *OrderProductHandler) Update(c *gin.Context) {
 	var uri request.UpdateOrderProductUriRequest
 	if err := c.ShouldBindUri(&uri); err != nil {
@@ -182,14 +191,17 @@ func (h *OrderProductHandler) Update(c *gin.Context) {
 	}
 
 	input := dto.UpdateOrderProductInput{
-		OrderID:   uri.OrderID,
-		ProductID: uri.ProductID,
-		Quantity:  body.Quantity,
+		ID:               uri.ID,
+		Quantity:         body.Quantity,
+		Notes:            body.Notes,
+		ModifierIDs:      body.ModifierIDs,
+		BundleSelections: toBundleSelectionsInput(body.BundleSelections),
 	}
 
+	p, contentType := selectOrderProductOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Update(
 		c.Request.Context(),
-		presenter.NewOrderProductJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -197,22 +209,22 @@ func (h *OrderProductHandler) Update(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
 }
 
 // Delete godoc
 //
 //	@Summary		Delete order product
-//	@Description	Deletes a order product by Order ID and Product ID
+//	@Description	Deletes a order product by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			orders
-//	@Produce		json
-//	@Param			order_id	path		int									true	"Order ID"
-//	@Param			product_id	path		int									true	"Product ID"
-//	@Success		200			{object}	presenter.OrderProductJsonResponse	"OK"
-//	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
-//	@Failure		404			{object}	middleware.ErrorJsonResponse		"Not Found"
-//	@Failure		500			{object}	middleware.ErrorJsonResponse		"Internal Server Error"
-//	@Router			/orders/products/{order_id}/{product_id} [delete]
+//	@Produce		json,xml
+//	@Param			id	path		int									true	"Order product ID"
+//	@Success		200	{object}	presenter.OrderProductJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse		"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/orders/products/items/{id} [delete]
 func (h *OrderProductHandler) Delete(c *gin.Context) {
 	var uri request.DeleteOrderProductUriRequest
 	if err := c.ShouldBindUri(&uri); err != nil {
@@ -221,13 +233,13 @@ func (h *OrderProductHandler) Delete(c *gin.Context) {
 	}
 
 	input := dto.DeleteOrderProductInput{
-		OrderID:   uri.OrderID,
-		ProductID: uri.ProductID,
+		ID: uri.ID,
 	}
 
+	p, contentType := selectOrderProductOutputConfigs(c.GetHeader("Accept"))
 	output, err := h.controller.Delete(
 		c.Request.Context(),
-		presenter.NewOrderProductJsonPresenter(),
+		p,
 		input,
 	)
 	if err != nil {
@@ -235,5 +247,74 @@ func (h *OrderProductHandler) Delete(c *gin.Context) {
 		return
 	}
 
-	c.Data(http.StatusOK, "application/json", output)
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// SalesReport godoc
+//
+//	@Summary		Products sales report
+//	@Description	Quantity and revenue of the products sold in the period, bundles are expanded into their components
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			orders
+//	@Produce		json,xml,text/csv
+//	@Param			from	query		string							true	"Start of the period (RFC3339)"
+//	@Param			to		query		string							true	"End of the period, exclusive (RFC3339)"
+//	@Success		200		{object}	presenter.SalesReportJsonResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/orders/products/sales-report [get]
+func (h *OrderProductHandler) SalesReport(c *gin.Context) {
+	var query request.SalesReportQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.GetSalesReportInput{
+		From: query.From,
+		To:   query.To,
+	}
+
+	p, contentType := selectOrderProductListOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.SalesReport(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// toBundleSelectionsInput converts the bundle selections request to the dto input
+func toBundleSelectionsInput(selections []request.BundleSelectionRequest) []dto.BundleSelectionInput {
+	if selections == nil {
+		return nil
+	}
+	output := make([]dto.BundleSelectionInput, len(selections))
+	for i, selection := range selections {
+		output[i] = dto.BundleSelectionInput{
+			SlotID:    selection.SlotID,
+			ProductID: selection.ProductID,
+		}
+	}
+	return output
+}
+
+func selectOrderProductOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderProductJsonPresenter(),
+		xml:  presenter.NewOrderProductXmlPresenter(),
+	})
+}
+
+func selectOrderProductListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewOrderProductJsonPresenter(),
+		xml:  presenter.NewOrderProductXmlPresenter(),
+		csv:  presenter.NewOrderProductCsvPresenter(),
+	})
 }
@@ -37,25 +37,27 @@ func (s *OrderProductHandlerSuiteTest) SetupTest() {
 	// Register routes
 	s.router.GET("/orders/products", s.handler.List)
 	s.router.POST("/orders/products/:order_id/:product_id", s.handler.Create)
-	s.router.PUT("/orders/products/:order_id/:product_id", s.handler.Update)
-	s.router.GET("/orders/products/:order_id/:product_id", s.handler.Get)
-	s.router.DELETE("/orders/products/:order_id/:product_id", s.handler.Delete)
+	s.router.GET("/orders/products/sales-report", s.handler.SalesReport)
+	s.router.PUT("/orders/products/items/:id", s.handler.Update)
+	s.router.GET("/orders/products/items/:id", s.handler.Get)
+	s.router.DELETE("/orders/products/items/:id", s.handler.Delete)
 
 	// Mock requests
 	var err error
 	s.requests, err = util.ReadFixtureFiles("order_product",
-		"create_success", "create_invalid_body",
+		"create_success", "create_invalid_body", "create_invalid_modifier",
 		"update_success", "update_invalid_body",
 	)
 	assert.NoError(s.T(), err)
 
 	// Mock responses
 	s.responses, err = util.ReadGoldenFiles("order_product",
-		"list_success", "list_success_with_query",
+		"list_success", "list_success_with_query", "list_success_xml", "list_success_csv",
 		"create_success",
 		"update_success",
 		"get_success",
 		"delete_success",
+		"sales_report_success",
 	)
 	assert.NoError(s.T(), err)
 	addCommonResponses(&s.responses)
@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
@@ -17,6 +18,7 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_List() {
 	tests := []struct {
 		name        string
 		url         string
+		accept      string
 		setupMocks  func()
 		checkResult func(*testing.T, *httptest.ResponseRecorder)
 	}{
@@ -49,6 +51,32 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_List() {
 				assert.Contains(t, res.Body.String(), s.responses["list_success_with_query"])
 			},
 		},
+		{
+			name:   "success - xml",
+			url:    "/orders/products",
+			accept: "application/json;q=0.5, application/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrderProductsInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - csv",
+			url:    "/orders/products",
+			accept: "text/csv",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListOrderProductsInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_csv"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_csv"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
 		{
 			name:       "invalid query - page",
 			url:        "/orders/products?page=invalid",
@@ -80,6 +108,9 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_List() {
 			tt.setupMocks()
 			w := httptest.NewRecorder()
 			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
 
 			// Act
 			s.router.ServeHTTP(w, req)
@@ -105,9 +136,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
-						Quantity:  4,
+						OrderID:     1,
+						ProductID:   1,
+						Quantity:    4,
+						Notes:       "No tomato",
+						ModifierIDs: []uint64{1},
 					}).
 					Return([]byte(s.responses["create_success"]), nil)
 			},
@@ -134,6 +167,16 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Create() {
 				assert.Equal(t, http.StatusBadRequest, res.Code)
 			},
 		},
+		{
+			name:       "invalid request - modifier id is zero",
+			url:        "/orders/products/1/1",
+			body:       strings.NewReader(s.requests["create_invalid_modifier"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_body"])
+			},
+		},
 		{
 			name:       "invalid request - order_id is not a number",
 			url:        "/orders/products/invalid/1",
@@ -151,9 +194,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Create() {
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Create(gomock.Any(), gomock.Any(), dto.CreateOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
-						Quantity:  4,
+						OrderID:     1,
+						ProductID:   1,
+						Quantity:    4,
+						Notes:       "No tomato",
+						ModifierIDs: []uint64{1},
 					}).
 					Return(nil, domain.NewInternalError(nil))
 			},
@@ -189,12 +234,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Get() {
 	}{
 		{
 			name: "success",
-			url:  "/orders/products/1/1",
+			url:  "/orders/products/items/1",
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Get(gomock.Any(), gomock.Any(), dto.GetOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
+						ID: 1,
 					}).
 					Return([]byte(s.responses["get_success"]), nil)
 			},
@@ -205,12 +249,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Get() {
 		},
 		{
 			name: "not found",
-			url:  "/orders/products/1/5",
+			url:  "/orders/products/items/5",
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Get(gomock.Any(), gomock.Any(), dto.GetOrderProductInput{
-						OrderID:   1,
-						ProductID: 5,
+						ID: 5,
 					}).
 					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
 			},
@@ -220,8 +263,8 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Get() {
 			},
 		},
 		{
-			name:       "invalid request - order_id is not a number",
-			url:        "/orders/products/invalid/1",
+			name:       "invalid request - id is not a number",
+			url:        "/orders/products/items/invalid",
 			setupMocks: func() {},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusBadRequest, res.Code)
@@ -256,14 +299,14 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Update() {
 	}{
 		{
 			name: "success - update OrderProduct Quantity",
-			url:  "/orders/products/1/1",
+			url:  "/orders/products/items/1",
 			body: strings.NewReader(s.requests["update_success"]),
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
-						Quantity:  2,
+						ID:       1,
+						Quantity: 2,
+						Notes:    "Well done",
 					}).
 					Return([]byte(s.responses["update_success"]), nil)
 			},
@@ -274,7 +317,7 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Update() {
 		},
 		{
 			name:       "invalid request - body is not a valid json",
-			url:        "/orders/products/1/1",
+			url:        "/orders/products/items/1",
 			body:       strings.NewReader("invalid"),
 			setupMocks: func() {},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
@@ -284,7 +327,7 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Update() {
 		},
 		{
 			name:       "invalid request - body field Quantity is not a number",
-			url:        "/orders/products/1/1",
+			url:        "/orders/products/items/1",
 			body:       strings.NewReader(s.requests["update_invalid_body"]),
 			setupMocks: func() {},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
@@ -293,8 +336,8 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Update() {
 			},
 		},
 		{
-			name:       "invalid request - order_id is not a number",
-			url:        "/orders/products/invalid/1",
+			name:       "invalid request - id is not a number",
+			url:        "/orders/products/items/invalid",
 			body:       strings.NewReader(s.requests["update_success"]),
 			setupMocks: func() {},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
@@ -304,14 +347,14 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Update() {
 		},
 		{
 			name: "controller error",
-			url:  "/orders/products/1/1",
+			url:  "/orders/products/items/1",
 			body: strings.NewReader(s.requests["update_success"]),
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Update(gomock.Any(), gomock.Any(), dto.UpdateOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
-						Quantity:  2,
+						ID:       1,
+						Quantity: 2,
+						Notes:    "Well done",
 					}).
 					Return(nil, domain.NewInternalError(nil))
 			},
@@ -347,12 +390,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Delete() {
 	}{
 		{
 			name: "success",
-			url:  "/orders/products/1/1",
+			url:  "/orders/products/items/1",
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Delete(gomock.Any(), gomock.Any(), dto.DeleteOrderProductInput{
-						OrderID:   1,
-						ProductID: 1,
+						ID: 1,
 					}).
 					Return([]byte(s.responses["delete_success"]), nil)
 			},
@@ -363,12 +405,11 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Delete() {
 		},
 		{
 			name: "not found",
-			url:  "/orders/products/1/5",
+			url:  "/orders/products/items/5",
 			setupMocks: func() {
 				s.mockController.EXPECT().
 					Delete(gomock.Any(), gomock.Any(), dto.DeleteOrderProductInput{
-						OrderID:   1,
-						ProductID: 5,
+						ID: 5,
 					}).
 					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
 			},
@@ -378,8 +419,8 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Delete() {
 			},
 		},
 		{
-			name:       "invalid request - order_id is not a number",
-			url:        "/orders/products/invalid/1",
+			name:       "invalid request - id is not a number",
+			url:        "/orders/products/items/invalid",
 			setupMocks: func() {},
 			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
 				assert.Equal(t, http.StatusBadRequest, res.Code)
@@ -403,3 +444,60 @@ func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_Delete() {
 		})
 	}
 }
+
+func (s *OrderProductHandlerSuiteTest) TestOrderProductHandler_SalesReport() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/products/sales-report?from=2025-01-01T00:00:00Z&to=2025-02-01T00:00:00Z",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					SalesReport(gomock.Any(), gomock.Any(), dto.GetSalesReportInput{
+						From: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						To:   time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+					}).
+					Return([]byte(s.responses["sales_report_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["sales_report_success"])
+			},
+		},
+		{
+			name:       "invalid query - missing period",
+			url:        "/orders/products/sales-report?from=2025-01-01T00:00:00Z",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid query - from is not a date",
+			url:        "/orders/products/sales-report?from=yesterday&to=2025-02-01T00:00:00Z",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
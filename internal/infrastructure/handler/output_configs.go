@@ -0,0 +1,31 @@
+package handler
+
+import (
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/negotiation"
+)
+
+// outputFormats are the presenters a response can be written with, csv is only set by the list endpoints
+type outputFormats struct {
+	json port.Presenter
+	xml  port.Presenter
+	csv  port.Presenter
+}
+
+// selectOutputConfigs negotiates the presenter and the content type of the response with the Accept header.
+// JSON is the default, so clients that don't accept any of the formats keep receiving JSON
+func selectOutputConfigs(acceptHeader string, formats outputFormats) (port.Presenter, string) {
+	offers := []string{negotiation.MIMEJSON, negotiation.MIMEXML, negotiation.MIMETextXML}
+	if formats.csv != nil {
+		offers = append(offers, negotiation.MIMECSV)
+	}
+
+	switch contentType := negotiation.Negotiate(acceptHeader, offers...); contentType {
+	case negotiation.MIMEXML, negotiation.MIMETextXML:
+		return formats.xml, contentType
+	case negotiation.MIMECSV:
+		return formats.csv, contentType
+	default:
+		return formats.json, negotiation.MIMEJSON
+	}
+}
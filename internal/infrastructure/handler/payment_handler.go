@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+// PaymentServicePartner is the partner ID sent by the payment service on the signed callbacks
+const PaymentServicePartner = "payment-service"
+
+type PaymentHandler struct {
+	controller      port.PaymentController
+	callbackSecrets map[string]string
+	signatureConfig middleware.WebhookSignatureConfig
+}
+
+// NewPaymentHandler creates the payment handler, the callbacks are rejected while the callback secret is empty
+func NewPaymentHandler(controller port.PaymentController, callbackSecret string, signatureConfig middleware.WebhookSignatureConfig) *PaymentHandler {
+	callbackSecrets := map[string]string{}
+	if callbackSecret != "" {
+		callbackSecrets[PaymentServicePartner] = callbackSecret
+	}
+	return &PaymentHandler{controller, callbackSecrets, signatureConfig}
+}
+
+// Register registers the callback of the payment service, it is only accepted when signed with the callback secret
+func (h *PaymentHandler) Register(router *gin.RouterGroup) {
+	router.POST("/callback", middleware.WebhookSignature(h.callbackSecrets, h.signatureConfig), h.Callback)
+}
+
+func (h *PaymentHandler) RegisterOrderRoutes(router *gin.RouterGroup) {
+	router.POST("", h.Checkout)
+}
+
+// Checkout godoc
+//
+//	@Summary		Checkout order
+//	@Description	Moves the **OPEN** order to **PENDING** and creates its payment on the payment service
+//	@Description	The order goes to **RECEIVED** or **CANCELLED** when the payment service informs the outcome of the payment
+//	@Description	The order is reopened when the payment can't be created
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			payments
+//	@Produce		json,xml
+//	@Param			id	path		int								true	"Order ID"
+//	@Success		201	{object}	presenter.OrderJsonResponse		"Created"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		409	{object}	middleware.ErrorJsonResponse	"Conflict"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/orders/{id}/checkout [post]
+func (h *PaymentHandler) Checkout(c *gin.Context) {
+	var uri request.CheckoutUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.CheckoutInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Checkout(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+// Callback godoc
+//
+//	@Summary		Payment callback
+//	@Description	Informs the outcome of a payment, approved payments move the order to **RECEIVED** and the others to **CANCELLED**
+//	@Description	Repeated callbacks of the same outcome return the order without changing it
+//	@Description	The request must be signed with the callback secret like the partner webhooks, with `X-Webhook-Partner: payment-service`
+//	@Description	A signed request is only accepted once, the retries must be signed again with a new timestamp
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			payments
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			X-Webhook-Partner	header		string								true	"payment-service"
+//	@Param			X-Webhook-Timestamp	header		int									true	"Unix time in seconds"
+//	@Param			X-Webhook-Signature	header		string								true	"HMAC-SHA256 signature"
+//	@Param			payment				body		request.PaymentCallbackBodyRequest	true	"Payment outcome"
+//	@Success		200					{object}	presenter.OrderJsonResponse			"OK"
+//	@Failure		400					{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		401					{object}	middleware.ErrorJsonResponse		"Unauthorized"
+//	@Failure		404					{object}	middleware.ErrorJsonResponse		"Not Found"
+//	@Failure		409					{object}	middleware.ErrorJsonResponse		"Conflict"
+//	@Failure		412					{object}	middleware.ErrorJsonResponse		"Precondition Failed"
+//	@Failure		500					{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/payments/callback [post]
+func (h *PaymentHandler) Callback(c *gin.Context) {
+	var body request.PaymentCallbackBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	status, _ := valueobject.ToPaymentStatus(body.Status)
+	input := dto.PaymentCallbackInput{
+		Reference: body.Reference,
+		Status:    status,
+	}
+
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Callback(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
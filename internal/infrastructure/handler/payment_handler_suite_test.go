@@ -0,0 +1,68 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/replay"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// paymentCallbackSecret is the secret of the payment service callbacks
+const paymentCallbackSecret = "payment-service-secret"
+
+type PaymentHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.PaymentHandler
+	router         *gin.Engine
+	mockController *mockport.MockPaymentController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *PaymentHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockPaymentController(ctrl)
+	s.handler = handler.NewPaymentHandler(s.mockController, paymentCallbackSecret, middleware.WebhookSignatureConfig{
+		Tolerance:    5 * time.Minute,
+		MaxBodyBytes: 1024,
+		ReplayStore:  replay.NewMemoryStore(),
+	})
+	s.ctx = context.Background()
+
+	// Register routes, the callback with the signature middleware
+	s.router.POST("/orders/:id/checkout", s.handler.Checkout)
+	s.handler.Register(s.router.Group("/payments"))
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("payment",
+		"callback_success", "callback_invalid_status",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("payment",
+		"checkout_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestPaymentHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(PaymentHandlerSuiteTest))
+}
@@ -0,0 +1,195 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *PaymentHandlerSuiteTest) TestPaymentHandler_Checkout() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/1/checkout",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Checkout(gomock.Any(), gomock.Any(), dto.CheckoutInput{ID: 1}).
+					Return([]byte(s.responses["checkout_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["checkout_success"])
+			},
+		},
+		{
+			name:       "invalid request - id is not a number",
+			url:        "/orders/invalid/checkout",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name: "order is not open",
+			url:  "/orders/1/checkout",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Checkout(gomock.Any(), gomock.Any(), dto.CheckoutInput{ID: 1}).
+					Return(nil, domain.NewInvalidInputError(domain.ErrOrderIsNotOpen))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "payment service failure",
+			url:  "/orders/1/checkout",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Checkout(gomock.Any(), gomock.Any(), dto.CheckoutInput{ID: 1}).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.url, nil)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *PaymentHandlerSuiteTest) TestPaymentHandler_Callback() {
+	now := time.Now().Unix()
+	// Each request is signed with its own timestamp, a request is only accepted once
+	signedHeaders := func(secret, body string) map[string]string {
+		now++
+		return map[string]string{
+			middleware.WebhookPartnerHeader:   handler.PaymentServicePartner,
+			middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+			middleware.WebhookSignatureHeader: signature.Sign(secret, now, []byte(body)),
+		}
+	}
+	input := dto.PaymentCallbackInput{Reference: "pay_8f14e45f", Status: valueobject.PaymentApproved}
+
+	tests := []struct {
+		name        string
+		body        string
+		headers     map[string]string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "success",
+			body:    s.requests["callback_success"],
+			headers: signedHeaders(paymentCallbackSecret, s.requests["callback_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Callback(gomock.Any(), gomock.Any(), input).
+					Return([]byte(s.responses["checkout_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:       "unauthorized - missing signature",
+			body:       s.requests["callback_success"],
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:       "unauthorized - signed with another secret",
+			body:       s.requests["callback_success"],
+			headers:    signedHeaders("another-secret", s.requests["callback_success"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - unknown payment status",
+			body:       s.requests["callback_invalid_status"],
+			headers:    signedHeaders(paymentCallbackSecret, s.requests["callback_invalid_status"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - body is empty",
+			body:       "",
+			headers:    signedHeaders(paymentCallbackSecret, ""),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:    "payment not found",
+			body:    s.requests["callback_success"],
+			headers: signedHeaders(paymentCallbackSecret, s.requests["callback_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Callback(gomock.Any(), gomock.Any(), input).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/payments/callback", strings.NewReader(tt.body))
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/negotiation"
+)
+
+type PickupBoardHandler struct {
+	controller port.PickupBoardController
+}
+
+func NewPickupBoardHandler(controller port.PickupBoardController) *PickupBoardHandler {
+	return &PickupBoardHandler{controller}
+}
+
+func (h *PickupBoardHandler) Register(router *gin.RouterGroup) {
+	router.GET("", h.Get)
+}
+
+// Get godoc
+//
+//	@Summary		Get pickup board
+//	@Description	Lists the pickup codes of the orders being prepared and ready to be picked up, for the lobby monitor
+//	@Description	No customer data is shown, the codes are assigned when the order is RECEIVED and start over every day
+//	@Description	Response can return JSON or an HTML page that reloads itself (Accept header: application/json or text/html)
+//	@Tags			pickup-board
+//	@Produce		json,html
+//	@Success		200	{object}	presenter.PickupBoardJsonResponse	"OK"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/pickup-board [get]
+func (h *PickupBoardHandler) Get(c *gin.Context) {
+	p, contentType := selectPickupBoardOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Get(c.Request.Context(), p)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// selectPickupBoardOutputConfigs negotiates the format of the pickup board, JSON is the default
+func selectPickupBoardOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	switch negotiation.Negotiate(acceptHeader, negotiation.MIMEJSON, negotiation.MIMEHTML) {
+	case negotiation.MIMEHTML:
+		return presenter.NewPickupBoardHtmlPresenter(), negotiation.MIMEHTML + "; charset=utf-8"
+	default:
+		return presenter.NewPickupBoardJsonPresenter(), negotiation.MIMEJSON
+	}
+}
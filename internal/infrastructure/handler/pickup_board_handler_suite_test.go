@@ -0,0 +1,50 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PickupBoardHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.PickupBoardHandler
+	router         *gin.Engine
+	mockController *mockport.MockPickupBoardController
+	ctx            context.Context
+	responses      map[string]string // Golden files
+}
+
+func (s *PickupBoardHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockPickupBoardController(ctrl)
+	s.handler = handler.NewPickupBoardHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/pickup-board", s.handler.Get)
+
+	// Mock responses
+	var err error
+	s.responses, err = util.ReadGoldenFiles("pickup_board",
+		"get_success", "get_success_html",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestPickupBoardHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(PickupBoardHandlerSuiteTest))
+}
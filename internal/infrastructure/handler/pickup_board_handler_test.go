@@ -0,0 +1,81 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *PickupBoardHandlerSuiteTest) TestPickupBoardHandler_Get() {
+	tests := []struct {
+		name        string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - json by default",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/json", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["get_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - html",
+			accept: "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return([]byte(s.responses["get_success_html"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/html; charset=utf-8", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["get_success_html"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "internal error",
+			accept: "application/json",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/pickup-board", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
@@ -32,12 +33,13 @@ func (h *ProductHandler) Register(router *gin.RouterGroup) {
 //
 //	@Summary		List products (Reference TC-1 2.b.iv)
 //	@Description	List all products
-//	@Description	Response can return JSON or XML format (Accept header: application/json or text/xml)
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
 //	@Tags			products
 //	@Accept			json
-//	@Produce		json,xml
+//	@Produce		json,xml,text/csv
 //	@Param			name		query		string									false	"Filter by name"
 //	@Param			category_id	query		int										false	"Filter by category ID"
+//	@Param			available_at	query		string									false	"Only products available at the moment (RFC3339), default now"
 //	@Param			page		query		int										false	"Page number"		default(1)
 //	@Param			limit		query		int										false	"Items per page"	default(10)
 //	@Success		200			{object}	presenter.ProductJsonPaginatedResponse	"OK"
@@ -52,13 +54,14 @@ func (h *ProductHandler) List(c *gin.Context) {
 	}
 
 	input := dto.ListProductsInput{
-		Name:       query.Name,
-		CategoryID: query.CategoryID,
-		Page:       query.Page,
-		Limit:      query.Limit,
+		Name:        query.Name,
+		CategoryID:  query.CategoryID,
+		AvailableAt: query.AvailableAt,
+		Page:        query.Page,
+		Limit:       query.Limit,
 	}
 
-	p, contentType := selectOutputConfigs(c.GetHeader("Accept"))
+	p, contentType := selectProductListOutputConfigs(c.GetHeader("Accept"))
 
 	output, err := h.controller.List(c.Request.Context(), p, input)
 	if err != nil {
@@ -73,7 +76,7 @@ func (h *ProductHandler) List(c *gin.Context) {
 //
 //	@Summary		Create product (Reference TC-1 2.b.iii)
 //	@Description	Creates a new product
-//	@Description	Response can return JSON or XML format (Accept header: application/json or text/xml)
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			products
 //	@Accept			json
 //	@Produce		json,xml
@@ -90,13 +93,17 @@ func (h *ProductHandler) Create(c *gin.Context) {
 	}
 
 	input := dto.CreateProductInput{
-		Name:        body.Name,
-		Description: body.Description,
-		Price:       body.Price,
-		CategoryID:  body.CategoryID,
+		Name:                body.Name,
+		Description:         body.Description,
+		Price:               body.Price,
+		CategoryID:          body.CategoryID,
+		KitchenStation:      toKitchenStation(body.KitchenStation),
+		ModifierGroups:      toProductModifierGroupsInput(body.ModifierGroups),
+		BundleSlots:         toProductBundleSlotsInput(body.BundleSlots),
+		AvailabilityWindows: toAvailabilityWindowsInput(body.Availability),
 	}
 
-	p, contentType := selectOutputConfigs(c.GetHeader("Accept"))
+	p, contentType := selectProductOutputConfigs(c.GetHeader("Accept"))
 
 	output, err := h.controller.Create(c.Request.Context(), p, input)
 	if err != nil {
@@ -111,7 +118,7 @@ func (h *ProductHandler) Create(c *gin.Context) {
 //
 //	@Summary		Get product
 //	@Description	Search for a product by ID
-//	@Description	Response can return JSON or XML format (Accept header: application/json or text/xml)
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			products
 //	@Accept			json
 //	@Produce		json,xml
@@ -132,7 +139,7 @@ func (h *ProductHandler) Get(c *gin.Context) {
 		ID: uri.ID,
 	}
 
-	p, contentType := selectOutputConfigs(c.GetHeader("Accept"))
+	p, contentType := selectProductOutputConfigs(c.GetHeader("Accept"))
 
 	output, err := h.controller.Get(c.Request.Context(), p, input)
 	if err != nil {
@@ -147,7 +154,7 @@ func (h *ProductHandler) Get(c *gin.Context) {
 //
 //	@Summary		Update product (Reference TC-1 2.b.iii)
 //	@Description	Update an existing product
-//	@Description	Response can return JSON or XML format (Accept header: application/json or text/xml)
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			products
 //	@Accept			json
 //	@Produce		json,xml
@@ -172,14 +179,18 @@ func (h *ProductHandler) Update(c *gin.Context) {
 	}
 
 	input := dto.UpdateProductInput{
-		ID:          uri.ID,
-		Name:        body.Name,
-		Description: body.Description,
-		Price:       body.Price,
-		CategoryID:  body.CategoryID,
+		ID:                  uri.ID,
+		Name:                body.Name,
+		Description:         body.Description,
+		Price:               body.Price,
+		CategoryID:          body.CategoryID,
+		KitchenStation:      toKitchenStation(body.KitchenStation),
+		ModifierGroups:      toProductModifierGroupsInput(body.ModifierGroups),
+		BundleSlots:         toProductBundleSlotsInput(body.BundleSlots),
+		AvailabilityWindows: toAvailabilityWindowsInput(body.Availability),
 	}
 
-	p, contentType := selectOutputConfigs(c.GetHeader("Accept"))
+	p, contentType := selectProductOutputConfigs(c.GetHeader("Accept"))
 
 	output, err := h.controller.Update(c.Request.Context(), p, input)
 	if err != nil {
@@ -194,7 +205,7 @@ func (h *ProductHandler) Update(c *gin.Context) {
 //
 //	@Summary		Delete product (Reference TC-1 2.b.iii)
 //	@Description	Deletes a product by ID
-//	@Description	Response can return JSON or XML format (Accept header: application/json or text/xml)
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
 //	@Tags			products
 //	@Accept			json
 //	@Produce		json,xml
@@ -215,7 +226,7 @@ func (h *ProductHandler) Delete(c *gin.Context) {
 		ID: uri.ID,
 	}
 
-	p, contentType := selectOutputConfigs(c.GetHeader("Accept"))
+	p, contentType := selectProductOutputConfigs(c.GetHeader("Accept"))
 
 	output, err := h.controller.Delete(c.Request.Context(), p, input)
 	if err != nil {
@@ -226,9 +237,81 @@ func (h *ProductHandler) Delete(c *gin.Context) {
 	c.Data(http.StatusOK, contentType, output)
 }
 
-func selectOutputConfigs(acceptHeader string) (port.Presenter, string) {
-	if acceptHeader == "text/xml" {
-		return presenter.NewProductXmlPresenter(), acceptHeader
+func selectProductOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewProductJsonPresenter(),
+		xml:  presenter.NewProductXmlPresenter(),
+	})
+}
+
+func selectProductListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewProductJsonPresenter(),
+		xml:  presenter.NewProductXmlPresenter(),
+		csv:  presenter.NewProductCsvPresenter(),
+	})
+}
+
+// toProductModifierGroupsInput converts the modifier groups request to the dto input
+func toProductModifierGroupsInput(groups []request.ProductModifierGroupRequest) []dto.ProductModifierGroupInput {
+	if groups == nil {
+		return nil
+	}
+	output := make([]dto.ProductModifierGroupInput, len(groups))
+	for i, group := range groups {
+		modifiers := make([]dto.ProductModifierInput, len(group.Modifiers))
+		for j, modifier := range group.Modifiers {
+			modifiers[j] = dto.ProductModifierInput{
+				Name:       modifier.Name,
+				PriceDelta: modifier.PriceDelta,
+			}
+		}
+		output[i] = dto.ProductModifierGroupInput{
+			Name:       group.Name,
+			MinChoices: group.MinChoices,
+			MaxChoices: group.MaxChoices,
+			Modifiers:  modifiers,
+		}
+	}
+	return output
+}
+
+// toProductBundleSlotsInput converts the bundle slots request to the dto input
+func toProductBundleSlotsInput(slots []request.ProductBundleSlotRequest) []dto.ProductBundleSlotInput {
+	if slots == nil {
+		return nil
+	}
+	output := make([]dto.ProductBundleSlotInput, len(slots))
+	for i, slot := range slots {
+		options := make([]dto.ProductBundleOptionInput, len(slot.Options))
+		for j, option := range slot.Options {
+			options[j] = dto.ProductBundleOptionInput{
+				ProductID:  option.ProductID,
+				PriceDelta: option.PriceDelta,
+			}
+		}
+		output[i] = dto.ProductBundleSlotInput{
+			Name:     slot.Name,
+			Quantity: slot.Quantity,
+			Options:  options,
+		}
+	}
+	return output
+}
+
+// toAvailabilityWindowsInput converts the availability windows request to the dto input
+func toAvailabilityWindowsInput(windows []request.AvailabilityWindowRequest) []dto.AvailabilityWindowInput {
+	if windows == nil {
+		return nil
+	}
+	output := make([]dto.AvailabilityWindowInput, len(windows))
+	for i, window := range windows {
+		output[i] = dto.AvailabilityWindowInput{
+			Weekday:   time.Weekday(*window.Weekday),
+			StartTime: window.StartTime,
+			EndTime:   window.EndTime,
+			Timezone:  window.Timezone,
+		}
 	}
-	return presenter.NewProductJsonPresenter(), "application/json"
+	return output
 }
@@ -45,6 +45,7 @@ func (s *ProductHandlerSuiteTest) SetupTest() {
 	var err error
 	s.requests, err = util.ReadFixtureFiles("product",
 		"create_success", "create_invalid_body",
+		"create_with_modifier_groups", "create_invalid_modifier_groups",
 		"update_success", "update_invalid_body",
 	)
 	assert.NoError(s.T(), err)
@@ -117,6 +117,45 @@ func (s *ProductHandlerSuiteTest) TestProductHandler_Create() {
 				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["create_success"])
 			},
 		},
+		{
+			name: "success - with modifier groups",
+			url:  "/products",
+			body: strings.NewReader(s.requests["create_with_modifier_groups"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreateProductInput{
+						Name:        "X-Burger",
+						Description: "Hamburger with cheese",
+						Price:       25.9,
+						CategoryID:  1,
+						ModifierGroups: []dto.ProductModifierGroupInput{
+							{
+								Name:       "Extras",
+								MinChoices: 0,
+								MaxChoices: 2,
+								Modifiers: []dto.ProductModifierInput{
+									{Name: "Extra cheese", PriceDelta: 2.5},
+									{Name: "No tomato", PriceDelta: 0},
+								},
+							},
+						},
+					}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - modifier group without modifiers",
+			url:        "/products",
+			body:       strings.NewReader(s.requests["create_invalid_modifier_groups"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_body"])
+			},
+		},
 		{
 			name:       "invalid request - body is not a valid json",
 			url:        "/products",
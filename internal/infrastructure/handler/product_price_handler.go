@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type ProductPriceHandler struct {
+	controller port.ProductPriceController
+}
+
+func NewProductPriceHandler(controller port.ProductPriceController) *ProductPriceHandler {
+	return &ProductPriceHandler{controller}
+}
+
+// RegisterProductRoutes registers the routes nested on a single product, ex: /products/{id}/prices
+func (h *ProductPriceHandler) RegisterProductRoutes(router *gin.RouterGroup) {
+	router.GET("", h.List)
+	router.POST("", h.Schedule)
+}
+
+// List godoc
+//
+//	@Summary		List product prices
+//	@Description	List the price history of a product, including the scheduled prices, the latest first
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			products
+//	@Produce		json,xml,text/csv
+//	@Param			id		path		int											true	"Product ID"
+//	@Param			page	query		int											false	"Page number"		default(1)
+//	@Param			limit	query		int											false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.ProductPriceJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse				"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse				"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/products/{id}/prices [get]
+func (h *ProductPriceHandler) List(c *gin.Context) {
+	var uri request.ProductPriceUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var query request.ListProductPricesQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.ListProductPricesInput{
+		ProductID: uri.ProductID,
+		Page:      query.Page,
+		Limit:     query.Limit,
+	}
+
+	p, contentType := selectProductPriceListOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.List(c.Request.Context(), p, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Schedule godoc
+//
+//	@Summary		Schedule product price
+//	@Description	Sets the price of a product from effective_from (now when omitted) until effective_to (the next change when omitted)
+//	@Description	The prices overlapping the range are trimmed, the past prices are kept on the history
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			products
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id		path		int										true	"Product ID"
+//	@Param			price	body		request.ScheduleProductPriceBodyRequest	true	"Price data"
+//	@Success		201		{object}	presenter.ProductPriceJsonResponse		"Created"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/products/{id}/prices [post]
+func (h *ProductPriceHandler) Schedule(c *gin.Context) {
+	var uri request.ProductPriceUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.ScheduleProductPriceBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	input := dto.ScheduleProductPriceInput{
+		ProductID:   uri.ProductID,
+		Price:       body.Price,
+		EffectiveTo: body.EffectiveTo,
+	}
+	if body.EffectiveFrom != nil {
+		input.EffectiveFrom = *body.EffectiveFrom
+	}
+
+	p, contentType := selectProductPriceOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Schedule(c.Request.Context(), p, input)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+func selectProductPriceOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewProductPriceJsonPresenter(),
+		xml:  presenter.NewProductPriceXmlPresenter(),
+	})
+}
+
+func selectProductPriceListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewProductPriceJsonPresenter(),
+		xml:  presenter.NewProductPriceXmlPresenter(),
+		csv:  presenter.NewProductPriceCsvPresenter(),
+	})
+}
@@ -0,0 +1,58 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ProductPriceHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.ProductPriceHandler
+	router         *gin.Engine
+	mockController *mockport.MockProductPriceController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *ProductPriceHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockProductPriceController(ctrl)
+	s.handler = handler.NewProductPriceHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/products/:id/prices", s.handler.List)
+	s.router.POST("/products/:id/prices", s.handler.Schedule)
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("product_price",
+		"schedule_success", "schedule_invalid_price",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("product_price",
+		"list_success", "schedule_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestProductPriceHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(ProductPriceHandlerSuiteTest))
+}
@@ -0,0 +1,158 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+)
+
+func (s *ProductPriceHandlerSuiteTest) TestProductPriceHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/products/1/prices",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), dto.ListProductPricesInput{ProductID: 1, Page: 1, Limit: 10}).
+					Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, s.responses["list_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:       "invalid product id",
+			url:        "/products/abc/prices",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Equal(t, s.responses["error_invalid_parameter"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "product not found",
+			url:  "/products/9/prices",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Equal(t, s.responses["error_not_found"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *ProductPriceHandlerSuiteTest) TestProductPriceHandler_Schedule() {
+	effectiveFrom := time.Date(2025, 3, 10, 3, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		url         string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/products/1/prices",
+			body: strings.NewReader(s.requests["schedule_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Schedule(gomock.Any(), gomock.Any(), gomock.Cond(func(input dto.ScheduleProductPriceInput) bool {
+						return input.ProductID == 1 && input.Price == 27.9 && input.EffectiveFrom.Equal(effectiveFrom) && input.EffectiveTo == nil
+					})).
+					Return([]byte(s.responses["schedule_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Equal(t, s.responses["schedule_success"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:       "invalid price",
+			url:        "/products/1/prices",
+			body:       strings.NewReader(s.requests["schedule_invalid_price"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "price in the past",
+			url:  "/products/1/prices",
+			body: strings.NewReader(s.requests["schedule_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Schedule(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInvalidInputError("price effective_from must not be in the past"))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), "price effective_from must not be in the past")
+			},
+		},
+		{
+			name: "internal error",
+			url:  "/products/1/prices",
+			body: strings.NewReader(s.requests["schedule_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Schedule(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Equal(t, s.responses["error_internal_error"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.url, tt.body)
+			req.Header.Set("Content-Type", "application/json")
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
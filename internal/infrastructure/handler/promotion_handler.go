@@ -0,0 +1,365 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type PromotionHandler struct {
+	controller port.PromotionController
+}
+
+func NewPromotionHandler(controller port.PromotionController) *PromotionHandler {
+	return &PromotionHandler{controller}
+}
+
+func (h *PromotionHandler) Register(router *gin.RouterGroup) {
+	router.GET("", h.List)
+	router.POST("", h.Create)
+	router.GET("/:id", h.Get)
+	router.PUT("/:id", h.Update)
+	router.DELETE("/:id", h.Delete)
+}
+
+// RegisterOrderRoutes registers the routes nested on a single order, ex: /orders/{id}/promotions
+func (h *PromotionHandler) RegisterOrderRoutes(router *gin.RouterGroup) {
+	router.POST("", h.ApplyToOrder)
+	router.DELETE("/:code", h.RemoveFromOrder)
+}
+
+// List godoc
+//
+//	@Summary		List promotions
+//	@Description	List all promotions
+//	@Description	Response can return JSON, XML or CSV format (Accept header: application/json, application/xml, text/xml or text/csv)
+//	@Tags			promotion
+//	@Accept			json
+//	@Produce		json,xml,text/csv
+//	@Param			name	query		string									false	"Filter by name"
+//	@Param			page	query		int										false	"Page number"		default(1)
+//	@Param			limit	query		int										false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.PromotionJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/promotions [get]
+func (h *PromotionHandler) List(c *gin.Context) {
+	var query request.ListPromotionsQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.ListPromotionsInput{
+		Name:  query.Name,
+		Page:  query.Page,
+		Limit: query.Limit,
+	}
+
+	p, contentType := selectPromotionListOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.List(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Create godoc
+//
+//	@Summary		Create promotion
+//	@Description	Creates a new promotion, promotions without a code are applied automatically to the OPEN orders
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			promotion
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			promotion	body		request.CreatePromotionBodyRequest	true	"Promotion data"
+//	@Success		201			{object}	presenter.PromotionJsonResponse		"Created"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		409			{object}	middleware.ErrorJsonResponse		"Conflict"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/promotions [post]
+func (h *PromotionHandler) Create(c *gin.Context) {
+	var body request.CreatePromotionBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Create(
+		c.Request.Context(),
+		p,
+		toCreatePromotionInput(body),
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+// Get godoc
+//
+//	@Summary		Get promotion
+//	@Description	Search for a promotion by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			promotion
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id	path		int								true	"Promotion ID"
+//	@Success		200	{object}	presenter.PromotionJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/promotions/{id} [get]
+func (h *PromotionHandler) Get(c *gin.Context) {
+	var uri request.GetPromotionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.GetPromotionInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Get(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update promotion
+//	@Description	Update an existing promotion
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			promotion
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id			path		int									true	"Promotion ID"
+//	@Param			promotion	body		request.UpdatePromotionBodyRequest	true	"Promotion data"
+//	@Success		200			{object}	presenter.PromotionJsonResponse		"OK"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse		"Bad Request"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse		"Not Found"
+//	@Failure		409			{object}	middleware.ErrorJsonResponse		"Conflict"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse		"Internal Server Error"
+//	@Router			/promotions/{id} [put]
+func (h *PromotionHandler) Update(c *gin.Context) {
+	var uri request.UpdatePromotionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.UpdatePromotionBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	input := dto.UpdatePromotionInput{
+		ID:                   uri.ID,
+		CreatePromotionInput: toCreatePromotionInput(body.CreatePromotionBodyRequest),
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Update(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete promotion
+//	@Description	Deletes a promotion by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			promotion
+//	@Produce		json,xml
+//	@Param			id	path		int								true	"Promotion ID"
+//	@Success		200	{object}	presenter.PromotionJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/promotions/{id} [delete]
+func (h *PromotionHandler) Delete(c *gin.Context) {
+	var uri request.DeletePromotionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.DeletePromotionInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Delete(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// ApplyToOrder godoc
+//
+//	@Summary		Apply promotions to order
+//	@Description	Applies a coupon to an OPEN order and recalculates its discounts, without a coupon code only the discounts are recalculated
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders, promotion
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id		path		int										true	"Order ID"
+//	@Param			coupon	body		request.ApplyOrderPromotionsBodyRequest	false	"Coupon"
+//	@Success		200		{object}	presenter.OrderJsonResponse				"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/orders/{id}/promotions [post]
+func (h *PromotionHandler) ApplyToOrder(c *gin.Context) {
+	var uri request.OrderPromotionsUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.ApplyOrderPromotionsBodyRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&body); err != nil {
+			_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+			return
+		}
+	}
+
+	input := dto.ApplyOrderPromotionsInput{
+		OrderID:    uri.OrderID,
+		CouponCode: body.CouponCode,
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.ApplyToOrder(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// RemoveFromOrder godoc
+//
+//	@Summary		Remove coupon from order
+//	@Description	Removes a coupon from an OPEN order and recalculates its discounts
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders, promotion
+//	@Produce		json,xml
+//	@Param			id		path		int								true	"Order ID"
+//	@Param			code	path		string							true	"Coupon code"
+//	@Success		200		{object}	presenter.OrderJsonResponse		"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/orders/{id}/promotions/{code} [delete]
+func (h *PromotionHandler) RemoveFromOrder(c *gin.Context) {
+	var uri request.RemoveOrderCouponUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.RemoveOrderCouponInput{
+		OrderID:    uri.OrderID,
+		CouponCode: uri.CouponCode,
+	}
+
+	p, contentType := selectPromotionOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.RemoveFromOrder(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// toCreatePromotionInput converts the promotion body, promotions are active unless stated otherwise
+func toCreatePromotionInput(body request.CreatePromotionBodyRequest) dto.CreatePromotionInput {
+	discountType, _ := valueobject.ToDiscountType(body.DiscountType)
+	active := body.Active == nil || *body.Active
+
+	return dto.CreatePromotionInput{
+		Name:               body.Name,
+		Code:               body.Code,
+		DiscountType:       discountType,
+		Value:              body.Value,
+		CategoryID:         body.CategoryID,
+		ProductID:          body.ProductID,
+		StartsAt:           body.StartsAt,
+		EndsAt:             body.EndsAt,
+		HappyHourStart:     body.HappyHourStart,
+		HappyHourEnd:       body.HappyHourEnd,
+		Timezone:           body.Timezone,
+		MaxUsesPerCustomer: body.MaxUsesPerCustomer,
+		Stackable:          body.Stackable,
+		Active:             active,
+	}
+}
+
+func selectPromotionOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewPromotionJsonPresenter(),
+		xml:  presenter.NewPromotionXmlPresenter(),
+	})
+}
+
+func selectPromotionListOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewPromotionJsonPresenter(),
+		xml:  presenter.NewPromotionXmlPresenter(),
+		csv:  presenter.NewPromotionCsvPresenter(),
+	})
+}
@@ -0,0 +1,66 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type PromotionHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.PromotionHandler
+	router         *gin.Engine
+	mockController *mockport.MockPromotionController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *PromotionHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockPromotionController(ctrl)
+	s.handler = handler.NewPromotionHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.GET("/promotions", s.handler.List)
+	s.router.POST("/promotions", s.handler.Create)
+	s.router.PUT("/promotions/:id", s.handler.Update)
+	s.router.GET("/promotions/:id", s.handler.Get)
+	s.router.DELETE("/promotions/:id", s.handler.Delete)
+	s.router.POST("/orders/:id/promotions", s.handler.ApplyToOrder)
+	s.router.DELETE("/orders/:id/promotions/:code", s.handler.RemoveFromOrder)
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("promotion",
+		"create_success", "create_invalid_discount_type", "create_invalid_happy_hour",
+		"apply_success",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("promotion",
+		"list_success", "list_success_xml", "list_success_csv",
+		"create_success",
+		"apply_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestPromotionHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(PromotionHandlerSuiteTest))
+}
@@ -0,0 +1,295 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *PromotionHandlerSuiteTest) TestPromotionHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		accept      string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/promotions",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListPromotionsInput{
+					Page:  1,
+					Limit: 10,
+				}).Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, res.Body.String(), s.responses["list_success"])
+			},
+		},
+		{
+			name:   "success - xml",
+			url:    "/promotions",
+			accept: "application/json;q=0.5, application/xml",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListPromotionsInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_xml"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "application/xml", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_xml"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name:   "success - csv",
+			url:    "/promotions",
+			accept: "text/csv",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListPromotionsInput{Page: 1, Limit: 10}).Return([]byte(s.responses["list_success_csv"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "text/csv", res.Header().Get("Content-Type"))
+				assert.Equal(t, s.responses["list_success_csv"], util.RemoveAllSpaces(res.Body.String()))
+			},
+		},
+		{
+			name: "controller error",
+			url:  "/promotions",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListPromotionsInput{
+					Page:  1,
+					Limit: 10,
+				}).Return(nil, domain.NewInternalError(nil))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *PromotionHandlerSuiteTest) TestPromotionHandler_Create() {
+	tests := []struct {
+		name        string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: strings.NewReader(s.requests["create_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreatePromotionInput{
+						Name:               "Welcome coupon",
+						Code:               "WELCOME10",
+						DiscountType:       valueobject.DiscountPercentage,
+						Value:              10,
+						MaxUsesPerCustomer: 1,
+						Active:             true,
+					}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["create_success"])
+			},
+		},
+		{
+			name:       "invalid request - unknown discount type",
+			body:       strings.NewReader(s.requests["create_invalid_discount_type"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - happy hour is not in the HH:MM format",
+			body:       strings.NewReader(s.requests["create_invalid_happy_hour"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "conflict - code already in use",
+			body: strings.NewReader(s.requests["create_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewConflictError(domain.ErrPromotionCodeInUse))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusConflict, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/promotions", tt.body)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *PromotionHandlerSuiteTest) TestPromotionHandler_ApplyToOrder() {
+	tests := []struct {
+		name        string
+		url         string
+		body        string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/5/promotions",
+			body: s.requests["apply_success"],
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					ApplyToOrder(gomock.Any(), gomock.Any(), dto.ApplyOrderPromotionsInput{OrderID: 5, CouponCode: "welcome10"}).
+					Return([]byte(s.responses["apply_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["apply_success"])
+			},
+		},
+		{
+			name: "success - without body only recalculates the discounts",
+			url:  "/orders/5/promotions",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					ApplyToOrder(gomock.Any(), gomock.Any(), dto.ApplyOrderPromotionsInput{OrderID: 5}).
+					Return([]byte(s.responses["apply_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - order id is not a number",
+			url:        "/orders/invalid/promotions",
+			body:       s.requests["apply_success"],
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name: "coupon not found",
+			url:  "/orders/5/promotions",
+			body: s.requests["apply_success"],
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					ApplyToOrder(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewNotFoundError(domain.ErrCouponNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.url, strings.NewReader(tt.body))
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *PromotionHandlerSuiteTest) TestPromotionHandler_RemoveFromOrder() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/orders/5/promotions/WELCOME10",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					RemoveFromOrder(gomock.Any(), gomock.Any(), dto.RemoveOrderCouponInput{OrderID: 5, CouponCode: "WELCOME10"}).
+					Return([]byte(s.responses["apply_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "coupon not applied",
+			url:  "/orders/5/promotions/OTHER",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					RemoveFromOrder(gomock.Any(), gomock.Any(), dto.RemoveOrderCouponInput{OrderID: 5, CouponCode: "OTHER"}).
+					Return(nil, domain.NewNotFoundError(domain.ErrCouponNotApplied))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, tt.url, nil)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
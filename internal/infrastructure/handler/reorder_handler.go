@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+type ReorderHandler struct {
+	controller port.ReorderController
+	jwtService port.JWTService
+}
+
+func NewReorderHandler(controller port.ReorderController, jwtService port.JWTService) *ReorderHandler {
+	return &ReorderHandler{controller: controller, jwtService: jwtService}
+}
+
+// RegisterOrderRoutes registers the reorder, an order is only repeated by the access token of its customer
+func (h *ReorderHandler) RegisterOrderRoutes(router *gin.RouterGroup) {
+	router.Use(middleware.JWTAuthMiddleware(h.jwtService))
+	router.POST("", h.Reorder)
+}
+
+// Reorder godoc
+//
+//	@Summary		Reorder
+//	@Description	Creates a new **OPEN** order with the items of a previous order, priced at the current prices
+//	@Description	The items that can't be ordered anymore are skipped and listed in the warnings
+//	@Description	Requires the access token of the customer of the previous order, guest orders must be attached to the customer first
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			orders
+//	@Produce		json,xml
+//	@Security		BearerAuth
+//	@Param			id	path		int								true	"Previous order ID"
+//	@Success		201	{object}	presenter.ReorderJsonResponse	"Created"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		401	{object}	middleware.ErrorJsonResponse	"Unauthorized"
+//	@Failure		403	{object}	middleware.ErrorJsonResponse	"Forbidden"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/orders/{id}/reorder [post]
+func (h *ReorderHandler) Reorder(c *gin.Context) {
+	var uri request.ReorderUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.ReorderInput{
+		ID:         uri.ID,
+		CustomerID: middleware.TokenCustomerID(c),
+	}
+
+	p, contentType := selectReorderOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Reorder(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+func selectReorderOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewReorderJsonPresenter(),
+		xml:  presenter.NewReorderXmlPresenter(),
+	})
+}
@@ -0,0 +1,52 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type ReorderHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.ReorderHandler
+	router         *gin.Engine
+	mockController *mockport.MockReorderController
+	mockJWTService *mockport.MockJWTService
+	ctx            context.Context
+	responses      map[string]string // Golden files
+}
+
+func (s *ReorderHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockReorderController(ctrl)
+	s.mockJWTService = mockport.NewMockJWTService(ctrl)
+	s.handler = handler.NewReorderHandler(s.mockController, s.mockJWTService)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.handler.RegisterOrderRoutes(s.router.Group("/orders/:id/reorder"))
+
+	// Mock responses
+	var err error
+	s.responses, err = util.ReadGoldenFiles("reorder",
+		"reorder_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestReorderHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(ReorderHandlerSuiteTest))
+}
@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *ReorderHandlerSuiteTest) TestReorderHandler_Reorder() {
+	tests := []struct {
+		name        string
+		url         string
+		token       string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "success",
+			url:   "/orders/1/reorder",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Reorder(gomock.Any(), gomock.Any(), dto.ReorderInput{ID: 1, CustomerID: 1}).
+					Return([]byte(s.responses["reorder_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["reorder_success"])
+			},
+		},
+		{
+			name:  "invalid request - id is not a number",
+			url:   "/orders/invalid/reorder",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name:       "missing authorization header",
+			url:        "/orders/1/reorder",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+			},
+		},
+		{
+			name:  "previous order of another customer",
+			url:   "/orders/1/reorder",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Reorder(gomock.Any(), gomock.Any(), dto.ReorderInput{ID: 1, CustomerID: 1}).
+					Return(nil, domain.NewForbiddenError(domain.ErrCustomerMismatch))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusForbidden, res.Code)
+			},
+		},
+		{
+			name:  "previous order not found",
+			url:   "/orders/1/reorder",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Reorder(gomock.Any(), gomock.Any(), dto.ReorderInput{ID: 1, CustomerID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+		{
+			name:  "internal error",
+			url:   "/orders/1/reorder",
+			token: "Bearer valid-token",
+			setupMocks: func() {
+				s.mockJWTService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				s.mockController.EXPECT().
+					Reorder(gomock.Any(), gomock.Any(), dto.ReorderInput{ID: 1, CustomerID: 1}).
+					Return(nil, domain.NewInternalError(assert.AnError))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.url, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
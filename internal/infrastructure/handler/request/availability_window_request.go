@@ -0,0 +1,9 @@
+package request
+
+type AvailabilityWindowRequest struct {
+	// Weekday goes from 0 (sunday) to 6 (saturday)
+	Weekday   *int   `json:"weekday" binding:"required,min=0,max=6" example:"1"`
+	StartTime string `json:"start_time" binding:"required,datetime=15:04" example:"06:00"`
+	EndTime   string `json:"end_time" binding:"required,datetime=15:04" example:"10:30"`
+	Timezone  string `json:"timezone" binding:"required,timezone" example:"America/Sao_Paulo"`
+}
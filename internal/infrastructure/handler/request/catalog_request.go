@@ -0,0 +1,99 @@
+package request
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type ImportCatalogQueryRequest struct {
+	DryRun bool `form:"dry_run" example:"true"`
+}
+
+// CatalogBodyRequest is the catalog file, the XML follows the elements of the product XML response
+type CatalogBodyRequest struct {
+	XMLName  xml.Name                `json:"-" xml:"catalog"`
+	Products []CatalogProductRequest `json:"products" xml:"products>product"`
+}
+
+// CatalogProductRequest is a row of the catalog, a row without name only upserts its category
+type CatalogProductRequest struct {
+	Category       string  `json:"category" xml:"category" example:"Burgers"`
+	ParentCategory string  `json:"parent_category" xml:"parent_category" example:"Foods"`
+	Name           string  `json:"name" xml:"name" example:"X-Burger"`
+	Description    string  `json:"description" xml:"description" example:"Hamburger with cheese"`
+	Price          float64 `json:"price" xml:"price" example:"25.90"`
+	StockMode      string  `json:"stock_mode" xml:"stock_mode" example:"UNLIMITED"`
+	StockQuantity  *int64  `json:"stock_quantity" xml:"stock_quantity" example:"0"`
+	Available      *bool   `json:"available" xml:"available" example:"true"`
+}
+
+// ParseCatalogCsv reads the catalog rows of a CSV file, the first line is the header with the column names,
+// as in the export, and only the category column is required
+func ParseCatalogCsv(r io.Reader) (*CatalogBodyRequest, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("catalog csv header is missing")
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["category"]; !ok {
+		return nil, errors.New("catalog csv requires the category column")
+	}
+
+	var body CatalogBodyRequest
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("catalog csv row %d is malformed", row)
+		}
+
+		cell := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		product := CatalogProductRequest{
+			Category:       cell("category"),
+			ParentCategory: cell("parent_category"),
+			Name:           cell("name"),
+			Description:    cell("description"),
+			StockMode:      cell("stock_mode"),
+		}
+		if value := cell("price"); value != "" {
+			if product.Price, err = strconv.ParseFloat(value, 64); err != nil {
+				return nil, fmt.Errorf("catalog csv row %d: price must be a number", row)
+			}
+		}
+		if value := cell("stock_quantity"); value != "" {
+			quantity, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("catalog csv row %d: stock_quantity must be an integer", row)
+			}
+			product.StockQuantity = &quantity
+		}
+		if value := cell("available"); value != "" {
+			available, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("catalog csv row %d: available must be true or false", row)
+			}
+			product.Available = &available
+		}
+		body.Products = append(body.Products, product)
+	}
+	return &body, nil
+}
@@ -7,7 +7,14 @@ type ListCategoriesQueryRequest struct {
 }
 
 type CreateCategoryBodyRequest struct {
-	Name string `json:"name" binding:"required,min=3,max=100" example:"Foods"`
+	Name         string  `json:"name" binding:"required,min=3,max=100" example:"Foods"`
+	ParentID     *uint64 `json:"parent_id" binding:"omitempty,gt=0" example:"1"`
+	DisplayOrder int     `json:"display_order" binding:"gte=0" example:"1"`
+	Active       *bool   `json:"active" example:"true"`
+	ImageURL     string  `json:"image_url" binding:"omitempty,url,max=255" example:"https://cdn.fastfood.com/categories/foods.png"`
+	// KitchenStation is empty to inherit the station of the parent category
+	KitchenStation string                      `json:"kitchen_station" binding:"omitempty,kitchen_station_exists" example:"GRILL"`
+	Availability   []AvailabilityWindowRequest `json:"availability" binding:"omitempty,dive"`
 }
 
 type GetCategoryUriRequest struct {
@@ -19,7 +26,14 @@ type UpdateCategoryUriRequest struct {
 }
 
 type UpdateCategoryBodyRequest struct {
-	Name string `json:"name" binding:"omitempty,required" example:"Beverages"`
+	Name         string  `json:"name" binding:"omitempty,required" example:"Beverages"`
+	ParentID     *uint64 `json:"parent_id" binding:"omitempty,gt=0" example:"1"`
+	DisplayOrder int     `json:"display_order" binding:"gte=0" example:"2"`
+	Active       *bool   `json:"active" example:"true"`
+	ImageURL     string  `json:"image_url" binding:"omitempty,url,max=255" example:"https://cdn.fastfood.com/categories/beverages.png"`
+	// KitchenStation is empty to inherit the station of the parent category
+	KitchenStation string                      `json:"kitchen_station" binding:"omitempty,kitchen_station_exists" example:"DRINKS"`
+	Availability   []AvailabilityWindowRequest `json:"availability" binding:"omitempty,dive"`
 }
 
 type DeleteCategoryUriRequest struct {
@@ -0,0 +1,27 @@
+package request
+
+type ListKitchenTicketsQueryRequest struct {
+	Station string `form:"station" binding:"omitempty,kitchen_station_exists" example:"GRILL"`
+	Status  string `form:"status" binding:"omitempty,kitchen_ticket_status_exists" example:"QUEUED"`
+	Page    int    `form:"page,default=1" example:"1"`
+	Limit   int    `form:"limit,default=10" example:"10"`
+}
+
+type ListStationKitchenTicketsUriRequest struct {
+	Station string `uri:"station" binding:"required,kitchen_station_exists"`
+}
+
+type ListStationKitchenTicketsQueryRequest struct {
+	Status string `form:"status" binding:"omitempty,kitchen_ticket_status_exists" example:"QUEUED"`
+	Page   int    `form:"page,default=1" example:"1"`
+	Limit  int    `form:"limit,default=10" example:"10"`
+}
+
+type UpdateKitchenTicketUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdateKitchenTicketBodyRequest struct {
+	Status  string `json:"status" binding:"required,kitchen_ticket_status_exists" example:"IN_PROGRESS"`
+	StaffID uint64 `json:"staff_id" binding:"required,gt=0" example:"1"`
+}
@@ -0,0 +1,7 @@
+package request
+
+import "time"
+
+type GetMenuQueryRequest struct {
+	At time.Time `form:"at" time_format:"2006-01-02T15:04:05Z07:00" example:"2024-02-05T08:00:00-03:00"`
+}
@@ -0,0 +1,22 @@
+package request
+
+type GetNotificationPreferenceUriRequest struct {
+	CustomerID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdateNotificationPreferenceUriRequest struct {
+	CustomerID uint64 `uri:"id" binding:"required"`
+}
+
+// UpdateNotificationPreferenceBodyRequest is the opt-in of the customer, an empty list of channels opts out of all of them
+type UpdateNotificationPreferenceBodyRequest struct {
+	Locale    string   `json:"locale" binding:"omitempty,locale_exists" example:"pt-BR"`
+	Channels  []string `json:"channels" binding:"omitempty,dive,notification_channel_exists" example:"SMS"`
+	Email     string   `json:"email" binding:"omitempty,email,max=255" example:"john.doe@email.com"`
+	Phone     string   `json:"phone" binding:"omitempty,e164" example:"+5511999999999"`
+	PushToken string   `json:"push_token" binding:"omitempty,max=255" example:"fcm-token"`
+}
+
+type DeleteNotificationPreferenceUriRequest struct {
+	CustomerID uint64 `uri:"id" binding:"required"`
+}
@@ -13,6 +13,6 @@ type GetOrderHistoryUriRequest struct {
 	ID uint64 `uri:"id" binding:"required"`
 }
 
-type DeleteOrderHistoryUriRequest struct {
-	ID uint64 `uri:"id" binding:"required"`
+type VerifyOrderHistoriesUriRequest struct {
+	OrderID uint64 `uri:"id" binding:"required"`
 }
@@ -1,5 +1,7 @@
 package request
 
+import "time"
+
 type ListOrderProductsQueryRequest struct {
 	OrderID   uint64 `form:"order_id,default=0" example:"1"`
 	ProductID uint64 `form:"product_id,default=0" example:"1"`
@@ -13,24 +15,37 @@ type CreateOrderProductUriRequest struct {
 }
 
 type CreateOrderProductBodyRequest struct {
-	Quantity uint32 `json:"quantity" binding:"required" example:"1"`
+	Quantity         uint32                   `json:"quantity" binding:"required" example:"1"`
+	Notes            string                   `json:"notes" binding:"max=255" example:"No tomato"`
+	ModifierIDs      []uint64                 `json:"modifier_ids" binding:"omitempty,dive,gt=0" example:"1,2"`
+	BundleSelections []BundleSelectionRequest `json:"bundle_selections" binding:"omitempty,dive"`
 }
 
 type GetOrderProductUriRequest struct {
-	OrderID   uint64 `uri:"order_id" binding:"required"`
-	ProductID uint64 `uri:"product_id" binding:"required"`
+	ID uint64 `uri:"id" binding:"required"`
 }
 
 type UpdateOrderProductUriRequest struct {
-	OrderID   uint64 `uri:"order_id" binding:"required"`
-	ProductID uint64 `uri:"product_id" binding:"required"`
+	ID uint64 `uri:"id" binding:"required"`
 }
 
 type UpdateOrderProductBodyRequest struct {
-	Quantity uint32 `json:"quantity" binding:"required" example:"1"`
+	Quantity         uint32                   `json:"quantity" binding:"required" example:"1"`
+	Notes            string                   `json:"notes" binding:"max=255" example:"No tomato"`
+	ModifierIDs      []uint64                 `json:"modifier_ids" binding:"omitempty,dive,gt=0" example:"1,2"`
+	BundleSelections []BundleSelectionRequest `json:"bundle_selections" binding:"omitempty,dive"`
 }
 
 type DeleteOrderProductUriRequest struct {
-	OrderID   uint64 `uri:"order_id" binding:"required"`
-	ProductID uint64 `uri:"product_id" binding:"required"`
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type BundleSelectionRequest struct {
+	SlotID    uint64 `json:"slot_id" binding:"required" example:"3"`
+	ProductID uint64 `json:"product_id" binding:"required" example:"2"`
+}
+
+type SalesReportQueryRequest struct {
+	From time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00" binding:"required" example:"2024-02-01T00:00:00Z"`
+	To   time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00" binding:"required" example:"2024-03-01T00:00:00Z"`
 }
@@ -1,47 +1,118 @@
 package request
 
-import valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+import (
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+)
 
 type ListOrdersQueryRequest struct {
-	CustomerID    uint64 `form:"customer_id" example:"1" default:"0"`
-	Status        string `form:"status" binding:"omitempty" example:"PENDING"`
-	StatusExclude string `form:"status_exclude" binding:"omitempty" example:"CANCELLED,COMPLETED"`
-	Page          int    `form:"page,default=1" example:"1"`
-	Limit         int    `form:"limit,default=10" example:"10"`
+	CustomerID     uint64 `form:"customer_id" example:"1" default:"0"`
+	Status         string `form:"status" binding:"omitempty" example:"PENDING"`
+	StatusExclude  string `form:"status_exclude" binding:"omitempty" example:"CANCELLED,COMPLETED,DELIVERED"`
+	Channel        string `form:"channel" binding:"omitempty" example:"TOTEM,APP"`
+	FulfilmentMode string `form:"fulfilment_mode" binding:"omitempty" example:"DELIVERY"`
+	Page           int    `form:"page,default=1" example:"1"`
+	Limit          int    `form:"limit,default=10" example:"10"`
 	// Sort by default: status:d,created_at. Use <field_name>:d for descending, and the default order is ascending
 	Sort string `form:"sort" example:"status:d,created_at"`
 }
 
 type CreateOrderBodyRequest struct {
-	CustomerID uint64 `json:"customer_id" binding:"required" example:"1"`
+	// CustomerID is omitted for guest orders, they may inform the GuestName to be called by
+	CustomerID     uint64 `json:"customer_id" binding:"omitempty" example:"1"`
+	GuestName      string `json:"guest_name" binding:"omitempty,max=100" example:"John"`
+	Channel        string `json:"channel" binding:"omitempty,order_channel_exists" example:"TOTEM"`
+	FulfilmentMode string `json:"fulfilment_mode" binding:"omitempty,fulfilment_mode_exists" example:"DINE_IN"`
+	// TableNumber is required for DINE_IN orders and DeliveryAddress for DELIVERY orders
+	TableNumber     *uint32                 `json:"table_number" binding:"omitempty,gt=0" example:"12"`
+	DeliveryAddress *DeliveryAddressRequest `json:"delivery_address" binding:"omitempty"`
+}
+
+type DeliveryAddressRequest struct {
+	Street       string `json:"street" binding:"required,max=150" example:"Av. Paulista"`
+	Number       string `json:"number" binding:"required,max=20" example:"1000"`
+	Complement   string `json:"complement" binding:"omitempty,max=100" example:"Apto 42"`
+	Neighborhood string `json:"neighborhood" binding:"omitempty,max=100" example:"Bela Vista"`
+	City         string `json:"city" binding:"required,max=100" example:"São Paulo"`
+	State        string `json:"state" binding:"required,max=50" example:"SP"`
+	ZipCode      string `json:"zip_code" binding:"required,max=20" example:"01310-100"`
 }
 
 type GetOrderUriRequest struct {
 	ID uint64 `uri:"id" binding:"required"`
 }
 
+type ListCustomerOrdersUriRequest struct {
+	CustomerID uint64 `uri:"id" binding:"required"`
+}
+
+type ListCustomerOrdersQueryRequest struct {
+	// From and To filter the orders by the creation date, the period is open on the side omitted
+	From  time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00" example:"2024-02-01T00:00:00Z"`
+	To    time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00" example:"2024-03-01T00:00:00Z"`
+	Page  int       `form:"page,default=1" example:"1"`
+	Limit int       `form:"limit,default=10" example:"10"`
+}
+
+type ReorderUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type GetGuestOrderUriRequest struct {
+	GuestToken string `uri:"guest_token" binding:"required,max=64"`
+}
+
+type AttachOrderCustomerUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type AttachOrderCustomerBodyRequest struct {
+	CustomerID uint64 `json:"customer_id" binding:"required" example:"1"`
+	// GuestToken is the token returned when the guest order was created
+	GuestToken string `json:"guest_token" binding:"required,max=64" example:"JBSWY3DPEHPK3PXPJBSWY3DPEH"`
+}
+
 type UpdateOrderUriRequest struct {
 	ID uint64 `uri:"id" binding:"required"`
 }
 
 type UpdateOrderBodyRequest struct {
-	// StaffID is only required when status is PREPARING, READY or COMPLETED
+	// StaffID is only required when status is PREPARING, READY, COMPLETED, OUT_FOR_DELIVERY or DELIVERED
 	StaffID    uint64                  `json:"staff_id" example:"1"`
 	CustomerID uint64                  `json:"customer_id" binding:"required" example:"1"`
 	Status     valueobject.OrderStatus `json:"status" binding:"required,order_status_exists" example:"PENDING"`
+	// ActorType, ActorID, ReasonCode and ReasonText are recorded in the order history,
+	// ActorType is CUSTOMER or STAFF, STAFF only for the API clients (X-API-Key)
+	ActorType  valueobject.ActorType `json:"actor_type" binding:"omitempty,api_actor_type" example:"CUSTOMER"`
+	ActorID    string                `json:"actor_id" binding:"omitempty,max=64" example:"1"`
+	ReasonCode string                `json:"reason_code" binding:"omitempty,max=50" example:"CUSTOMER_REQUEST"`
+	ReasonText string                `json:"reason_text" binding:"omitempty,max=255" example:"Customer gave up the order"`
 }
 
 type UpdateOrderPartilRequest struct {
-	// StaffID is only required when status is PREPARING, READY or COMPLETED
+	// StaffID is only required when status is PREPARING, READY, COMPLETED, OUT_FOR_DELIVERY or DELIVERED
 	StaffID uint64                  `json:"staff_id" example:"1"`
 	Status  valueobject.OrderStatus `json:"status" example:"PENDING"`
+	// ActorType, ActorID, ReasonCode and ReasonText are recorded in the order history,
+	// ActorType is CUSTOMER or STAFF, STAFF only for the API clients (X-API-Key)
+	ActorType  valueobject.ActorType `json:"actor_type" binding:"omitempty,api_actor_type" example:"CUSTOMER"`
+	ActorID    string                `json:"actor_id" binding:"omitempty,max=64" example:"1"`
+	ReasonCode string                `json:"reason_code" binding:"omitempty,max=50" example:"CUSTOMER_REQUEST"`
+	ReasonText string                `json:"reason_text" binding:"omitempty,max=255" example:"Customer gave up the order"`
 }
 
 type UpdateOrderPartilBodyRequest struct {
 	CustomerID uint64 `json:"customer_id" example:"1"`
-	// StaffID is only required when status is PREPARING, READY or COMPLETED
+	// StaffID is only required when status is PREPARING, READY, COMPLETED, OUT_FOR_DELIVERY or DELIVERED
 	StaffID uint64                  `json:"staff_id" example:"1"`
 	Status  valueobject.OrderStatus `json:"status" binding:"omitempty,order_status_exists" example:"PENDING"`
+	// ActorType, ActorID, ReasonCode and ReasonText are recorded in the order history,
+	// ActorType is CUSTOMER or STAFF, STAFF only for the API clients (X-API-Key)
+	ActorType  valueobject.ActorType `json:"actor_type" binding:"omitempty,api_actor_type" example:"CUSTOMER"`
+	ActorID    string                `json:"actor_id" binding:"omitempty,max=64" example:"1"`
+	ReasonCode string                `json:"reason_code" binding:"omitempty,max=50" example:"CUSTOMER_REQUEST"`
+	ReasonText string                `json:"reason_text" binding:"omitempty,max=255" example:"Customer gave up the order"`
 }
 
 type DeleteOrderUriRequest struct {
@@ -0,0 +1,11 @@
+package request
+
+type CheckoutUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type PaymentCallbackBodyRequest struct {
+	// Reference is the payment ID returned by the payment service on the checkout
+	Reference string `json:"reference" binding:"required,max=100" example:"pay_8f14e45f"`
+	Status    string `json:"status" binding:"required,payment_status_exists" example:"APPROVED"`
+}
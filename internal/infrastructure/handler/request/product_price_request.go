@@ -0,0 +1,19 @@
+package request
+
+import "time"
+
+type ProductPriceUriRequest struct {
+	ProductID uint64 `uri:"id" binding:"required"`
+}
+
+type ListProductPricesQueryRequest struct {
+	Page  int `form:"page,default=1" example:"1"`
+	Limit int `form:"limit,default=10" example:"10"`
+}
+
+type ScheduleProductPriceBodyRequest struct {
+	Price float64 `json:"price" binding:"required,gt=0" example:"27.90"`
+	// EffectiveFrom is now when omitted, EffectiveTo keeps the price until the next change when omitted
+	EffectiveFrom *time.Time `json:"effective_from" example:"2024-02-05T00:00:00-03:00"`
+	EffectiveTo   *time.Time `json:"effective_to" example:"2024-02-12T00:00:00-03:00"`
+}
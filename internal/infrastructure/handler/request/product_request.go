@@ -1,10 +1,13 @@
 package request
 
+import "time"
+
 type ListProductQueryRequest struct {
-	Name       string `form:"name" example:"Product A"`
-	CategoryID uint64 `form:"category_id" example:"1"`
-	Page       int    `form:"page,default=1" example:"1"`
-	Limit      int    `form:"limit,default=10" example:"10"`
+	Name        string    `form:"name" example:"Product A"`
+	CategoryID  uint64    `form:"category_id" example:"1"`
+	AvailableAt time.Time `form:"available_at" time_format:"2006-01-02T15:04:05Z07:00" example:"2024-02-05T08:00:00-03:00"`
+	Page        int       `form:"page,default=1" example:"1"`
+	Limit       int       `form:"limit,default=10" example:"10"`
 }
 
 type CreateProductBodyRequest struct {
@@ -12,6 +15,11 @@ type CreateProductBodyRequest struct {
 	Description string  `json:"description" binding:"max=500" example:"Product A description"`
 	Price       float64 `json:"price" binding:"required,gt=0" example:"99.99"`
 	CategoryID  uint64  `json:"category_id" binding:"required,gt=0" example:"1"`
+	// KitchenStation is empty to inherit the station of the category
+	KitchenStation string                        `json:"kitchen_station" binding:"omitempty,kitchen_station_exists" example:"GRILL"`
+	ModifierGroups []ProductModifierGroupRequest `json:"modifier_groups" binding:"omitempty,dive"`
+	BundleSlots    []ProductBundleSlotRequest    `json:"bundle_slots" binding:"omitempty,dive"`
+	Availability   []AvailabilityWindowRequest   `json:"availability" binding:"omitempty,dive"`
 }
 
 // func (p *CreateProductRequest) Validate() error {
@@ -31,8 +39,36 @@ type UpdateProductBodyRequest struct {
 	Description string  `json:"description" binding:"max=500" example:"Product A description"`
 	Price       float64 `json:"price" binding:"required,gt=0" example:"99.99"`
 	CategoryID  uint64  `json:"category_id" binding:"required,gt=0" example:"1"`
+	// KitchenStation is empty to inherit the station of the category
+	KitchenStation string                        `json:"kitchen_station" binding:"omitempty,kitchen_station_exists" example:"GRILL"`
+	ModifierGroups []ProductModifierGroupRequest `json:"modifier_groups" binding:"omitempty,dive"`
+	BundleSlots    []ProductBundleSlotRequest    `json:"bundle_slots" binding:"omitempty,dive"`
+	Availability   []AvailabilityWindowRequest   `json:"availability" binding:"omitempty,dive"`
 }
 
 type DeleteProductUriRequest struct {
 	ID uint64 `uri:"id" binding:"required"`
 }
+
+type ProductModifierGroupRequest struct {
+	Name       string                   `json:"name" binding:"required,min=1,max=100" example:"Extras"`
+	MinChoices uint32                   `json:"min_choices" example:"0"`
+	MaxChoices uint32                   `json:"max_choices" binding:"required,gt=0,gtefield=MinChoices" example:"2"`
+	Modifiers  []ProductModifierRequest `json:"modifiers" binding:"required,min=1,dive"`
+}
+
+type ProductModifierRequest struct {
+	Name       string  `json:"name" binding:"required,min=1,max=100" example:"Extra cheese"`
+	PriceDelta float64 `json:"price_delta" binding:"gte=0" example:"2.00"`
+}
+
+type ProductBundleSlotRequest struct {
+	Name     string                       `json:"name" binding:"required,min=1,max=100" example:"Drink"`
+	Quantity uint32                       `json:"quantity" binding:"required,gt=0" example:"1"`
+	Options  []ProductBundleOptionRequest `json:"options" binding:"required,min=1,dive"`
+}
+
+type ProductBundleOptionRequest struct {
+	ProductID  uint64  `json:"product_id" binding:"required" example:"2"`
+	PriceDelta float64 `json:"price_delta" binding:"gte=0" example:"0"`
+}
@@ -0,0 +1,56 @@
+package request
+
+import "time"
+
+type ListPromotionsQueryRequest struct {
+	Name  string `form:"name" example:"Happy hour"`
+	Page  int    `form:"page,default=1" example:"1"`
+	Limit int    `form:"limit,default=10" example:"10"`
+}
+
+type CreatePromotionBodyRequest struct {
+	Name           string     `json:"name" binding:"required,min=3,max=100" example:"Happy hour"`
+	Code           string     `json:"code" binding:"omitempty,alphanum,min=3,max=50" example:"WELCOME10"`
+	DiscountType   string     `json:"discount_type" binding:"required,discount_type_exists" example:"PERCENTAGE"`
+	Value          float64    `json:"value" binding:"required,gt=0" example:"10"`
+	CategoryID     *uint64    `json:"category_id" binding:"omitempty,gt=0" example:"1"`
+	ProductID      *uint64    `json:"product_id" binding:"omitempty,gt=0" example:"1"`
+	StartsAt       *time.Time `json:"starts_at" example:"2024-02-09T00:00:00Z"`
+	EndsAt         *time.Time `json:"ends_at" example:"2024-03-09T00:00:00Z"`
+	HappyHourStart string     `json:"happy_hour_start" binding:"omitempty,datetime=15:04" example:"17:00"`
+	HappyHourEnd   string     `json:"happy_hour_end" binding:"omitempty,datetime=15:04" example:"19:00"`
+	// Timezone is the IANA timezone of the happy hour, required with it
+	Timezone           string `json:"timezone" binding:"omitempty,max=64" example:"America/Sao_Paulo"`
+	MaxUsesPerCustomer uint32 `json:"max_uses_per_customer" example:"1"`
+	Stackable          bool   `json:"stackable" example:"false"`
+	Active             *bool  `json:"active" example:"true"`
+}
+
+type GetPromotionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdatePromotionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdatePromotionBodyRequest struct {
+	CreatePromotionBodyRequest
+}
+
+type DeletePromotionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type OrderPromotionsUriRequest struct {
+	OrderID uint64 `uri:"id" binding:"required"`
+}
+
+type ApplyOrderPromotionsBodyRequest struct {
+	CouponCode string `json:"coupon_code" binding:"omitempty,max=50" example:"WELCOME10"`
+}
+
+type RemoveOrderCouponUriRequest struct {
+	OrderID    uint64 `uri:"id" binding:"required"`
+	CouponCode string `uri:"code" binding:"required"`
+}
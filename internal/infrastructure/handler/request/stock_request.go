@@ -0,0 +1,11 @@
+package request
+
+type UpdateProductStockUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdateProductStockBodyRequest struct {
+	StockMode     string `json:"stock_mode" binding:"omitempty,stock_mode_exists" example:"COUNTED"`
+	StockQuantity *int64 `json:"stock_quantity" binding:"omitempty,gte=0" example:"20"`
+	Available     *bool  `json:"available" example:"true"`
+}
@@ -0,0 +1,54 @@
+package request
+
+type ListWebhookSubscriptionsQueryRequest struct {
+	Page  int `form:"page,default=1" example:"1"`
+	Limit int `form:"limit,default=10" example:"10"`
+}
+
+type CreateWebhookSubscriptionBodyRequest struct {
+	URL             string   `json:"url" binding:"required,url,max=500" example:"https://partner.example.com/webhooks/orders"`
+	EventTypes      []string `json:"event_types" binding:"required,min=1,dive,order_event_type_exists" example:"order.status_changed"`
+	Statuses        []string `json:"statuses" binding:"omitempty,dive,order_status_exists" example:"READY"`
+	Channels        []string `json:"channels" binding:"omitempty,dive,order_channel_exists" example:"APP"`
+	FulfilmentModes []string `json:"fulfilment_modes" binding:"omitempty,dive,fulfilment_mode_exists" example:"DELIVERY"`
+	Secret          string   `json:"secret" binding:"required,min=16,max=255" example:"7f3c1a9e5b2d4f6a8c0e"`
+	Active          *bool    `json:"active" example:"true"`
+}
+
+type GetWebhookSubscriptionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type UpdateWebhookSubscriptionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+// UpdateWebhookSubscriptionBodyRequest is the subscription without the secret being mandatory, it is kept when not informed
+type UpdateWebhookSubscriptionBodyRequest struct {
+	URL             string   `json:"url" binding:"required,url,max=500" example:"https://partner.example.com/webhooks/orders"`
+	EventTypes      []string `json:"event_types" binding:"required,min=1,dive,order_event_type_exists" example:"order.status_changed"`
+	Statuses        []string `json:"statuses" binding:"omitempty,dive,order_status_exists" example:"READY"`
+	Channels        []string `json:"channels" binding:"omitempty,dive,order_channel_exists" example:"APP"`
+	FulfilmentModes []string `json:"fulfilment_modes" binding:"omitempty,dive,fulfilment_mode_exists" example:"DELIVERY"`
+	Secret          string   `json:"secret" binding:"omitempty,min=16,max=255" example:"7f3c1a9e5b2d4f6a8c0e"`
+	Active          *bool    `json:"active" example:"true"`
+}
+
+type DeleteWebhookSubscriptionUriRequest struct {
+	ID uint64 `uri:"id" binding:"required"`
+}
+
+type ListWebhookDeliveriesUriRequest struct {
+	SubscriptionID uint64 `uri:"id" binding:"required"`
+}
+
+type ListWebhookDeliveriesQueryRequest struct {
+	Status string `form:"status" binding:"omitempty,webhook_delivery_status_exists" example:"FAILED"`
+	Page   int    `form:"page,default=1" example:"1"`
+	Limit  int    `form:"limit,default=10" example:"10"`
+}
+
+type RedeliverWebhookUriRequest struct {
+	SubscriptionID uint64 `uri:"id" binding:"required"`
+	DeliveryID     uint64 `uri:"delivery_id" binding:"required"`
+}
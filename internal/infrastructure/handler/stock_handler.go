@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+)
+
+type StockHandler struct {
+	controller port.StockController
+}
+
+func NewStockHandler(controller port.StockController) *StockHandler {
+	return &StockHandler{controller}
+}
+
+// RegisterProductRoutes registers the routes nested on a single product, ex: /products/{id}/stock
+func (h *StockHandler) RegisterProductRoutes(router *gin.RouterGroup) {
+	router.PATCH("", h.Update)
+}
+
+// Update godoc
+//
+//	@Summary		Update product stock
+//	@Description	Updates the stock mode, the stock quantity and the availability of a product, the omitted fields keep their values
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			products
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			id		path		int										true	"Product ID"
+//	@Param			stock	body		request.UpdateProductStockBodyRequest	true	"Stock data"
+//	@Success		200		{object}	presenter.ProductJsonResponse			"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/products/{id}/stock [patch]
+func (h *StockHandler) Update(c *gin.Context) {
+	var uri request.UpdateProductStockUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.UpdateProductStockBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	stockMode, _ := valueobject.ToStockMode(body.StockMode)
+	input := dto.UpdateProductStockInput{
+		ProductID:     uri.ID,
+		StockMode:     stockMode,
+		StockQuantity: body.StockQuantity,
+		Available:     body.Available,
+	}
+
+	p, contentType := selectProductOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Update(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
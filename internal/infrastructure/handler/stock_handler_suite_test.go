@@ -0,0 +1,57 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+type StockHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.StockHandler
+	router         *gin.Engine
+	mockController *mockport.MockStockController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *StockHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockStockController(ctrl)
+	s.handler = handler.NewStockHandler(s.mockController)
+	s.ctx = context.Background()
+
+	// Register routes
+	s.router.PATCH("/products/:id/stock", s.handler.Update)
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("stock",
+		"update_success", "update_out_of_stock", "update_invalid_stock_mode", "update_invalid_quantity",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("stock",
+		"update_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestStockHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(StockHandlerSuiteTest))
+}
@@ -9,3 +9,63 @@ func OrderStatusValidator(fl validator.FieldLevel) bool {
 	status := fl.Field().String()
 	return valueobject.IsValidOrderStatus(status)
 }
+
+func APIActorTypeValidator(fl validator.FieldLevel) bool {
+	actorType := fl.Field().String()
+	return valueobject.IsAPIActorType(actorType)
+}
+
+func DiscountTypeValidator(fl validator.FieldLevel) bool {
+	discountType := fl.Field().String()
+	return valueobject.IsValidDiscountType(discountType)
+}
+
+func StockModeValidator(fl validator.FieldLevel) bool {
+	stockMode := fl.Field().String()
+	return valueobject.IsValidStockMode(stockMode)
+}
+
+func KitchenStationValidator(fl validator.FieldLevel) bool {
+	station := fl.Field().String()
+	return valueobject.IsValidKitchenStation(station)
+}
+
+func KitchenTicketStatusValidator(fl validator.FieldLevel) bool {
+	status := fl.Field().String()
+	return valueobject.IsValidKitchenTicketStatus(status)
+}
+
+func OrderChannelValidator(fl validator.FieldLevel) bool {
+	channel := fl.Field().String()
+	return valueobject.IsValidOrderChannel(channel)
+}
+
+func FulfilmentModeValidator(fl validator.FieldLevel) bool {
+	mode := fl.Field().String()
+	return valueobject.IsValidFulfilmentMode(mode)
+}
+
+func PaymentStatusValidator(fl validator.FieldLevel) bool {
+	status := fl.Field().String()
+	return valueobject.IsValidPaymentStatus(status)
+}
+
+func OrderEventTypeValidator(fl validator.FieldLevel) bool {
+	eventType := fl.Field().String()
+	return valueobject.IsValidOrderEventType(eventType)
+}
+
+func WebhookDeliveryStatusValidator(fl validator.FieldLevel) bool {
+	status := fl.Field().String()
+	return valueobject.IsValidWebhookDeliveryStatus(status)
+}
+
+func NotificationChannelValidator(fl validator.FieldLevel) bool {
+	channel := fl.Field().String()
+	return valueobject.IsValidNotificationChannel(channel)
+}
+
+func LocaleValidator(fl validator.FieldLevel) bool {
+	locale := fl.Field().String()
+	return valueobject.IsValidLocale(locale)
+}
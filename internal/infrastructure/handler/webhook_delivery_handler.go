@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+type WebhookDeliveryHandler struct {
+	controller port.WebhookDeliveryController
+	apiKeys    map[string]string
+}
+
+func NewWebhookDeliveryHandler(controller port.WebhookDeliveryController, apiKeys map[string]string) *WebhookDeliveryHandler {
+	return &WebhookDeliveryHandler{controller, apiKeys}
+}
+
+// RegisterSubscriptionRoutes registers the routes nested on a single subscription, ex: /webhook-subscriptions/{id}/deliveries,
+// they are only accepted with the key of an API client
+func (h *WebhookDeliveryHandler) RegisterSubscriptionRoutes(router *gin.RouterGroup) {
+	router.Use(middleware.APIKeyAuth(h.apiKeys))
+	router.GET("", h.List)
+	router.POST("/:delivery_id/redeliver", h.Redeliver)
+}
+
+// List godoc
+//
+//	@Summary		List webhook deliveries
+//	@Description	Log of the events sent to the subscription, the latest first
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			id		path		int												true	"Subscription ID"
+//	@Param			status	query		string											false	"Filter by status"	Enums(PENDING, DELIVERED, FAILED)
+//	@Param			page	query		int												false	"Page number"		default(1)
+//	@Param			limit	query		int												false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.WebhookDeliveryJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401		{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		404		{object}	middleware.ErrorJsonResponse					"Not Found"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/webhook-subscriptions/{id}/deliveries [get]
+func (h *WebhookDeliveryHandler) List(c *gin.Context) {
+	var uri request.ListWebhookDeliveriesUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var query request.ListWebhookDeliveriesQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	status, _ := valueobject.ToWebhookDeliveryStatus(query.Status)
+	input := dto.ListWebhookDeliveriesInput{
+		SubscriptionID: uri.SubscriptionID,
+		Status:         status,
+		Page:           query.Page,
+		Limit:          query.Limit,
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.List(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Redeliver godoc
+//
+//	@Summary		Redeliver webhook
+//	@Description	Sends the event of a delivery again, as a new delivery, on the next dispatch
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			id			path		int										true	"Subscription ID"
+//	@Param			delivery_id	path		int										true	"Delivery ID"
+//	@Success		201			{object}	presenter.WebhookDeliveryJsonResponse	"Created"
+//	@Failure		400			{object}	middleware.ErrorJsonResponse			"Bad Request"
+//	@Failure		401			{object}	middleware.ErrorJsonResponse			"Unauthorized"
+//	@Failure		404			{object}	middleware.ErrorJsonResponse			"Not Found"
+//	@Failure		500			{object}	middleware.ErrorJsonResponse			"Internal Server Error"
+//	@Router			/webhook-subscriptions/{id}/deliveries/{delivery_id}/redeliver [post]
+func (h *WebhookDeliveryHandler) Redeliver(c *gin.Context) {
+	var uri request.RedeliverWebhookUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.RedeliverWebhookInput{
+		SubscriptionID: uri.SubscriptionID,
+		DeliveryID:     uri.DeliveryID,
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Redeliver(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+type WebhookHandler struct {
+	controller      port.OrderStatusUpdatedController
+	partnerSecrets  map[string]string
+	signatureConfig middleware.WebhookSignatureConfig
+}
+
+func NewWebhookHandler(controller port.OrderStatusUpdatedController, partnerSecrets map[string]string, signatureConfig middleware.WebhookSignatureConfig) *WebhookHandler {
+	return &WebhookHandler{controller, partnerSecrets, signatureConfig}
+}
+
+// Register registers the webhooks called by the partners, they are only accepted when signed with the partner secret
+func (h *WebhookHandler) Register(router *gin.RouterGroup) {
+	router.Use(middleware.WebhookSignature(h.partnerSecrets, h.signatureConfig))
+	router.POST("/order-status", h.OrderStatus)
+}
+
+// OrderStatus godoc
+//
+//	@Summary		Order status webhook
+//	@Description	Updates the status of an order on behalf of a partner that can't publish to the SQS queue, the payload is the same of the queue messages
+//	@Description	The request must be signed with the partner secret: `X-Webhook-Signature: sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">`
+//	@Description	The timestamp is the Unix time in seconds of the request and must be within the accepted window (5 minutes by default)
+//	@Description	A signed request is only accepted once, the retries must be signed again with a new timestamp
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json,xml
+//	@Param			X-Webhook-Partner	header		string							true	"Partner ID"
+//	@Param			X-Webhook-Timestamp	header		int								true	"Unix time in seconds"
+//	@Param			X-Webhook-Signature	header		string							true	"HMAC-SHA256 signature"
+//	@Param			event				body		entity.OrderStatusUpdated		true	"Order status update"
+//	@Success		200					{object}	presenter.OrderJsonResponse		"OK"
+//	@Failure		400					{object}	middleware.ErrorJsonResponse	"Bad Request"
+//	@Failure		401					{object}	middleware.ErrorJsonResponse	"Unauthorized"
+//	@Failure		404					{object}	middleware.ErrorJsonResponse	"Not Found"
+//	@Failure		409					{object}	middleware.ErrorJsonResponse	"Conflict"
+//	@Failure		500					{object}	middleware.ErrorJsonResponse	"Internal Server Error"
+//	@Router			/webhooks/order-status [post]
+func (h *WebhookHandler) OrderStatus(c *gin.Context) {
+	var body entity.OrderStatusUpdated
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	input := dto.ProcessOrderStatusUpdatedInput{
+		Event:   body,
+		Source:  valueobject.SourceWebhook,
+		ActorID: middleware.WebhookPartner(c),
+	}
+
+	p, contentType := selectOrderOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Process(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
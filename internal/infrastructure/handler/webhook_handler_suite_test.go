@@ -0,0 +1,69 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/replay"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	webhookPartner = "partner-a"
+	webhookSecret  = "partner-a-secret"
+)
+
+type WebhookHandlerSuiteTest struct {
+	suite.Suite
+	handler        *handler.WebhookHandler
+	router         *gin.Engine
+	mockController *mockport.MockOrderStatusUpdatedController
+	ctx            context.Context
+	requests       map[string]string // Fixture files
+	responses      map[string]string // Golden files
+}
+
+func (s *WebhookHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockOrderStatusUpdatedController(ctrl)
+	s.handler = handler.NewWebhookHandler(s.mockController, map[string]string{webhookPartner: webhookSecret}, middleware.WebhookSignatureConfig{
+		Tolerance:    5 * time.Minute,
+		MaxBodyBytes: 1024,
+		ReplayStore:  replay.NewMemoryStore(),
+	})
+	s.ctx = context.Background()
+
+	// Register routes, with the signature middleware
+	s.handler.Register(s.router.Group("/webhooks"))
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("webhook",
+		"order_status_success", "order_status_invalid_body",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("webhook",
+		"order_status_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestWebhookHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(WebhookHandlerSuiteTest))
+}
@@ -0,0 +1,222 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *WebhookHandlerSuiteTest) TestWebhookHandler_OrderStatus() {
+	now := time.Now().Unix()
+	sign := func(secret string, timestamp int64, body string) string {
+		return signature.Sign(secret, timestamp, []byte(body))
+	}
+	input := dto.ProcessOrderStatusUpdatedInput{
+		Event:   entity.OrderStatusUpdated{OrderID: 1, Status: valueobject.RECEIVED, ReasonCode: "PAID"},
+		Source:  valueobject.SourceWebhook,
+		ActorID: webhookPartner,
+	}
+
+	tests := []struct {
+		name        string
+		body        string
+		headers     map[string]string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now, s.requests["order_status_success"]),
+			},
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Process(gomock.Any(), gomock.Any(), input).
+					Return([]byte(s.responses["order_status_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["order_status_success"])
+			},
+		},
+		{
+			name: "unauthorized - missing signature",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrMissingWebhookSignature)
+			},
+		},
+		{
+			name: "unauthorized - unknown partner",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   "partner-b",
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now, s.requests["order_status_success"]),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrInvalidWebhookSignature)
+			},
+		},
+		{
+			name: "unauthorized - signed with another secret",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign("another-secret", now, s.requests["order_status_success"]),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrInvalidWebhookSignature)
+			},
+		},
+		{
+			name: "unauthorized - body changed after signing",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now, `{"order_id":2,"status":"RECEIVED"}`),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrInvalidWebhookSignature)
+			},
+		},
+		{
+			name: "unauthorized - timestamp out of the window",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now-600, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now-600, s.requests["order_status_success"]),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusUnauthorized, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrWebhookSignatureExpired)
+			},
+		},
+		{
+			name: "invalid request - invalid body",
+			body: s.requests["order_status_invalid_body"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now, s.requests["order_status_invalid_body"]),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "invalid request - body too large",
+			body: `{"order_id":1,"status":"CANCELLED","reason_text":"` + strings.Repeat("a", 1024) + `"}`,
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now, `{"order_id":1,"status":"CANCELLED","reason_text":"`+strings.Repeat("a", 1024)+`"}`),
+			},
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrWebhookBodyTooLarge)
+			},
+		},
+		{
+			name: "order not found",
+			body: s.requests["order_status_success"],
+			headers: map[string]string{
+				middleware.WebhookPartnerHeader:   webhookPartner,
+				middleware.WebhookTimestampHeader: strconv.FormatInt(now-1, 10),
+				middleware.WebhookSignatureHeader: sign(webhookSecret, now-1, s.requests["order_status_success"]),
+			},
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Process(gomock.Any(), gomock.Any(), input).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/webhooks/order-status", strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookHandlerSuiteTest) TestWebhookHandler_OrderStatusReplayed() {
+	// Arrange
+	now := time.Now().Unix()
+	body := s.requests["order_status_success"]
+	s.mockController.EXPECT().
+		Process(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(s.responses["order_status_success"]), nil).
+		Times(1)
+
+	send := func() *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/webhooks/order-status", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(middleware.WebhookPartnerHeader, webhookPartner)
+		req.Header.Set(middleware.WebhookTimestampHeader, strconv.FormatInt(now, 10))
+		req.Header.Set(middleware.WebhookSignatureHeader, signature.Sign(webhookSecret, now, []byte(body)))
+		s.router.ServeHTTP(w, req)
+		return w
+	}
+
+	// Act
+	first := send()
+	replayed := send()
+
+	// Assert
+	assert.Equal(s.T(), http.StatusOK, first.Code)
+	assert.Equal(s.T(), http.StatusConflict, replayed.Code)
+	assert.Contains(s.T(), replayed.Body.String(), domain.ErrWebhookReplayed)
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/adapter/presenter"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler/request"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+type WebhookSubscriptionHandler struct {
+	controller port.WebhookSubscriptionController
+	apiKeys    map[string]string
+}
+
+func NewWebhookSubscriptionHandler(controller port.WebhookSubscriptionController, apiKeys map[string]string) *WebhookSubscriptionHandler {
+	return &WebhookSubscriptionHandler{controller, apiKeys}
+}
+
+// Register registers the subscription routes, they are only accepted with the key of an API client
+func (h *WebhookSubscriptionHandler) Register(router *gin.RouterGroup) {
+	router.Use(middleware.APIKeyAuth(h.apiKeys))
+	router.GET("", h.List)
+	router.POST("", h.Create)
+	router.GET("/:id", h.Get)
+	router.PUT("/:id", h.Update)
+	router.DELETE("/:id", h.Delete)
+}
+
+// List godoc
+//
+//	@Summary		List webhook subscriptions
+//	@Description	List all webhook subscriptions, the secrets are never returned
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			page	query		int													false	"Page number"		default(1)
+//	@Param			limit	query		int													false	"Items per page"	default(10)
+//	@Success		200		{object}	presenter.WebhookSubscriptionJsonPaginatedResponse	"OK"
+//	@Failure		400		{object}	middleware.ErrorJsonResponse						"Bad Request"
+//	@Failure		401		{object}	middleware.ErrorJsonResponse						"Unauthorized"
+//	@Failure		500		{object}	middleware.ErrorJsonResponse						"Internal Server Error"
+//	@Router			/webhook-subscriptions [get]
+func (h *WebhookSubscriptionHandler) List(c *gin.Context) {
+	var query request.ListWebhookSubscriptionsQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidQueryParams))
+		return
+	}
+
+	input := dto.ListWebhookSubscriptionsInput{
+		Page:  query.Page,
+		Limit: query.Limit,
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.List(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Create godoc
+//
+//	@Summary		Create webhook subscription
+//	@Description	Subscribes a URL to the order events, the statuses, channels and fulfilment modes restrict the events to the matching orders
+//	@Description	The events are sent as a POST signed with the secret: `X-Webhook-Signature: sha256=<hex HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>">`
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			subscription	body		request.CreateWebhookSubscriptionBodyRequest	true	"Subscription data"
+//	@Success		201				{object}	presenter.WebhookSubscriptionJsonResponse		"Created"
+//	@Failure		400				{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401				{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		500				{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/webhook-subscriptions [post]
+func (h *WebhookSubscriptionHandler) Create(c *gin.Context) {
+	var body request.CreateWebhookSubscriptionBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Create(
+		c.Request.Context(),
+		p,
+		toCreateWebhookSubscriptionInput(body),
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusCreated, contentType, output)
+}
+
+// Get godoc
+//
+//	@Summary		Get webhook subscription
+//	@Description	Search for a webhook subscription by ID
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			id	path		int											true	"Subscription ID"
+//	@Success		200	{object}	presenter.WebhookSubscriptionJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse				"Bad Request"
+//	@Failure		401	{object}	middleware.ErrorJsonResponse				"Unauthorized"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse				"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/webhook-subscriptions/{id} [get]
+func (h *WebhookSubscriptionHandler) Get(c *gin.Context) {
+	var uri request.GetWebhookSubscriptionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.GetWebhookSubscriptionInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Get(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update webhook subscription
+//	@Description	Update an existing webhook subscription, the secret is kept when it is not informed
+//	@Description	A subscription disabled after repeated failures is enabled again by updating it as active
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Accept			json
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			id				path		int												true	"Subscription ID"
+//	@Param			subscription	body		request.UpdateWebhookSubscriptionBodyRequest	true	"Subscription data"
+//	@Success		200				{object}	presenter.WebhookSubscriptionJsonResponse		"OK"
+//	@Failure		400				{object}	middleware.ErrorJsonResponse					"Bad Request"
+//	@Failure		401				{object}	middleware.ErrorJsonResponse					"Unauthorized"
+//	@Failure		404				{object}	middleware.ErrorJsonResponse					"Not Found"
+//	@Failure		500				{object}	middleware.ErrorJsonResponse					"Internal Server Error"
+//	@Router			/webhook-subscriptions/{id} [put]
+func (h *WebhookSubscriptionHandler) Update(c *gin.Context) {
+	var uri request.UpdateWebhookSubscriptionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	var body request.UpdateWebhookSubscriptionBodyRequest
+	if err := c.ShouldBindJSON(&body); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+		return
+	}
+
+	input := dto.UpdateWebhookSubscriptionInput{
+		ID:                             uri.ID,
+		CreateWebhookSubscriptionInput: toCreateWebhookSubscriptionInput(request.CreateWebhookSubscriptionBodyRequest(body)),
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Update(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete webhook subscription
+//	@Description	Deletes a webhook subscription with its deliveries
+//	@Description	Response can return JSON or XML format (Accept header: application/json, application/xml or text/xml)
+//	@Tags			webhooks
+//	@Produce		json,xml
+//	@Security		ApiKeyAuth
+//	@Param			id	path		int											true	"Subscription ID"
+//	@Success		200	{object}	presenter.WebhookSubscriptionJsonResponse	"OK"
+//	@Failure		400	{object}	middleware.ErrorJsonResponse				"Bad Request"
+//	@Failure		401	{object}	middleware.ErrorJsonResponse				"Unauthorized"
+//	@Failure		404	{object}	middleware.ErrorJsonResponse				"Not Found"
+//	@Failure		500	{object}	middleware.ErrorJsonResponse				"Internal Server Error"
+//	@Router			/webhook-subscriptions/{id} [delete]
+func (h *WebhookSubscriptionHandler) Delete(c *gin.Context) {
+	var uri request.DeleteWebhookSubscriptionUriRequest
+	if err := c.ShouldBindUri(&uri); err != nil {
+		_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidParam))
+		return
+	}
+
+	input := dto.DeleteWebhookSubscriptionInput{
+		ID: uri.ID,
+	}
+
+	p, contentType := selectWebhookOutputConfigs(c.GetHeader("Accept"))
+	output, err := h.controller.Delete(
+		c.Request.Context(),
+		p,
+		input,
+	)
+	if err != nil {
+		_ = c.Error(err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, output)
+}
+
+// toCreateWebhookSubscriptionInput converts the subscription body, the values are stored as the domain names them
+// and the subscriptions are active unless stated otherwise
+func toCreateWebhookSubscriptionInput(body request.CreateWebhookSubscriptionBodyRequest) dto.CreateWebhookSubscriptionInput {
+	input := dto.CreateWebhookSubscriptionInput{
+		URL:    body.URL,
+		Secret: body.Secret,
+		Active: body.Active == nil || *body.Active,
+	}
+	for _, eventType := range body.EventTypes {
+		t, _ := valueobject.ToOrderEventType(eventType)
+		input.EventTypes = append(input.EventTypes, t.String())
+	}
+	for _, status := range body.Statuses {
+		s, _ := valueobject.ToOrderStatus(status)
+		input.Statuses = append(input.Statuses, s.String())
+	}
+	for _, channel := range body.Channels {
+		ch, _ := valueobject.ToOrderChannel(channel)
+		input.Channels = append(input.Channels, ch.String())
+	}
+	for _, mode := range body.FulfilmentModes {
+		m, _ := valueobject.ToFulfilmentMode(mode)
+		input.FulfilmentModes = append(input.FulfilmentModes, m.String())
+	}
+	return input
+}
+
+func selectWebhookOutputConfigs(acceptHeader string) (port.Presenter, string) {
+	return selectOutputConfigs(acceptHeader, outputFormats{
+		json: presenter.NewWebhookJsonPresenter(),
+		xml:  presenter.NewWebhookXmlPresenter(),
+	})
+}
@@ -0,0 +1,70 @@
+package handler_test
+
+import (
+	"context"
+	"testing"
+
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"go.uber.org/mock/gomock"
+)
+
+// webhookAPIKey is the key of the API client allowed on the subscription routes
+const webhookAPIKey = "back-office-key"
+
+type WebhookSubscriptionHandlerSuiteTest struct {
+	suite.Suite
+	handler                *handler.WebhookSubscriptionHandler
+	deliveryHandler        *handler.WebhookDeliveryHandler
+	router                 *gin.Engine
+	mockController         *mockport.MockWebhookSubscriptionController
+	mockDeliveryController *mockport.MockWebhookDeliveryController
+	ctx                    context.Context
+	requests               map[string]string // Fixture files
+	responses              map[string]string // Golden files
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) SetupTest() {
+	// Create a new router
+	s.router = newRouter()
+
+	// Create a new handler
+	ctrl := gomock.NewController(s.T())
+	defer ctrl.Finish()
+	s.mockController = mockport.NewMockWebhookSubscriptionController(ctrl)
+	s.mockDeliveryController = mockport.NewMockWebhookDeliveryController(ctrl)
+	apiKeys := map[string]string{"back-office": webhookAPIKey}
+	s.handler = handler.NewWebhookSubscriptionHandler(s.mockController, apiKeys)
+	s.deliveryHandler = handler.NewWebhookDeliveryHandler(s.mockDeliveryController, apiKeys)
+	s.ctx = context.Background()
+
+	// Register routes, with the API key middleware
+	s.handler.Register(s.router.Group("/webhook-subscriptions"))
+	s.deliveryHandler.RegisterSubscriptionRoutes(s.router.Group("/webhook-subscriptions/:id/deliveries"))
+
+	// Mock requests
+	var err error
+	s.requests, err = util.ReadFixtureFiles("webhook_subscription",
+		"create_success", "create_invalid_event_type", "create_invalid_url",
+		"update_success",
+	)
+	assert.NoError(s.T(), err)
+
+	// Mock responses
+	s.responses, err = util.ReadGoldenFiles("webhook_subscription",
+		"list_success",
+		"create_success",
+		"deliveries_list_success",
+		"redeliver_success",
+	)
+	assert.NoError(s.T(), err)
+	addCommonResponses(&s.responses)
+}
+
+func TestWebhookSubscriptionHandlerSuiteTest(t *testing.T) {
+	suite.Run(t, new(WebhookSubscriptionHandlerSuiteTest))
+}
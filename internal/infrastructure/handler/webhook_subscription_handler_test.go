@@ -0,0 +1,493 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/dto"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/util"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/webhook-subscriptions",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListWebhookSubscriptionsInput{
+					Page:  1,
+					Limit: 10,
+				}).Return([]byte(s.responses["list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, res.Body.String(), s.responses["list_success"])
+			},
+		},
+		{
+			name: "controller error",
+			url:  "/webhook-subscriptions",
+			setupMocks: func() {
+				s.mockController.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(nil))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_Create() {
+	tests := []struct {
+		name        string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			body: strings.NewReader(s.requests["create_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), dto.CreateWebhookSubscriptionInput{
+						URL:        "https://partner.example.com/webhooks/orders",
+						EventTypes: []string{valueobject.OrderStatusChangedEvent.String()},
+						Statuses:   []string{valueobject.READY.String()},
+						Channels:   []string{valueobject.ChannelApp.String()},
+						Secret:     "partner-secret-0001",
+						Active:     true,
+					}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["create_success"])
+			},
+		},
+		{
+			name:       "invalid request - unknown event type",
+			body:       strings.NewReader(s.requests["create_invalid_event_type"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name:       "invalid request - url is not absolute",
+			body:       strings.NewReader(s.requests["create_invalid_url"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "controller error",
+			body: strings.NewReader(s.requests["create_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Create(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInternalError(nil))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusInternalServerError, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_internal_error"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/webhook-subscriptions", tt.body)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_Get() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/webhook-subscriptions/1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetWebhookSubscriptionInput{ID: 1}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["create_success"])
+			},
+		},
+		{
+			name:       "invalid parameter",
+			url:        "/webhook-subscriptions/abc",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name: "not found",
+			url:  "/webhook-subscriptions/1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Get(gomock.Any(), gomock.Any(), dto.GetWebhookSubscriptionInput{ID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_Update() {
+	tests := []struct {
+		name        string
+		url         string
+		body        *strings.Reader
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - keeps the secret when it is not informed",
+			url:  "/webhook-subscriptions/1",
+			body: strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Update(gomock.Any(), gomock.Any(), dto.UpdateWebhookSubscriptionInput{
+						ID: 1,
+						CreateWebhookSubscriptionInput: dto.CreateWebhookSubscriptionInput{
+							URL:        "https://partner.example.com/webhooks/orders",
+							EventTypes: []string{valueobject.OrderCreatedEvent.String(), valueobject.OrderStatusChangedEvent.String()},
+							Active:     true,
+						},
+					}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:       "invalid parameter",
+			url:        "/webhook-subscriptions/abc",
+			body:       strings.NewReader(s.requests["update_success"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name:       "invalid request - unknown event type",
+			url:        "/webhook-subscriptions/1",
+			body:       strings.NewReader(s.requests["create_invalid_event_type"]),
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPut, tt.url, tt.body)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_Delete() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/webhook-subscriptions/1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), dto.DeleteWebhookSubscriptionInput{ID: 1}).
+					Return([]byte(s.responses["create_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "not found",
+			url:  "/webhook-subscriptions/1",
+			setupMocks: func() {
+				s.mockController.EXPECT().
+					Delete(gomock.Any(), gomock.Any(), dto.DeleteWebhookSubscriptionInput{ID: 1}).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodDelete, tt.url, nil)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookDeliveryHandler_List() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success - filtered by status",
+			url:  "/webhook-subscriptions/1/deliveries?status=failed",
+			setupMocks: func() {
+				s.mockDeliveryController.EXPECT().List(gomock.Any(), gomock.Any(), dto.ListWebhookDeliveriesInput{
+					SubscriptionID: 1,
+					Status:         valueobject.DeliveryFailed,
+					Page:           1,
+					Limit:          10,
+				}).Return([]byte(s.responses["deliveries_list_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Contains(t, res.Body.String(), s.responses["deliveries_list_success"])
+			},
+		},
+		{
+			name:       "invalid query - unknown status",
+			url:        "/webhook-subscriptions/1/deliveries?status=LOST",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+			},
+		},
+		{
+			name: "subscription not found",
+			url:  "/webhook-subscriptions/1/deliveries",
+			setupMocks: func() {
+				s.mockDeliveryController.EXPECT().List(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewNotFoundError(domain.ErrNotFound))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusNotFound, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_not_found"])
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookDeliveryHandler_Redeliver() {
+	tests := []struct {
+		name        string
+		url         string
+		setupMocks  func()
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name: "success",
+			url:  "/webhook-subscriptions/1/deliveries/1/redeliver",
+			setupMocks: func() {
+				s.mockDeliveryController.EXPECT().
+					Redeliver(gomock.Any(), gomock.Any(), dto.RedeliverWebhookInput{SubscriptionID: 1, DeliveryID: 1}).
+					Return([]byte(s.responses["redeliver_success"]), nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusCreated, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["redeliver_success"])
+			},
+		},
+		{
+			name:       "invalid parameter",
+			url:        "/webhook-subscriptions/1/deliveries/abc/redeliver",
+			setupMocks: func() {},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, util.RemoveAllSpaces(res.Body.String()), s.responses["error_invalid_parameter"])
+			},
+		},
+		{
+			name: "subscription disabled",
+			url:  "/webhook-subscriptions/1/deliveries/1/redeliver",
+			setupMocks: func() {
+				s.mockDeliveryController.EXPECT().
+					Redeliver(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, domain.NewInvalidInputError(domain.ErrWebhookSubscriptionDisabled))
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusBadRequest, res.Code)
+				assert.Contains(t, res.Body.String(), domain.ErrWebhookSubscriptionDisabled)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			tt.setupMocks()
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, tt.url, nil)
+			req.Header.Set(middleware.APIKeyHeader, webhookAPIKey)
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
+
+func (s *WebhookSubscriptionHandlerSuiteTest) TestWebhookSubscriptionHandler_Unauthorized() {
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		apiKey string
+	}{
+		{
+			name:   "subscriptions - missing api key",
+			method: http.MethodPost,
+			url:    "/webhook-subscriptions",
+		},
+		{
+			name:   "subscriptions - invalid api key",
+			method: http.MethodGet,
+			url:    "/webhook-subscriptions",
+			apiKey: "unknown-key",
+		},
+		{
+			name:   "redeliver - missing api key",
+			method: http.MethodPost,
+			url:    "/webhook-subscriptions/1/deliveries/7/redeliver",
+		},
+		{
+			name:   "deliveries - invalid api key",
+			method: http.MethodGet,
+			url:    "/webhook-subscriptions/1/deliveries",
+			apiKey: "unknown-key",
+		},
+	}
+
+	for _, tt := range tests {
+		s.T().Run(tt.name, func(t *testing.T) {
+			// Arrange
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tt.method, tt.url, strings.NewReader(s.requests["create_success"]))
+			if tt.apiKey != "" {
+				req.Header.Set(middleware.APIKeyHeader, tt.apiKey)
+			}
+
+			// Act
+			s.router.ServeHTTP(w, req)
+
+			// Assert
+			assert.Equal(t, http.StatusUnauthorized, w.Code)
+		})
+	}
+}
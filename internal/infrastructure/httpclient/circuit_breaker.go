@@ -0,0 +1,86 @@
+package httpclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without calling the service while the circuit breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// CircuitBreaker stops calling a service after maxFailures failed calls in a row, so the callers fail fast
+// instead of waiting for the timeouts of a service that is down. After openTimeout a single call is let
+// through, it closes the circuit when it succeeds and opens it again when it fails
+type CircuitBreaker struct {
+	mu          sync.Mutex
+	maxFailures int
+	openTimeout time.Duration
+	state       circuitState
+	failures    int
+	openedAt    time.Time
+	now         func() time.Time
+}
+
+func NewCircuitBreaker(maxFailures int, openTimeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		maxFailures: max(maxFailures, 1),
+		openTimeout: openTimeout,
+		now:         time.Now,
+	}
+}
+
+// Execute calls fn when the circuit allows it, the errors of fn are counted as failures of the service
+func (cb *CircuitBreaker) Execute(fn func() error) error {
+	if !cb.allow() {
+		return ErrCircuitOpen
+	}
+
+	err := fn()
+	cb.record(err == nil)
+	return err
+}
+
+func (cb *CircuitBreaker) allow() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	switch cb.state {
+	case circuitOpen:
+		if cb.now().Sub(cb.openedAt) < cb.openTimeout {
+			return false
+		}
+		cb.state = circuitHalfOpen
+		return true
+	case circuitHalfOpen:
+		// Only the trial call goes through until it finishes
+		return false
+	default:
+		return true
+	}
+}
+
+func (cb *CircuitBreaker) record(success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if success {
+		cb.state = circuitClosed
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.state == circuitHalfOpen || cb.failures >= cb.maxFailures {
+		cb.state = circuitOpen
+		cb.openedAt = cb.now()
+	}
+}
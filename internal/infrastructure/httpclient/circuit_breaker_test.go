@@ -0,0 +1,59 @@
+package httpclient_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+)
+
+func TestCircuitBreaker_Execute(t *testing.T) {
+	succeed := func() error { return nil }
+	fail := func() error { return assert.AnError }
+
+	t.Run("should keep the circuit closed while the calls succeed", func(t *testing.T) {
+		breaker := httpclient.NewCircuitBreaker(2, time.Minute)
+
+		assert.ErrorIs(t, breaker.Execute(fail), assert.AnError)
+		assert.NoError(t, breaker.Execute(succeed))
+		assert.ErrorIs(t, breaker.Execute(fail), assert.AnError)
+		assert.NoError(t, breaker.Execute(succeed))
+	})
+
+	t.Run("should fail fast after the max failures in a row", func(t *testing.T) {
+		breaker := httpclient.NewCircuitBreaker(2, time.Minute)
+		_ = breaker.Execute(fail)
+		_ = breaker.Execute(fail)
+
+		calls := 0
+		err := breaker.Execute(func() error {
+			calls++
+			return nil
+		})
+
+		assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("should close the circuit when the trial call succeeds", func(t *testing.T) {
+		breaker := httpclient.NewCircuitBreaker(1, 10*time.Millisecond)
+		_ = breaker.Execute(fail)
+		time.Sleep(20 * time.Millisecond)
+
+		assert.NoError(t, breaker.Execute(succeed))
+		assert.ErrorIs(t, breaker.Execute(fail), assert.AnError)
+	})
+
+	t.Run("should open the circuit again when the trial call fails", func(t *testing.T) {
+		breaker := httpclient.NewCircuitBreaker(3, 10*time.Millisecond)
+		_ = breaker.Execute(fail)
+		_ = breaker.Execute(fail)
+		_ = breaker.Execute(fail)
+		time.Sleep(20 * time.Millisecond)
+
+		assert.ErrorIs(t, breaker.Execute(fail), assert.AnError)
+		assert.ErrorIs(t, breaker.Execute(succeed), httpclient.ErrCircuitOpen)
+	})
+}
@@ -1,6 +1,8 @@
 package httpclient
 
 import (
+	"net/http"
+
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
 	"github.com/go-resty/resty/v2"
@@ -12,12 +14,29 @@ type HTTPClient struct {
 
 func NewRestyClient(cfg *config.Config, logger *logger.Logger) *HTTPClient {
 	httpCLient := resty.New().
-		SetTimeout(10*1000*1000*1000). // 10 seconds
-		SetRetryCount(2).
-		// SetHeader("Authorization", fmt.Sprintf("Bearer %s", cfg.MercadoPagoToken)).
+		SetTimeout(cfg.HTTPClientTimeout).
+		SetRetryCount(cfg.HTTPClientRetryCount).
+		SetRetryWaitTime(cfg.HTTPClientRetryWaitTime).
+		// Client errors would fail again, only the network errors and the server errors are retried
+		AddRetryCondition(func(r *resty.Response, err error) bool {
+			return err != nil || r.StatusCode() >= http.StatusInternalServerError
+		}).
 		SetHeader("Content-Type", "application/json")
 
 	logger.Info("resty client created")
 
 	return &HTTPClient{httpCLient}
 }
+
+// NewWebhookClient creates the client of the webhook subscribers. The failed deliveries are retried by the
+// dispatcher with its own backoff and counted on the subscription, so the client sends each attempt only once
+func NewWebhookClient(cfg *config.Config, logger *logger.Logger) *HTTPClient {
+	httpCLient := resty.New().
+		SetTimeout(cfg.HTTPClientTimeout).
+		SetRetryCount(0).
+		SetHeader("Content-Type", "application/json")
+
+	logger.Info("webhook client created")
+
+	return &HTTPClient{httpCLient}
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+)
+
+// APIKeyHeader is the header of the keys given to the API clients, ex: the partners and the back office
+const APIKeyHeader = "X-API-Key"
+
+// apiClientKey is the gin context key of the client authenticated by the API key
+const apiClientKey = "api_client"
+
+// APIKeyAuth accepts the requests with the key of one of the clients, the keys are the values of the clients map
+func APIKeyAuth(keys map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(APIKeyHeader)
+		if key == "" {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrMissingAPIKey))
+			c.Abort()
+			return
+		}
+
+		client, ok := FindAPIClient(keys, key)
+		if !ok {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrInvalidAPIKey))
+			c.Abort()
+			return
+		}
+
+		c.Set(apiClientKey, client)
+		c.Next()
+	}
+}
+
+// FindAPIClient returns the client of the key, the keys are compared in constant time
+func FindAPIClient(keys map[string]string, key string) (string, bool) {
+	for client, clientKey := range keys {
+		if clientKey != "" && subtle.ConstantTimeCompare([]byte(clientKey), []byte(key)) == 1 {
+			return client, true
+		}
+	}
+	return "", false
+}
+
+// APIClient returns the client authenticated by APIKeyAuth, empty when there's none
+func APIClient(c *gin.Context) string {
+	return c.GetString(apiClientKey)
+}
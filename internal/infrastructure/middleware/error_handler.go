@@ -2,11 +2,13 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/negotiation"
 )
 
 type ErrorJsonResponse struct {
@@ -45,10 +47,27 @@ func handleError(c *gin.Context, err error, logger *logger.Logger) {
 		setResponse(c, http.StatusBadRequest, e.Error())
 		logWarning(logger, domain.ErrInvalidInput, e, c.Request)
 
+	case *domain.ConflictError:
+		setResponse(c, http.StatusConflict, e.Error())
+		logWarning(logger, domain.ErrConflict, e, c.Request)
+
+	case *domain.PreconditionFailedError:
+		setResponse(c, http.StatusPreconditionFailed, e.Error())
+		logWarning(logger, domain.ErrOrderVersionMismatch, e, c.Request)
+
 	case *domain.UnauthorizedError:
 		setResponse(c, http.StatusUnauthorized, e.Error())
 		logWarning(logger, domain.ErrUnauthorized, e, c.Request)
 
+	case *domain.ForbiddenError:
+		setResponse(c, http.StatusForbidden, e.Error())
+		logWarning(logger, domain.ErrForbidden, e, c.Request)
+
+	case *domain.TooManyRequestsError:
+		c.Header(RetryAfterHeader, strconv.Itoa(ceilSeconds(e.RetryAfter)))
+		setResponse(c, http.StatusTooManyRequests, e.Error())
+		logWarning(logger, domain.ErrTooManyRequests, e, c.Request)
+
 	case *domain.InternalError:
 		setResponse(c, http.StatusInternalServerError, domain.ErrInternalError)
 		logError(logger, domain.ErrInternalError, e, c.Request)
@@ -60,7 +79,10 @@ func handleError(c *gin.Context, err error, logger *logger.Logger) {
 }
 
 func setResponse(c *gin.Context, status int, message string) {
-	if c.GetHeader("Accept") == "text/xml" {
+	accepted := negotiation.Negotiate(c.GetHeader("Accept"), negotiation.MIMEJSON, negotiation.MIMEXML, negotiation.MIMETextXML)
+	if accepted == negotiation.MIMEXML || accepted == negotiation.MIMETextXML {
+		// c.XML keeps the content type set before, so the error echoes the XML type the client asked for
+		c.Header("Content-Type", accepted+"; charset=utf-8")
 		c.XML(status, ErrorXmlResponse{
 			Code:    status,
 			Message: message,
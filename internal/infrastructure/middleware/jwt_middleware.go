@@ -9,6 +9,9 @@ import (
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
 )
 
+// customerIDKey is the gin context key of the customer the access token was generated for
+const customerIDKey = "customer_id"
+
 func JWTAuthMiddleware(jwtService port.JWTService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
@@ -25,12 +28,19 @@ func JWTAuthMiddleware(jwtService port.JWTService) gin.HandlerFunc {
 			return
 		}
 
-		if err := jwtService.ValidateToken(parts[1]); err != nil {
+		customerID, err := jwtService.ParseToken(parts[1])
+		if err != nil {
 			_ = c.Error(domain.NewUnauthorizedError(domain.ErrInvalidToken))
 			c.Abort()
 			return
 		}
 
+		c.Set(customerIDKey, customerID)
 		c.Next()
 	}
 }
+
+// TokenCustomerID returns the ID of the customer authenticated by JWTAuthMiddleware, zero when there's none
+func TokenCustomerID(c *gin.Context) uint64 {
+	return c.GetUint64(customerIDKey)
+}
@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+// Headers of the rate limited responses, following the IETF RateLimit header fields draft
+const (
+	RetryAfterHeader         = "Retry-After"
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RateLimitPolicyHeader    = "RateLimit-Policy"
+)
+
+// DefaultRateLimitGroup is the name of the limit of the route groups without their own
+const DefaultRateLimitGroup = "default"
+
+// RateLimiter limits the requests of each client on each route group with a token bucket
+type RateLimiter struct {
+	store      port.RateLimitStore
+	jwtService port.JWTService
+	apiKeys    map[string]string
+	limits     map[string]valueobject.RateLimit
+	logger     *logger.Logger
+}
+
+// NewRateLimiter creates the rate limiter of the limits by route group, the API keys are the keys of the
+// API clients by client name, the same of APIKeyAuth
+func NewRateLimiter(store port.RateLimitStore, jwtService port.JWTService, apiKeys map[string]string, limits map[string]valueobject.RateLimit, logger *logger.Logger) *RateLimiter {
+	return &RateLimiter{store: store, jwtService: jwtService, apiKeys: apiKeys, limits: limits, logger: logger}
+}
+
+// Limit returns the limit of the route group or the default one, false when the group is not limited
+func (r *RateLimiter) Limit(group string) (valueobject.RateLimit, bool) {
+	if limit, ok := r.limits[group]; ok {
+		return limit, true
+	}
+	limit, ok := r.limits[DefaultRateLimitGroup]
+	return limit, ok
+}
+
+// Middleware limits the requests of the route group, the clients are the customers of the access tokens,
+// the API keys or the IPs, in this order. The requests go through when the store fails
+func (r *RateLimiter) Middleware(group string, limit valueobject.RateLimit) gin.HandlerFunc {
+	// The bucket allows the burst on the window it takes to be refilled
+	window := limit.Period * time.Duration(limit.Burst) / time.Duration(limit.Requests)
+	policy := strconv.Itoa(limit.Burst) + ";w=" + strconv.Itoa(ceilSeconds(window))
+
+	return func(c *gin.Context) {
+		result, err := r.store.Take(c.Request.Context(), group+":"+r.clientKey(c), limit)
+		if err != nil {
+			r.logger.Warn("rate limit store failed, request not limited", "group", group, "error", err.Error())
+			c.Next()
+			return
+		}
+
+		c.Header(RateLimitLimitHeader, strconv.Itoa(limit.Burst))
+		c.Header(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+		c.Header(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.ResetAfter)))
+		c.Header(RateLimitPolicyHeader, policy)
+
+		if !result.Allowed {
+			_ = c.Error(domain.NewTooManyRequestsError(domain.ErrTooManyRequests, result.RetryAfter))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// clientKey identifies the client of the request. Only the known API keys identify a client, the others would
+// give a new bucket on every request, and the IP is only taken from X-Forwarded-For behind the trusted proxies
+func (r *RateLimiter) clientKey(c *gin.Context) string {
+	if scheme, token, ok := strings.Cut(c.GetHeader("Authorization"), " "); ok && strings.EqualFold(scheme, "bearer") {
+		if customerID, err := r.jwtService.ParseToken(token); err == nil {
+			return "customer:" + strconv.FormatUint(customerID, 10)
+		}
+	}
+
+	if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+		if client, ok := FindAPIClient(r.apiKeys, apiKey); ok {
+			return "client:" + client
+		}
+	}
+
+	return "ip:" + c.ClientIP()
+}
+
+// ceilSeconds rounds the duration up to whole seconds, at least one
+func ceilSeconds(d time.Duration) int {
+	return max(int(math.Ceil(d.Seconds())), 1)
+}
@@ -0,0 +1,155 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	mockport "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port/mocks"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
+)
+
+func TestRateLimiter_Middleware(t *testing.T) {
+	limit := valueobject.RateLimit{Requests: 60, Period: time.Minute, Burst: 10}
+
+	tests := []struct {
+		name        string
+		headers     map[string]string
+		setupMocks  func(*mockport.MockRateLimitStore, *mockport.MockJWTService)
+		checkResult func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:    "should limit by the customer of the access token",
+			headers: map[string]string{"Authorization": "Bearer valid-token", middleware.APIKeyHeader: "partner-key"},
+			setupMocks: func(store *mockport.MockRateLimitStore, jwtService *mockport.MockJWTService) {
+				jwtService.EXPECT().ParseToken("valid-token").Return(uint64(1), nil)
+				store.EXPECT().
+					Take(gomock.Any(), "orders:customer:1", limit).
+					Return(valueobject.RateLimitResult{Allowed: true, Remaining: 9, ResetAfter: time.Second}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Equal(t, "10", res.Header().Get(middleware.RateLimitLimitHeader))
+				assert.Equal(t, "9", res.Header().Get(middleware.RateLimitRemainingHeader))
+				assert.Equal(t, "1", res.Header().Get(middleware.RateLimitResetHeader))
+				assert.Equal(t, "10;w=10", res.Header().Get(middleware.RateLimitPolicyHeader))
+				assert.Empty(t, res.Header().Get(middleware.RetryAfterHeader))
+			},
+		},
+		{
+			name:    "should limit by the api client when the access token is invalid",
+			headers: map[string]string{"Authorization": "Bearer invalid-token", middleware.APIKeyHeader: "partner-key"},
+			setupMocks: func(store *mockport.MockRateLimitStore, jwtService *mockport.MockJWTService) {
+				jwtService.EXPECT().ParseToken("invalid-token").Return(uint64(0), assert.AnError)
+				store.EXPECT().
+					Take(gomock.Any(), "orders:client:partner-a", limit).
+					Return(valueobject.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:    "should limit by the ip when the api key is unknown",
+			headers: map[string]string{middleware.APIKeyHeader: "random-key"},
+			setupMocks: func(store *mockport.MockRateLimitStore, _ *mockport.MockJWTService) {
+				store.EXPECT().
+					Take(gomock.Any(), "orders:ip:192.0.2.1", limit).
+					Return(valueobject.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name:    "should not trust the forwarded ip without trusted proxies",
+			headers: map[string]string{"X-Forwarded-For": "198.51.100.7"},
+			setupMocks: func(store *mockport.MockRateLimitStore, _ *mockport.MockJWTService) {
+				store.EXPECT().
+					Take(gomock.Any(), "orders:ip:192.0.2.1", limit).
+					Return(valueobject.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "should limit by the ip without credentials",
+			setupMocks: func(store *mockport.MockRateLimitStore, _ *mockport.MockJWTService) {
+				store.EXPECT().
+					Take(gomock.Any(), "orders:ip:192.0.2.1", limit).
+					Return(valueobject.RateLimitResult{Allowed: true, Remaining: 9}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+			},
+		},
+		{
+			name: "should reject with retry after when the bucket is empty",
+			setupMocks: func(store *mockport.MockRateLimitStore, _ *mockport.MockJWTService) {
+				store.EXPECT().
+					Take(gomock.Any(), "orders:ip:192.0.2.1", limit).
+					Return(valueobject.RateLimitResult{Remaining: 0, RetryAfter: 1500 * time.Millisecond, ResetAfter: 10 * time.Second}, nil)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusTooManyRequests, res.Code)
+				assert.Equal(t, "2", res.Header().Get(middleware.RetryAfterHeader))
+				assert.Equal(t, "0", res.Header().Get(middleware.RateLimitRemainingHeader))
+				assert.Equal(t, "10", res.Header().Get(middleware.RateLimitResetHeader))
+				assert.JSONEq(t, `{"code":429,"message":"too many requests"}`, res.Body.String())
+			},
+		},
+		{
+			name: "should let the request through when the store fails",
+			setupMocks: func(store *mockport.MockRateLimitStore, _ *mockport.MockJWTService) {
+				store.EXPECT().
+					Take(gomock.Any(), gomock.Any(), limit).
+					Return(valueobject.RateLimitResult{}, assert.AnError)
+			},
+			checkResult: func(t *testing.T, res *httptest.ResponseRecorder) {
+				assert.Equal(t, http.StatusOK, res.Code)
+				assert.Empty(t, res.Header().Get(middleware.RateLimitLimitHeader))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			ctrl := gomock.NewController(t)
+			store := mockport.NewMockRateLimitStore(ctrl)
+			jwtService := mockport.NewMockJWTService(ctrl)
+			tt.setupMocks(store, jwtService)
+
+			log := logger.NewLogger("")
+			gin.SetMode(gin.TestMode)
+			router := gin.New()
+			_ = router.SetTrustedProxies(nil)
+			router.Use(middleware.ErrorHandler(log))
+			rateLimiter := middleware.NewRateLimiter(store, jwtService, map[string]string{"partner-a": "partner-key"}, map[string]valueobject.RateLimit{"orders": limit}, log)
+			router.GET("/orders", rateLimiter.Middleware("orders", limit), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/orders", nil)
+			req.RemoteAddr = "192.0.2.1:1234"
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+
+			// Act
+			router.ServeHTTP(w, req)
+
+			// Assert
+			tt.checkResult(t, w)
+		})
+	}
+}
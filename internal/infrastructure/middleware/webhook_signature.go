@@ -0,0 +1,114 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+)
+
+// Headers of the signed webhook requests, the signature is the one of signature.Sign
+const (
+	WebhookPartnerHeader   = "X-Webhook-Partner"
+	WebhookTimestampHeader = signature.TimestampHeader
+	WebhookSignatureHeader = signature.SignatureHeader
+)
+
+// webhookPartnerKey is the gin context key of the partner that signed the webhook request
+const webhookPartnerKey = "webhook_partner"
+
+// WebhookSignatureConfig configures how the signed webhook requests are checked
+type WebhookSignatureConfig struct {
+	// Tolerance is how far the timestamp of the request can be from the current time
+	Tolerance time.Duration
+	// MaxBodyBytes limits the body read before the signature is checked
+	MaxBodyBytes int64
+	// ReplayStore remembers the accepted requests while their timestamp is within the tolerance
+	ReplayStore port.WebhookReplayStore
+}
+
+// WebhookSignature accepts the requests signed with the secret of the partner, the timestamp must
+// be within the tolerance of the current time so a captured request can't be replayed later, and
+// a request already accepted within the tolerance is rejected. The retries must be signed again
+func WebhookSignature(secrets map[string]string, cfg WebhookSignatureConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		partner := c.GetHeader(WebhookPartnerHeader)
+		timestampHeader := c.GetHeader(WebhookTimestampHeader)
+		sig := c.GetHeader(WebhookSignatureHeader)
+		if partner == "" || timestampHeader == "" || sig == "" {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrMissingWebhookSignature))
+			c.Abort()
+			return
+		}
+
+		secret, ok := secrets[partner]
+		if !ok {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrInvalidWebhookSignature))
+			c.Abort()
+			return
+		}
+
+		timestamp, err := strconv.ParseInt(timestampHeader, 10, 64)
+		if err != nil {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrInvalidWebhookSignature))
+			c.Abort()
+			return
+		}
+
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > cfg.Tolerance || age < -cfg.Tolerance {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrWebhookSignatureExpired))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, cfg.MaxBodyBytes))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				_ = c.Error(domain.NewInvalidInputError(domain.ErrWebhookBodyTooLarge))
+			} else {
+				_ = c.Error(domain.NewInvalidInputError(domain.ErrInvalidBody))
+			}
+			c.Abort()
+			return
+		}
+		// The handler binds the body again
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		if !signature.Verify(secret, timestamp, body, sig) {
+			_ = c.Error(domain.NewUnauthorizedError(domain.ErrInvalidWebhookSignature))
+			c.Abort()
+			return
+		}
+
+		// The signature covers the timestamp and the body, so it identifies the request until the timestamp expires
+		seen, err := cfg.ReplayStore.Seen(c.Request.Context(), partner+":"+sig, cfg.Tolerance-age)
+		if err != nil {
+			_ = c.Error(domain.NewInternalError(err))
+			c.Abort()
+			return
+		}
+		if seen {
+			_ = c.Error(domain.NewConflictError(domain.ErrWebhookReplayed))
+			c.Abort()
+			return
+		}
+
+		c.Set(webhookPartnerKey, partner)
+		c.Next()
+	}
+}
+
+// WebhookPartner returns the partner authenticated by WebhookSignature, empty when there's none
+func WebhookPartner(c *gin.Context) string {
+	return c.GetString(webhookPartnerKey)
+}
@@ -0,0 +1,97 @@
+package negotiation
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	MIMEJSON    = "application/json"
+	MIMEXML     = "application/xml"
+	MIMETextXML = "text/xml"
+	MIMECSV     = "text/csv"
+	MIMEText    = "text/plain"
+	MIMEHTML    = "text/html"
+	MIMEPDF     = "application/pdf"
+)
+
+// mediaRange is one of the comma separated entries of an Accept header
+type mediaRange struct {
+	mainType string
+	subType  string
+	quality  float64
+}
+
+// Negotiate returns the offer the Accept header prefers, following RFC 9110 section 12.5.1.
+// The offers are in the order the server prefers them: the first one is returned when the header
+// is empty, and ties on the quality are won by the earlier offer. An empty string is returned
+// when the header does not accept any of the offers
+func Negotiate(acceptHeader string, offers ...string) string {
+	if len(offers) == 0 {
+		return ""
+	}
+	if strings.TrimSpace(acceptHeader) == "" {
+		return offers[0]
+	}
+
+	ranges := parseAccept(acceptHeader)
+	best, bestQuality := "", 0.0
+	for _, offer := range offers {
+		if quality := qualityOf(offer, ranges); quality > bestQuality {
+			best, bestQuality = offer, quality
+		}
+	}
+	return best
+}
+
+// parseAccept reads the media ranges of an Accept header, the malformed ones are skipped
+func parseAccept(acceptHeader string) []mediaRange {
+	var ranges []mediaRange
+	for _, entry := range strings.Split(acceptHeader, ",") {
+		params := strings.Split(entry, ";")
+		mainType, subType, ok := strings.Cut(strings.ToLower(strings.TrimSpace(params[0])), "/")
+		if !ok || mainType == "" || subType == "" {
+			continue
+		}
+
+		quality := 1.0
+		for _, param := range params[1:] {
+			key, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+			if strings.ToLower(strings.TrimSpace(key)) != "q" {
+				continue
+			}
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil || q < 0 || q > 1 {
+				q = 0
+			}
+			quality = q
+		}
+
+		ranges = append(ranges, mediaRange{mainType: mainType, subType: subType, quality: quality})
+	}
+	return ranges
+}
+
+// qualityOf returns the quality of the most specific range matching the offer,
+// so "text/csv;q=0" excludes CSV even when "*/*" is accepted
+func qualityOf(offer string, ranges []mediaRange) float64 {
+	mainType, subType, _ := strings.Cut(offer, "/")
+	quality, specificity := 0.0, -1
+	for _, r := range ranges {
+		var s int
+		switch {
+		case r.mainType == mainType && r.subType == subType:
+			s = 2
+		case r.mainType == mainType && r.subType == "*":
+			s = 1
+		case r.mainType == "*" && r.subType == "*":
+			s = 0
+		default:
+			continue
+		}
+		if s > specificity {
+			quality, specificity = r.quality, s
+		}
+	}
+	return quality
+}
@@ -0,0 +1,120 @@
+package negotiation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/negotiation"
+)
+
+func TestNegotiate(t *testing.T) {
+	offers := []string{negotiation.MIMEJSON, negotiation.MIMEXML, negotiation.MIMECSV}
+
+	tests := []struct {
+		name   string
+		accept string
+		offers []string
+		want   string
+	}{
+		{
+			name:   "should return the first offer when the header is empty",
+			accept: "",
+			offers: offers,
+			want:   negotiation.MIMEJSON,
+		},
+		{
+			name:   "should return nothing when there are no offers",
+			accept: "application/json",
+			want:   "",
+		},
+		{
+			name:   "should return the accepted offer",
+			accept: "text/csv",
+			offers: offers,
+			want:   negotiation.MIMECSV,
+		},
+		{
+			name:   "should ignore the case and the spaces of the header",
+			accept: "  Application/XML ; Q=0.9 ",
+			offers: offers,
+			want:   negotiation.MIMEXML,
+		},
+		{
+			name:   "should return the offer with the highest quality",
+			accept: "application/json;q=0.5, text/csv;q=0.8",
+			offers: offers,
+			want:   negotiation.MIMECSV,
+		},
+		{
+			name:   "should return the earlier offer on a quality tie",
+			accept: "text/csv, application/xml",
+			offers: offers,
+			want:   negotiation.MIMEXML,
+		},
+		{
+			name:   "should return the earlier offer on a wildcard",
+			accept: "*/*",
+			offers: offers,
+			want:   negotiation.MIMEJSON,
+		},
+		{
+			name:   "should exclude the offers with a zero quality",
+			accept: "application/json;q=0, application/xml;q=0.0, */*",
+			offers: offers,
+			want:   negotiation.MIMECSV,
+		},
+		{
+			name:   "should return nothing when all the offers are excluded",
+			accept: "text/*;q=0, application/*;q=0",
+			offers: offers,
+			want:   "",
+		},
+		{
+			name:   "should prefer the exact range over the subtype wildcard",
+			accept: "text/*;q=0.9, text/csv;q=0.1, application/xml;q=0.5",
+			offers: offers,
+			want:   negotiation.MIMEXML,
+		},
+		{
+			name:   "should prefer the subtype wildcard over the full wildcard",
+			accept: "*/*;q=0.1, application/*;q=0.6, text/csv;q=0.5",
+			offers: offers,
+			want:   negotiation.MIMEJSON,
+		},
+		{
+			name:   "should not match other main types with a subtype wildcard",
+			accept: "text/*",
+			offers: []string{negotiation.MIMEJSON, negotiation.MIMEXML},
+			want:   "",
+		},
+		{
+			name:   "should skip the malformed entries",
+			accept: "json, /xml, text/, ;q=1, text/csv;q=0.2",
+			offers: offers,
+			want:   negotiation.MIMECSV,
+		},
+		{
+			name:   "should exclude the entries with an invalid quality",
+			accept: "application/json;q=abc, application/xml;q=1.5, text/csv;q=-1, text/plain",
+			offers: append(offers, negotiation.MIMEText),
+			want:   negotiation.MIMEText,
+		},
+		{
+			name:   "should ignore the parameters other than the quality",
+			accept: "application/xml;charset=utf-8;q=0.7, application/json;level=1;q=0.3",
+			offers: offers,
+			want:   negotiation.MIMEXML,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got := negotiation.Negotiate(tt.accept, tt.offers...)
+
+			// Assert
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
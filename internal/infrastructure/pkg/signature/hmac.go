@@ -0,0 +1,32 @@
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+)
+
+// Headers of the signed webhook requests, the same ones are used on the received and on the sent webhooks
+const (
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// prefix names the algorithm of the signatures, ex: sha256=5d41402abc4b2a76b9719d911017c592
+const prefix = "sha256="
+
+// Sign returns the HMAC-SHA256 of the timestamp and the body with the secret.
+// The timestamp is signed with the body, so an old request can't be replayed with a new timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return prefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature is the one of the timestamp and the body with the secret
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
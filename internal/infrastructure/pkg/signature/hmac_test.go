@@ -0,0 +1,30 @@
+package signature_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+)
+
+func TestSignature(t *testing.T) {
+	body := []byte(`{"order_id":1,"status":"READY"}`)
+
+	t.Run("should sign the timestamp and the body", func(t *testing.T) {
+		sig := signature.Sign("secret", 1741280608, body)
+
+		assert.Equal(t, "sha256=", sig[:7])
+		assert.Len(t, sig, 7+64)
+		assert.True(t, signature.Verify("secret", 1741280608, body, sig))
+	})
+
+	t.Run("should not verify a signature changed on the way", func(t *testing.T) {
+		sig := signature.Sign("secret", 1741280608, body)
+
+		assert.False(t, signature.Verify("other", 1741280608, body, sig))
+		assert.False(t, signature.Verify("secret", 1741280609, body, sig))
+		assert.False(t, signature.Verify("secret", 1741280608, []byte(`{"order_id":2,"status":"READY"}`), sig))
+		assert.False(t, signature.Verify("secret", 1741280608, body, ""))
+	})
+}
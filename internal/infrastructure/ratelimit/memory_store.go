@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// sweepInterval is how often the full buckets are dropped, a missing bucket is the same as a full one
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	fullAt    time.Time
+}
+
+type memoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+// NewMemoryStore creates a RateLimitStore that keeps the buckets in memory,
+// each instance of the service limits the clients on its own
+func NewMemoryStore() port.RateLimitStore {
+	return &memoryStore{buckets: make(map[string]*bucket), sweptAt: time.Now()}
+}
+
+func (s *memoryStore) Take(_ context.Context, key string, limit valueobject.RateLimit) (valueobject.RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	tokens, result := limit.Take(b.tokens, now.Sub(b.updatedAt))
+	b.tokens = tokens
+	b.updatedAt = now
+	b.fullAt = now.Add(result.ResetAfter)
+
+	return result, nil
+}
+
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < sweepInterval {
+		return
+	}
+	for key, b := range s.buckets {
+		if !now.Before(b.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+	s.sweptAt = now
+}
@@ -0,0 +1,73 @@
+package ratelimit_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/ratelimit"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	ctx := context.Background()
+	limit := valueobject.RateLimit{Requests: 1, Period: time.Hour, Burst: 2}
+
+	t.Run("should limit the requests of the key to the burst", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+
+		result, err := store.Take(ctx, "orders:ip:10.0.0.1", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 1, result.Remaining)
+
+		result, err = store.Take(ctx, "orders:ip:10.0.0.1", limit)
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+		assert.Equal(t, 0, result.Remaining)
+
+		result, err = store.Take(ctx, "orders:ip:10.0.0.1", limit)
+		assert.NoError(t, err)
+		assert.False(t, result.Allowed)
+		assert.Greater(t, result.RetryAfter, 59*time.Minute)
+	})
+
+	t.Run("should keep a bucket per key", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+
+		for range limit.Burst {
+			_, _ = store.Take(ctx, "orders:ip:10.0.0.1", limit)
+		}
+		result, err := store.Take(ctx, "orders:ip:10.0.0.2", limit)
+
+		assert.NoError(t, err)
+		assert.True(t, result.Allowed)
+	})
+
+	t.Run("should not allow more than the burst on concurrent requests", func(t *testing.T) {
+		store := ratelimit.NewMemoryStore()
+		concurrentLimit := valueobject.RateLimit{Requests: 1, Period: time.Hour, Burst: 10}
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		allowed := 0
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				result, _ := store.Take(ctx, "orders:ip:10.0.0.1", concurrentLimit)
+				if result.Allowed {
+					mu.Lock()
+					allowed++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, concurrentLimit.Burst, allowed)
+	})
+}
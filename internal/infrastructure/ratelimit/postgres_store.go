@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+type postgresStore struct {
+	db     *gorm.DB
+	logger *logger.Logger
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+// NewPostgresStore creates a RateLimitStore that keeps the buckets on the rate_limit_buckets table,
+// so the clients are limited across all the instances of the service. The elapsed time is taken
+// from the database clock, the clocks of the instances don't need to agree. The full buckets are
+// deleted every sweep interval, like the memory store drops them
+func NewPostgresStore(db *gorm.DB, logger *logger.Logger) port.RateLimitStore {
+	return &postgresStore{db: db, logger: logger, sweptAt: time.Now()}
+}
+
+func (s *postgresStore) Take(ctx context.Context, key string, limit valueobject.RateLimit) (valueobject.RateLimitResult, error) {
+	var result valueobject.RateLimitResult
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The bucket is created full and locked, the concurrent requests of the client take their tokens in turn
+		if err := tx.Exec(
+			"INSERT INTO rate_limit_buckets (bucket_key, tokens, updated_at, full_at) VALUES (?, ?, now(), now()) ON CONFLICT (bucket_key) DO NOTHING",
+			key, float64(limit.Burst),
+		).Error; err != nil {
+			return fmt.Errorf("error creating rate limit bucket: %w", err)
+		}
+
+		var b struct {
+			Tokens  float64
+			Elapsed float64
+		}
+		if err := tx.Raw(
+			"SELECT tokens, EXTRACT(EPOCH FROM (now() - updated_at)) AS elapsed FROM rate_limit_buckets WHERE bucket_key = ? FOR UPDATE",
+			key,
+		).Scan(&b).Error; err != nil {
+			return fmt.Errorf("error finding rate limit bucket: %w", err)
+		}
+
+		var tokens float64
+		tokens, result = limit.Take(b.Tokens, time.Duration(b.Elapsed*float64(time.Second)))
+
+		if err := tx.Exec(
+			"UPDATE rate_limit_buckets SET tokens = ?, updated_at = now(), full_at = now() + make_interval(secs => ?) WHERE bucket_key = ?",
+			tokens, result.ResetAfter.Seconds(), key,
+		).Error; err != nil {
+			return fmt.Errorf("error updating rate limit bucket: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return valueobject.RateLimitResult{}, err
+	}
+
+	s.sweep(ctx)
+	return result, nil
+}
+
+// sweep deletes the full buckets, at most once per sweep interval on each instance.
+// A failed sweep doesn't fail the request, the buckets are deleted on the next one
+func (s *postgresStore) sweep(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.sweptAt) < sweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.sweptAt = time.Now()
+	s.mu.Unlock()
+
+	if err := s.db.WithContext(ctx).Exec("DELETE FROM rate_limit_buckets WHERE full_at <= now()").Error; err != nil {
+		s.logger.Warn("failed to delete the full rate limit buckets", "error", err.Error())
+	}
+}
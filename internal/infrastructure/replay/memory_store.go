@@ -0,0 +1,51 @@
+package replay
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+)
+
+// sweepInterval is how often the expired keys are dropped, an expired key is the same as a missing one
+const sweepInterval = time.Minute
+
+type memoryStore struct {
+	mu      sync.Mutex
+	keys    map[string]time.Time
+	sweptAt time.Time
+}
+
+// NewMemoryStore creates a WebhookReplayStore that keeps the keys in memory,
+// each instance of the service only rejects the requests it received itself
+func NewMemoryStore() port.WebhookReplayStore {
+	return &memoryStore{keys: make(map[string]time.Time), sweptAt: time.Now()}
+}
+
+func (s *memoryStore) Seen(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	if keyExpiresAt, ok := s.keys[key]; ok && now.Before(keyExpiresAt) {
+		return true, nil
+	}
+	s.keys[key] = now.Add(ttl)
+
+	return false, nil
+}
+
+func (s *memoryStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < sweepInterval {
+		return
+	}
+	for key, expiresAt := range s.keys {
+		if !now.Before(expiresAt) {
+			delete(s.keys, key)
+		}
+	}
+	s.sweptAt = now
+}
@@ -0,0 +1,71 @@
+package replay_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/replay"
+)
+
+func TestMemoryStore_Seen(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("should report the keys already recorded", func(t *testing.T) {
+		store := replay.NewMemoryStore()
+
+		seen, err := store.Seen(ctx, "partner-a:sha256=abc", time.Minute)
+		assert.NoError(t, err)
+		assert.False(t, seen)
+
+		seen, err = store.Seen(ctx, "partner-a:sha256=abc", time.Minute)
+		assert.NoError(t, err)
+		assert.True(t, seen)
+	})
+
+	t.Run("should keep the keys apart", func(t *testing.T) {
+		store := replay.NewMemoryStore()
+
+		_, _ = store.Seen(ctx, "partner-a:sha256=abc", time.Minute)
+		seen, err := store.Seen(ctx, "partner-b:sha256=abc", time.Minute)
+
+		assert.NoError(t, err)
+		assert.False(t, seen)
+	})
+
+	t.Run("should record the key again once it expired", func(t *testing.T) {
+		store := replay.NewMemoryStore()
+
+		_, _ = store.Seen(ctx, "partner-a:sha256=abc", time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+		seen, err := store.Seen(ctx, "partner-a:sha256=abc", time.Minute)
+
+		assert.NoError(t, err)
+		assert.False(t, seen)
+	})
+
+	t.Run("should accept a key only once on concurrent requests", func(t *testing.T) {
+		store := replay.NewMemoryStore()
+
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		accepted := 0
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if seen, err := store.Seen(ctx, "partner-a:sha256=abc", time.Minute); err == nil && !seen {
+					mu.Lock()
+					accepted++
+					mu.Unlock()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, accepted)
+	})
+}
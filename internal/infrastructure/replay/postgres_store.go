@@ -0,0 +1,60 @@
+package replay
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+type postgresStore struct {
+	db     *gorm.DB
+	logger *logger.Logger
+
+	mu      sync.Mutex
+	sweptAt time.Time
+}
+
+// NewPostgresStore creates a WebhookReplayStore that keeps the keys on the webhook_requests table,
+// so a request is rejected by all the instances of the service. The expiration is taken from the
+// database clock, the clocks of the instances don't need to agree. The expired keys are deleted
+// every sweep interval, like the memory store drops them
+func NewPostgresStore(db *gorm.DB, logger *logger.Logger) port.WebhookReplayStore {
+	return &postgresStore{db: db, logger: logger, sweptAt: time.Now()}
+}
+
+func (s *postgresStore) Seen(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	// The key is only taken again once it expired, the concurrent requests with the same key insert it in turn
+	result := s.db.WithContext(ctx).Exec(
+		`INSERT INTO webhook_requests (request_key, expires_at) VALUES (?, now() + make_interval(secs => ?))
+		ON CONFLICT (request_key) DO UPDATE SET expires_at = EXCLUDED.expires_at WHERE webhook_requests.expires_at <= now()`,
+		key, ttl.Seconds(),
+	)
+	if result.Error != nil {
+		return false, fmt.Errorf("error recording webhook request: %w", result.Error)
+	}
+
+	s.sweep(ctx)
+	return result.RowsAffected == 0, nil
+}
+
+// sweep deletes the expired keys, at most once per sweep interval on each instance.
+// A failed sweep doesn't fail the request, the keys are deleted on the next one
+func (s *postgresStore) sweep(ctx context.Context) {
+	s.mu.Lock()
+	if time.Since(s.sweptAt) < sweepInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.sweptAt = time.Now()
+	s.mu.Unlock()
+
+	if err := s.db.WithContext(ctx).Exec("DELETE FROM webhook_requests WHERE expires_at <= now()").Error; err != nil {
+		s.logger.Warn("failed to delete the expired webhook requests", "error", err.Error())
+	}
+}
@@ -1,6 +1,8 @@
 package route
 
 import (
+	"fmt"
+
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -12,17 +14,23 @@ import (
 )
 
 type Router struct {
-	engine *gin.Engine
-	logger *logger.Logger
+	engine      *gin.Engine
+	logger      *logger.Logger
+	rateLimiter *middleware.RateLimiter
 }
 
-func NewRouter(logger *logger.Logger, cfg *config.Config) *Router {
+// NewRouter creates the router, the route groups are limited by the rate limiter when it is informed.
+// The client IP is only taken from X-Forwarded-For when the request comes from one of the trusted proxies
+func NewRouter(logger *logger.Logger, cfg *config.Config, rateLimiter *middleware.RateLimiter) (*Router, error) {
 	// Set Gin mode
 	if cfg.Environment == "production" {
 		gin.SetMode(gin.ReleaseMode)
 	}
 
 	engine := gin.New()
+	if err := engine.SetTrustedProxies(cfg.TrustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
 
 	// Global middlewares
 	engine.Use(
@@ -36,9 +44,10 @@ func NewRouter(logger *logger.Logger, cfg *config.Config) *Router {
 	engine.GET("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
 	return &Router{
-		engine: engine,
-		logger: logger,
-	}
+		engine:      engine,
+		logger:      logger,
+		rateLimiter: rateLimiter,
+	}, nil
 }
 
 // RegisterRoutes configure all routes of the application
@@ -48,15 +57,46 @@ func (r *Router) RegisterRoutes(handlers *Handlers) {
 	// API v1
 	v1 := r.engine.Group("/api/v1")
 	{
-		handlers.Product.Register(v1.Group("/products"))
-		handlers.Order.Register(v1.Group("/orders"))
-		handlers.OrderProduct.Register(v1.Group("/orders/products"))
-		handlers.OrderHistory.Register(v1.Group("/orders/histories"))
-		handlers.Category.Register(v1.Group("/categories"))
+		handlers.Product.Register(r.group(v1, "products", "/products"))
+		handlers.Stock.RegisterProductRoutes(r.group(v1, "products", "/products/:id/stock"))
+		handlers.ProductPrice.RegisterProductRoutes(r.group(v1, "products", "/products/:id/prices"))
+		handlers.Order.Register(r.group(v1, "orders", "/orders"))
+		handlers.OrderProduct.Register(r.group(v1, "orders", "/orders/products"))
+		handlers.OrderHistory.Register(r.group(v1, "orders", "/orders/histories"))
+		handlers.OrderHistory.RegisterOrderRoutes(r.group(v1, "orders", "/orders/:id/histories"))
+		handlers.Reorder.RegisterOrderRoutes(r.group(v1, "orders", "/orders/:id/reorder"))
+		handlers.Payment.RegisterOrderRoutes(r.group(v1, "orders", "/orders/:id/checkout"))
+		handlers.Payment.Register(r.group(v1, "payments", "/payments"))
+		handlers.Webhook.Register(r.group(v1, "webhooks", "/webhooks"))
+		handlers.WebhookSubscription.Register(r.group(v1, "webhook-subscriptions", "/webhook-subscriptions"))
+		handlers.WebhookDelivery.RegisterSubscriptionRoutes(r.group(v1, "webhook-subscriptions", "/webhook-subscriptions/:id/deliveries"))
+		handlers.Order.RegisterCustomerRoutes(r.group(v1, "customers", "/customers/:id/orders"))
+		handlers.NotificationPreference.RegisterCustomerRoutes(r.group(v1, "customers", "/customers/:id/notification-preferences"))
+		handlers.Category.Register(r.group(v1, "categories", "/categories"))
+		handlers.Menu.Register(r.group(v1, "menu", "/menu"))
+		handlers.Catalog.Register(r.group(v1, "catalog", "/catalog"))
+		handlers.Promotion.Register(r.group(v1, "promotions", "/promotions"))
+		handlers.Promotion.RegisterOrderRoutes(r.group(v1, "orders", "/orders/:id/promotions"))
+		handlers.KitchenTicket.Register(r.group(v1, "kitchen", "/kitchen/tickets"))
+		handlers.KitchenTicket.RegisterStationRoutes(r.group(v1, "kitchen", "/kitchen/stations/:station/tickets"))
+		handlers.PickupBoard.Register(r.group(v1, "pickup-board", "/pickup-board"))
 		handlers.HealthCheck.Register(v1.Group("/health"))
 	}
 }
 
+// group creates a route group limited by the rate limit of its name, or the default one. The groups of the same
+// name share the buckets of the clients, and the groups without a limit are not limited
+func (r *Router) group(parent *gin.RouterGroup, name, path string) *gin.RouterGroup {
+	if r.rateLimiter == nil {
+		return parent.Group(path)
+	}
+	limit, ok := r.rateLimiter.Limit(name)
+	if !ok {
+		return parent.Group(path)
+	}
+	return parent.Group(path, r.rateLimiter.Middleware(name, limit))
+}
+
 // Engine returns the gin engine
 func (r *Router) Engine() *gin.Engine {
 	return r.engine
@@ -64,11 +104,24 @@ func (r *Router) Engine() *gin.Engine {
 
 // Handlers contains all handlers of the application
 type Handlers struct {
-	Product      *handler.ProductHandler
-	Order        *handler.OrderHandler
-	OrderProduct *handler.OrderProductHandler
-	OrderHistory *handler.OrderHistoryHandler
-	HealthCheck  *handler.HealthCheckHandler
-	Category     *handler.CategoryHandler
-	Redoc        *handler.RedocHandler
+	Product                *handler.ProductHandler
+	Order                  *handler.OrderHandler
+	OrderProduct           *handler.OrderProductHandler
+	OrderHistory           *handler.OrderHistoryHandler
+	Reorder                *handler.ReorderHandler
+	Payment                *handler.PaymentHandler
+	Webhook                *handler.WebhookHandler
+	WebhookSubscription    *handler.WebhookSubscriptionHandler
+	WebhookDelivery        *handler.WebhookDeliveryHandler
+	NotificationPreference *handler.NotificationPreferenceHandler
+	HealthCheck            *handler.HealthCheckHandler
+	Category               *handler.CategoryHandler
+	Promotion              *handler.PromotionHandler
+	Stock                  *handler.StockHandler
+	ProductPrice           *handler.ProductPriceHandler
+	Menu                   *handler.MenuHandler
+	Catalog                *handler.CatalogHandler
+	KitchenTicket          *handler.KitchenTicketHandler
+	PickupBoard            *handler.PickupBoardHandler
+	Redoc                  *handler.RedocHandler
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/handler"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/middleware"
 	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/route"
 	"github.com/gin-gonic/gin/binding"
 
@@ -24,8 +25,11 @@ type Server struct {
 	logger *logger.Logger
 }
 
-func NewServer(cfg *config.Config, logger *logger.Logger, handlers *route.Handlers) *Server {
-	router := route.NewRouter(logger, cfg)
+func NewServer(cfg *config.Config, logger *logger.Logger, handlers *route.Handlers, rateLimiter *middleware.RateLimiter) (*Server, error) {
+	router, err := route.NewRouter(logger, cfg, rateLimiter)
+	if err != nil {
+		return nil, err
+	}
 
 	RegisterCustomValidation()
 	router.RegisterRoutes(handlers)
@@ -34,7 +38,7 @@ func NewServer(cfg *config.Config, logger *logger.Logger, handlers *route.Handle
 		router: router,
 		config: cfg,
 		logger: logger,
-	}
+	}, nil
 }
 
 func (s *Server) Start() error {
@@ -88,5 +92,53 @@ func RegisterCustomValidation() {
 		if err != nil {
 			panic(err)
 		}
+		err = v.RegisterValidation("api_actor_type", handler.APIActorTypeValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("discount_type_exists", handler.DiscountTypeValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("stock_mode_exists", handler.StockModeValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("kitchen_station_exists", handler.KitchenStationValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("kitchen_ticket_status_exists", handler.KitchenTicketStatusValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("order_channel_exists", handler.OrderChannelValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("fulfilment_mode_exists", handler.FulfilmentModeValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("payment_status_exists", handler.PaymentStatusValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("order_event_type_exists", handler.OrderEventTypeValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("webhook_delivery_status_exists", handler.WebhookDeliveryStatusValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("notification_channel_exists", handler.NotificationChannelValidator)
+		if err != nil {
+			panic(err)
+		}
+		err = v.RegisterValidation("locale_exists", handler.LocaleValidator)
+		if err != nil {
+			panic(err)
+		}
 	}
 }
@@ -60,3 +60,23 @@ func (s *jwtService) ValidateToken(tokenString string) error {
 
 	return nil
 }
+
+func (s *jwtService) ParseToken(tokenString string) (uint64, error) {
+	var claims jwt.RegisteredClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signature method")
+		}
+		return s.secretKey, nil
+	})
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !token.Valid {
+		return 0, errors.New("invalid token")
+	}
+
+	return strconv.ParseUint(claims.ID, 10, 64)
+}
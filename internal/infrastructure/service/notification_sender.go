@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+)
+
+// NewNotificationSenders creates the senders of all the notification channels. Until the SMS, email and push
+// providers are integrated every channel is sent to the same sink, the file when a path is set or the log otherwise
+func NewNotificationSenders(path string, logger *logger.Logger) map[valueobject.NotificationChannel]port.NotificationSender {
+	var sink port.NotificationSender = NewLogNotificationSender(logger)
+	if path != "" {
+		sink = NewFileNotificationSender(path)
+	}
+
+	senders := make(map[valueobject.NotificationChannel]port.NotificationSender, len(valueobject.NotificationChannels))
+	for _, channel := range valueobject.NotificationChannels {
+		senders[channel] = sink
+	}
+	return senders
+}
+
+type logNotificationSender struct {
+	logger *logger.Logger
+}
+
+// NewLogNotificationSender creates a NotificationSender that only logs the notifications
+func NewLogNotificationSender(logger *logger.Logger) port.NotificationSender {
+	return &logNotificationSender{logger}
+}
+
+func (s *logNotificationSender) Send(_ context.Context, notification *entity.Notification) error {
+	s.logger.Info("Notification sent",
+		"channel", notification.Channel,
+		"customerID", notification.CustomerID,
+		"orderID", notification.OrderID,
+		"status", notification.Status,
+		"subject", notification.Subject,
+		"body", notification.Body,
+	)
+	return nil
+}
+
+type fileNotificationSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileNotificationSender creates a NotificationSender that appends the notifications to a file, one JSON per line
+func NewFileNotificationSender(path string) port.NotificationSender {
+	return &fileNotificationSender{path: path}
+}
+
+func (s *fileNotificationSender) Send(_ context.Context, notification *entity.Notification) error {
+	line, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("error encoding notification: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening notification file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("error writing notification file: %w", err)
+	}
+	return nil
+}
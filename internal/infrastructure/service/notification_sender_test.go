@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
+)
+
+func TestFileNotificationSender_Send(t *testing.T) {
+	ctx := context.Background()
+	notification := &entity.Notification{
+		CustomerID: 1,
+		OrderID:    42,
+		Status:     valueobject.READY,
+		Channel:    valueobject.NotificationSMS,
+		Recipient:  "+5511999999999",
+		Locale:     valueobject.LocaleEn,
+		Body:       "Your order #42 is ready!",
+	}
+
+	t.Run("should append the notifications as JSON lines", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "notifications.jsonl")
+		sender := service.NewFileNotificationSender(path)
+
+		assert.NoError(t, sender.Send(ctx, notification))
+		assert.NoError(t, sender.Send(ctx, notification))
+
+		data, err := os.ReadFile(path)
+		assert.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		assert.Len(t, lines, 2)
+
+		var sent entity.Notification
+		assert.NoError(t, json.Unmarshal([]byte(lines[1]), &sent))
+		assert.Equal(t, uint64(42), sent.OrderID)
+		assert.Equal(t, valueobject.NotificationSMS, sent.Channel)
+		assert.Equal(t, "+5511999999999", sent.Recipient)
+	})
+
+	t.Run("should fail when the file can not be opened", func(t *testing.T) {
+		sender := service.NewFileNotificationSender(filepath.Join(t.TempDir(), "missing", "notifications.jsonl"))
+
+		assert.Error(t, sender.Send(ctx, notification))
+	})
+}
+
+func TestNewNotificationSenders(t *testing.T) {
+	senders := service.NewNotificationSenders("", logger.NewLogger(""))
+
+	for _, channel := range valueobject.NotificationChannels {
+		assert.Contains(t, senders, channel)
+		assert.NoError(t, senders[channel].Send(context.Background(), &entity.Notification{Channel: channel}))
+	}
+}
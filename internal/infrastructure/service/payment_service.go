@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+)
+
+type paymentService struct {
+	client  *httpclient.HTTPClient
+	breaker *httpclient.CircuitBreaker
+	url     string
+	token   string
+}
+
+// NewPaymentService creates the PaymentService of the payment service HTTP API.
+// The client retries the failed requests, the breaker only counts the calls that failed after the retries
+func NewPaymentService(cfg *config.Config, client *httpclient.HTTPClient) port.PaymentService {
+	return &paymentService{
+		client:  client,
+		breaker: httpclient.NewCircuitBreaker(cfg.PaymentBreakerMaxFailures, cfg.PaymentBreakerOpenTimeout),
+		url:     cfg.PaymentServiceURL,
+		token:   cfg.PaymentServiceToken,
+	}
+}
+
+type createPaymentRequest struct {
+	OrderID    uint64  `json:"order_id"`
+	CustomerID uint64  `json:"customer_id,omitempty"`
+	Amount     float64 `json:"amount"`
+}
+
+type createPaymentResponse struct {
+	ID string `json:"id"`
+}
+
+func (s *paymentService) CreatePayment(ctx context.Context, order *entity.Order) (*entity.Payment, error) {
+	body := createPaymentRequest{
+		OrderID:    order.ID,
+		CustomerID: order.CustomerID,
+		Amount:     order.Total,
+	}
+
+	var result createPaymentResponse
+	var status int
+	var responseBody string
+	err := s.breaker.Execute(func() error {
+		req := s.client.R().
+			SetContext(ctx).
+			// The retried requests must not charge the order twice, the version changes on every checkout
+			// so a reopened order checked out again gets a new payment
+			SetHeader("Idempotency-Key", fmt.Sprintf("order-%d-v%d", order.ID, order.Version)).
+			SetBody(body).
+			SetResult(&result)
+		if s.token != "" {
+			req.SetAuthToken(s.token)
+		}
+
+		resp, err := req.Post(s.url + "/payments")
+		if err != nil {
+			return fmt.Errorf("error calling payment service: %w", err)
+		}
+		status, responseBody = resp.StatusCode(), resp.String()
+		if status >= http.StatusInternalServerError {
+			return fmt.Errorf("payment service responded with status %d", status)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The client errors are not a failure of the payment service, they don't open the circuit
+	if status >= http.StatusBadRequest {
+		return nil, fmt.Errorf("payment service rejected the payment with status %d: %s", status, responseBody)
+	}
+	if result.ID == "" {
+		return nil, errors.New("payment service responded without the payment id")
+	}
+
+	return &entity.Payment{
+		OrderID:   order.ID,
+		Reference: result.ID,
+		Amount:    order.Total,
+	}, nil
+}
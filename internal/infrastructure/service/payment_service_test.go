@@ -0,0 +1,149 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
+)
+
+// newPaymentServer starts a stand-in of the payment service and returns the config pointing to it
+func newPaymentServer(t *testing.T, handler http.HandlerFunc) *config.Config {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &config.Config{
+		HTTPClientTimeout:         200 * time.Millisecond,
+		HTTPClientRetryCount:      2,
+		HTTPClientRetryWaitTime:   time.Millisecond,
+		PaymentServiceURL:         server.URL,
+		PaymentServiceToken:       "secret",
+		PaymentBreakerMaxFailures: 2,
+		PaymentBreakerOpenTimeout: time.Minute,
+	}
+}
+
+func TestPaymentService_CreatePayment(t *testing.T) {
+	ctx := context.Background()
+	order := &entity.Order{ID: 42, CustomerID: 7, Total: 59.98, Version: 3}
+
+	t.Run("should create the payment of the order", func(t *testing.T) {
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/payments", r.URL.Path)
+			assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+			assert.Equal(t, "order-42-v3", r.Header.Get("Idempotency-Key"))
+
+			var body map[string]any
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, map[string]any{"order_id": float64(42), "customer_id": float64(7), "amount": 59.98}, body)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id":"pay_8f14e45f"}`))
+		})
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		payment, err := paymentService.CreatePayment(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, &entity.Payment{OrderID: 42, Reference: "pay_8f14e45f", Amount: 59.98}, payment)
+	})
+
+	t.Run("should retry the server errors", func(t *testing.T) {
+		var calls atomic.Int32
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			if calls.Add(1) < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"pay_8f14e45f"}`))
+		})
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		payment, err := paymentService.CreatePayment(ctx, order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "pay_8f14e45f", payment.Reference)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should not retry the payments rejected by the payment service", func(t *testing.T) {
+		var calls atomic.Int32
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte(`{"error":"invalid amount"}`))
+		})
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		for range 3 {
+			payment, err := paymentService.CreatePayment(ctx, order)
+
+			assert.Nil(t, payment)
+			assert.ErrorContains(t, err, "invalid amount")
+			assert.NotErrorIs(t, err, httpclient.ErrCircuitOpen)
+		}
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("should open the circuit when the payment service keeps failing", func(t *testing.T) {
+		var calls atomic.Int32
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			calls.Add(1)
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		_, err := paymentService.CreatePayment(ctx, order)
+		assert.ErrorContains(t, err, "status 500")
+		_, err = paymentService.CreatePayment(ctx, order)
+		assert.ErrorContains(t, err, "status 500")
+		_, err = paymentService.CreatePayment(ctx, order)
+
+		assert.ErrorIs(t, err, httpclient.ErrCircuitOpen)
+		// The first request and its 2 retries on each of the 2 failed calls
+		assert.Equal(t, int32(6), calls.Load())
+	})
+
+	t.Run("should give up when the payment service doesn't answer in time", func(t *testing.T) {
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-time.After(500 * time.Millisecond):
+			case <-r.Context().Done():
+			}
+		})
+		cfg.HTTPClientRetryCount = 0
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		payment, err := paymentService.CreatePayment(ctx, order)
+
+		assert.Nil(t, payment)
+		assert.ErrorContains(t, err, "error calling payment service")
+	})
+
+	t.Run("should return error when the payment id is missing", func(t *testing.T) {
+		cfg := newPaymentServer(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		})
+		paymentService := service.NewPaymentService(cfg, httpclient.NewRestyClient(cfg, logger.NewLogger("")))
+
+		payment, err := paymentService.CreatePayment(ctx, order)
+
+		assert.Nil(t, payment)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/port"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+)
+
+// Headers of the sent webhooks, besides the timestamp and the signature of the signature package
+const (
+	webhookEventHeader    = "X-Webhook-Event"
+	webhookDeliveryHeader = "X-Webhook-Delivery"
+)
+
+type webhookSender struct {
+	client *httpclient.HTTPClient
+}
+
+// NewWebhookSender creates the WebhookSender of the subscribers HTTP endpoints.
+// The body is signed with the secret of the subscription like the partners sign the received webhooks,
+// the delivery ID is the same on all the attempts of a delivery so the subscriber can skip the repeated ones.
+// Unless the private networks are allowed, the transport of the client is replaced by one that only connects
+// to public addresses, so the client must not be shared with the calls to the internal services
+func NewWebhookSender(client *httpclient.HTTPClient, allowPrivateNetworks bool) port.WebhookSender {
+	if !allowPrivateNetworks {
+		client.SetTransport(newPublicTransport())
+	}
+	return &webhookSender{client}
+}
+
+// newPublicTransport creates a transport that refuses to connect to the addresses that are not public.
+// The address is checked after the host is resolved, on every connection and redirect, so a subscriber
+// host can't be pointed to the internal network after the subscription was validated
+func newPublicTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil || !entity.IsPublicAddress(addrPort.Addr()) {
+				return fmt.Errorf("webhook subscriber address %s is not public", address)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would be dialed instead of the subscriber
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return transport
+}
+
+func (s *webhookSender) Send(ctx context.Context, subscription *entity.WebhookSubscription, delivery *entity.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+
+	resp, err := s.client.R().
+		SetContext(ctx).
+		SetHeader(webhookEventHeader, delivery.EventType.String()).
+		SetHeader(webhookDeliveryHeader, strconv.FormatUint(delivery.ID, 10)).
+		SetHeader(signature.TimestampHeader, strconv.FormatInt(timestamp, 10)).
+		SetHeader(signature.SignatureHeader, signature.Sign(subscription.Secret, timestamp, body)).
+		SetBody(body).
+		Post(subscription.URL)
+	if err != nil {
+		return 0, fmt.Errorf("error calling webhook subscriber: %w", err)
+	}
+
+	if resp.StatusCode() < http.StatusOK || resp.StatusCode() >= http.StatusMultipleChoices {
+		return resp.StatusCode(), fmt.Errorf("webhook subscriber responded with status %d", resp.StatusCode())
+	}
+	return resp.StatusCode(), nil
+}
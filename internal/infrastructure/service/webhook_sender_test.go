@@ -0,0 +1,116 @@
+package service_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/entity"
+	valueobject "github.com/FIAP-SOAT-G20/tc4-order-service/internal/core/domain/value_object"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/config"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/httpclient"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/logger"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/pkg/signature"
+	"github.com/FIAP-SOAT-G20/tc4-order-service/internal/infrastructure/service"
+)
+
+func TestWebhookSender_Send(t *testing.T) {
+	ctx := context.Background()
+	cfg := &config.Config{HTTPClientTimeout: 200 * time.Millisecond, HTTPClientRetryCount: 3}
+	// The test servers listen on the loopback
+	sender := service.NewWebhookSender(httpclient.NewWebhookClient(cfg, logger.NewLogger("")), true)
+	delivery := &entity.WebhookDelivery{
+		ID:        7,
+		EventType: valueobject.OrderStatusChangedEvent,
+		Payload:   `{"type":"order.status_changed","order_id":1,"status":"READY"}`,
+	}
+
+	t.Run("should post the signed payload", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/webhooks", r.URL.Path)
+			assert.Equal(t, "order.status_changed", r.Header.Get("X-Webhook-Event"))
+			assert.Equal(t, "7", r.Header.Get("X-Webhook-Delivery"))
+
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, delivery.Payload, string(body))
+
+			timestamp, err := strconv.ParseInt(r.Header.Get(signature.TimestampHeader), 10, 64)
+			assert.NoError(t, err)
+			assert.True(t, signature.Verify("partner-secret-0001", timestamp, body, r.Header.Get(signature.SignatureHeader)))
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(server.Close)
+		subscription := &entity.WebhookSubscription{ID: 1, URL: server.URL + "/webhooks", Secret: "partner-secret-0001"}
+
+		status, err := sender.Send(ctx, subscription, delivery)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+	})
+
+	t.Run("should fail on the responses out of the 2xx range", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		t.Cleanup(server.Close)
+		subscription := &entity.WebhookSubscription{ID: 1, URL: server.URL, Secret: "partner-secret-0001"}
+
+		status, err := sender.Send(ctx, subscription, delivery)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusGone, status)
+	})
+
+	t.Run("should send each attempt only once", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(server.Close)
+		subscription := &entity.WebhookSubscription{ID: 1, URL: server.URL, Secret: "partner-secret-0001"}
+
+		status, err := sender.Send(ctx, subscription, delivery)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, status)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("should fail without status when the subscriber is unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+		subscription := &entity.WebhookSubscription{ID: 1, URL: server.URL, Secret: "partner-secret-0001"}
+
+		status, err := sender.Send(ctx, subscription, delivery)
+
+		assert.Error(t, err)
+		assert.Zero(t, status)
+	})
+
+	t.Run("should not connect to the private addresses", func(t *testing.T) {
+		called := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			called = true
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		t.Cleanup(server.Close)
+		publicSender := service.NewWebhookSender(httpclient.NewWebhookClient(cfg, logger.NewLogger("")), false)
+		subscription := &entity.WebhookSubscription{ID: 1, URL: server.URL, Secret: "partner-secret-0001"}
+
+		status, err := publicSender.Send(ctx, subscription, delivery)
+
+		assert.Error(t, err)
+		assert.Zero(t, status)
+		assert.False(t, called)
+	})
+}